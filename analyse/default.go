@@ -0,0 +1,38 @@
+package analyse
+
+import (
+	"github.com/fumiama/jieba/dict"
+	"github.com/fumiama/jieba/posseg"
+)
+
+// NewDefaultTagExtracter creates a TagExtracter with the embedded default
+// dictionary and IDF dictionary, see package dict.
+func NewDefaultTagExtracter() (*TagExtracter, error) {
+	t := &TagExtracter{}
+	file, err := dict.Open(dict.Default)
+	if err != nil {
+		return nil, err
+	}
+	err = t.LoadDictionary(file)
+	file.Close()
+	if err != nil {
+		return nil, err
+	}
+	file, err = dict.Open(dict.Idf)
+	if err != nil {
+		return nil, err
+	}
+	err = t.LoadIdf(file)
+	file.Close()
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// NewDefaultTextRanker creates a TextRanker with the embedded default
+// dictionary, see package dict.
+func NewDefaultTextRanker() (*TextRanker, error) {
+	seg, err := posseg.NewDefault()
+	return (*TextRanker)(seg), err
+}
@@ -1,13 +1,20 @@
 package analyse
 
 import (
+	"context"
+	"errors"
 	"io"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/fumiama/jieba/dictionary"
+	"github.com/fumiama/jieba/util"
 )
 
+// ErrEmptyIdf is returned when a reloaded IDF dictionary has no words.
+var ErrEmptyIdf = errors.New("analyse: empty idf dictionary")
+
 // Idf represents a thread-safe dictionary for all words with their
 // IDFs(Inverse Document Frequency).
 type Idf struct {
@@ -35,7 +42,9 @@ func (i *Idf) Load(tokens ...dictionary.Token) {
 		i.freqs = append(i.freqs, token.Frequency())
 	}
 	sort.Float64s(i.freqs)
-	i.median = i.freqs[len(i.freqs)/2]
+	if len(i.freqs) > 0 {
+		i.median = i.freqs[len(i.freqs)/2]
+	}
 	i.Unlock()
 }
 
@@ -47,6 +56,25 @@ func (i *Idf) loadDictionaryAt(fileName string) error {
 	return dictionary.LoadDictionaryAt(i, fileName)
 }
 
+// SaveCompiled writes all words with their IDFs to w in compiled format.
+func (i *Idf) SaveCompiled(w io.Writer) error {
+	i.RLock()
+	total := 0.0
+	tokens := make([]dictionary.Token, 0, len(i.freqMap))
+	for word, freq := range i.freqMap {
+		tokens = append(tokens, dictionary.NewToken(word, freq, ""))
+		total += freq
+	}
+	i.RUnlock()
+	sort.Slice(tokens, func(a, b int) bool { return tokens[a].Text() < tokens[b].Text() })
+	return dictionary.WriteCompiled(w, total, tokens)
+}
+
+// LoadCompiled loads words with their IDFs written by SaveCompiled.
+func (i *Idf) LoadCompiled(file io.Reader) error {
+	return dictionary.LoadCompiled(i, file)
+}
+
 // Frequency returns the IDF of given word.
 func (i *Idf) Frequency(key string) (float64, bool) {
 	i.RLock()
@@ -55,6 +83,53 @@ func (i *Idf) Frequency(key string) (float64, bool) {
 	return freq, ok
 }
 
+// Median returns the median IDF of all words.
+func (i *Idf) Median() float64 {
+	i.RLock()
+	median := i.median
+	i.RUnlock()
+	return median
+}
+
+// replace replaces the words of i with those of n if n is not empty.
+func (i *Idf) replace(n *Idf) error {
+	if len(n.freqs) == 0 {
+		return ErrEmptyIdf
+	}
+	i.Lock()
+	i.median, i.freqMap, i.freqs = n.median, n.freqMap, n.freqs
+	i.Unlock()
+	return nil
+}
+
+// Reload loads a new IDF dictionary from file and replaces all words of i
+// with it. If loading fails, i is kept unchanged.
+func (i *Idf) Reload(file io.Reader) error {
+	n := NewIdf()
+	if err := n.loadDictionary(file); err != nil {
+		return err
+	}
+	return i.replace(n)
+}
+
+// ReloadAt is like Reload but reads the IDF dictionary from the given file name.
+func (i *Idf) ReloadAt(fileName string) error {
+	n := NewIdf()
+	if err := n.loadDictionaryAt(fileName); err != nil {
+		return err
+	}
+	return i.replace(n)
+}
+
+// Watch polls the IDF dictionary file every interval, and calls ReloadAt
+// whenever it changes. Reload errors are reported to onError, which may be
+// nil. It blocks until ctx is done and returns ctx.Err().
+func (i *Idf) Watch(ctx context.Context, interval time.Duration, onError func(error), fileName string) error {
+	return util.Watch(ctx, interval, []string{fileName}, func() error {
+		return i.ReloadAt(fileName)
+	}, onError)
+}
+
 // NewIdf creates a new Idf instance.
 func NewIdf() *Idf {
 	return &Idf{freqMap: make(map[string]float64, 256), freqs: make([]float64, 0, 256)}
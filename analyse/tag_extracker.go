@@ -8,6 +8,7 @@ import (
 	"unicode/utf8"
 
 	jieba "github.com/fumiama/jieba"
+	"github.com/fumiama/jieba/dictionary"
 )
 
 // Segment represents a word with weight.
@@ -52,6 +53,12 @@ type TagExtracter struct {
 	stopWord *StopWord
 }
 
+// NewTagExtracterShared creates a TagExtracter using the shared dictionary,
+// the IDF dictionary should be loaded by LoadIdf before extracting tags.
+func NewTagExtracterShared(shared *dictionary.Shared) *TagExtracter {
+	return &TagExtracter{seg: jieba.NewSegmenter(shared), stopWord: NewStopWord()}
+}
+
 // LoadDictionary reads the given filename and create a new dictionary.
 func (t *TagExtracter) LoadDictionary(file io.Reader) (err error) {
 	t.stopWord = NewStopWord()
@@ -78,6 +85,23 @@ func (t *TagExtracter) LoadIdfAt(fileName string) error {
 	return t.idf.loadDictionaryAt(fileName)
 }
 
+// LoadIdfCompiled reads the given compiled file and create a new Idf dictionary.
+func (t *TagExtracter) LoadIdfCompiled(file io.Reader) error {
+	t.idf = NewIdf()
+	return t.idf.LoadCompiled(file)
+}
+
+// Segmenter returns the Segmenter used to cut sentences, which can be
+// watched for dictionary changes.
+func (t *TagExtracter) Segmenter() *jieba.Segmenter {
+	return t.seg
+}
+
+// Idf returns the Idf dictionary, which can be watched for changes.
+func (t *TagExtracter) Idf() *Idf {
+	return t.idf
+}
+
 // LoadStopWords reads the given file and create a new StopWord dictionary.
 func (t *TagExtracter) LoadStopWords(file io.Reader) error {
 	t.stopWord = NewStopWord()
@@ -119,7 +143,7 @@ func (t *TagExtracter) ExtractTags(sentence string, topK int) (tags Segments) {
 		if freq, ok := t.idf.Frequency(k); ok {
 			ws[i].weight = freq * float64(v) / float64(total)
 		} else {
-			ws[i].weight = t.idf.median * float64(v) / float64(total)
+			ws[i].weight = t.idf.Median() * float64(v) / float64(total)
 		}
 		i++
 	}
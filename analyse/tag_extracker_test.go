@@ -1,7 +1,9 @@
 package analyse
 
 import (
+	"bytes"
 	"math"
+	"strings"
 	"testing"
 )
 
@@ -298,3 +300,49 @@ func TestExtractTagsWithStopWordsFile(t *testing.T) {
 		}
 	}
 }
+
+func TestIdfCompiled(t *testing.T) {
+	idf := NewIdf()
+	idf.loadDictionary(strings.NewReader("吉林 8.5\n欧亚 10.2\n置业 9.1\n"))
+	var buf bytes.Buffer
+	if err := idf.SaveCompiled(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var te TagExtracter
+	if err := te.LoadIdfCompiled(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if te.idf.median != idf.median || len(te.idf.freqMap) != 3 {
+		t.Fatalf("got %v, expected %v", te.idf.freqMap, idf.freqMap)
+	}
+	for word, freq := range idf.freqMap {
+		if f, ok := te.idf.Frequency(word); !ok || f != freq {
+			t.Fatalf("%s: got %f, expected %f", word, f, freq)
+		}
+	}
+}
+
+func TestIdfReload(t *testing.T) {
+	idf := NewIdf()
+	if err := idf.Reload(strings.NewReader("有限 1.5\n公司 3\n永和 2\n")); err != nil {
+		t.Fatal(err)
+	}
+	if median := idf.Median(); median != 2 {
+		t.Fatalf("got median %f, expected 2", median)
+	}
+	if err := idf.Reload(strings.NewReader("有限 x\n")); err == nil {
+		t.Fatal("expected error for invalid IDF")
+	}
+	if err := idf.Reload(strings.NewReader("")); err != ErrEmptyIdf {
+		t.Fatalf("got %v, expected ErrEmptyIdf", err)
+	}
+	if freq, ok := idf.Frequency("永和"); !ok || freq != 2 {
+		t.Fatalf("got %f %v, expected 2 true", freq, ok)
+	}
+	if err := idf.Reload(strings.NewReader("服装 4\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := idf.Frequency("永和"); ok {
+		t.Fatal("words of previous IDF dictionary should be removed")
+	}
+}
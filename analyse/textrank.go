@@ -6,6 +6,7 @@ import (
 	"math"
 	"sort"
 
+	"github.com/fumiama/jieba/dictionary"
 	"github.com/fumiama/jieba/posseg"
 	"github.com/fumiama/jieba/util/helper"
 )
@@ -181,3 +182,8 @@ func NewTextRankerAt(file string) (*TextRanker, error) {
 	seg, err := posseg.LoadDictionaryAt(file)
 	return (*TextRanker)(seg), err
 }
+
+// NewTextRankerShared creates a new TextRanker using the shared dictionary.
+func NewTextRankerShared(shared *dictionary.Shared) *TextRanker {
+	return (*TextRanker)(posseg.NewSegmenter(shared))
+}
@@ -0,0 +1,45 @@
+package jieba
+
+import (
+	"context"
+
+	"github.com/fumiama/jieba/util"
+)
+
+// BatchOptions controls how CutBatch cuts sentences.
+type BatchOptions struct {
+	// Workers is the number of goroutines to use,
+	// runtime.NumCPU() is used if it is not positive.
+	Workers int
+	// Mode is the cutting mode.
+	Mode Mode
+	// HMM controls whether to use the Hidden Markov Model,
+	// it is ignored in FullMode.
+	HMM bool
+}
+
+// CutBatch cuts sentences concurrently, and the words of sentences[i]
+// are stored in the i-th element of the result.
+//
+// It stops as soon as ctx is done and returns ctx.Err(), in which case
+// the sentences not yet cut are left nil in the result.
+func (seg *Segmenter) CutBatch(ctx context.Context, sentences []string, opts BatchOptions) ([][]string, error) {
+	var cut func(sentence string) []string
+	switch opts.Mode {
+	case FullMode:
+		cut = seg.CutAll
+	case SearchMode:
+		cut = func(sentence string) []string {
+			return seg.CutForSearch(sentence, opts.HMM)
+		}
+	default:
+		cut = func(sentence string) []string {
+			return seg.Cut(sentence, opts.HMM)
+		}
+	}
+	results := make([][]string, len(sentences))
+	err := util.Parallel(ctx, len(sentences), opts.Workers, func(i int) {
+		results[i] = cut(sentences[i])
+	})
+	return results, err
+}
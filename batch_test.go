@@ -0,0 +1,41 @@
+package jieba
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestCutBatch(t *testing.T) {
+	seg := loadTestSegmenter(t, testDict)
+	sentences := make([]string, 100)
+	for i := range sentences {
+		sentences[i] = strings.Repeat("永和服装饰品有限公司，", i%7)
+	}
+	for _, mode := range []Mode{DefaultMode, SearchMode, FullMode} {
+		results, err := seg.CutBatch(context.Background(), sentences, BatchOptions{Workers: 4, Mode: mode, HMM: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, sentence := range sentences {
+			var expected []string
+			switch mode {
+			case FullMode:
+				expected = seg.CutAll(sentence)
+			case SearchMode:
+				expected = seg.CutForSearch(sentence, true)
+			default:
+				expected = seg.Cut(sentence, true)
+			}
+			if strings.Join(results[i], "/") != strings.Join(expected, "/") {
+				t.Fatalf("mode %d: got %v, expected %v", mode, results[i], expected)
+			}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := seg.CutBatch(ctx, sentences, BatchOptions{}); err != context.Canceled {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,67 @@
+package jieba
+
+import (
+	"regexp"
+
+	"github.com/fumiama/jieba/util"
+)
+
+/*
+BlockPatterns are the regular expressions deciding which characters are
+cut by dictionary. A sentence is split into blocks by Han, and only the
+blocks matched by Han are cut by dictionary, so a dictionary word can
+never match if it contains a character out of Han. The other blocks are
+split by Skip, and every rune between the separators is a single word.
+
+HanAll and SkipAll are the counterparts used in full mode, but the
+separators matched by SkipAll are dropped.
+*/
+type BlockPatterns struct {
+	Han, Skip       *regexp.Regexp
+	HanAll, SkipAll *regexp.Regexp
+}
+
+var (
+	// DefaultBlockPatterns are the block patterns used by default, which
+	// let Chinese characters, letters, digits and "+#&._" reach dictionary.
+	DefaultBlockPatterns = BlockPatterns{
+		Han:     reHanDefault,
+		Skip:    reSkipDefault,
+		HanAll:  reHanCutAll,
+		SkipAll: reSkipCutAll,
+	}
+	// ExtendedBlockPatterns also let spaces, hyphens, "%" and full-width
+	// letters, digits, "+" and "-" reach dictionary, so that words like
+	// "Windows 11", "C++ 模板" and "Wi-Fi" can match. Line breaks and tabs
+	// are still separators.
+	ExtendedBlockPatterns = BlockPatterns{
+		Han:     util.ExtendedHanPattern,
+		Skip:    reSkipDefault,
+		HanAll:  util.ExtendedHanPattern,
+		SkipAll: reSkipCutAll,
+	}
+)
+
+// SetBlockPatterns sets the block patterns of Segmenter, the missing
+// patterns are taken from DefaultBlockPatterns. It should not be called
+// concurrently with cutting.
+func (seg *Segmenter) SetBlockPatterns(patterns BlockPatterns) {
+	if patterns.Han == nil {
+		patterns.Han = DefaultBlockPatterns.Han
+	}
+	if patterns.Skip == nil {
+		patterns.Skip = DefaultBlockPatterns.Skip
+	}
+	if patterns.HanAll == nil {
+		patterns.HanAll = DefaultBlockPatterns.HanAll
+	}
+	if patterns.SkipAll == nil {
+		patterns.SkipAll = DefaultBlockPatterns.SkipAll
+	}
+	seg.blocks = patterns
+}
+
+// BlockPatterns returns the block patterns of Segmenter.
+func (seg *Segmenter) BlockPatterns() BlockPatterns {
+	return seg.blocks
+}
@@ -0,0 +1,33 @@
+package jieba
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBlockPatterns(t *testing.T) {
+	seg := loadTestSegmenter(t, "Wi-Fi 100\n升级 100\n连接 100\n")
+	seg.AddWord("Windows 11", 100)
+	seg.AddWord("C++ 模板", 100)
+	sentence := "升级Windows 11，连接Wi-Fi，C++ 模板"
+	expected := []string{"升级", "Windows", " ", "11", "，", "连接", "Wi", "-", "Fi", "，", "C", "+", "+", " ", "模", "板"}
+	if result := seg.Cut(sentence, false); strings.Join(result, "/") != strings.Join(expected, "/") {
+		t.Fatalf("got %v, expected %v", result, expected)
+	}
+	seg.SetBlockPatterns(ExtendedBlockPatterns)
+	expected = []string{"升级", "Windows 11", "，", "连接", "Wi-Fi", "，", "C++ 模板"}
+	if result := seg.Cut(sentence, false); strings.Join(result, "/") != strings.Join(expected, "/") {
+		t.Fatalf("got %v, expected %v", result, expected)
+	}
+	if result := seg.Cut(sentence, true); strings.Join(result, "/") != strings.Join(expected, "/") {
+		t.Fatalf("got %v, expected %v", result, expected)
+	}
+	expected = []string{"升级", "Windows 11", "\n", "连接", "Wi-Fi"}
+	if result := seg.Cut("升级Windows 11\n连接Wi-Fi", false); strings.Join(result, "/") != strings.Join(expected, "/") {
+		t.Fatalf("got %v, expected %v", result, expected)
+	}
+	seg.SetBlockPatterns(BlockPatterns{})
+	if seg.BlockPatterns() != DefaultBlockPatterns {
+		t.Fatalf("missing block patterns should be the default ones")
+	}
+}
@@ -0,0 +1,33 @@
+package jieba
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestCompiled(t *testing.T) {
+	seg := loadTestSegmenter(t, testDict)
+	var buf bytes.Buffer
+	if err := seg.SaveCompiled(&buf); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadCompiled(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Dictionary().Total() != seg.Dictionary().Total() || loaded.Dictionary().LogTotal() != seg.Dictionary().LogTotal() {
+		t.Fatalf("got total %f, expected %f", loaded.Dictionary().Total(), seg.Dictionary().Total())
+	}
+	for _, word := range []string{"永和", "有限", "有限公司", "有限公", "公"} {
+		f1, ok1 := seg.Frequency(word)
+		f2, ok2 := loaded.Frequency(word)
+		if f1 != f2 || ok1 != ok2 {
+			t.Fatalf("%s: got %f %v, expected %f %v", word, f2, ok2, f1, ok1)
+		}
+	}
+	sentence := "永和服装饰品有限公司"
+	if strings.Join(loaded.Cut(sentence, true), "/") != strings.Join(seg.Cut(sentence, true), "/") {
+		t.Fatal(loaded.Cut(sentence, true))
+	}
+}
@@ -0,0 +1,213 @@
+package jieba
+
+import (
+	"context"
+	"regexp"
+	"unicode"
+
+	"github.com/fumiama/jieba/dictionary"
+	"github.com/fumiama/jieba/finalseg"
+	"github.com/fumiama/jieba/util"
+)
+
+/*
+Cutter is a Segmenter configured once by options, so that the same
+settings apply to every cut without being passed at every call site.
+
+A Cutter owns its Segmenter, while the dictionary is shared, so every
+change to the dictionary applies to the Cutter too.
+*/
+type Cutter struct {
+	seg             *Segmenter
+	hmm             bool
+	mode            Mode
+	grams           []int
+	dropWhitespace  bool
+	dropPunctuation bool
+}
+
+// Option configures a Cutter created by New.
+type Option func(c *Cutter)
+
+// WithHMM sets whether to use the Hidden Markov Model, which is true by default.
+func WithHMM(hmm bool) Option {
+	return func(c *Cutter) {
+		c.hmm = hmm
+	}
+}
+
+// WithMode sets the cutting mode, which is DefaultMode by default.
+func WithMode(mode Mode) Option {
+	return func(c *Cutter) {
+		c.mode = mode
+	}
+}
+
+// WithSearchGrams sets the lengths in runes of the grams of long words
+// added in SearchMode, which are 2 and 3 by default. The lengths less
+// than 2 are ignored.
+func WithSearchGrams(lengths ...int) Option {
+	return func(c *Cutter) {
+		c.grams = make([]int, 0, len(lengths))
+		for _, n := range lengths {
+			if n >= 2 {
+				c.grams = append(c.grams, n)
+			}
+		}
+	}
+}
+
+// WithoutWhitespace drops the words consisting of whitespaces only.
+func WithoutWhitespace() Option {
+	return func(c *Cutter) {
+		c.dropWhitespace = true
+	}
+}
+
+// WithoutPunctuation drops the words consisting of punctuations only.
+func WithoutPunctuation() Option {
+	return func(c *Cutter) {
+		c.dropPunctuation = true
+	}
+}
+
+// WithProtectedPatterns sets the protected patterns, see Segmenter.SetProtectedPatterns.
+func WithProtectedPatterns(patterns ...*regexp.Regexp) Option {
+	return func(c *Cutter) {
+		c.seg.SetProtectedPatterns(patterns...)
+	}
+}
+
+// WithNormalization enables or disables the normalization, see Segmenter.SetNormalization.
+func WithNormalization(enabled bool) Option {
+	return func(c *Cutter) {
+		c.seg.SetNormalization(enabled)
+	}
+}
+
+// WithBlockPatterns sets the block patterns, see Segmenter.SetBlockPatterns.
+func WithBlockPatterns(patterns BlockPatterns) Option {
+	return func(c *Cutter) {
+		c.seg.SetBlockPatterns(patterns)
+	}
+}
+
+// WithHMMModel sets the Hidden Markov Model, see Segmenter.SetHMMModel.
+func WithHMMModel(model *finalseg.Model) Option {
+	return func(c *Cutter) {
+		c.seg.SetHMMModel(model)
+	}
+}
+
+// WithLimits sets the limits of CutContext, see Segmenter.SetLimits.
+func WithLimits(limits Limits) Option {
+	return func(c *Cutter) {
+		c.seg.SetLimits(limits)
+	}
+}
+
+// New creates a Cutter using the shared dictionary with options, which
+// cuts in DefaultMode with the Hidden Markov Model by default.
+func New(shared *dictionary.Shared, opts ...Option) *Cutter {
+	c := &Cutter{
+		seg:   NewSegmenter(shared),
+		hmm:   true,
+		grams: searchGrams,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Segmenter returns the Segmenter of Cutter, whose settings are the
+// settings of Cutter.
+func (c *Cutter) Segmenter() *Segmenter {
+	return c.seg
+}
+
+// Cut cuts sentence into words using the configured mode.
+func (c *Cutter) Cut(sentence string) []string {
+	d := c.seg.dictionary()
+	var words []string
+	switch c.mode {
+	case FullMode:
+		words = c.seg.CutAll(sentence)
+	case SearchMode:
+		words = c.seg.cut(nil, d, sentence, c.hmm, nil)
+		words = c.seg.appendSearch(make([]string, 0, len(words)), d, words, c.grams)
+	default:
+		words = c.seg.cut(nil, d, sentence, c.hmm, nil)
+	}
+	return c.filter(words)
+}
+
+/*
+CutContext is like Cut, but it checks ctx between blocks and returns
+ctx.Err() once ctx is done, and returns a *LimitError if the sentence
+exceeds the configured limits, see Segmenter.CutContext.
+
+Only MaxInputLength is checked in FullMode, which never uses the Hidden
+Markov Model.
+*/
+func (c *Cutter) CutContext(ctx context.Context, sentence string) ([]string, error) {
+	d := c.seg.dictionary()
+	words, err := util.Limit(ctx, c.seg.limits, len(sentence), func(l *util.Limiter) []string {
+		if c.mode == FullMode {
+			return c.seg.CutAll(sentence)
+		}
+		return c.seg.cut(nil, d, sentence, c.hmm, l)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if c.mode == SearchMode {
+		words = c.seg.appendSearch(make([]string, 0, len(words)), d, words, c.grams)
+	}
+	return c.filter(words), nil
+}
+
+// Tokenize cuts sentence into tokens with their offsets in the sentence
+// using the configured mode, see Segmenter.Tokenize.
+func (c *Cutter) Tokenize(sentence string) []Token {
+	var drop func(word string) bool
+	if c.dropWhitespace || c.dropPunctuation {
+		drop = c.drop
+	}
+	if c.mode == FullMode {
+		return c.seg.tokenizeAll(sentence, drop)
+	}
+	d := c.seg.dictionary()
+	return c.seg.tokenize(d, c.seg.cut(nil, d, sentence, c.hmm, nil), c.mode, c.grams, drop)
+}
+
+// filter removes the dropped words in place.
+func (c *Cutter) filter(words []string) []string {
+	if !c.dropWhitespace && !c.dropPunctuation {
+		return words
+	}
+	result := words[:0]
+	for _, word := range words {
+		if !c.drop(word) {
+			result = append(result, word)
+		}
+	}
+	return result
+}
+
+// drop reports whether word should be dropped.
+func (c *Cutter) drop(word string) bool {
+	return c.dropWhitespace && only(word, unicode.IsSpace) ||
+		c.dropPunctuation && only(word, unicode.IsPunct)
+}
+
+// only reports whether every rune of word satisfies f, so the empty words
+// left by CutAll between the dropped words are dropped as well.
+func only(word string, f func(r rune) bool) bool {
+	for _, r := range word {
+		if !f(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,48 @@
+package jieba
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestCutter(t *testing.T) {
+	shared := loadTestSegmenter(t, testDict+"限公司 10\n").Shared()
+	sentence := "永和服装饰品有限公司， in 2022"
+	for _, test := range []struct {
+		opts  []Option
+		words []string
+	}{
+		{nil, []string{"永和", "服装", "饰品", "有限公司", "，", " ", "in", " ", "2022"}},
+		{[]Option{WithoutWhitespace(), WithoutPunctuation()}, []string{"永和", "服装", "饰品", "有限公司", "in", "2022"}},
+		{[]Option{WithMode(SearchMode), WithoutWhitespace()}, []string{"永和", "服装", "饰品", "有限", "公司", "限公司", "有限公司", "，", "in", "2022"}},
+		{[]Option{WithMode(SearchMode), WithSearchGrams(2), WithoutWhitespace()}, []string{"永和", "服装", "饰品", "有限", "公司", "有限公司", "，", "in", "2022"}},
+		{[]Option{WithMode(SearchMode), WithSearchGrams(-1, 0, 1, 2), WithoutWhitespace()}, []string{"永和", "服装", "饰品", "有限", "公司", "有限公司", "，", "in", "2022"}},
+		{[]Option{WithMode(FullMode), WithoutWhitespace()}, []string{"永和", "服装", "饰品", "有限", "有限公司", "限公司", "公司", "in", "2022"}},
+	} {
+		c := New(shared, append(test.opts, WithHMM(false))...)
+		if result := c.Cut(sentence); strings.Join(result, "/") != strings.Join(test.words, "/") {
+			t.Fatalf("got %v, expected %v", result, test.words)
+		}
+		result, err := c.CutContext(context.Background(), sentence)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(result, "/") != strings.Join(test.words, "/") {
+			t.Fatalf("got %v, expected %v", result, test.words)
+		}
+		tokens := c.Tokenize(sentence)
+		if len(tokens) != len(test.words) {
+			t.Fatalf("got %v, expected %v", tokens, test.words)
+		}
+		for i, token := range tokens {
+			if token.Text() != test.words[i] || sentence[token.Start():token.End()] != token.Text() || token.Position() != i+1 {
+				t.Fatalf("got %s at [%d,%d) position %d, expected %s", token.Text(), token.Start(), token.End(), token.Position(), test.words[i])
+			}
+		}
+	}
+	c := New(shared, WithLimits(Limits{MaxInputLength: 8}))
+	if _, err := c.CutContext(context.Background(), sentence); err == nil {
+		t.Fatal("expected LimitError")
+	}
+}
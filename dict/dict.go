@@ -0,0 +1,46 @@
+/*
+Package dict embeds the default dictionaries of jieba, so that the
+segmenters work out of the box without any file on disk.
+
+The dictionaries are downloaded from jieba into the data directory by
+running go generate in this package, and the files there are embedded.
+Open returns ErrNotEmbedded for a dictionary not downloaded.
+
+The dictionaries are about 20MB in total, build with tag jieba_nodict
+to leave them out of the binary, then Open always returns ErrNotEmbedded.
+*/
+package dict
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+)
+
+// Names of the embedded dictionaries.
+const (
+	// Default is the standard dictionary of jieba.
+	Default = "dict.txt"
+	// Small is a smaller dictionary using less memory.
+	Small = "dict.txt.small"
+	// Big is a bigger dictionary with better support for traditional Chinese.
+	Big = "dict.txt.big"
+	// Idf is the IDF dictionary used by keywords extraction.
+	Idf = "idf.txt"
+)
+
+//go:generate go run ./internal/fetch
+
+// ErrNotEmbedded is returned by Open if the dictionary is not embedded.
+var ErrNotEmbedded = errors.New("dict: dictionary not embedded")
+
+// Open opens the embedded dictionary of given name.
+func Open(name string) (io.ReadCloser, error) {
+	f, err := files.Open(path.Join("data", name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNotEmbedded, name)
+	}
+	return f, err
+}
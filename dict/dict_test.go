@@ -0,0 +1,27 @@
+package dict
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestOpen(t *testing.T) {
+	for _, name := range []string{Default, Small, Big, Idf} {
+		f, err := Open(name)
+		if errors.Is(err, ErrNotEmbedded) {
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(f)
+		f.Close()
+		if err != nil || len(data) == 0 {
+			t.Fatalf("failed to read %s: %v", name, err)
+		}
+	}
+	if _, err := Open("foobar.txt"); !errors.Is(err, ErrNotEmbedded) {
+		t.Fatal(err)
+	}
+}
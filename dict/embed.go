@@ -0,0 +1,8 @@
+//go:build !jieba_nodict
+
+package dict
+
+import "embed"
+
+//go:embed data
+var files embed.FS
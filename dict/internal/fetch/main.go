@@ -0,0 +1,87 @@
+// Command fetch downloads the default dictionaries of jieba into data,
+// which are embedded by the package. It is run by go generate in the
+// package directory. The dictionaries are released by jieba under the
+// MIT license.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// files maps the embedded names to their paths in the jieba repository.
+var files = map[string]string{
+	"dict.txt":       "jieba/dict.txt",
+	"dict.txt.small": "extra_dict/dict.txt.small",
+	"dict.txt.big":   "extra_dict/dict.txt.big",
+	"idf.txt":        "jieba/analyse/idf.txt",
+}
+
+func main() {
+	ref := flag.String("ref", "master", "the git ref of jieba to fetch from")
+	dir := flag.String("dir", "data", "the directory to write the dictionaries to")
+	flag.Parse()
+	for name, path := range files {
+		url := "https://raw.githubusercontent.com/fxsjy/jieba/" + *ref + "/" + path
+		data, err := fetch(url)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err = check(data); err != nil {
+			log.Fatalf("%s: %v", url, err)
+		}
+		// write to a hidden temporary file first, which is never embedded,
+		// so a broken download never replaces a good dictionary
+		file, tmp := filepath.Join(*dir, name), filepath.Join(*dir, "."+name+".tmp")
+		if err = os.WriteFile(tmp, data, 0644); err != nil {
+			log.Fatal(err)
+		}
+		if err = os.Rename(tmp, file); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("fetched %s, %d bytes", file, len(data))
+	}
+}
+
+func fetch(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// check reports an error unless every line of data is a word followed by
+// its frequency or IDF, as in the dictionaries of jieba.
+func check(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("empty dictionary")
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 2 {
+			return fmt.Errorf("line %d: missing frequency", line)
+		}
+		if _, err := strconv.ParseFloat(fields[1], 64); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	return scanner.Err()
+}
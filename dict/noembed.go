@@ -0,0 +1,7 @@
+//go:build jieba_nodict
+
+package dict
+
+import "embed"
+
+var files embed.FS
@@ -0,0 +1,278 @@
+package dictionary
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"unicode/utf8"
+
+	"github.com/fumiama/jieba/util"
+)
+
+/*
+The compiled format is a binary encoding of a dictionary which can be loaded
+much faster than the text format. All integers are little endian, counts and
+lengths are uvarints, and a string is its length and bytes:
+
+	magic     4 bytes "JBDC"
+	version   uint16, CompiledVersion or CompiledTrieVersion
+	body      the tokens or the trie, by version
+	checksum  uint32, CRC-32 (IEEE) of all bytes above
+
+The body of CompiledVersion, written by WriteCompiled, is a list of tokens:
+
+	count     the number of tokens
+	total     float64, the total frequency of the dictionary
+	tokens    count times of
+	    text      string
+	    frequency float64
+	    pos       string
+
+The body of CompiledTrieVersion, written by Dictionary.SaveCompiled, is the
+nodes of its Trie in breadth-first order from TrieRoot, so the children of
+every node are the nodes following the children of the nodes before it:
+
+	total     float64, the total frequency of the dictionary
+	count     the number of nodes
+	nodes     count times of
+	    flag      uvarint, 0 for a prefix, 1 for a word and 2 for a word with POS
+	    frequency float64 of a word
+	    pos       string of a word with POS
+	    edges     count, and count times of the uvarint difference of the
+	              char from the previous char, which is -1 for the first
+
+A Dictionary loads the trie as is, without inserting any word.
+*/
+const (
+	compiledMagic = "JBDC"
+	// CompiledVersion is the version of compiled format written by WriteCompiled.
+	CompiledVersion = 1
+	// CompiledTrieVersion is the version of compiled format written by
+	// Dictionary.SaveCompiled.
+	CompiledTrieVersion = 2
+)
+
+var (
+	// ErrNotCompiled is returned when reading a file not in compiled format.
+	ErrNotCompiled = errors.New("dictionary: not a compiled dictionary")
+	// ErrCorrupted is returned when a compiled dictionary is corrupted.
+	ErrCorrupted = errors.New("dictionary: compiled dictionary is corrupted")
+)
+
+// writeCompiled writes the body in the given version to w with the
+// header and the checksum.
+func writeCompiled(w io.Writer, version uint16, body func(cw *util.CompactWriter)) error {
+	h := crc32.NewIEEE()
+	cw := util.NewCompactWriter(io.MultiWriter(w, h))
+	cw.WriteString(compiledMagic)
+	var buf [4]byte
+	binary.LittleEndian.PutUint16(buf[:2], version)
+	cw.Write(buf[:2])
+	body(cw)
+	if err := cw.Flush(); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(buf[:], h.Sum32())
+	_, err := w.Write(buf[:])
+	return err
+}
+
+// readCompiled reads all data from r, and returns the version and a
+// reader of the body checked by the checksum.
+func readCompiled(r io.Reader) (uint16, *util.CompactReader, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(data) < len(compiledMagic)+2 || string(data[:len(compiledMagic)]) != compiledMagic {
+		return 0, nil, ErrNotCompiled
+	}
+	version := binary.LittleEndian.Uint16(data[len(compiledMagic):])
+	if version != CompiledVersion && version != CompiledTrieVersion {
+		return 0, nil, fmt.Errorf("dictionary: unsupported compiled dictionary version %d", version)
+	}
+	n := len(data) - 4
+	if n < len(compiledMagic)+2 || binary.LittleEndian.Uint32(data[n:]) != crc32.ChecksumIEEE(data[:n]) {
+		return 0, nil, ErrCorrupted
+	}
+	return version, util.NewCompactReader(data[len(compiledMagic)+2:n], ErrCorrupted), nil
+}
+
+func putString(cw *util.CompactWriter, s string) {
+	cw.Uvarint(uint64(len(s)))
+	cw.WriteString(s)
+}
+
+func getString(r *util.CompactReader) string {
+	return string(r.Bytes(r.Count(1)))
+}
+
+// WriteCompiled writes tokens and the total frequency to w in compiled format.
+func WriteCompiled(w io.Writer, total float64, tokens []Token) error {
+	return writeCompiled(w, CompiledVersion, func(cw *util.CompactWriter) {
+		cw.Uvarint(uint64(len(tokens)))
+		cw.Float(total)
+		for _, token := range tokens {
+			putString(cw, token.text)
+			cw.Float(token.frequency)
+			putString(cw, token.pos)
+		}
+	})
+}
+
+// writeTrie writes the body of CompiledTrieVersion of d.
+func (d *Dictionary) writeTrie(cw *util.CompactWriter) {
+	t := d.trie
+	count := len(t.nodes) - len(t.free)
+	cw.Float(d.total)
+	cw.Uvarint(uint64(count))
+	order := make([]int32, 1, count)
+	for i := 0; i < len(order); i++ {
+		n := &t.nodes[order[i]]
+		switch {
+		case !n.word:
+			cw.Uvarint(0)
+		case n.pos == "":
+			cw.Uvarint(1)
+			cw.Float(n.frequency)
+		default:
+			cw.Uvarint(2)
+			cw.Float(n.frequency)
+			putString(cw, n.pos)
+		}
+		cw.Uvarint(uint64(len(n.edges)))
+		prev := rune(-1)
+		for _, edge := range n.edges {
+			cw.Uvarint(uint64(edge.char - prev))
+			prev = edge.char
+			order = append(order, edge.node)
+		}
+	}
+}
+
+// readTrie creates a Dictionary of the body of CompiledTrieVersion.
+func readTrie(r *util.CompactReader) *Dictionary {
+	d := &Dictionary{total: r.Float()}
+	count := r.Count(2)
+	if count == 0 {
+		r.Fail()
+		return nil
+	}
+	// a tree of count nodes has count-1 edges, which share one array
+	t := &Trie{nodes: make([]trieNode, count)}
+	edges := make([]trieEdge, count-1)
+	interned := make(map[string]string)
+	next := 1
+	for i := range t.nodes {
+		n := &t.nodes[i]
+		switch flag := r.Uvarint(); flag {
+		case 0:
+		case 1, 2:
+			n.word, n.frequency = true, r.Float()
+			t.words++
+			if flag == 1 {
+				break
+			}
+			b := r.Bytes(r.Count(1))
+			if n.pos = interned[string(b)]; n.pos == "" {
+				n.pos = string(b)
+				interned[n.pos] = n.pos
+			}
+		default:
+			r.Fail()
+			return nil
+		}
+		k := r.Count(1)
+		if next+k > count {
+			r.Fail()
+			return nil
+		}
+		n.edges = edges[next-1 : next-1+k : next-1+k]
+		prev := rune(-1)
+		for j := range n.edges {
+			delta := r.Uvarint()
+			if delta == 0 || delta > uint64(utf8.MaxRune-prev) {
+				r.Fail()
+				return nil
+			}
+			prev += rune(delta)
+			n.edges[j] = trieEdge{char: prev, node: int32(next)}
+			next++
+		}
+	}
+	if next != count {
+		r.Fail()
+		return nil
+	}
+	d.trie = t
+	return d
+}
+
+// readTokens reads the body of CompiledVersion.
+func readTokens(r *util.CompactReader) (float64, []Token) {
+	tokens := make([]Token, r.Count(10))
+	total := r.Float()
+	for i := range tokens {
+		tokens[i].text = getString(r)
+		tokens[i].frequency = r.Float()
+		tokens[i].pos = getString(r)
+	}
+	return total, tokens
+}
+
+// readDictionary creates a Dictionary of the tokens or the trie in
+// compiled format, with the total frequency stored in it.
+func readDictionary(r io.Reader) (*Dictionary, error) {
+	version, cr, err := readCompiled(r)
+	if err != nil {
+		return nil, err
+	}
+	var d *Dictionary
+	if version == CompiledTrieVersion {
+		d = readTrie(cr)
+	} else {
+		total, tokens := readTokens(cr)
+		d = New()
+		for _, token := range tokens {
+			d.addToken(token)
+		}
+		d.total = total
+	}
+	if err := cr.End(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// ReadCompiled reads tokens and the total frequency from r in compiled
+// format of any version.
+func ReadCompiled(r io.Reader) (total float64, tokens []Token, err error) {
+	version, cr, err := readCompiled(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	if version == CompiledTrieVersion {
+		d := readTrie(cr)
+		if err := cr.End(); err != nil {
+			return 0, nil, err
+		}
+		return d.total, d.tokens(), nil
+	}
+	total, tokens = readTokens(cr)
+	if err := cr.End(); err != nil {
+		return 0, nil, err
+	}
+	return total, tokens, nil
+}
+
+// LoadCompiled reads the given compiled dictionary and passes all tokens to a DictLoader.
+func LoadCompiled(dl DictLoader, r io.Reader) error {
+	_, tokens, err := ReadCompiled(r)
+	if err != nil {
+		return err
+	}
+	dl.Load(tokens...)
+	return nil
+}
@@ -0,0 +1,167 @@
+package dictionary
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestCompiled(t *testing.T) {
+	tokens := []Token{{99, "好用", "a"}, {3.5, "云计算", ""}, {0, "韩玉赏鉴", "nz"}}
+	var buf bytes.Buffer
+	if err := WriteCompiled(&buf, 102.5, tokens); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	total, loaded, err := ReadCompiled(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 102.5 {
+		t.Fatalf("got total %f, expected 102.5", total)
+	}
+	if len(loaded) != len(tokens) {
+		t.Fatalf("got %v, expected %v", loaded, tokens)
+	}
+	for i := range tokens {
+		if loaded[i] != tokens[i] {
+			t.Fatalf("got %v, expected %v", loaded[i], tokens[i])
+		}
+	}
+
+	corrupted := append([]byte(nil), data...)
+	corrupted[len(corrupted)/2] ^= 0xff
+	if _, _, err := ReadCompiled(bytes.NewReader(corrupted)); err == nil {
+		t.Fatal("corrupted dictionary should not be loaded")
+	}
+	if _, _, err := ReadCompiled(bytes.NewReader(data[:len(data)-1])); err == nil {
+		t.Fatal("truncated dictionary should not be loaded")
+	}
+	if _, _, err := ReadCompiled(strings.NewReader("好用 99 a\n")); err != ErrNotCompiled {
+		t.Fatal(err)
+	}
+}
+
+func TestCompiledTrie(t *testing.T) {
+	d := New()
+	d.Load(NewToken("好用", 99, "a"), NewToken("云计算", 3.5, ""), NewToken("云", 2, "n"),
+		NewToken("韩玉赏鉴", 0, "nz"), NewToken("韩玉", 1, ""), NewToken("计算", 8, "v"))
+	d.DeleteToken("韩玉")
+	var buf bytes.Buffer
+	if err := d.SaveCompiled(&buf); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	loaded := New()
+	if err := loaded.LoadCompiled(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Total() != d.Total() || loaded.LogTotal() != d.LogTotal() {
+		t.Fatalf("got total %f, expected %f", loaded.Total(), d.Total())
+	}
+	tokens, expected := loaded.Tokens(), d.Tokens()
+	if len(tokens) != len(expected) {
+		t.Fatalf("got %v, expected %v", tokens, expected)
+	}
+	for i := range expected {
+		if tokens[i] != expected[i] {
+			t.Fatalf("got %v, expected %v", tokens[i], expected[i])
+		}
+	}
+	if pos, ok := loaded.Pos("云"); !ok || pos != "n" {
+		t.Fatalf("got POS %q of 云, expected n", pos)
+	}
+	if _, ok := loaded.Frequency("韩玉"); !ok {
+		t.Fatal("韩玉 should be a prefix")
+	}
+	loaded.AddToken(NewToken("韩玉", 1, ""))
+	loaded.AddToken(NewToken("云端", 1, ""))
+	if f, _ := loaded.Frequency("云端"); f != 1 {
+		t.Fatalf("got frequency %f of added 云端, expected 1", f)
+	}
+	if f, _ := loaded.Frequency("云计算"); f != 3.5 {
+		t.Fatalf("got frequency %f of 云计算, expected 3.5", f)
+	}
+
+	merged := New()
+	merged.Load(NewToken("机器", 5, "n"), NewToken("好用", 1, ""))
+	if err := merged.LoadCompiled(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	if f, _ := merged.Frequency("机器"); f != 5 {
+		t.Fatalf("got frequency %f of 机器, expected 5", f)
+	}
+	if f, _ := merged.Frequency("好用"); f != 99 {
+		t.Fatalf("got frequency %f of 好用, expected 99", f)
+	}
+	if total := d.Total() + 5; merged.Total() != total || merged.LogTotal() != math.Log(total) {
+		t.Fatalf("got total %f, expected %f", merged.Total(), total)
+	}
+
+	total, read, err := ReadCompiled(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != d.Total() || len(read) != len(expected) {
+		t.Fatalf("got %v, expected %v", read, expected)
+	}
+
+	for i := 6; i < len(data); i++ {
+		corrupted := append([]byte(nil), data...)
+		corrupted[i] ^= 0xff
+		if err := New().LoadCompiled(bytes.NewReader(corrupted)); err == nil {
+			t.Fatalf("dictionary corrupted at %d should not be loaded", i)
+		}
+	}
+	if err := New().LoadCompiled(bytes.NewReader(data[:len(data)-1])); err == nil {
+		t.Fatal("truncated dictionary should not be loaded")
+	}
+}
+
+// benchmarkDictionary returns a dictionary of n generated words in text
+// and compiled format.
+func benchmarkDictionary(b *testing.B, n int) ([]byte, []byte) {
+	r := rand.New(rand.NewSource(1))
+	d := New()
+	poss := []string{"n", "v", "a", "nr", "ns", ""}
+	for i := 0; i < n; i++ {
+		word := make([]rune, 2+r.Intn(3))
+		for j := range word {
+			word[j] = rune(0x4e00 + r.Intn(3000))
+		}
+		d.AddToken(NewToken(string(word), float64(1+r.Intn(10000)), poss[r.Intn(len(poss))]))
+	}
+	var text, compiled bytes.Buffer
+	if err := WriteDictionary(&text, d.Tokens()); err != nil {
+		b.Fatal(err)
+	}
+	if err := d.SaveCompiled(&compiled); err != nil {
+		b.Fatal(err)
+	}
+	return text.Bytes(), compiled.Bytes()
+}
+
+func BenchmarkLoadDictionary(b *testing.B) {
+	text, _ := benchmarkDictionary(b, 300000)
+	b.SetBytes(int64(len(text)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := LoadDictionary(New(), bytes.NewReader(text)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLoadCompiled(b *testing.B) {
+	_, compiled := benchmarkDictionary(b, 300000)
+	b.SetBytes(int64(len(compiled)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := New().LoadCompiled(bytes.NewReader(compiled)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -21,6 +21,7 @@ func loadDictionary(file io.Reader) (tokens []Token, err error) {
 	var token Token
 	var line string
 	var fields []string
+	// the frequency and POS missing in a line are kept from the previous line
 	for scanner.Scan() {
 		line = scanner.Text()
 		fields = strings.Split(line, " ")
@@ -1,6 +1,8 @@
 package dictionary
 
 import (
+	"bytes"
+	"strings"
 	"sync"
 	"testing"
 )
@@ -57,3 +59,30 @@ func TestAddToken(t *testing.T) {
 		t.Fatalf("Failed to add token, got pos %s, expected \"a\"", d.posMap["好用"])
 	}
 }
+
+func TestWriteDictionary(t *testing.T) {
+	tokens, err := loadDictionary(strings.NewReader("云计算 5\n好人 0\n李小福 2 nr\n创新办 3.5 i\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != 4 {
+		t.Fatalf("got %v, expected 4 tokens", tokens)
+	}
+	var buf bytes.Buffer
+	if err = WriteDictionary(&buf, tokens); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "云计算 5\n好人 0\n李小福 2 nr\n创新办 3.5 i\n" {
+		t.Fatal(buf.String())
+	}
+	buf.Reset()
+	if err = WriteDictionary(&buf, []Token{{2, "李小福", "nr"}, {5, "云计算", ""}}); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "云计算 5\n李小福 2 nr\n" {
+		t.Fatal(buf.String())
+	}
+	if err = WriteDictionary(&buf, []Token{{1, "a b", ""}}); err == nil {
+		t.Fatal("text with space should not be written")
+	}
+}
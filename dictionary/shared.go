@@ -0,0 +1,511 @@
+package dictionary
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fumiama/jieba/util"
+)
+
+// ErrEmptyDictionary is returned when a reloaded dictionary has no words
+// with positive frequency.
+var ErrEmptyDictionary = errors.New("dictionary: empty dictionary")
+
+/*
+A Dictionary represents a thread-safe dictionary of words with their
+frequencies and POS, which is used by jieba, posseg, analyse and
+tokenizers for word segmentation.
+*/
+type Dictionary struct {
+	sync.RWMutex
+	total, logTotal float64
+	trie            *Trie
+	normalized      *Dictionary // built by Normalized, nil after modified
+}
+
+// New creates an empty Dictionary.
+func New() *Dictionary {
+	return &Dictionary{trie: NewTrie()}
+}
+
+// Clone returns a deep copy of d, which can be modified without affecting d.
+func (d *Dictionary) Clone() *Dictionary {
+	d.RLock()
+	c := &Dictionary{
+		total:    d.total,
+		logTotal: d.logTotal,
+		trie:     d.trie.Clone(),
+	}
+	d.RUnlock()
+	return c
+}
+
+// Load loads all tokens
+func (d *Dictionary) Load(tokens ...Token) {
+	d.Lock()
+	for _, token := range tokens {
+		d.addToken(token)
+	}
+	d.updateLogTotal()
+	d.Unlock()
+}
+
+// AddToken adds one token
+func (d *Dictionary) AddToken(token Token) {
+	d.Lock()
+	d.addToken(token)
+	d.updateLogTotal()
+	d.Unlock()
+}
+
+func (d *Dictionary) addToken(token Token) {
+	d.normalized = nil
+	if old, existed := d.trie.Set(token.Text(), token.Frequency()); existed {
+		d.total -= old
+	}
+	d.total += token.Frequency()
+	if len(token.Pos()) > 0 {
+		d.trie.SetPOS(d.trie.Find(token.Text()), token.Pos())
+	}
+}
+
+// DeleteToken removes the word of given text
+func (d *Dictionary) DeleteToken(text string) {
+	d.Lock()
+	d.normalized = nil
+	if old, existed := d.trie.Delete(text); existed {
+		d.total -= old
+	}
+	d.updateLogTotal()
+	d.Unlock()
+}
+
+func (d *Dictionary) updateLogTotal() {
+	d.logTotal = math.Log(d.total)
+}
+
+// Total returns the total frequency of all words.
+func (d *Dictionary) Total() float64 {
+	d.RLock()
+	total := d.total
+	d.RUnlock()
+	return total
+}
+
+// LogTotal returns the natural logarithm of Total.
+func (d *Dictionary) LogTotal() float64 {
+	d.RLock()
+	logTotal := d.logTotal
+	d.RUnlock()
+	return logTotal
+}
+
+// Frequency returns the frequency and existence of give word
+func (d *Dictionary) Frequency(key string) (float64, bool) {
+	d.RLock()
+	freq, ok := d.trie.Get(key)
+	d.RUnlock()
+	return freq, ok
+}
+
+// Pos returns the POS and existence of give word
+func (d *Dictionary) Pos(key string) (string, bool) {
+	d.RLock()
+	pos, ok := d.pos(key)
+	d.RUnlock()
+	return pos, ok
+}
+
+func (d *Dictionary) pos(key string) (string, bool) {
+	node := d.trie.Find(key)
+	if node < 0 || d.trie.POS(node) == "" {
+		return "", false
+	}
+	return d.trie.POS(node), true
+}
+
+// Tokens returns all words in the dictionary with their frequencies and POS,
+// prefixes of words which are only used for building DAG are excluded.
+func (d *Dictionary) Tokens() []Token {
+	d.RLock()
+	tokens := d.tokens()
+	d.RUnlock()
+	return tokens
+}
+
+func (d *Dictionary) tokens() []Token {
+	tokens := make([]Token, 0, d.trie.Len())
+	d.trie.walkNodes(TrieRoot, make([]rune, 0, 16), func(node int, word []rune) {
+		tokens = append(tokens, NewToken(string(word), d.trie.Frequency(node), d.trie.POS(node)))
+	})
+	return tokens
+}
+
+// Edge is an edge of DAG, which points to the last rune of a word.
+type Edge struct {
+	Index     int
+	Frequency float64
+}
+
+// DAG returns all possible words in runes, the edges from k point to the
+// last rune of every word starting at k. A single rune is always a word,
+// with frequency 1 if it is not in the dictionary.
+func (d *Dictionary) DAG(runes []rune) [][]Edge {
+	n := len(runes)
+	dag := make([][]Edge, n)
+	edges := make([]Edge, 0, 2*n)
+	starts := make([]int, n+1)
+	d.RLock()
+	for k := 0; k < n; k++ {
+		starts[k] = len(edges)
+		node := TrieRoot
+		for i := k; i < n; i++ {
+			if node = d.trie.Child(node, runes[i]); node < 0 {
+				break
+			}
+			if freq := d.trie.Frequency(node); freq > 0.0 {
+				edges = append(edges, Edge{Index: i, Frequency: freq})
+			}
+		}
+		if len(edges) == starts[k] {
+			freq := 1.0
+			if node := d.trie.Child(TrieRoot, runes[k]); node >= 0 {
+				freq = d.trie.Frequency(node)
+			}
+			edges = append(edges, Edge{Index: k, Frequency: freq})
+		}
+	}
+	d.RUnlock()
+	starts[n] = len(edges)
+	for k := 0; k < n; k++ {
+		dag[k] = edges[starts[k]:starts[k+1]]
+	}
+	return dag
+}
+
+// SaveCompiled writes the dictionary to w in compiled format, with the
+// nodes of its trie which are loaded by LoadCompiled as is.
+func (d *Dictionary) SaveCompiled(w io.Writer) error {
+	d.RLock()
+	defer d.RUnlock()
+	return writeCompiled(w, CompiledTrieVersion, d.writeTrie)
+}
+
+/*
+LoadCompiled loads words written by SaveCompiled or WriteCompiled.
+
+If d is empty, the trie is taken as is with the total frequency stored in
+the file. Otherwise the words are added to d one by one, and the total
+frequency of d is updated like AddToken.
+*/
+func (d *Dictionary) LoadCompiled(file io.Reader) error {
+	c, err := readDictionary(file)
+	if err != nil {
+		return err
+	}
+	d.Lock()
+	if d.trie.Len() == 0 {
+		d.trie, d.total = c.trie, c.total
+		d.normalized = nil
+	} else {
+		for _, token := range c.tokens() {
+			d.addToken(token)
+		}
+	}
+	d.updateLogTotal()
+	d.Unlock()
+	return nil
+}
+
+/*
+Normalized returns the dictionary of the words of d normalized by
+util.Normalize, which is used to cut the normalized text. The
+frequencies of the words normalized into the same word are added up,
+and the POS of the first one in order is kept.
+
+It is built on first use and kept until d is modified, and it is d
+itself if no word is changed by normalization.
+*/
+func (d *Dictionary) Normalized() *Dictionary {
+	d.RLock()
+	n := d.normalized
+	d.RUnlock()
+	if n != nil {
+		return n
+	}
+	d.Lock()
+	defer d.Unlock()
+	if d.normalized == nil {
+		d.normalized = d.normalize()
+	}
+	return d.normalized
+}
+
+func (d *Dictionary) normalize() *Dictionary {
+	tokens := d.tokens()
+	changed := false
+	for i := range tokens {
+		normalized, _ := util.Normalize(tokens[i].text)
+		changed = changed || normalized != tokens[i].text
+		tokens[i].text = normalized
+	}
+	if !changed {
+		return d
+	}
+	n := New()
+	for _, token := range tokens {
+		if frequency, ok := n.trie.Get(token.text); ok {
+			token.frequency += frequency
+			if pos, ok := n.pos(token.text); ok {
+				token.pos = pos
+			}
+		}
+		n.addToken(token)
+	}
+	n.total = d.total
+	n.updateLogTotal()
+	return n
+}
+
+/*
+Shared holds the current snapshot of a Dictionary, which can be shared by
+the segmenters of jieba, posseg, analyse and tokenizers, so that the
+dictionary is loaded only once and every change applies to all of them.
+
+The snapshot is never modified in place, every change is applied to a
+copy which then replaces the snapshot atomically, so every cut sees one
+consistent version of dictionary without waiting for the change.
+*/
+type Shared struct {
+	dict  atomic.Pointer[Dictionary]
+	mu    sync.Mutex        // serializes the modifications of dictionary
+	edits map[string]*Token // the last token added of every text, nil if deleted
+}
+
+// NewShared creates a Shared holding d.
+func NewShared(d *Dictionary) *Shared {
+	s := &Shared{}
+	s.dict.Store(d)
+	return s
+}
+
+// LoadShared creates a Shared with the dictionary read from file.
+func LoadShared(file io.Reader) (*Shared, error) {
+	d := New()
+	err := LoadDictionary(d, file)
+	return NewShared(d), err
+}
+
+// LoadSharedAt creates a Shared with the dictionary read from the given file name.
+func LoadSharedAt(file string) (*Shared, error) {
+	d := New()
+	err := LoadDictionaryAt(d, file)
+	return NewShared(d), err
+}
+
+// LoadSharedCompiled creates a Shared with the dictionary read from file
+// in compiled format.
+func LoadSharedCompiled(file io.Reader) (*Shared, error) {
+	d := New()
+	if err := d.LoadCompiled(file); err != nil {
+		return nil, err
+	}
+	return NewShared(d), nil
+}
+
+// Dictionary returns the current dictionary snapshot.
+func (s *Shared) Dictionary() *Dictionary {
+	return s.dict.Load()
+}
+
+// SetDictionary replaces the current dictionary with d atomically.
+// Cuts in progress keep using the previous dictionary until they finish.
+func (s *Shared) SetDictionary(d *Dictionary) {
+	s.mu.Lock()
+	s.dict.Store(d)
+	s.mu.Unlock()
+}
+
+/*
+AddToken adds one token to a copy of current dictionary, which then
+replaces the current one. The token is kept and added again by Reload
+and ReloadAt until ClearEdits.
+
+Copying the dictionary takes time proportional to its size, so Edit
+should be used to add or delete many tokens with one copy.
+*/
+func (s *Shared) AddToken(token Token) {
+	s.Edit(func(e *Editor) {
+		e.AddToken(token)
+	})
+}
+
+// DeleteToken removes the word of given text from a copy of current
+// dictionary like AddToken, and the word is removed again by Reload and
+// ReloadAt until ClearEdits.
+func (s *Shared) DeleteToken(text string) {
+	s.Edit(func(e *Editor) {
+		e.DeleteToken(text)
+	})
+}
+
+// Editor adds and deletes the tokens of a dictionary in Shared.Edit, and
+// records them for Reload and ReloadAt.
+type Editor struct {
+	d     *Dictionary
+	edits map[string]*Token
+}
+
+// AddToken adds token like Shared.AddToken.
+func (e *Editor) AddToken(token Token) {
+	e.d.AddToken(token)
+	e.edits[token.text] = &token
+}
+
+// DeleteToken removes the word of given text like Shared.DeleteToken.
+func (e *Editor) DeleteToken(text string) {
+	e.d.DeleteToken(text)
+	e.edits[text] = nil
+}
+
+// Dictionary returns the copy of dictionary being edited, which should
+// not be kept after Edit returns.
+func (e *Editor) Dictionary() *Dictionary {
+	return e.d
+}
+
+// Edit calls fn with an Editor of a copy of current dictionary, which
+// then replaces the current one, so that all the tokens added and deleted
+// by fn take effect at once with one copy.
+func (s *Shared) Edit(fn func(e *Editor)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.edits == nil {
+		s.edits = make(map[string]*Token)
+	}
+	e := &Editor{d: s.Dictionary().Clone(), edits: s.edits}
+	fn(e)
+	s.dict.Store(e.d)
+}
+
+// ClearEdits forgets the tokens added and deleted by AddToken, DeleteToken
+// and Edit, so that Reload and ReloadAt build the dictionary from the
+// files only. The current dictionary is not changed.
+func (s *Shared) ClearEdits() {
+	s.mu.Lock()
+	s.edits = nil
+	s.mu.Unlock()
+}
+
+// replay applies the recorded edits to d.
+func (s *Shared) replay(d *Dictionary) {
+	for text, token := range s.edits {
+		if token != nil {
+			d.AddToken(*token)
+		} else {
+			d.DeleteToken(text)
+		}
+	}
+}
+
+// Update applies fn to a copy of current dictionary, and replaces the
+// current one with it only if fn succeeds.
+func (s *Shared) Update(fn func(d *Dictionary) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.Dictionary().Clone()
+	if err := fn(d); err != nil {
+		return err
+	}
+	s.dict.Store(d)
+	return nil
+}
+
+// LoadUserDictionary loads words from file into a copy of current
+// dictionary, which replaces the current one only if the whole file is
+// loaded successfully.
+func (s *Shared) LoadUserDictionary(file io.Reader) error {
+	return s.Update(func(d *Dictionary) error {
+		return LoadDictionary(d, file)
+	})
+}
+
+// LoadUserDictionaryAt is like LoadUserDictionary but reads words from
+// the given file name.
+func (s *Shared) LoadUserDictionaryAt(file string) error {
+	return s.Update(func(d *Dictionary) error {
+		return LoadDictionaryAt(d, file)
+	})
+}
+
+/*
+Reload builds a new dictionary from the base dictionary and user
+dictionaries in order, adds and deletes again the words changed by
+AddToken, DeleteToken and Edit, and then replaces the current dictionary with
+it atomically. The other changes, made by Update, LoadUserDictionary
+and SetDictionary, are discarded unless they are in the given files.
+
+The new dictionary is built without blocking any cut. If any file fails
+to load, or the result has no words, the current dictionary is kept
+unchanged and the error is returned.
+*/
+func (s *Shared) Reload(base io.Reader, users ...io.Reader) error {
+	d := New()
+	if err := LoadDictionary(d, base); err != nil {
+		return err
+	}
+	for _, user := range users {
+		if err := LoadDictionary(d, user); err != nil {
+			return err
+		}
+	}
+	return s.reload(d)
+}
+
+// reload replays the edits on d loaded from files if it has any word, and
+// replaces the current dictionary with it.
+func (s *Shared) reload(d *Dictionary) error {
+	if d.total <= 0 {
+		return ErrEmptyDictionary
+	}
+	s.mu.Lock()
+	s.replay(d)
+	s.dict.Store(d)
+	s.mu.Unlock()
+	return nil
+}
+
+// ReloadAt is like Reload but reads dictionaries from the given file names.
+func (s *Shared) ReloadAt(base string, users ...string) error {
+	d := New()
+	if err := LoadDictionaryAt(d, base); err != nil {
+		return err
+	}
+	for _, user := range users {
+		if err := LoadDictionaryAt(d, user); err != nil {
+			return err
+		}
+	}
+	return s.reload(d)
+}
+
+/*
+Watch polls the base and user dictionary files every interval, and calls
+ReloadAt with them whenever any of the files changes, so that editing the
+files on disk takes effect without restarting. Reload errors are reported
+to onError, which may be nil, and the current dictionary is kept. The
+changes of AddToken, DeleteToken and Edit are kept like Reload.
+
+It blocks until ctx is done and returns ctx.Err().
+*/
+func (s *Shared) Watch(ctx context.Context, interval time.Duration, onError func(error), base string, users ...string) error {
+	files := append([]string{base}, users...)
+	return util.Watch(ctx, interval, files, func() error {
+		return s.ReloadAt(base, users...)
+	}, onError)
+}
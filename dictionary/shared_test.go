@@ -0,0 +1,102 @@
+package dictionary
+
+import (
+	"strings"
+	"testing"
+)
+
+// testDict is a small dictionary in text format with POS.
+const testDict = "有限 300 a\n公司 600 n\n"
+
+func TestDictionaryDAG(t *testing.T) {
+	d := New()
+	d.Load(NewToken("有限", 300, "a"), NewToken("有限公司", 400, "nt"), NewToken("有限公", 0, ""))
+	dag := d.DAG([]rune("有限公司"))
+	if len(dag) != 4 {
+		t.Fatalf("got %d nodes, expected 4", len(dag))
+	}
+	if len(dag[0]) != 2 || dag[0][0].Index != 1 || dag[0][1].Index != 3 {
+		t.Fatalf("got %v, expected edges to 1 and 3", dag[0])
+	}
+	for k := 1; k < 4; k++ {
+		if len(dag[k]) != 1 || dag[k][0].Index != k || dag[k][0].Frequency != 1 {
+			t.Fatalf("got %v at %d, expected single rune with frequency 1", dag[k], k)
+		}
+	}
+	if total := d.Total(); total != 700 {
+		t.Fatalf("got total %f, expected 700", total)
+	}
+}
+
+func TestShared(t *testing.T) {
+	shared, err := LoadShared(strings.NewReader(testDict))
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := shared.Dictionary()
+	if err = shared.LoadUserDictionary(strings.NewReader("有限公司 400 nt\n永和 x ns\n")); err == nil {
+		t.Fatal("expected error for invalid frequency")
+	}
+	if shared.Dictionary() != before {
+		t.Fatal("dictionary should not be replaced by a failed load")
+	}
+	if err = shared.LoadUserDictionary(strings.NewReader("有限公司 400 nt\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := before.Pos("有限公司"); ok {
+		t.Fatal("previous snapshot should not be modified")
+	}
+	if pos, ok := shared.Dictionary().Pos("有限公司"); !ok || pos != "nt" {
+		t.Fatalf("got %s %v, expected nt true", pos, ok)
+	}
+	before = shared.Dictionary()
+	shared.DeleteToken("有限公司")
+	if total := shared.Dictionary().Total(); total != 900 {
+		t.Fatalf("got total %f after deleting, expected 900", total)
+	}
+	if _, ok := before.Frequency("有限公司"); !ok {
+		t.Fatal("previous snapshot should not be modified by DeleteToken")
+	}
+	shared.AddToken(NewToken("永和", 100, "ns"))
+	if err = shared.Reload(strings.NewReader(testDict + "有限公司 400 nt\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := shared.Dictionary().Frequency("有限公司"); ok {
+		t.Fatal("deleted word should be deleted again after reloading")
+	}
+	if pos, ok := shared.Dictionary().Pos("永和"); !ok || pos != "ns" {
+		t.Fatalf("got %s %v after reloading, expected ns true", pos, ok)
+	}
+	if err = shared.Reload(strings.NewReader("")); err != ErrEmptyDictionary {
+		t.Fatalf("got %v, expected ErrEmptyDictionary", err)
+	}
+
+	before = shared.Dictionary()
+	shared.Edit(func(e *Editor) {
+		e.AddToken(NewToken("服装", 200, "n"))
+		e.AddToken(NewToken("饰品", 100, "n"))
+		e.DeleteToken("永和")
+		if e.Dictionary() == before {
+			t.Fatal("Edit should change a copy of dictionary")
+		}
+	})
+	if _, ok := before.Frequency("服装"); ok {
+		t.Fatal("previous snapshot should not be modified by Edit")
+	}
+	if err = shared.Reload(strings.NewReader("有限 300 a\n")); err != nil {
+		t.Fatal(err)
+	}
+	if total := shared.Dictionary().Total(); total != 600 {
+		t.Fatalf("got total %f after reloading, expected the edits replayed to 600", total)
+	}
+	shared.ClearEdits()
+	if err = shared.Reload(strings.NewReader("有限 300 a\n永和 50 ns\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := shared.Dictionary().Frequency("服装"); ok {
+		t.Fatal("cleared edits should not be replayed")
+	}
+	if _, ok := shared.Dictionary().Frequency("永和"); !ok {
+		t.Fatal("cleared deletion should not be replayed")
+	}
+}
@@ -0,0 +1,228 @@
+package dictionary
+
+import "sort"
+
+// TrieRoot is the node of the empty prefix in every Trie.
+const TrieRoot = 0
+
+type trieEdge struct {
+	char rune
+	node int32
+}
+
+type trieNode struct {
+	frequency float64
+	word      bool
+	pos       string
+	edges     []trieEdge // sorted by char
+}
+
+/*
+Trie is a compact prefix tree storing words with their frequencies and POS.
+
+Unlike a map with every prefix of every word as a key, it keeps one node per
+distinct prefix without storing any string, and all prefixes of a text can
+be found by walking the tree from the TrieRoot node with Child, one rune a
+time.
+
+A Trie is not safe for concurrent use, it should be protected by the
+dictionary holding it.
+*/
+type Trie struct {
+	nodes []trieNode
+	free  []int32 // nodes removed by Delete
+	words int
+}
+
+// NewTrie creates a new empty Trie.
+func NewTrie() *Trie {
+	return &Trie{nodes: make([]trieNode, 1, 1024)}
+}
+
+// Child returns the node reached by appending char to the prefix of node,
+// or -1 if there is no such prefix.
+func (t *Trie) Child(node int, char rune) int {
+	edges := t.nodes[node].edges
+	lo, hi := 0, len(edges)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if edges[mid].char < char {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo < len(edges) && edges[lo].char == char {
+		return int(edges[lo].node)
+	}
+	return -1
+}
+
+// Frequency returns the frequency of node, which is 0 for a prefix
+// that is not a word.
+func (t *Trie) Frequency(node int) float64 {
+	return t.nodes[node].frequency
+}
+
+// IsWord reports whether the prefix of node is a word.
+func (t *Trie) IsWord(node int) bool {
+	return t.nodes[node].word
+}
+
+// POS returns the POS of the word of node, which is empty if it has none.
+func (t *Trie) POS(node int) string {
+	return t.nodes[node].pos
+}
+
+// SetPOS sets the POS of the word of node.
+func (t *Trie) SetPOS(node int, pos string) {
+	t.nodes[node].pos = pos
+}
+
+// Find returns the node of key, or -1 if key is not a prefix of any word.
+func (t *Trie) Find(key string) int {
+	node := TrieRoot
+	for _, char := range key {
+		if node = t.Child(node, char); node < 0 {
+			return -1
+		}
+	}
+	return node
+}
+
+// Get returns the frequency of key, and whether key is a word or a
+// prefix of some word. The frequency of a prefix is always 0.
+func (t *Trie) Get(key string) (float64, bool) {
+	node := t.Find(key)
+	if node < 0 {
+		return 0.0, false
+	}
+	return t.nodes[node].frequency, true
+}
+
+// Set sets the frequency of word, and returns its previous frequency
+// and whether it was a word before.
+func (t *Trie) Set(word string, frequency float64) (float64, bool) {
+	node := TrieRoot
+	for _, char := range word {
+		child := t.Child(node, char)
+		if child < 0 {
+			child = t.newNode()
+			t.insertEdge(node, trieEdge{char: char, node: int32(child)})
+		}
+		node = child
+	}
+	n := &t.nodes[node]
+	old, existed := n.frequency, n.word
+	n.frequency, n.word = frequency, true
+	if !existed {
+		t.words++
+	}
+	return old, existed
+}
+
+// Delete removes word, and returns its previous frequency and whether
+// it was a word before. The prefixes only used by word are removed as well.
+func (t *Trie) Delete(word string) (float64, bool) {
+	path := make([]int32, 1, 16)
+	node := TrieRoot
+	for _, char := range word {
+		if node = t.Child(node, char); node < 0 {
+			return 0.0, false
+		}
+		path = append(path, int32(node))
+	}
+	n := &t.nodes[node]
+	if !n.word {
+		return 0.0, false
+	}
+	old := n.frequency
+	n.frequency, n.word, n.pos = 0.0, false, ""
+	t.words--
+	for i := len(path) - 1; i > 0; i-- {
+		n := &t.nodes[path[i]]
+		if n.word || len(n.edges) > 0 {
+			break
+		}
+		t.removeEdge(int(path[i-1]), path[i])
+		t.free = append(t.free, path[i])
+	}
+	return old, true
+}
+
+func (t *Trie) newNode() int {
+	if n := len(t.free); n > 0 {
+		node := t.free[n-1]
+		t.free = t.free[:n-1]
+		t.nodes[node] = trieNode{edges: t.nodes[node].edges[:0]}
+		return int(node)
+	}
+	t.nodes = append(t.nodes, trieNode{})
+	return len(t.nodes) - 1
+}
+
+func (t *Trie) removeEdge(node int, child int32) {
+	edges := t.nodes[node].edges
+	for i := range edges {
+		if edges[i].node == child {
+			t.nodes[node].edges = append(edges[:i], edges[i+1:]...)
+			return
+		}
+	}
+}
+
+func (t *Trie) insertEdge(node int, edge trieEdge) {
+	edges := t.nodes[node].edges
+	i := sort.Search(len(edges), func(i int) bool { return edges[i].char >= edge.char })
+	edges = append(edges, trieEdge{})
+	copy(edges[i+1:], edges[i:])
+	edges[i] = edge
+	t.nodes[node].edges = edges
+}
+
+// Len returns the number of words.
+func (t *Trie) Len() int {
+	return t.words
+}
+
+// Walk calls fn for every word with its frequency, in the order of runes.
+func (t *Trie) Walk(fn func(word string, frequency float64)) {
+	t.walkNodes(TrieRoot, make([]rune, 0, 16), func(node int, word []rune) {
+		fn(string(word), t.nodes[node].frequency)
+	})
+}
+
+// walkNodes calls fn with the node and the runes of every word under the
+// given node, whose prefix is prefix.
+func (t *Trie) walkNodes(node int, prefix []rune, fn func(node int, word []rune)) {
+	n := &t.nodes[node]
+	if n.word {
+		fn(node, prefix)
+	}
+	for _, edge := range n.edges {
+		t.walkNodes(int(edge.node), append(prefix, edge.char), fn)
+	}
+}
+
+// Clone returns a deep copy of t, which can be modified independently.
+func (t *Trie) Clone() *Trie {
+	c := &Trie{
+		nodes: make([]trieNode, len(t.nodes), cap(t.nodes)),
+		free:  append([]int32(nil), t.free...),
+		words: t.words,
+	}
+	edges := 0
+	for i := range t.nodes {
+		edges += len(t.nodes[i].edges)
+	}
+	buf := make([]trieEdge, edges)
+	for i, n := range t.nodes {
+		c.nodes[i] = trieNode{frequency: n.frequency, word: n.word, pos: n.pos}
+		if len(n.edges) > 0 {
+			c.nodes[i].edges = buf[:len(n.edges):len(n.edges)]
+			copy(c.nodes[i].edges, n.edges)
+			buf = buf[len(n.edges):]
+		}
+	}
+	return c
+}
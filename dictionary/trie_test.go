@@ -0,0 +1,117 @@
+package dictionary
+
+import "testing"
+
+func TestTrie(t *testing.T) {
+	trie := NewTrie()
+	for word, freq := range map[string]float64{"中国": 3, "中国人": 2, "中": 1, "人民": 4} {
+		if _, existed := trie.Set(word, freq); existed {
+			t.Fatalf("%s should not exist", word)
+		}
+	}
+	if trie.Len() != 4 {
+		t.Fatalf("got %d words, expected 4", trie.Len())
+	}
+	if old, existed := trie.Set("中国", 5); !existed || old != 3 {
+		t.Fatalf("got %f %v, expected 3 true", old, existed)
+	}
+	if trie.Len() != 4 {
+		t.Fatalf("got %d words, expected 4", trie.Len())
+	}
+	for _, test := range []struct {
+		key       string
+		frequency float64
+		ok        bool
+	}{
+		{"中", 1, true},
+		{"中国", 5, true},
+		{"中国人", 2, true},
+		{"人", 0, true},
+		{"人民", 4, true},
+		{"国", 0, false},
+		{"中国人民", 0, false},
+	} {
+		if freq, ok := trie.Get(test.key); freq != test.frequency || ok != test.ok {
+			t.Fatalf("%s: got %f %v, expected %f %v", test.key, freq, ok, test.frequency, test.ok)
+		}
+	}
+	node := TrieRoot
+	var found []string
+	for i, char := range []rune("中国人民") {
+		if node = trie.Child(node, char); node < 0 {
+			break
+		}
+		if trie.IsWord(node) {
+			found = append(found, string([]rune("中国人民")[:i+1]))
+		}
+	}
+	if len(found) != 3 || found[0] != "中" || found[1] != "中国" || found[2] != "中国人" {
+		t.Fatal(found)
+	}
+}
+
+func TestTrieDelete(t *testing.T) {
+	trie := NewTrie()
+	trie.Set("中国", 3)
+	trie.Set("中国人民", 2)
+	trie.Set("人民", 4)
+	trie.SetPOS(trie.Find("中国人民"), "nt")
+	if old, existed := trie.Delete("中国人"); existed || old != 0 {
+		t.Fatalf("got %f %v, a prefix should not be deleted", old, existed)
+	}
+	if old, existed := trie.Delete("中国人民"); !existed || old != 2 {
+		t.Fatalf("got %f %v, expected 2 true", old, existed)
+	}
+	if _, ok := trie.Get("中国人"); ok {
+		t.Fatal("orphaned prefix should be removed")
+	}
+	if freq, ok := trie.Get("中国"); !ok || freq != 3 {
+		t.Fatalf("got %f %v, expected 3 true", freq, ok)
+	}
+	if old, existed := trie.Delete("中国"); !existed || old != 3 {
+		t.Fatalf("got %f %v, expected 3 true", old, existed)
+	}
+	if _, ok := trie.Get("中"); ok {
+		t.Fatal("orphaned prefix should be removed")
+	}
+	if trie.Len() != 1 {
+		t.Fatalf("got %d words, expected 1", trie.Len())
+	}
+	nodes := len(trie.nodes)
+	trie.Set("中国人", 1)
+	if len(trie.nodes) != nodes {
+		t.Fatal("removed nodes should be reused")
+	}
+	if freq, ok := trie.Get("中国人"); !ok || freq != 1 {
+		t.Fatalf("got %f %v, expected 1 true", freq, ok)
+	}
+	trie.Set("中国人民", 2)
+	if pos := trie.POS(trie.Find("中国人民")); pos != "" {
+		t.Fatalf("got POS %q, the POS of deleted word should be removed", pos)
+	}
+}
+
+func TestTrieClone(t *testing.T) {
+	trie := NewTrie()
+	trie.Set("中国", 3)
+	trie.Set("中国人", 2)
+	c := trie.Clone()
+	c.Set("中间", 1)
+	c.Set("中国", 5)
+	c.Delete("中国人")
+	if freq, ok := trie.Get("中国"); !ok || freq != 3 {
+		t.Fatalf("got %f %v, expected 3 true", freq, ok)
+	}
+	if _, ok := trie.Get("中间"); ok {
+		t.Fatal("中间 should not be in the original trie")
+	}
+	if freq, ok := trie.Get("中国人"); !ok || freq != 2 {
+		t.Fatalf("got %f %v, expected 2 true", freq, ok)
+	}
+	if trie.Len() != 2 || c.Len() != 2 {
+		t.Fatalf("got %d and %d words, expected 2 and 2", trie.Len(), c.Len())
+	}
+	if freq, ok := c.Get("中国"); !ok || freq != 5 {
+		t.Fatalf("got %f %v, expected 5 true", freq, ok)
+	}
+}
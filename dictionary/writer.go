@@ -0,0 +1,55 @@
+package dictionary
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// WriteDictionary writes tokens to w in the text format read by LoadDictionary,
+// one token per line as "text frequency pos", pos is omitted if empty. The
+// tokens without POS are written first in order, and then the others, as
+// LoadDictionary keeps the POS of a line for the following lines without POS.
+func WriteDictionary(w io.Writer, tokens []Token) error {
+	for _, token := range tokens {
+		if len(token.text) == 0 || strings.ContainsAny(token.text, " \r\n") {
+			return fmt.Errorf("dictionary: invalid token text %q", token.text)
+		}
+	}
+	bw := bufio.NewWriter(w)
+	for _, withPos := range []bool{false, true} {
+		for _, token := range tokens {
+			if (len(token.pos) > 0) == withPos {
+				writeToken(bw, token)
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+func writeToken(bw *bufio.Writer, token Token) {
+	bw.WriteString(token.text)
+	bw.WriteByte(' ')
+	bw.WriteString(strconv.FormatFloat(token.frequency, 'f', -1, 64))
+	if len(token.pos) > 0 {
+		bw.WriteByte(' ')
+		bw.WriteString(token.pos)
+	}
+	bw.WriteByte('\n')
+}
+
+// WriteDictionaryAt writes tokens to the given file, see WriteDictionary.
+func WriteDictionaryAt(file string, tokens []Token) error {
+	dictFile, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	err = WriteDictionary(dictFile, tokens)
+	if cerr := dictFile.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
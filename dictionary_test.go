@@ -0,0 +1,84 @@
+package jieba
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestSaveDictionary(t *testing.T) {
+	seg := loadTestSegmenter(t, "有限 300\n公司 600\n有限公司 400\n")
+	seg.AddWord("永和", 50.5)
+	seg.AddWord("服装", 200)
+	tokens := seg.Dictionary().Tokens()
+	if len(tokens) != 5 {
+		t.Fatalf("got %v, expected 5 tokens", tokens)
+	}
+	var buf bytes.Buffer
+	if err := seg.SaveDictionary(&buf); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadDictionary(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, token := range tokens {
+		if freq, ok := loaded.Frequency(token.Text()); !ok || freq != token.Frequency() {
+			t.Fatalf("%s: got %f, expected %f", token.Text(), freq, token.Frequency())
+		}
+	}
+	if loaded.Dictionary().Total() != seg.Dictionary().Total() {
+		t.Fatalf("got total %f, expected %f", loaded.Dictionary().Total(), seg.Dictionary().Total())
+	}
+}
+
+func TestDictionaryTotal(t *testing.T) {
+	seg := loadTestSegmenter(t, "有限 300\n公司 600\n有限公司 400\n")
+	if seg.Dictionary().Total() != 1300 {
+		t.Fatalf("got total %f, expected 1300", seg.Dictionary().Total())
+	}
+	seg.AddWord("有限公司", 100)
+	if seg.Dictionary().Total() != 1000 {
+		t.Fatalf("got total %f after overriding, expected 1000", seg.Dictionary().Total())
+	}
+	if err := seg.LoadUserDictionary(strings.NewReader("公司 500\n永和 50\n")); err != nil {
+		t.Fatal(err)
+	}
+	if seg.Dictionary().Total() != 950 {
+		t.Fatalf("got total %f after loading user dictionary, expected 950", seg.Dictionary().Total())
+	}
+	seg.DeleteWord("有限公司")
+	seg.DeleteWord("不存在")
+	if seg.Dictionary().Total() != 850 {
+		t.Fatalf("got total %f after deleting, expected 850", seg.Dictionary().Total())
+	}
+	if _, ok := seg.Frequency("有限公"); ok {
+		t.Fatal("orphaned prefix should be removed")
+	}
+	if freq, ok := seg.Frequency("有限"); !ok || freq != 300 {
+		t.Fatalf("got %f %v, expected 300 true", freq, ok)
+	}
+}
+
+func TestTuneFrequency(t *testing.T) {
+	seg := loadTestSegmenter(t, "今天 200\n天气 500\n今天天气 300\n石墨 100\n烯 10\n")
+	if result := seg.Cut("今天天气", false); len(result) != 1 {
+		t.Fatalf("got %v, expected 1 word", result)
+	}
+	if freq := seg.TuneFrequency("今天", "天气"); freq != 90 {
+		t.Fatalf("got frequency %f, expected 90", freq)
+	}
+	if result := seg.Cut("今天天气", false); len(result) != 2 {
+		t.Fatalf("got %v after tuning, expected 2 words", result)
+	}
+	if total := seg.Dictionary().Total(); total != 900 {
+		t.Fatalf("got total %f, expected 900", total)
+	}
+	if result := seg.Cut("石墨烯", false); len(result) != 2 {
+		t.Fatalf("got %v, expected 2 words", result)
+	}
+	seg.TuneFrequency("石墨烯")
+	if result := seg.Cut("石墨烯", false); len(result) != 1 {
+		t.Fatalf("got %v after tuning, expected 1 word", result)
+	}
+}
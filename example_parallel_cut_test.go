@@ -2,35 +2,14 @@ package jieba
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"log"
 	"os"
-	"runtime"
 	"strings"
 	"time"
 )
 
-type line struct {
-	number int
-	text   string
-}
-
-var (
-	segmenter  *Segmenter
-	numThreads = runtime.NumCPU()
-	task       = make(chan line, numThreads)
-	result     = make(chan line, numThreads)
-)
-
-func worker() {
-	for l := range task {
-		segments := segmenter.Cut(l.text, true)
-
-		l.text = fmt.Sprintf("%s\n", strings.Join(segments, " / "))
-		result <- l
-	}
-}
-
 func Example_parallelCut() {
 	// open file for segmentation
 	file, err := os.Open("README.md")
@@ -40,17 +19,12 @@ func Example_parallelCut() {
 	defer file.Close()
 
 	// Load dictionary
-	segmenter, err = LoadDictionaryAt("dict.txt")
+	segmenter, err := LoadDictionaryAt("dict.txt")
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	// start worker routines
-	for i := 0; i < numThreads; i++ {
-		go worker()
-	}
-
-	var length, size int
+	var size int
 	scanner := bufio.NewScanner(file)
 
 	t0 := time.Now()
@@ -63,31 +37,21 @@ func Example_parallelCut() {
 		size += len(t)
 		lines = append(lines, t)
 	}
-	length = len(lines)
 
-	// Segmentation
-	go func() {
-		for i := 0; i < length; i++ {
-			task <- line{number: i, text: lines[i]}
-		}
-		close(task)
-	}()
-
-	// Make sure the segmentation result contains same line as original file
-	for i := 0; i < length; i++ {
-		l := <-result
-		lines[l.number] = l.text
+	// Segmentation, the results keep the same order as lines
+	results, err := segmenter.CutBatch(context.Background(), lines, BatchOptions{HMM: true})
+	if err != nil {
+		log.Fatal(err)
 	}
 
 	t1 := time.Now()
-	close(result)
 
 	// Write the segments into a file for verify
 	outputFile, _ := os.OpenFile("parallelCut.log", os.O_CREATE|os.O_WRONLY, 0600)
 	defer outputFile.Close()
 	writer := bufio.NewWriter(outputFile)
-	for _, l := range lines {
-		writer.WriteString(l)
+	for _, segments := range results {
+		writer.WriteString(fmt.Sprintf("%s\n", strings.Join(segments, " / ")))
 	}
 	writer.Flush()
 
@@ -0,0 +1,126 @@
+package finalseg
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/fumiama/jieba/util"
+)
+
+/*
+The compact format of a model is a binary encoding, which is embedded as
+model.bin for the default model. All integers are uvarints, and floats
+are little endian float64:
+
+	magic  4 bytes "JBFM"
+	start  mask byte, the bit i is set if states[i] has a start
+	       probability, and float64 for every set bit of mask in order
+	trans  for every state in order, mask byte of the states it may
+	       transit to, and float64 for every set bit of mask in order
+	count  the number of runes emitted by any state
+	runes  count times of
+	    rune   the difference from the previous rune
+	    mask   byte, the bit i is set if states[i] emits the rune
+	    probs  float64 for every set bit of mask in order
+*/
+const compactMagic = "JBFM"
+
+// writeCompact writes m to w in the compact format.
+func writeCompact(w io.Writer, m *Model) error {
+	cw := util.NewCompactWriter(w)
+	putProbs := func(probs map[byte]float64) {
+		mask := byte(0)
+		for i := 0; i < len(states); i++ {
+			if _, ok := probs[states[i]]; ok {
+				mask |= 1 << i
+			}
+		}
+		cw.WriteByte(mask)
+		for i := 0; i < len(states); i++ {
+			if p, ok := probs[states[i]]; ok {
+				cw.Float(p)
+			}
+		}
+	}
+	cw.WriteString(compactMagic)
+	putProbs(m.start)
+	for i := 0; i < len(states); i++ {
+		putProbs(m.trans[states[i]])
+	}
+	e := &m.emit
+	cw.Uvarint(uint64(len(e.runes)))
+	prev := rune(0)
+	for j, r := range e.runes {
+		cw.Uvarint(uint64(r - prev))
+		prev = r
+		mask := byte(0)
+		for i := range e.probs {
+			if e.probs[i][j] != minFloat {
+				mask |= 1 << i
+			}
+		}
+		cw.WriteByte(mask)
+		for i := range e.probs {
+			if mask&(1<<i) != 0 {
+				cw.Float(e.probs[i][j])
+			}
+		}
+	}
+	return cw.Flush()
+}
+
+// readCompact decodes a model in the compact format from data.
+func readCompact(data []byte) (*Model, error) {
+	if !strings.HasPrefix(string(data), compactMagic) {
+		return nil, fmt.Errorf("%w: not a compact model", ErrInvalidModel)
+	}
+	r := util.NewCompactReader(data[len(compactMagic):],
+		fmt.Errorf("%w: corrupted compact model", ErrInvalidModel))
+	mask := func() byte {
+		if b := r.Bytes(1); len(b) == 1 && b[0] < 1<<len(states) {
+			return b[0]
+		}
+		r.Fail()
+		return 0
+	}
+	getProbs := func(probs map[byte]float64) {
+		mask := mask()
+		for i := 0; i < len(states); i++ {
+			if mask&(1<<i) != 0 {
+				probs[states[i]] = r.Float()
+			}
+		}
+	}
+	m := newModel()
+	getProbs(m.start)
+	for i := 0; i < len(states); i++ {
+		getProbs(m.trans[states[i]])
+	}
+	e := &m.emit
+	n := r.Count(2)
+	e.runes = make([]rune, n)
+	for i := range e.probs {
+		e.probs[i] = make([]float64, n)
+	}
+	prev := uint64(0)
+	for j := range e.runes {
+		prev += r.Uvarint()
+		if prev > math.MaxInt32 {
+			r.Fail()
+		}
+		e.runes[j] = rune(prev)
+		mask := mask()
+		for i := range e.probs {
+			e.probs[i][j] = minFloat
+			if mask&(1<<i) != 0 {
+				e.probs[i][j] = r.Float()
+			}
+		}
+	}
+	if err := r.End(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -0,0 +1,58 @@
+package finalseg
+
+import (
+	"sort"
+	"strings"
+)
+
+// emission has the emission probabilities of the states in dense arrays
+// on the runes emitted by any state.
+type emission struct {
+	runes []rune                 // the runes in order
+	probs [len(states)][]float64 // probs[i][j] of states[i] emitting runes[j], minFloat if never
+}
+
+// newEmission creates an emission of the probabilities keyed by state and rune.
+func newEmission(emit map[byte]map[rune]float64) emission {
+	var e emission
+	seen := make(map[rune]struct{})
+	for _, probs := range emit {
+		for r := range probs {
+			if _, ok := seen[r]; !ok {
+				seen[r] = struct{}{}
+				e.runes = append(e.runes, r)
+			}
+		}
+	}
+	sort.Slice(e.runes, func(i, j int) bool { return e.runes[i] < e.runes[j] })
+	for i := range e.probs {
+		e.probs[i] = make([]float64, len(e.runes))
+		probs := emit[states[i]]
+		for j, r := range e.runes {
+			if p, ok := probs[r]; ok {
+				e.probs[i][j] = p
+			} else {
+				e.probs[i][j] = minFloat
+			}
+		}
+	}
+	return e
+}
+
+// index returns the index of r in runes, or -1 if no state emits r.
+func (e *emission) index(r rune) int {
+	j := sort.Search(len(e.runes), func(j int) bool { return e.runes[j] >= r })
+	if j < len(e.runes) && e.runes[j] == r {
+		return j
+	}
+	return -1
+}
+
+// prob returns the log probability of state emitting r, minFloat if never.
+func (e *emission) prob(state byte, r rune) float64 {
+	i, j := strings.IndexByte(states, state), e.index(r)
+	if i < 0 || j < 0 {
+		return minFloat
+	}
+	return e.probs[i][j]
+}
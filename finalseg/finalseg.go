@@ -2,7 +2,11 @@
 package finalseg
 
 import (
+	"context"
 	"regexp"
+	"unicode/utf8"
+
+	"github.com/fumiama/jieba/util"
 )
 
 var (
@@ -10,35 +14,84 @@ var (
 	reSkip = regexp.MustCompile(`(\d+\.\d+|[a-zA-Z0-9]+)`)
 )
 
-func cutHan(sentence string) []string {
-	runes := []rune(sentence)
-	result := make([]string, 0, len(runes))
-	_, posList := viterbi(runes, 'B', 'M', 'E', 'S')
+// appendHan appends the words of sentence cut by Viterbi algorithm to
+// result, the words are substrings of sentence.
+func (m *Model) appendHan(result []string, sentence string) []string {
+	var buf [stackRun * (len(states) + 1)]byte
+	n := utf8.RuneCountInString(sentence)
+	scratch := buf[:]
+	if n > stackRun {
+		scratch = make([]byte, n*(len(states)+1))
+	}
+	_, posList := m.viterbi(sentence, n, scratch)
 	begin, next := 0, 0
-	for i, char := range runes {
-		pos := posList[i]
-		switch pos {
+	i := 0
+	for j, char := range sentence {
+		switch posList[i] {
 		case 'B':
-			begin = i
+			begin = j
 		case 'E':
-			result = append(result, string(runes[begin:i+1]))
-			next = i + 1
+			next = j + utf8.RuneLen(char)
+			result = append(result, sentence[begin:next])
 		case 'S':
-			result = append(result, string(char))
-			next = i + 1
+			result = append(result, sentence[j:j+utf8.RuneLen(char)])
+			next = j + utf8.RuneLen(char)
 		}
+		i++
 	}
-	if next < len(runes) {
-		result = append(result, string(runes[next:]))
+	if next < len(sentence) {
+		result = append(result, sentence[next:])
 	}
 
 	return result
 }
 
 // Cut cuts sentence into words using Hidden Markov Model with Viterbi
-// algorithm. It is used by jieba for unknown words.
+// algorithm and the default model. It is used by jieba for unknown words.
 func Cut(s string) []string {
-	result := make([]string, 0, len(s))
+	return DefaultModel().Cut(s)
+}
+
+// CutAppend is like Cut, but appends the words to dst and returns the
+// extended slice. The words are substrings of s, which are not copied.
+func CutAppend(dst []string, s string) []string {
+	return DefaultModel().CutAppend(dst, s)
+}
+
+// CutContext is like Cut, but it stops and returns ctx.Err() once ctx is
+// done, and returns a *util.LimitError if more than maxRun consecutive
+// Chinese characters would be cut by Viterbi algorithm at once. Zero
+// maxRun means no limit.
+func CutContext(ctx context.Context, s string, maxRun int) ([]string, error) {
+	return DefaultModel().CutContext(ctx, s, maxRun)
+}
+
+// Cut cuts sentence into words using Hidden Markov Model with Viterbi
+// algorithm and the model m.
+func (m *Model) Cut(s string) []string {
+	return m.cut(make([]string, 0, len(s)), s, nil)
+}
+
+// CutAppend is like Cut, but appends the words to dst and returns the
+// extended slice. The words are substrings of s, which are not copied.
+func (m *Model) CutAppend(dst []string, s string) []string {
+	return m.cut(dst, s, nil)
+}
+
+// CutContext is like Cut, but it stops and returns ctx.Err() once ctx is
+// done, and returns a *util.LimitError if more than maxRun consecutive
+// Chinese characters would be cut by Viterbi algorithm at once. Zero
+// maxRun means no limit.
+func (m *Model) CutContext(ctx context.Context, s string, maxRun int) ([]string, error) {
+	l := util.NewLimiter(ctx, util.Limits{MaxHMMRun: maxRun})
+	result := m.cut(make([]string, 0, len(s)), s, l)
+	if err := l.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (m *Model) cut(result []string, s string, l *util.Limiter) []string {
 lop:
 	for {
 		hanLoc := reHan.FindStringIndex(s)
@@ -49,7 +102,10 @@ lop:
 		} else if hanLoc[0] == 0 {
 			hans := s[hanLoc[0]:hanLoc[1]]
 			s = s[hanLoc[1]:]
-			result = append(result, cutHan(hans)...)
+			if l.Done() || !l.Run(utf8.RuneCountInString(hans)) {
+				break
+			}
+			result = m.appendHan(result, hans)
 			continue
 		}
 		nonhanLoc := reSkip.FindStringIndex(s)
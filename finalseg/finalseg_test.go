@@ -1,13 +1,20 @@
 package finalseg
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"math"
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/fumiama/jieba/util"
 )
 
 func TestViterbi(t *testing.T) {
 	obs := "我们是程序员"
-	prob, path := viterbi([]rune(obs), 'B', 'M', 'E', 'S')
+	prob, path := DefaultModel().viterbi(obs, 6, make([]byte, 6*5))
 	if math.Abs(prob+39.68824128493802) > 1e-10 {
 		t.Fatal(prob)
 	}
@@ -20,7 +27,7 @@ func TestViterbi(t *testing.T) {
 
 func TestCutHan(t *testing.T) {
 	obs := "我们是程序员"
-	result := cutHan(obs)
+	result := DefaultModel().appendHan(nil, obs)
 	if len(result) != 3 {
 		t.Fatal(result)
 	}
@@ -33,6 +40,9 @@ func TestCutHan(t *testing.T) {
 	if result[2] != "程序员" {
 		t.Fatal(result[2])
 	}
+	if allocs := testing.AllocsPerRun(100, func() { DefaultModel().appendHan(result[:0], obs) }); allocs != 0 {
+		t.Fatalf("got %v allocations, expected none", allocs)
+	}
 }
 
 func TestCut(t *testing.T) {
@@ -60,3 +70,102 @@ func TestCut(t *testing.T) {
 	}
 
 }
+
+func TestCutContext(t *testing.T) {
+	result, err := CutContext(context.Background(), "我们是程序员, hello", 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != len(Cut("我们是程序员, hello")) {
+		t.Fatal(result)
+	}
+	var limitErr *util.LimitError
+	if _, err = CutContext(context.Background(), "我们是程序员", 5); !errors.As(err, &limitErr) || limitErr.Length != 6 {
+		t.Fatalf("got %v, expected MaxHMMRun exceeded", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = CutContext(ctx, "我们是程序员", 0); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, expected %v", err, context.Canceled)
+	}
+}
+
+func TestModel(t *testing.T) {
+	var buf bytes.Buffer
+	if err := DefaultModel().Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	m, err := LoadModel(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := DefaultModel().emit.prob('B', '一'); p != -3.6544978750449433 {
+		t.Fatalf("got emit B 一 %v, expected -3.6544978750449433", p)
+	}
+	buf.Reset()
+	if err = m.SaveCompact(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), modelData) {
+		t.Fatal("the compact model should be the same as model.bin")
+	}
+	if compact, err := LoadCompactModel(bytes.NewReader(buf.Bytes())); err != nil || !reflect.DeepEqual(compact, DefaultModel()) {
+		t.Fatalf("got %v, expected the compact model decoded as the same", err)
+	}
+	if _, err = LoadCompactModel(bytes.NewReader(buf.Bytes()[:buf.Len()-1])); !errors.Is(err, ErrInvalidModel) {
+		t.Fatalf("got %v, expected %v", err, ErrInvalidModel)
+	}
+	sentence := "小明硕士毕业于中国科学院计算所，后在日本京都大学深造"
+	if result, expected := m.Cut(sentence), Cut(sentence); strings.Join(result, "/") != strings.Join(expected, "/") {
+		t.Fatalf("got %v, expected %v", result, expected)
+	}
+	m, err = LoadModel(strings.NewReader("# every rune is a word\nstart S 0\ntrans S S 0\nemit S 小 -1\nemit S 明 -1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := m.Cut("小明"); strings.Join(result, "/") != "小/明" {
+		t.Fatalf("got %v, expected [小 明]", result)
+	}
+	for _, model := range []string{"", "start X 0\nemit S 小 -1\n", "start S 0\nemit S 小明 -1\n", "start S 0\nemit S 小 x\n", "prob S 0\n"} {
+		if _, err = LoadModel(strings.NewReader(model)); !errors.Is(err, ErrInvalidModel) {
+			t.Fatalf("got %v, expected %v for %q", err, ErrInvalidModel, model)
+		}
+	}
+}
+
+func TestTrain(t *testing.T) {
+	corpus := "我们 是 程序员\n是 我们 的 朋友\nhello 我们\n"
+	m, err := Train(strings.NewReader(corpus), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := m.start['B']; math.Abs(p-math.Log(2.0/3.0)) > 1e-10 {
+		t.Fatalf("got start B %f, expected %f", p, math.Log(2.0/3.0))
+	}
+	if _, ok := m.trans['B']['S']; ok {
+		t.Fatal("transition from B to S should never happen")
+	}
+	if result := m.Cut("程序员是我们的朋友"); strings.Join(result, "/") != "程序员/是/我们/的/朋友" {
+		t.Fatalf("got %v", result)
+	}
+	smoothed, err := Train(strings.NewReader(corpus), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if smoothed.emit.prob('S', '程') == minFloat {
+		t.Fatal("smoothing should give every rune a probability")
+	}
+	var buf bytes.Buffer
+	if err = smoothed.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = LoadModel(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = Train(strings.NewReader(corpus), -1); !errors.Is(err, ErrInvalidSmoothing) {
+		t.Fatalf("got %v, expected %v", err, ErrInvalidSmoothing)
+	}
+	if _, err = Train(strings.NewReader("hello world\n"), 1); !errors.Is(err, ErrEmptyCorpus) {
+		t.Fatalf("got %v, expected %v", err, ErrEmptyCorpus)
+	}
+}
@@ -0,0 +1,20 @@
+// Command gen converts the default model of finalseg from model.txt in
+// the text format to model.bin in the compact format, which is embedded
+// by the package. It is run by go generate in the package directory.
+package main
+
+import (
+	"log"
+
+	"github.com/fumiama/jieba/finalseg"
+)
+
+func main() {
+	m, err := finalseg.LoadModelAt("model.txt")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = m.SaveCompactAt("model.bin"); err != nil {
+		log.Fatal(err)
+	}
+}
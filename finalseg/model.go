@@ -0,0 +1,224 @@
+package finalseg
+
+import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/fumiama/jieba/util"
+)
+
+// states are the states of the Hidden Markov Model: the Beginning, Middle
+// and End of a word, and a Single rune word.
+const states = "BMES"
+
+// ErrInvalidModel is returned when loading a model in wrong format.
+var ErrInvalidModel = errors.New("finalseg: invalid model")
+
+/*
+Model is a Hidden Markov Model used to cut the unknown words, which has
+the log probabilities of the start states, the transitions between states
+and the emissions of runes from states. A missing probability means the
+event never happens.
+
+A Model is read-only after loading, so it can be shared by any number of
+cuts concurrently.
+
+The text format of a model has one probability per line, the fields are
+separated by whitespaces, and empty lines and lines beginning with '#'
+are ignored:
+
+	start STATE LOGP
+	trans FROM TO LOGP
+	emit STATE RUNE LOGP
+
+where STATE, FROM and TO are one of B, M, E and S, RUNE is a single
+character, and LOGP is the natural logarithm of the probability. For
+example:
+
+	start B -0.26268660809250016
+	trans B E -0.51082562376599
+	emit S 的 -3.3376539265624
+*/
+type Model struct {
+	start map[byte]float64
+	trans map[byte]map[byte]float64
+	emit  emission
+}
+
+// modelData is the model trained by the original Jieba in the compact
+// format, which is decoded by DefaultModel on first use. It is generated
+// from model.txt in the text format.
+//
+//go:generate go run ./internal/gen
+//go:embed model.bin
+var modelData []byte
+
+var (
+	defaultModel     *Model
+	defaultModelOnce sync.Once
+)
+
+// DefaultModel returns the built-in model trained by the original Jieba,
+// which is used by Cut. It is decoded on the first call.
+func DefaultModel() *Model {
+	defaultModelOnce.Do(func() {
+		var err error
+		if defaultModel, err = readCompact(modelData); err != nil {
+			panic(err)
+		}
+	})
+	return defaultModel
+}
+
+func newModel() *Model {
+	m := &Model{
+		start: make(map[byte]float64, len(states)),
+		trans: make(map[byte]map[byte]float64, len(states)),
+	}
+	for i := 0; i < len(states); i++ {
+		m.start[states[i]] = minFloat
+		m.trans[states[i]] = make(map[byte]float64, len(states))
+	}
+	return m
+}
+
+// parseState returns the state of s.
+func parseState(s string) (byte, bool) {
+	if len(s) != 1 || !strings.Contains(states, s) {
+		return 0, false
+	}
+	return s[0], true
+}
+
+// LoadModel reads a model in the text format from r.
+func LoadModel(r io.Reader) (*Model, error) {
+	m := newModel()
+	emit := make(map[byte]map[rune]float64, len(states))
+	hasStart := false
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		fields := strings.Fields(line)
+		logp, err := strconv.ParseFloat(fields[len(fields)-1], 64)
+		if err != nil {
+			return nil, util.LineError(ErrInvalidModel, n, line)
+		}
+		switch {
+		case fields[0] == "start" && len(fields) == 3:
+			state, ok := parseState(fields[1])
+			if !ok {
+				return nil, util.LineError(ErrInvalidModel, n, line)
+			}
+			m.start[state] = logp
+			hasStart = true
+		case fields[0] == "trans" && len(fields) == 4:
+			from, ok := parseState(fields[1])
+			to, ok2 := parseState(fields[2])
+			if !ok || !ok2 {
+				return nil, util.LineError(ErrInvalidModel, n, line)
+			}
+			m.trans[from][to] = logp
+		case fields[0] == "emit" && len(fields) == 4:
+			state, ok := parseState(fields[1])
+			r, size := utf8.DecodeRuneInString(fields[2])
+			if !ok || r == utf8.RuneError || size != len(fields[2]) {
+				return nil, util.LineError(ErrInvalidModel, n, line)
+			}
+			if emit[state] == nil {
+				emit[state] = make(map[rune]float64)
+			}
+			emit[state][r] = logp
+		default:
+			return nil, util.LineError(ErrInvalidModel, n, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if !hasStart || len(emit) == 0 {
+		return nil, fmt.Errorf("%w: no start or emit probability", ErrInvalidModel)
+	}
+	m.emit = newEmission(emit)
+	return m, nil
+}
+
+// LoadModelAt reads a model in the text format from the given file name.
+func LoadModelAt(file string) (*Model, error) {
+	return util.ReadFile(file, LoadModel)
+}
+
+// Save writes m to w in the text format read by LoadModel.
+func (m *Model) Save(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	formatFloat := func(f float64) string {
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	for i := 0; i < len(states); i++ {
+		if p, ok := m.start[states[i]]; ok && p > minFloat {
+			fmt.Fprintf(bw, "start %c %s\n", states[i], formatFloat(p))
+		}
+	}
+	for i := 0; i < len(states); i++ {
+		for j := 0; j < len(states); j++ {
+			if p, ok := m.trans[states[i]][states[j]]; ok {
+				fmt.Fprintf(bw, "trans %c %c %s\n", states[i], states[j], formatFloat(p))
+			}
+		}
+	}
+	for i, probs := range m.emit.probs {
+		for j, r := range m.emit.runes {
+			if probs[j] == minFloat {
+				continue
+			}
+			if unicode.IsSpace(r) {
+				return fmt.Errorf("%w: cannot save rune %q", ErrInvalidModel, r)
+			}
+			fmt.Fprintf(bw, "emit %c %c %s\n", states[i], r, formatFloat(probs[j]))
+		}
+	}
+	return bw.Flush()
+}
+
+// SaveAt writes m to the given file in the text format read by LoadModelAt.
+func (m *Model) SaveAt(file string) error {
+	return util.WriteFile(file, m.Save)
+}
+
+// LoadCompactModel reads a model in the compact format written by
+// SaveCompact from r.
+func LoadCompactModel(r io.Reader) (*Model, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return readCompact(data)
+}
+
+// LoadCompactModelAt reads a model in the compact format from the given
+// file name.
+func LoadCompactModelAt(file string) (*Model, error) {
+	return util.ReadFile(file, LoadCompactModel)
+}
+
+// SaveCompact writes m to w in a compact binary format, which is smaller
+// and faster to load than the text format, and read by LoadCompactModel.
+func (m *Model) SaveCompact(w io.Writer) error {
+	return writeCompact(w, m)
+}
+
+// SaveCompactAt writes m to the given file in the compact format read by
+// LoadCompactModelAt.
+func (m *Model) SaveCompactAt(file string) error {
+	return util.WriteFile(file, m.SaveCompact)
+}
@@ -0,0 +1,187 @@
+package finalseg
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/fumiama/jieba/util"
+)
+
+var (
+	// ErrEmptyCorpus is returned when training a model without any word.
+	ErrEmptyCorpus = errors.New("finalseg: empty corpus")
+	// ErrInvalidSmoothing is returned when training a model with negative smoothing.
+	ErrInvalidSmoothing = errors.New("finalseg: invalid smoothing")
+)
+
+// nextStates are the states which may follow a state, the others never happen.
+var nextStates = map[byte][2]byte{
+	'B': {'M', 'E'},
+	'M': {'M', 'E'},
+	'E': {'B', 'S'},
+	'S': {'B', 'S'},
+}
+
+/*
+Trainer counts the states of a segmented corpus to train a Model.
+
+Every word is tagged by B, M and E for its beginning, middle and end
+runes, or S if it has only one rune. The consecutive words of Chinese
+characters form a sequence, and a word with any other rune ends the
+sequence and is skipped, because only Chinese characters are cut by the
+model.
+*/
+type Trainer struct {
+	start map[byte]float64
+	trans map[byte]map[byte]float64
+	emit  map[byte]map[rune]float64
+	runes map[rune]struct{}
+}
+
+// NewTrainer creates an empty Trainer.
+func NewTrainer() *Trainer {
+	t := &Trainer{
+		start: make(map[byte]float64, len(states)),
+		trans: make(map[byte]map[byte]float64, len(states)),
+		emit:  make(map[byte]map[rune]float64, len(states)),
+		runes: make(map[rune]struct{}),
+	}
+	for i := 0; i < len(states); i++ {
+		t.trans[states[i]] = make(map[byte]float64, len(states))
+		t.emit[states[i]] = make(map[rune]float64)
+	}
+	return t
+}
+
+// Add counts the words of one segmented sentence.
+func (t *Trainer) Add(words ...string) {
+	var prev byte // the state of the previous rune, 0 at the sequence start
+	for _, word := range words {
+		if len(word) == 0 {
+			continue
+		}
+		if !util.IsHan(word) {
+			prev = 0
+			continue
+		}
+		n := len([]rune(word))
+		i := 0
+		for _, r := range word {
+			var state byte
+			switch {
+			case n == 1:
+				state = 'S'
+			case i == 0:
+				state = 'B'
+			case i == n-1:
+				state = 'E'
+			default:
+				state = 'M'
+			}
+			if prev == 0 {
+				t.start[state]++
+			} else {
+				t.trans[prev][state]++
+			}
+			t.emit[state][r]++
+			t.runes[r] = struct{}{}
+			prev = state
+			i++
+		}
+	}
+}
+
+// Read counts a corpus from r, which has one sentence per line with the
+// words separated by whitespaces.
+func (t *Trainer) Read(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		t.Add(strings.Fields(scanner.Text())...)
+	}
+	return scanner.Err()
+}
+
+/*
+Model returns the model of the counted corpus with additive smoothing,
+the probability of an event is
+
+	(count + smoothing) / (total + smoothing * n)
+
+where n is the number of possible events, for example the number of
+distinct runes of the corpus for emissions. The transitions which never
+happen, like B to S, are always missing. Zero smoothing gives the
+maximum likelihood estimation, where the events not in the corpus are
+missing.
+
+It returns ErrEmptyCorpus if no word has been counted, or
+ErrInvalidSmoothing if smoothing is negative or NaN.
+*/
+func (t *Trainer) Model(smoothing float64) (*Model, error) {
+	if !(smoothing >= 0) {
+		return nil, ErrInvalidSmoothing
+	}
+	if len(t.runes) == 0 {
+		return nil, ErrEmptyCorpus
+	}
+	m := newModel()
+	logp := func(count, total, n float64) (float64, bool) {
+		count += smoothing
+		if count <= 0 {
+			return 0, false
+		}
+		return math.Log(count / (total + smoothing*n)), true
+	}
+	total := t.start['B'] + t.start['S']
+	for _, state := range []byte{'B', 'S'} {
+		if p, ok := logp(t.start[state], total, 2); ok {
+			m.start[state] = p
+		}
+	}
+	for i := 0; i < len(states); i++ {
+		from := states[i]
+		total := t.trans[from][nextStates[from][0]] + t.trans[from][nextStates[from][1]]
+		for _, to := range nextStates[from] {
+			if p, ok := logp(t.trans[from][to], total, 2); ok {
+				m.trans[from][to] = p
+			}
+		}
+	}
+	n := float64(len(t.runes))
+	emit := make(map[byte]map[rune]float64, len(states))
+	for i := 0; i < len(states); i++ {
+		state := states[i]
+		total := 0.0
+		for _, count := range t.emit[state] {
+			total += count
+		}
+		emit[state] = make(map[rune]float64, len(t.runes))
+		for r := range t.runes {
+			if p, ok := logp(t.emit[state][r], total, n); ok {
+				emit[state][r] = p
+			}
+		}
+	}
+	m.emit = newEmission(emit)
+	return m, nil
+}
+
+// Train trains a model from the corpus read from r with additive
+// smoothing, see Trainer.Read and Trainer.Model.
+func Train(r io.Reader, smoothing float64) (*Model, error) {
+	t := NewTrainer()
+	if err := t.Read(r); err != nil {
+		return nil, err
+	}
+	return t.Model(smoothing)
+}
+
+// TrainAt is like Train but reads the corpus from the given file name.
+func TrainAt(file string, smoothing float64) (*Model, error) {
+	return util.ReadFile(file, func(r io.Reader) (*Model, error) {
+		return Train(r, smoothing)
+	})
+}
@@ -1,97 +1,73 @@
 package finalseg
 
-import (
-	"fmt"
-	"sort"
-)
-
 const minFloat = -3.14e100
 
-var (
-	prevStatus = map[byte][2]byte{
-		'B': {'E', 'S'},
-		'M': {'M', 'B'},
-		'S': {'S', 'E'},
-		'E': {'B', 'M'},
-	}
-	probStart = map[byte]float64{
-		'B': -0.26268660809250016,
-		'E': -3.14e+100,
-		'M': -3.14e+100,
-		'S': -1.4652633398537678,
-	}
-)
-
-type probState struct {
-	prob  float64
-	state byte
-}
-
-func (p probState) String() string {
-	return fmt.Sprintf("(%f, %x)", p.prob, p.state)
-}
-
-type probStates []*probState
-
-func (ps probStates) Len() int {
-	return len(ps)
+// prevStates are the indexes in states of the states which may come
+// before every state.
+var prevStates = [len(states)][2]int{
+	{2, 3}, // B after E or S
+	{1, 0}, // M after M or B
+	{0, 1}, // E after B or M
+	{3, 2}, // S after S or E
 }
 
-func (ps probStates) Less(i, j int) bool {
-	if ps[i].prob == ps[j].prob {
-		return ps[i].state < ps[j].state
-	}
-	return ps[i].prob < ps[j].prob
-}
+// stackRun is the number of runes decoded by appendHan without allocation.
+const stackRun = 64
 
-func (ps probStates) Swap(i, j int) {
-	ps[i], ps[j] = ps[j], ps[i]
-}
+/*
+viterbi returns the log probability of the most probable states of the n
+runes of sentence, and the states, using scratch of at least n*5 bytes
+for the backpointers and the states.
 
-func viterbi(obs []rune, states ...byte) (float64, []byte) {
-	path := [256][]byte{}
-	newPath := [256][]byte{}
-	V := make([][256]float64, len(obs))
-	for _, y := range states {
-		if val, ok := probEmit[y][obs[0]]; ok {
-			V[0][y] = val + probStart[y]
-		} else {
-			V[0][y] = minFloat + probStart[y]
+Of the states with the same probability, the one greater in byte wins.
+*/
+func (m *Model) viterbi(sentence string, n int, scratch []byte) (float64, []byte) {
+	back, path := scratch[:n*len(states)], scratch[n*len(states):n*(len(states)+1)]
+	var trans [len(states)][2]float64 // from prevStates[y][k] to y
+	for y := range prevStates {
+		for k, y0 := range prevStates[y] {
+			if p, ok := m.trans[states[y0]][states[y]]; ok {
+				trans[y][k] = p
+			} else {
+				trans[y][k] = minFloat
+			}
 		}
-		path[y] = []byte{y}
 	}
-	for t := 1; t < len(obs); t++ {
-		for _, y := range states {
-			ps0 := make(probStates, 0, 2)
-			var emP float64
-			if val, ok := probEmit[y][obs[t]]; ok {
-				emP = val
-			} else {
-				emP = minFloat
+	var v, next [len(states)]float64
+	t := 0
+	for _, r := range sentence {
+		j := m.emit.index(r)
+		for y := range v {
+			emP := minFloat
+			if j >= 0 {
+				emP = m.emit.probs[y][j]
+			}
+			if t == 0 {
+				next[y] = emP + m.start[states[y]]
+				continue
 			}
-			for _, y0 := range prevStatus[y] {
-				var transP float64
-				if tp, ok := probTrans[y0][y]; ok {
-					transP = tp
-				} else {
-					transP = minFloat
-				}
-				prob0 := V[t-1][y0] + transP + emP
-				ps0 = append(ps0, &probState{prob: prob0, state: y0})
+			a, b := prevStates[y][0], prevStates[y][1]
+			probA := v[a] + trans[y][0] + emP
+			probB := v[b] + trans[y][1] + emP
+			if probA > probB || probA == probB && states[a] > states[b] {
+				next[y], back[t*len(states)+y] = probA, byte(a)
+			} else {
+				next[y], back[t*len(states)+y] = probB, byte(b)
 			}
-			sort.Sort(sort.Reverse(ps0))
-			V[t][y] = ps0[0].prob
-			pp := make([]byte, len(path[ps0[0].state]))
-			copy(pp, path[ps0[0].state])
-			newPath[y] = append(pp, y)
 		}
-		path = newPath
+		v = next
+		t++
+	}
+	// the last state is E or S
+	y := 3
+	if v[2] > v[3] {
+		y = 2
 	}
-	ps := probStates{
-		&probState{V[len(obs)-1]['E'], 'E'},
-		&probState{V[len(obs)-1]['S'], 'S'},
+	prob := v[y]
+	for t := n - 1; t > 0; t-- {
+		path[t] = states[y]
+		y = int(back[t*len(states)+y])
 	}
-	sort.Sort(sort.Reverse(ps))
-	v := ps[0]
-	return v.prob, path[v.state]
+	path[0] = states[y]
+	return prob, path
 }
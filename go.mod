@@ -2,7 +2,10 @@ module github.com/fumiama/jieba
 
 go 1.19
 
-require github.com/blevesearch/bleve v1.0.14
+require (
+	github.com/blevesearch/bleve v1.0.14
+	golang.org/x/text v0.3.8
+)
 
 require (
 	github.com/RoaringBitmap/roaring v0.4.23 // indirect
@@ -25,5 +28,5 @@ require (
 	github.com/tinylib/msgp v1.1.0 // indirect
 	github.com/willf/bitset v1.1.10 // indirect
 	go.etcd.io/bbolt v1.3.5 // indirect
-	golang.org/x/sys v0.0.0-20200202164722-d101bd2416d5 // indirect
+	golang.org/x/sys v0.0.0-20220722155257-8c9f86f7a55f // indirect
 )
@@ -0,0 +1,73 @@
+// Package nbest finds the k best routes of a DAG, which is shared by the
+// CutNBest of jieba and posseg.
+package nbest
+
+import (
+	"sort"
+
+	"github.com/fumiama/jieba/dictionary"
+)
+
+// Route is one of the best routes from a position of DAG to the end.
+type Route struct {
+	Score float64 // the sum of the costs of the words on the route
+	Index int     // the last rune of the first word
+	Next  int     // the rank of the route starting at Index+1
+}
+
+// Calc keeps at most k best routes from every position of dag to the end,
+// sorted by score in descending order. The score of a word is given by
+// cost of its edge.
+func Calc(dag [][]dictionary.Edge, k int, cost func(e dictionary.Edge) float64) [][]Route {
+	n := len(dag)
+	rs := make([][]Route, n+1)
+	rs[n] = []Route{{Score: 0.0, Index: 0, Next: -1}}
+	for idx := n - 1; idx >= 0; idx-- {
+		var candidates []Route
+		for _, e := range dag[idx] {
+			score := cost(e)
+			for j, r := range rs[e.Index+1] {
+				candidates = append(candidates, Route{Score: score + r.Score, Index: e.Index, Next: j})
+			}
+		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if candidates[i].Score == candidates[j].Score {
+				return candidates[i].Index > candidates[j].Index
+			}
+			return candidates[i].Score > candidates[j].Score
+		})
+		if len(candidates) > k {
+			candidates = candidates[:k]
+		}
+		rs[idx] = candidates
+	}
+	return rs
+}
+
+// Walk calls fn with the words of the route of the given rank from the
+// start, where x is the first rune of the word.
+func Walk(rs [][]Route, rank int, fn func(x int, r Route)) {
+	for x, j := 0, rank; x < len(rs)-1; {
+		r := rs[x][j]
+		fn(x, r)
+		x, j = r.Index+1, r.Next
+	}
+}
+
+// Merge joins every candidate of a with every candidate of b by join,
+// and keeps at most k best ones sorted by score in descending order.
+func Merge[T any](a, b []T, k int, join func(x, y T) T, score func(c T) float64) []T {
+	merged := make([]T, 0, len(a)*len(b))
+	for _, x := range a {
+		for _, y := range b {
+			merged = append(merged, join(x, y))
+		}
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return score(merged[i]) > score(merged[j])
+	})
+	if len(merged) > k {
+		merged = merged[:k]
+	}
+	return merged
+}
@@ -0,0 +1,37 @@
+package nbest
+
+import (
+	"testing"
+
+	"github.com/fumiama/jieba/dictionary"
+)
+
+func TestCalc(t *testing.T) {
+	// the words of "abc" are "a", "ab", "b", "bc" and "c"
+	dag := [][]dictionary.Edge{
+		{{Index: 0, Frequency: 1}, {Index: 1, Frequency: 4}},
+		{{Index: 1, Frequency: 1}, {Index: 2, Frequency: 2}},
+		{{Index: 2, Frequency: 1}},
+	}
+	rs := Calc(dag, 2, func(e dictionary.Edge) float64 { return e.Frequency })
+	expected := [][]int{{0, 2}, {0, 1}} // "ab c" and "a bc"
+	for rank, want := range expected {
+		var starts []int
+		Walk(rs, rank, func(x int, r Route) {
+			starts = append(starts, x)
+		})
+		if len(starts) != len(want) {
+			t.Fatalf("rank %d: got words starting at %v, expected %v", rank, starts, want)
+		}
+		for i := range want {
+			if starts[i] != want[i] {
+				t.Fatalf("rank %d: got words starting at %v, expected %v", rank, starts, want)
+			}
+		}
+	}
+	merged := Merge([]float64{1, 3}, []float64{2, 0}, 3, func(x, y float64) float64 { return x + y },
+		func(c float64) float64 { return c })
+	if len(merged) != 3 || merged[0] != 5 || merged[1] != 3 || merged[2] != 3 {
+		t.Fatalf("got %v, expected [5 3 3]", merged)
+	}
+}
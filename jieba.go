@@ -6,6 +6,7 @@ import (
 	"math"
 	"regexp"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/fumiama/jieba/dictionary"
 	"github.com/fumiama/jieba/finalseg"
@@ -13,29 +14,60 @@ import (
 )
 
 var (
-	reEng         = regexp.MustCompile(`[[:alnum:]]`)
 	reHanCutAll   = regexp.MustCompile(`(\p{Han}+)`)
 	reSkipCutAll  = regexp.MustCompile(`[^[:alnum:]+#\n]`)
 	reHanDefault  = regexp.MustCompile(`([\p{Han}+[:alnum:]+#&\._]+)`)
 	reSkipDefault = regexp.MustCompile(`(\r\n|\s)`)
 )
 
-// Segmenter is a Chinese words segmentation struct.
-type Segmenter Dictionary
+/*
+Segmenter is a Chinese words segmentation struct.
+
+The dictionary of a Segmenter is a snapshot which is replaced atomically
+when loading user dictionaries or reloading, so every cut sees one
+consistent version of dictionary without waiting for the loading.
+*/
+type Segmenter struct {
+	shared    *dictionary.Shared
+	blocks    BlockPatterns
+	normalize bool
+	limits    Limits
+	model     *finalseg.Model // nil for the default model
+	patterns  []*regexp.Regexp
+	protected *regexp.Regexp // patterns joined, nil if no pattern
+}
+
+// NewSegmenter creates a Segmenter using the shared dictionary, every
+// change to the dictionary applies to all segmenters sharing it.
+func NewSegmenter(shared *dictionary.Shared) *Segmenter {
+	return &Segmenter{shared: shared, blocks: DefaultBlockPatterns}
+}
+
+// Shared returns the shared dictionary of Segmenter, which can be used
+// to create segmenters of posseg, analyse and tokenizers.
+func (seg *Segmenter) Shared() *dictionary.Shared {
+	return seg.shared
+}
+
+// Dictionary returns the current dictionary snapshot of Segmenter.
+func (seg *Segmenter) Dictionary() *Dictionary {
+	return seg.shared.Dictionary()
+}
 
 // Frequency returns a word's frequency and existence
 func (seg *Segmenter) Frequency(word string) (float64, bool) {
-	return (*Dictionary)(seg).Frequency(word)
+	return seg.Dictionary().Frequency(word)
 }
 
-// AddWord adds a new word with frequency to dictionary
+// AddWord adds a new word with frequency to dictionary. It copies the whole
+// dictionary, use Shared().Edit to add many words with one copy.
 func (seg *Segmenter) AddWord(word string, frequency float64) {
-	(*Dictionary)(seg).AddToken(dictionary.NewToken(word, frequency, ""))
+	seg.shared.AddToken(dictionary.NewToken(word, frequency, ""))
 }
 
 // DeleteWord removes a word from dictionary
 func (seg *Segmenter) DeleteWord(word string) {
-	(*Dictionary)(seg).AddToken(dictionary.NewToken(word, 0.0, ""))
+	seg.shared.DeleteToken(word)
 }
 
 /*
@@ -53,17 +85,40 @@ further cutted into two words "今天" and "天气",  SuggestFrequency("今天",
 should return the minimum frequency for word "今天天气".
 */
 func (seg *Segmenter) SuggestFrequency(words ...string) float64 {
+	return seg.suggestFrequency(seg.Dictionary(), false, words...)
+}
+
+/*
+TuneFrequency is like SuggestFrequency, but also sets the suggested
+frequency to the word in dictionary, so that the following cuts keep the
+word as a single word, or cut it into the given words.
+*/
+func (seg *Segmenter) TuneFrequency(words ...string) float64 {
+	frequency := seg.suggestFrequency(seg.Dictionary(), true, words...)
+	seg.AddWord(strings.Join(words, ""), frequency)
+	return frequency
+}
+
+// suggestFrequency suggests the frequency of words in d. If strict is set,
+// the frequency for cutting a word is strictly lower than the product of
+// the given words, otherwise the route of the whole word may win by a tie.
+func (seg *Segmenter) suggestFrequency(d *Dictionary, strict bool, words ...string) float64 {
+	total := d.Total()
 	frequency := 1.0
 	if len(words) > 1 {
 		for _, word := range words {
-			if freq, ok := (*Dictionary)(seg).Frequency(word); ok {
+			if freq, ok := d.Frequency(word); ok {
 				frequency *= freq
 			}
-			frequency /= (*Dictionary)(seg).total
+			frequency /= total
+		}
+		if strict {
+			frequency = math.Max(math.Ceil(frequency*total)-1, 0)
+		} else {
+			frequency, _ = math.Modf(frequency * total)
 		}
-		frequency, _ = math.Modf(frequency * (*Dictionary)(seg).total)
 		wordFreq := 0.0
-		if freq, ok := (*Dictionary)(seg).Frequency(strings.Join(words, "")); ok {
+		if freq, ok := d.Frequency(strings.Join(words, "")); ok {
 			wordFreq = freq
 		}
 		if wordFreq < frequency {
@@ -72,16 +127,16 @@ func (seg *Segmenter) SuggestFrequency(words ...string) float64 {
 		return frequency
 	}
 	word := words[0]
-	for _, segment := range seg.Cut(word, false) {
-		if freq, ok := (*Dictionary)(seg).Frequency(segment); ok {
+	seg.cutText(d, word, false, nil, func(segment string) {
+		if freq, ok := d.Frequency(segment); ok {
 			frequency *= freq
 		}
-		frequency /= (*Dictionary)(seg).total
-	}
-	frequency, _ = math.Modf(frequency * (*Dictionary)(seg).total)
+		frequency /= total
+	})
+	frequency, _ = math.Modf(frequency * total)
 	frequency += 1.0
 	wordFreq := 1.0
-	if freq, ok := (*Dictionary)(seg).Frequency(word); ok {
+	if freq, ok := d.Frequency(word); ok {
 		wordFreq = freq
 	}
 	if wordFreq > frequency {
@@ -93,59 +148,47 @@ func (seg *Segmenter) SuggestFrequency(words ...string) float64 {
 // LoadDictionary loads dictionary from given file name. Everytime
 // LoadDictionary is called, previously loaded dictionary will be cleard.
 func LoadDictionary(file io.Reader) (*Segmenter, error) {
-	d := &Dictionary{freqMap: make(map[string]float64)}
-	err := d.loadDictionary(file)
-	return (*Segmenter)(d), err
+	shared, err := dictionary.LoadShared(file)
+	return NewSegmenter(shared), err
 }
 
 // LoadDictionaryAt loads dictionary from given file name. Everytime
 // LoadDictionaryAt is called, previously loaded dictionary will be cleard.
 func LoadDictionaryAt(file string) (*Segmenter, error) {
-	d := &Dictionary{freqMap: make(map[string]float64)}
-	err := d.loadDictionaryAt(file)
-	return (*Segmenter)(d), err
+	shared, err := dictionary.LoadSharedAt(file)
+	return NewSegmenter(shared), err
 }
 
 // LoadUserDictionary loads a user specified dictionary, it must be called
 // after LoadDictionary, and it will not clear any previous loaded dictionary,
 // instead it will override exist entries.
+//
+// The words are loaded into a copy of current dictionary, which replaces
+// the current one only if the whole file is loaded successfully.
 func (seg *Segmenter) LoadUserDictionary(file io.Reader) error {
-	return (*Dictionary)(seg).loadDictionary(file)
+	return seg.shared.LoadUserDictionary(file)
 }
 
 // LoadUserDictionaryAt loads a user specified dictionary, it must be called
 // after LoadDictionary, and it will not clear any previous loaded dictionary,
 // instead it will override exist entries.
+//
+// The words are loaded into a copy of current dictionary, which replaces
+// the current one only if the whole file is loaded successfully.
 func (seg *Segmenter) LoadUserDictionaryAt(file string) error {
-	return (*Dictionary)(seg).loadDictionaryAt(file)
+	return seg.shared.LoadUserDictionaryAt(file)
 }
 
-func (seg *Segmenter) dag(runes []rune) [][]int {
-	n := len(runes)
-	dag := make([][]int, n)
-	for k := 0; k < n; k++ {
-		dag[k] = make([]int, 0, 64)
-		i := k
-		frag := runes[k : k+1]
-		for {
-			freq, ok := (*Dictionary)(seg).Frequency(string(frag))
-			if !ok {
-				break
-			}
-			if freq > 0.0 {
-				dag[k] = append(dag[k], i)
-			}
-			i++
-			if i >= n {
-				break
-			}
-			frag = runes[k : i+1]
-		}
-		if len(dag[k]) == 0 {
-			dag[k] = append(dag[k], k)
-		}
-	}
-	return dag
+// SaveDictionary writes all words in dictionary to w in the text format,
+// which can be loaded again by LoadDictionary.
+func (seg *Segmenter) SaveDictionary(w io.Writer) error {
+	return dictionary.WriteDictionary(w, seg.Dictionary().Tokens())
+}
+
+// SaveDictionaryAt writes all words in dictionary to the given file in the
+// text format, which can be loaded again by LoadDictionaryAt.
+func (seg *Segmenter) SaveDictionaryAt(file string) error {
+	return dictionary.WriteDictionaryAt(file, seg.Dictionary().Tokens())
 }
 
 type route struct {
@@ -153,25 +196,17 @@ type route struct {
 	index     int
 }
 
-func (seg *Segmenter) calc(runes []rune) []*route {
-	dag := seg.dag(runes)
+func calc(d *Dictionary, runes []rune) []route {
+	dag := d.DAG(runes)
+	logTotal := d.LogTotal()
 	n := len(runes)
-	rs := make([]*route, n+1)
-	rs[n] = &route{frequency: 0.0, index: 0}
+	rs := make([]route, n+1)
 	for idx := n - 1; idx >= 0; idx-- {
-		for _, i := range dag[idx] {
-			var r *route
-			if freq, ok := (*Dictionary)(seg).Frequency(string(runes[idx : i+1])); ok {
-				r = &route{frequency: math.Log(freq) - (*Dictionary)(seg).logTotal + rs[i+1].frequency, index: i}
-			} else {
-				r = &route{frequency: math.Log(1.0) - (*Dictionary)(seg).logTotal + rs[i+1].frequency, index: i}
-			}
-			if v := rs[idx]; v == nil {
+		rs[idx] = route{frequency: math.Inf(-1), index: -1}
+		for _, e := range dag[idx] {
+			r := route{frequency: math.Log(e.Frequency) - logTotal + rs[e.Index+1].frequency, index: e.Index}
+			if v := rs[idx]; v.frequency < r.frequency || (v.frequency == r.frequency && v.index < r.index) {
 				rs[idx] = r
-			} else {
-				if v.frequency < r.frequency || (v.frequency == r.frequency && v.index < r.index) {
-					rs[idx] = r
-				}
 			}
 		}
 	}
@@ -184,82 +219,122 @@ const (
 	RatioLetterWordFull float32 = 1
 )
 
-type cutFunc func(sentence string) []string
+// cutFunc calls emit with the words of sentence in order.
+type cutFunc func(d *Dictionary, sentence string, l *util.Limiter, emit func(word string))
 
-func (seg *Segmenter) cutDAG(sentence string) []string {
-	result := make([]string, 0, int(float32(len(sentence))/RatioLetterWord)+1)
+func (seg *Segmenter) cutDAG(d *Dictionary, sentence string, l *util.Limiter, emit func(word string)) {
 	runes := []rune(sentence)
-	routes := seg.calc(runes)
-	buf := make([]rune, 0, 256)
+	seg.cutRoutes(d, sentence, runes, calc(d, runes), l, emit)
+}
+
+// wordEnd returns the byte offset where the word runes[x:y] ends in
+// sentence, and the word starts at byte offset start.
+func wordEnd(sentence string, start, x, y int) int {
+	for ; x < y; x++ {
+		_, size := utf8.DecodeRuneInString(sentence[start:])
+		start += size
+	}
+	return start
+}
+
+// isAlnum reports whether r is an ASCII letter or digit.
+func isAlnum(r rune) bool {
+	return r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+}
+
+/*
+cutRoutes calls emit with the words of runes along routes, and cuts the
+consecutive single runes not in dictionary again by finalseg, the runes
+are kept as they are if l stops.
+
+The words are substrings of sentence, which are not copied.
+*/
+func (seg *Segmenter) cutRoutes(d *Dictionary, sentence string, runes []rune, routes []route, l *util.Limiter, emit func(word string)) {
+	start, n := 0, 0 // byte offset and length in runes of the single runes
+	pos := 0
 	for x := 0; x < len(runes); {
 		y := routes[x].index + 1
-		frag := runes[x:y]
+		end := wordEnd(sentence, pos, x, y)
 		if y-x == 1 {
-			buf = append(buf, frag...)
+			if n == 0 {
+				start = pos
+			}
+			n++
 		} else {
-			if len(buf) > 0 {
-				bufString := string(buf)
-				if len(buf) == 1 {
-					result = append(result, bufString)
-				} else {
-					if v, ok := (*Dictionary)(seg).Frequency(bufString); !ok || v == 0.0 {
-						result = append(result, finalseg.Cut(bufString)...)
-					} else {
-						for _, elem := range buf {
-							result = append(result, string(elem))
-						}
-					}
-				}
-				buf = buf[:0]
+			if n > 0 {
+				seg.cutUnknown(d, sentence[start:pos], n, l, emit)
+				n = 0
 			}
-			result = append(result, string(frag))
+			emit(sentence[pos:end])
 		}
-		x = y
+		x, pos = y, end
 	}
+	if n > 0 {
+		seg.cutUnknown(d, sentence[start:pos], n, l, emit)
+	}
+}
 
-	if len(buf) > 0 {
-		bufString := string(buf)
-		if len(buf) == 1 {
-			result = append(result, bufString)
-		} else {
-			if v, ok := (*Dictionary)(seg).Frequency(bufString); !ok || v == 0.0 {
-				result = append(result, finalseg.Cut(bufString)...)
-			} else {
-				for _, elem := range buf {
-					result = append(result, string(elem))
-				}
-			}
+// cutUnknown calls emit with the words of buf, which consists of n single
+// runes, and emits nothing once l is done.
+func (seg *Segmenter) cutUnknown(d *Dictionary, buf string, n int, l *util.Limiter, emit func(word string)) {
+	if l.Done() {
+		return
+	}
+	if n == 1 {
+		emit(buf)
+		return
+	}
+	if v, ok := d.Frequency(buf); (!ok || v == 0.0) && l.Run(n) {
+		var words [16]string
+		for _, word := range seg.HMMModel().CutAppend(words[:0], buf) {
+			emit(word)
 		}
+		return
 	}
+	emitRunes(buf, emit)
+}
 
-	return result
+// emitRunes calls emit with every rune of s as a substring of s.
+func emitRunes(s string, emit func(word string)) {
+	for len(s) > 0 {
+		_, size := utf8.DecodeRuneInString(s)
+		emit(s[:size])
+		s = s[size:]
+	}
 }
 
-func (seg *Segmenter) cutDAGNoHMM(sentence string) []string {
-	result := make([]string, 0, int(float32(len(sentence))/RatioLetterWord)+1)
+func (seg *Segmenter) cutDAGNoHMM(d *Dictionary, sentence string, _ *util.Limiter, emit func(word string)) {
 	runes := []rune(sentence)
-	routes := seg.calc(runes)
-	buf := make([]rune, 0, 256)
+	seg.cutRoutesNoHMM(sentence, runes, calc(d, runes), emit)
+}
+
+// cutRoutesNoHMM calls emit with the words of runes along routes, and
+// joins the consecutive single letters into one word. The words are
+// substrings of sentence, which are not copied.
+func (seg *Segmenter) cutRoutesNoHMM(sentence string, runes []rune, routes []route, emit func(word string)) {
+	start, n := 0, 0 // byte offset and length in runes of the single letters
+	pos := 0
 	for x := 0; x < len(runes); {
 		y := routes[x].index + 1
-		frag := runes[x:y]
-		if reEng.MatchString(string(frag)) && len(frag) == 1 {
-			buf = append(buf, frag...)
-			x = y
+		end := wordEnd(sentence, pos, x, y)
+		if y-x == 1 && isAlnum(runes[x]) {
+			if n == 0 {
+				start = pos
+			}
+			n++
+			x, pos = y, end
 			continue
 		}
-		if len(buf) > 0 {
-			result = append(result, string(buf))
-			buf = buf[:0]
+		if n > 0 {
+			emit(sentence[start:pos])
+			n = 0
 		}
-		result = append(result, string(frag))
-		x = y
+		emit(sentence[pos:end])
+		x, pos = y, end
 	}
-	if len(buf) > 0 {
-		result = append(result, string(buf))
+	if n > 0 {
+		emit(sentence[start:pos])
 	}
-
-	return result
 }
 
 // Cut cuts a sentence into words using accurate mode.
@@ -267,7 +342,76 @@ func (seg *Segmenter) cutDAGNoHMM(sentence string) []string {
 // Accurate mode attempts to cut the sentence into the most accurate
 // segmentations, which is suitable for text analysis.
 func (seg *Segmenter) Cut(sentence string, hmm bool) []string {
-	result := make([]string, 0, int(float32(len(sentence))/RatioLetterWord)+1)
+	return seg.cut(nil, seg.dictionary(), sentence, hmm, nil)
+}
+
+// CutAppend is like Cut, but appends the words to dst and returns the
+// extended slice, so that the caller can reuse its buffer. The words are
+// substrings of sentence, which are not copied.
+func (seg *Segmenter) CutAppend(dst []string, sentence string, hmm bool) []string {
+	return seg.cut(dst, seg.dictionary(), sentence, hmm, nil)
+}
+
+// cut appends the words of sentence cut by accurate mode to result. A new
+// slice is allocated if result is nil.
+func (seg *Segmenter) cut(result []string, d *Dictionary, sentence string, hmm bool, l *util.Limiter) []string {
+	if result == nil {
+		result = make([]string, 0, int(float32(len(sentence))/RatioLetterWord)+1)
+	}
+	seg.cutRanges(d, sentence, hmm, l, func(start, end int) {
+		result = append(result, sentence[start:end])
+	})
+	return result
+}
+
+/*
+cutRanges calls emit with the byte range [start, end) of every word of
+sentence cut by accurate mode in order, the ranges are adjacent and start
+from 0.
+
+If normalization is enabled, the normalized sentence is cut and the
+ranges are mapped back to the original sentence, and the words which
+split a rune of sentence are joined.
+*/
+func (seg *Segmenter) cutRanges(d *Dictionary, sentence string, hmm bool, l *util.Limiter, emit func(start, end int)) {
+	if !seg.normalize {
+		start := 0
+		seg.cutText(d, sentence, hmm, l, func(word string) {
+			emit(start, start+len(word))
+			start += len(word)
+		})
+		return
+	}
+	normalized, origin := util.Normalize(sentence)
+	start, end := 0, 0 // the start in sentence, and the end in normalized
+	seg.cutText(d, normalized, hmm, l, func(word string) {
+		end += len(word)
+		if end < len(origin)-1 && origin[end] == origin[end-1] {
+			return
+		}
+		emit(start, origin[end])
+		start = origin[end]
+	})
+}
+
+// cutText calls emit with the words of sentence, which is not normalized.
+func (seg *Segmenter) cutText(d *Dictionary, sentence string, hmm bool, l *util.Limiter, emit func(word string)) {
+	if seg.protected == nil {
+		seg.cutBlocks(d, sentence, hmm, l, emit)
+		return
+	}
+	seg.splitProtected(sentence, func(part string, protected bool) {
+		if protected {
+			emit(part)
+			return
+		}
+		seg.cutBlocks(d, part, hmm, l, emit)
+	})
+}
+
+// cutBlocks calls emit with the words of sentence without protected
+// patterns, and stops before the next block if l stops.
+func (seg *Segmenter) cutBlocks(d *Dictionary, sentence string, hmm bool, l *util.Limiter, emit func(word string)) {
 	var cut cutFunc
 	if hmm {
 		cut = seg.cutDAG
@@ -275,49 +419,58 @@ func (seg *Segmenter) Cut(sentence string, hmm bool) []string {
 		cut = seg.cutDAGNoHMM
 	}
 
-	for _, block := range util.RegexpSplit(reHanDefault, sentence, -1) {
+	for _, block := range util.RegexpSplit(seg.blocks.Han, sentence, -1) {
 		if len(block) == 0 {
 			continue
 		}
-		if reHanDefault.MatchString(block) {
-			result = append(result, cut(block)...)
-			continue
+		if l.Done() {
+			break
 		}
-		for _, subBlock := range util.RegexpSplit(reSkipDefault, block, -1) {
-			if reSkipDefault.MatchString(subBlock) {
-				result = append(result, subBlock)
-				continue
-			}
-			for _, r := range subBlock {
-				result = append(result, string(r))
-			}
+		if seg.blocks.Han.MatchString(block) {
+			cut(d, block, l, emit)
+			continue
 		}
+		seg.cutSkipped(block, emit)
 	}
+}
 
-	return result
+// cutSkipped calls emit with the words of a block without Chinese
+// characters, which is cut into whitespaces and single runes.
+func (seg *Segmenter) cutSkipped(block string, emit func(word string)) {
+	for _, subBlock := range util.RegexpSplit(seg.blocks.Skip, block, -1) {
+		if seg.blocks.Skip.MatchString(subBlock) {
+			emit(subBlock)
+			continue
+		}
+		emitRunes(subBlock, emit)
+	}
 }
 
-func (seg *Segmenter) cutAll(sentence string) []string {
-	result := make([]string, 0, int(float32(len(sentence))/RatioLetterWord)+1)
+// cutAll cuts sentence using full mode, and calls word with the byte
+// range [i, j) of every word in sentence.
+func (seg *Segmenter) cutAll(d *Dictionary, sentence string, word func(i, j int)) {
 	runes := []rune(sentence)
-	dag := seg.dag(runes)
+	offsets := make([]int, 0, len(runes)+1)
+	for i := range sentence {
+		offsets = append(offsets, i)
+	}
+	offsets = append(offsets, len(sentence))
+	dag := d.DAG(runes)
 	start := -1
 	for k := 0; k < len(dag); k++ {
 		l := dag[k]
 		if len(l) == 1 && k > start {
-			result = append(result, string(runes[k:l[0]+1]))
-			start = l[0]
+			word(offsets[k], offsets[l[0].Index+1])
+			start = l[0].Index
 			continue
 		}
-		for _, j := range l {
-			if j > k {
-				result = append(result, string(runes[k:j+1]))
-				start = j
+		for _, e := range l {
+			if e.Index > k {
+				word(offsets[k], offsets[e.Index+1])
+				start = e.Index
 			}
 		}
 	}
-
-	return result
 }
 
 // CutAll cuts a sentence into words using full mode.
@@ -325,19 +478,45 @@ func (seg *Segmenter) cutAll(sentence string) []string {
 // Fast but not accurate.
 func (seg *Segmenter) CutAll(sentence string) []string {
 	result := make([]string, 0, int(float32(len(sentence))/RatioLetterWordFull)+1)
+	seg.cutAllRanges(sentence, func(i, j int) {
+		result = append(result, sentence[i:j])
+	})
+	return result
+}
+
+// cutAllRanges calls word with the byte range of every word of CutAll in
+// sentence, the ranges are in order of their starts.
+func (seg *Segmenter) cutAllRanges(sentence string, word func(i, j int)) {
+	d := seg.dictionary()
+	text, origin := sentence, []int(nil)
+	if seg.normalize {
+		text, origin = util.Normalize(sentence)
+	}
+	start := 0
+	blockWord := func(i, j int) {
+		if origin == nil {
+			word(start+i, start+j)
+		} else {
+			word(restoreRange(origin, start+i, start+j))
+		}
+	}
 
-	for _, block := range util.RegexpSplit(reHanCutAll, sentence, -1) {
+	for _, block := range util.RegexpSplit(seg.blocks.HanAll, text, -1) {
 		if len(block) == 0 {
 			continue
 		}
-		if reHanCutAll.MatchString(block) {
-			result = append(result, seg.cutAll(block)...)
-			continue
+		if seg.blocks.HanAll.MatchString(block) {
+			seg.cutAll(d, block, blockWord)
+		} else {
+			i := 0
+			for _, loc := range seg.blocks.SkipAll.FindAllStringIndex(block, -1) {
+				blockWord(i, loc[0])
+				i = loc[1]
+			}
+			blockWord(i, len(block))
 		}
-		result = append(result, reSkipCutAll.Split(block, -1)...)
+		start += len(block)
 	}
-
-	return result
 }
 
 // CutForSearch cuts sentence into words using search engine mode.
@@ -345,17 +524,36 @@ func (seg *Segmenter) CutAll(sentence string) []string {
 // into several short words, which can raise the recall rate.
 // Suitable for search engines.
 func (seg *Segmenter) CutForSearch(sentence string, hmm bool) []string {
+	d := seg.dictionary()
 	result := make([]string, 0, int(float32(len(sentence))/RatioLetterWordFull)+1)
+	return seg.appendSearch(result, d, seg.cut(nil, d, sentence, hmm, nil), searchGrams)
+}
+
+// searchGrams are the lengths of the grams of long words in search mode.
+var searchGrams = []int{2, 3}
 
-	for _, word := range seg.Cut(sentence, hmm) {
-		runes := []rune(word)
-		for _, increment := range []int{2, 3} {
-			if len(runes) <= increment {
+// appendSearch appends every word to result after its grams of the given
+// lengths which are found in dictionary.
+func (seg *Segmenter) appendSearch(result []string, d *Dictionary, words []string, grams []int) []string {
+	offsets := make([]int, 0, 64)
+	for _, word := range words {
+		if seg.isProtected(seg.fold(word)) {
+			result = append(result, word)
+			continue
+		}
+		offsets = offsets[:0]
+		for i := range word {
+			offsets = append(offsets, i)
+		}
+		offsets = append(offsets, len(word))
+		width := len(offsets) - 1
+		for _, increment := range grams {
+			if width <= increment {
 				continue
 			}
-			for i := 0; i < len(runes)-increment+1; i++ {
-				gram := string(runes[i : i+increment])
-				if v, ok := (*Dictionary)(seg).Frequency(gram); ok && v > 0.0 {
+			for i := 0; i < width-increment+1; i++ {
+				gram := word[offsets[i]:offsets[i+increment]]
+				if v, ok := d.Frequency(seg.fold(gram)); ok && v > 0.0 {
 					result = append(result, gram)
 				}
 			}
@@ -1,6 +1,9 @@
 package jieba
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 var (
 	seg          *Segmenter
@@ -615,6 +618,24 @@ var (
 	}
 )
 
+// Small dictionaries shared by the tests which do not need dict.txt.
+const (
+	// testDict cuts "永和服装饰品有限公司" into 永和/服装/饰品/有限公司.
+	testDict = "永和 50\n服装 200\n饰品 100\n有限 300\n公司 600\n有限公司 400\n"
+	// testAmbiguousDict cuts "和尚未" into 和/尚未 or 和尚/未.
+	testAmbiguousDict = "结婚 500\n的 2000\n和 1000\n和尚 300\n尚未 400\n未 200\n尚 50\n"
+)
+
+// loadTestSegmenter creates a Segmenter of the dictionary in text format.
+func loadTestSegmenter(t *testing.T, text string) *Segmenter {
+	t.Helper()
+	s, err := LoadDictionary(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
 func init() {
 	var err error
 	seg, err = LoadDictionaryAt("dict.txt")
@@ -624,14 +645,20 @@ func init() {
 }
 
 func TestCutDAG(t *testing.T) {
-	result := seg.cutDAG("BP神经网络如何训练才能在分类时增加区分度？")
+	var result []string
+	seg.cutDAG(seg.Dictionary(), "BP神经网络如何训练才能在分类时增加区分度？", nil, func(word string) {
+		result = append(result, word)
+	})
 	if len(result) != 11 {
 		t.Fatal(result)
 	}
 }
 
 func TestCutDAGNoHmm(t *testing.T) {
-	result := seg.cutDAGNoHMM("BP神经网络如何训练才能在分类时增加区分度？")
+	var result []string
+	seg.cutDAGNoHMM(seg.Dictionary(), "BP神经网络如何训练才能在分类时增加区分度？", nil, func(word string) {
+		result = append(result, word)
+	})
 	if len(result) != 11 {
 		t.Fatal(result)
 	}
@@ -807,6 +834,16 @@ func BenchmarkCut(b *testing.B) {
 	}
 }
 
+func BenchmarkCutAppendNoHMM(b *testing.B) {
+	sentence := "工信处女干事每月经过下属科室都要亲口交代24口交换机等技术性器件的安装工作"
+	words := make([]string, 0, 64)
+	b.SetBytes(int64(len(sentence)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		words = seg.CutAppend(words[:0], sentence, false)
+	}
+}
+
 func BenchmarkCutAll(b *testing.B) {
 	sentence := "工信处女干事每月经过下属科室都要亲口交代24口交换机等技术性器件的安装工作"
 	b.SetBytes(int64(len(sentence)))
@@ -0,0 +1,214 @@
+package jieba
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/fumiama/jieba/util"
+)
+
+// Span is a candidate word in the lattice of a sentence.
+type Span struct {
+	text               string
+	start, end         int
+	runeStart, runeEnd int
+	frequency          float64
+	logProbability     float64
+	score              float64
+	chosen             bool
+}
+
+// Text returns the span's text.
+func (s Span) Text() string {
+	return s.text
+}
+
+// Start returns the byte offset where the span begins.
+func (s Span) Start() int {
+	return s.start
+}
+
+// End returns the byte offset where the span ends.
+func (s Span) End() int {
+	return s.end
+}
+
+// RuneStart returns the rune offset where the span begins.
+func (s Span) RuneStart() int {
+	return s.runeStart
+}
+
+// RuneEnd returns the rune offset where the span ends.
+func (s Span) RuneEnd() int {
+	return s.runeEnd
+}
+
+// Frequency returns the frequency of the word used in the DAG, which is
+// 1 for a single rune not in dictionary.
+func (s Span) Frequency() float64 {
+	return s.frequency
+}
+
+// LogProbability returns log(frequency/total) of the word.
+func (s Span) LogProbability() float64 {
+	return s.logProbability
+}
+
+// Score returns the score of the best route starting with this span,
+// which is LogProbability plus the best score from the end of the span.
+// The span with the highest score at every position wins.
+func (s Span) Score() float64 {
+	return s.score
+}
+
+// Chosen reports whether the span is on the path chosen by Cut without HMM.
+func (s Span) Chosen() bool {
+	return s.chosen
+}
+
+// Lattice is the word lattice of a sentence, i.e. all words of the DAG.
+type Lattice struct {
+	spans []Span
+}
+
+// Spans returns all candidate words ordered by their start and end.
+func (l *Lattice) Spans() []Span {
+	return l.spans
+}
+
+// Path returns the chosen words in order.
+func (l *Lattice) Path() []Span {
+	path := make([]Span, 0, len(l.spans))
+	for _, s := range l.spans {
+		if s.chosen {
+			path = append(path, s)
+		}
+	}
+	return path
+}
+
+// String renders the lattice as text, one span per line with its rune
+// offsets, and the chosen spans are marked by '*'.
+func (l *Lattice) String() string {
+	var sb strings.Builder
+	for _, s := range l.spans {
+		mark := ' '
+		if s.chosen {
+			mark = '*'
+		}
+		fmt.Fprintf(&sb, "%c [%d,%d) %s\tfreq=%g\tlogp=%.4f\tscore=%.4f\n",
+			mark, s.runeStart, s.runeEnd, s.text, s.frequency, s.logProbability, s.score)
+	}
+	return sb.String()
+}
+
+// DOT renders the lattice in Graphviz DOT language, where nodes are rune
+// offsets and edges are words, and the chosen path is drawn in bold red.
+func (l *Lattice) DOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph lattice {\n\trankdir=LR;\n\tnode [shape=circle];\n")
+	for _, s := range l.spans {
+		fmt.Fprintf(&sb, "\t%d -> %d [label=%q", s.runeStart, s.runeEnd,
+			fmt.Sprintf("%s\n%.4f", s.text, s.logProbability))
+		if s.chosen {
+			sb.WriteString(", style=bold, color=red")
+		}
+		sb.WriteString("];\n")
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+/*
+Lattice returns the word lattice of sentence used by accurate mode, which
+contains every word of the DAG with its frequency, log-probability and
+route score, and marks the path chosen by Cut without HMM.
+
+Only the blocks of Chinese characters are cut by the DAG, so the other
+parts of sentence, including the text matched by protected patterns,
+have no spans.
+
+If normalization is enabled, the DAG is built on the normalized sentence,
+and the text and offsets of the spans are restored into sentence like
+the words of Cut.
+*/
+func (seg *Segmenter) Lattice(sentence string) *Lattice {
+	text, origin := sentence, []int(nil)
+	if seg.normalize {
+		text, origin = util.Normalize(sentence)
+	}
+	d := seg.dictionary()
+	logTotal := d.LogTotal()
+	l := &Lattice{}
+	start, runeStart := 0, 0
+	offsets := make([]int, 0, 64)
+	skip := func(text string) {
+		start += len(text)
+		runeStart += utf8.RuneCountInString(text)
+	}
+	seg.splitProtected(text, func(part string, protected bool) {
+		if protected {
+			skip(part)
+			return
+		}
+		for _, block := range util.RegexpSplit(seg.blocks.Han, part, -1) {
+			if !seg.blocks.Han.MatchString(block) {
+				skip(block)
+				continue
+			}
+			runes := []rune(block)
+			offsets = offsets[:0]
+			for i := range block {
+				offsets = append(offsets, i)
+			}
+			offsets = append(offsets, len(block))
+			dag := d.DAG(runes)
+			routes := calc(d, runes)
+			next := 0
+			for k, edges := range dag {
+				for _, e := range edges {
+					logProbability := math.Log(e.Frequency) - logTotal
+					l.spans = append(l.spans, Span{
+						text:           block[offsets[k]:offsets[e.Index+1]],
+						start:          start + offsets[k],
+						end:            start + offsets[e.Index+1],
+						runeStart:      runeStart + k,
+						runeEnd:        runeStart + e.Index + 1,
+						frequency:      e.Frequency,
+						logProbability: logProbability,
+						score:          logProbability + routes[e.Index+1].frequency,
+						chosen:         k == next && routes[k].index == e.Index,
+					})
+				}
+				if k == next {
+					next = routes[k].index + 1
+				}
+			}
+			skip(block)
+		}
+	})
+	if origin != nil {
+		l.restore(sentence, origin)
+	}
+	return l
+}
+
+// restore maps the spans of the normalized sentence back to sentence.
+func (l *Lattice) restore(sentence string, origin []int) {
+	runeOffsets := make([]int, len(sentence)+1)
+	n := 0
+	for i := range sentence {
+		runeOffsets[i] = n
+		n++
+	}
+	runeOffsets[len(sentence)] = n
+	for i := range l.spans {
+		s := &l.spans[i]
+		s.text = restoreSpan(sentence, origin, s.start, s.end)
+		s.start = origin[s.start]
+		s.end = s.start + len(s.text)
+		s.runeStart, s.runeEnd = runeOffsets[s.start], runeOffsets[s.end]
+	}
+}
@@ -0,0 +1,40 @@
+package jieba
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLattice(t *testing.T) {
+	seg := loadTestSegmenter(t, testAmbiguousDict)
+	sentence := "ok, 和尚未结婚"
+	lattice := seg.Lattice(sentence)
+	var path []string
+	for _, s := range lattice.Path() {
+		if sentence[s.Start():s.End()] != s.Text() {
+			t.Fatalf("got %s at [%d,%d), expected %s", s.Text(), s.Start(), s.End(), sentence[s.Start():s.End()])
+		}
+		path = append(path, s.Text())
+	}
+	if strings.Join(path, "/") != "o/k/和/尚未/结婚" {
+		t.Fatalf("got path %v", path)
+	}
+	var heshang Span
+	for _, s := range lattice.Spans() {
+		if s.Text() == "和尚" {
+			heshang = s
+		}
+	}
+	if heshang.RuneStart() != 4 || heshang.RuneEnd() != 6 || heshang.Chosen() || heshang.Frequency() != 300 {
+		t.Fatalf("got %+v for 和尚", heshang)
+	}
+	if path := lattice.Path(); heshang.Score() >= path[2].Score() {
+		t.Fatalf("和尚 should lose to 和: %f >= %f", heshang.Score(), path[2].Score())
+	}
+	if text := lattice.String(); !strings.Contains(text, "* [5,7) 尚未") || !strings.Contains(text, "  [4,6) 和尚") {
+		t.Fatalf("got text %s", text)
+	}
+	if dot := lattice.DOT(); !strings.HasPrefix(dot, "digraph lattice {") || !strings.Contains(dot, "4 -> 5 [label=\"和\\n") {
+		t.Fatalf("got dot %s", dot)
+	}
+}
@@ -0,0 +1,43 @@
+package jieba
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCutContext(t *testing.T) {
+	seg := loadTestSegmenter(t, "我们 100\n程序员 100\n")
+	sentence := "我们是程序员，鲸鲨鲟鳄"
+	result, err := seg.CutContext(context.Background(), sentence, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := seg.Cut(sentence, true); strings.Join(result, "/") != strings.Join(expected, "/") {
+		t.Fatalf("got %v, expected %v", result, expected)
+	}
+	seg.SetLimits(Limits{MaxInputLength: 16})
+	var limitErr *LimitError
+	if _, err = seg.CutContext(context.Background(), sentence, true); !errors.As(err, &limitErr) || limitErr.Limit != "MaxInputLength" {
+		t.Fatalf("got %v, expected MaxInputLength exceeded", err)
+	}
+	seg.SetLimits(Limits{MaxHMMRun: 3})
+	if _, err = seg.CutContext(context.Background(), sentence, true); !errors.As(err, &limitErr) || limitErr.Limit != "MaxHMMRun" || limitErr.Length != 4 {
+		t.Fatalf("got %v, expected MaxHMMRun exceeded", err)
+	}
+	if _, err = seg.CutContext(context.Background(), sentence, false); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = seg.CutContext(ctx, sentence, true); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, expected %v", err, context.Canceled)
+	}
+	// 有限 is in dictionary, so its single runes are not cut by HMM
+	seg = loadTestSegmenter(t, "有 1000\n限 1000\n有限 1\n")
+	seg.SetLimits(Limits{MaxHMMRun: 1})
+	if result, err := seg.CutContext(context.Background(), "有限", true); err != nil || strings.Join(result, "/") != "有/限" {
+		t.Fatalf("got %v %v, expected [有 限]", result, err)
+	}
+}
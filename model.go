@@ -0,0 +1,19 @@
+package jieba
+
+import "github.com/fumiama/jieba/finalseg"
+
+// SetHMMModel sets the Hidden Markov Model used to cut the consecutive
+// runes not in dictionary, nil for finalseg.DefaultModel. It should not
+// be called concurrently with cutting.
+func (seg *Segmenter) SetHMMModel(model *finalseg.Model) {
+	seg.model = model
+}
+
+// HMMModel returns the Hidden Markov Model used to cut the consecutive
+// runes not in dictionary.
+func (seg *Segmenter) HMMModel() *finalseg.Model {
+	if seg.model == nil {
+		return finalseg.DefaultModel()
+	}
+	return seg.model
+}
@@ -0,0 +1,28 @@
+package jieba
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fumiama/jieba/finalseg"
+)
+
+func TestHMMModel(t *testing.T) {
+	seg := loadTestSegmenter(t, "来到 100\n")
+	if seg.HMMModel() != finalseg.DefaultModel() {
+		t.Fatal("expected the default model")
+	}
+	model, err := finalseg.LoadModel(strings.NewReader("start S 0\ntrans S S 0\nemit S 杭 -1\nemit S 研 -1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	seg.SetHMMModel(model)
+	expected := []string{"杭", "研", "来到"}
+	if result := seg.Cut("杭研来到", true); strings.Join(result, "/") != strings.Join(expected, "/") {
+		t.Fatalf("got %v, expected %v", result, expected)
+	}
+	c := New(seg.Shared(), WithHMMModel(model))
+	if result := c.Cut("杭研来到"); strings.Join(result, "/") != strings.Join(expected, "/") {
+		t.Fatalf("got %v, expected %v", result, expected)
+	}
+}
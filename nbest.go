@@ -0,0 +1,139 @@
+package jieba
+
+import (
+	"math"
+	"strings"
+
+	"github.com/fumiama/jieba/dictionary"
+	"github.com/fumiama/jieba/internal/nbest"
+	"github.com/fumiama/jieba/util"
+)
+
+// Candidate is a candidate segmentation with its score.
+type Candidate struct {
+	words []string
+	score float64
+}
+
+// Words returns the words of the candidate.
+func (c Candidate) Words() []string {
+	return c.words
+}
+
+// Score returns the log-probability of the candidate, which is the sum of
+// log(frequency/total) of all words chosen from the DAG.
+func (c Candidate) Score() float64 {
+	return c.score
+}
+
+// cutNBest returns at most k candidates of a block of Chinese characters,
+// candidates with the same words are merged into the best one.
+func (seg *Segmenter) cutNBest(d *Dictionary, block string, k int, hmm bool) []Candidate {
+	runes := []rune(block)
+	logTotal := d.LogTotal()
+	rs := nbest.Calc(d.DAG(runes), k, func(e dictionary.Edge) float64 {
+		return math.Log(e.Frequency) - logTotal
+	})
+	candidates := make([]Candidate, 0, len(rs[0]))
+	seen := make(map[string]struct{}, len(rs[0]))
+	routes := make([]route, len(runes)+1)
+	for rank := range rs[0] {
+		nbest.Walk(rs, rank, func(x int, r nbest.Route) {
+			routes[x] = route{frequency: r.Score, index: r.Index}
+		})
+		var words []string
+		emit := func(word string) {
+			words = append(words, word)
+		}
+		if hmm {
+			seg.cutRoutes(d, block, runes, routes, nil, emit)
+		} else {
+			seg.cutRoutesNoHMM(block, runes, routes, emit)
+		}
+		key := strings.Join(words, "\x00")
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		candidates = append(candidates, Candidate{words: words, score: rs[0][rank].Score})
+	}
+	return candidates
+}
+
+// mergeCandidates joins every candidate of a with every candidate of b,
+// and keeps at most k best ones.
+func mergeCandidates(a, b []Candidate, k int) []Candidate {
+	return nbest.Merge(a, b, k, func(x, y Candidate) Candidate {
+		words := make([]string, 0, len(x.words)+len(y.words))
+		words = append(append(words, x.words...), y.words...)
+		return Candidate{words: words, score: x.score + y.score}
+	}, Candidate.Score)
+}
+
+/*
+CutNBest cuts a sentence into at most k candidate segmentations using
+accurate mode, ranked by their scores in descending order, so the first
+candidate is the same as the result of Cut.
+
+The candidates are the k best routes of the DAG, scored by the same
+frequency model as Cut. Parameter hmm controls whether to cut the
+consecutive single characters not in dictionary again by the Hidden
+Markov Model, which does not change the scores.
+
+If normalization is enabled, the candidates are cut from the normalized
+sentence and their words are restored like Cut, and the candidates which
+become the same words are merged into the best one.
+*/
+func (seg *Segmenter) CutNBest(sentence string, k int, hmm bool) []Candidate {
+	if k <= 0 {
+		return nil
+	}
+	if !seg.normalize {
+		return seg.cutNBestText(sentence, k, hmm)
+	}
+	normalized, origin := util.Normalize(sentence)
+	candidates := seg.cutNBestText(normalized, k, hmm)
+	result := candidates[:0]
+	seen := make(map[string]struct{}, len(candidates))
+	for _, c := range candidates {
+		c.words = restoreWords(sentence, origin, c.words)
+		key := strings.Join(c.words, "\x00")
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		result = append(result, c)
+	}
+	return result
+}
+
+// cutNBestText returns at most k candidates of sentence without
+// normalization.
+func (seg *Segmenter) cutNBestText(sentence string, k int, hmm bool) []Candidate {
+	d := seg.dictionary()
+	candidates := []Candidate{{}}
+	appendWords := func(words ...string) {
+		for i := range candidates {
+			candidates[i].words = append(candidates[i].words, words...)
+		}
+	}
+	seg.splitProtected(sentence, func(part string, protected bool) {
+		if protected {
+			appendWords(part)
+			return
+		}
+		for _, block := range util.RegexpSplit(seg.blocks.Han, part, -1) {
+			if len(block) == 0 {
+				continue
+			}
+			if seg.blocks.Han.MatchString(block) {
+				candidates = mergeCandidates(candidates, seg.cutNBest(d, block, k, hmm), k)
+				continue
+			}
+			seg.cutSkipped(block, func(word string) {
+				appendWords(word)
+			})
+		}
+	})
+	return candidates
+}
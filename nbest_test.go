@@ -0,0 +1,35 @@
+package jieba
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCutNBest(t *testing.T) {
+	seg := loadTestSegmenter(t, testAmbiguousDict)
+	sentence := "结婚的和尚未结婚的, ok"
+	candidates := seg.CutNBest(sentence, 3, false)
+	if len(candidates) != 3 {
+		t.Fatalf("got %d candidates, expected 3", len(candidates))
+	}
+	if best := strings.Join(candidates[0].Words(), "/"); best != strings.Join(seg.Cut(sentence, false), "/") {
+		t.Fatalf("got best %s, expected the same as Cut", best)
+	}
+	if best := strings.Join(candidates[0].Words(), "/"); best != "结婚/的/和/尚未/结婚/的/,/ /ok" {
+		t.Fatalf("got best %s", best)
+	}
+	if second := strings.Join(candidates[1].Words(), "/"); second != "结婚/的/和尚/未/结婚/的/,/ /ok" {
+		t.Fatalf("got second %s", second)
+	}
+	for i := 1; i < len(candidates); i++ {
+		if candidates[i].Score() > candidates[i-1].Score() {
+			t.Fatalf("candidate %d is not ranked: %f > %f", i, candidates[i].Score(), candidates[i-1].Score())
+		}
+	}
+	if candidates := seg.CutNBest(sentence, 0, true); candidates != nil {
+		t.Fatalf("got %v, expected nil", candidates)
+	}
+	if candidates := seg.CutNBest("结婚", 10, true); len(candidates) != 1 {
+		t.Fatalf("got %v, expected 1 candidate", candidates)
+	}
+}
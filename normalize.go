@@ -0,0 +1,80 @@
+package jieba
+
+import "github.com/fumiama/jieba/util"
+
+/*
+SetNormalization enables or disables the normalization of Segmenter.
+
+If enabled, every rune is normalized by NFKC and Latin letters are
+lowercased before looking up the dictionary and matching the patterns,
+see util.NormalizeRune, so "ＡＢＣ１２３" and "ABC123"
+both match the word "abc123" of dictionary. The returned words and
+tokens are still taken from the original sentence, and their offsets
+point into it. A rune which is normalized into several runes, like "㎏",
+is never cut apart. It applies to Cut, CutAll, CutForSearch, Tokenize
+and the functions based on them.
+
+The words of dictionary are normalized the same way, see
+Dictionary.Normalized, so "T恤" of dictionary matches both "T恤" and
+"ｔ恤". Normalization is disabled by default, and it should not be
+changed concurrently with cutting.
+*/
+func (seg *Segmenter) SetNormalization(enabled bool) {
+	seg.normalize = enabled
+}
+
+// Normalization reports whether the normalization of Segmenter is enabled.
+func (seg *Segmenter) Normalization() bool {
+	return seg.normalize
+}
+
+// dictionary returns the current dictionary to cut with, whose words are
+// normalized if normalization is enabled.
+func (seg *Segmenter) dictionary() *Dictionary {
+	if !seg.normalize {
+		return seg.Dictionary()
+	}
+	return seg.Dictionary().Normalized()
+}
+
+// fold returns the normalized word if normalization is enabled.
+func (seg *Segmenter) fold(word string) string {
+	if !seg.normalize {
+		return word
+	}
+	normalized, _ := util.Normalize(word)
+	return normalized
+}
+
+// restoreWords replaces the words cut from the normalized sentence with
+// the original text in place, and joins the words which split a rune of
+// sentence. The words must cover the normalized sentence in order.
+func restoreWords(sentence string, origin []int, words []string) []string {
+	result := words[:0]
+	start, end := 0, 0
+	for _, word := range words {
+		end += len(word)
+		if end > 0 && end < len(origin)-1 && origin[end] == origin[end-1] {
+			continue
+		}
+		result = append(result, sentence[start:origin[end]])
+		start = origin[end]
+	}
+	return result
+}
+
+// restoreSpan returns the original text of normalized[i:j], which is
+// widened to whole runes of sentence.
+func restoreSpan(sentence string, origin []int, i, j int) string {
+	i, j = restoreRange(origin, i, j)
+	return sentence[i:j]
+}
+
+// restoreRange returns the original byte range of normalized[i:j], which
+// is widened to whole runes of sentence.
+func restoreRange(origin []int, i, j int) (int, int) {
+	for j > i && j < len(origin)-1 && origin[j] == origin[j-1] {
+		j++
+	}
+	return origin[i], origin[j]
+}
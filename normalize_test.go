@@ -0,0 +1,62 @@
+package jieba
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalization(t *testing.T) {
+	seg := loadTestSegmenter(t, "abc123 100\n手机 100\n重量 100\n")
+	sentence := "ＡＢＣ１２３手机，Abc123重量5㎏"
+	expected := []string{"Ａ", "Ｂ", "Ｃ", "１", "２", "３", "手机", "，", "Abc123", "重量", "5", "㎏"}
+	if result := seg.Cut(sentence, false); strings.Join(result, "/") != strings.Join(expected, "/") {
+		t.Fatalf("got %v, expected %v", result, expected)
+	}
+	seg.SetNormalization(true)
+	expected = []string{"ＡＢＣ１２３", "手机", "，", "Abc123", "重量", "5㎏"}
+	if result := seg.Cut(sentence, false); strings.Join(result, "/") != strings.Join(expected, "/") {
+		t.Fatalf("got %v, expected %v", result, expected)
+	}
+	if candidates := seg.CutNBest(sentence, 3, false); strings.Join(candidates[0].Words(), "/") != strings.Join(expected, "/") {
+		t.Fatalf("got %v, expected %v", candidates[0].Words(), expected)
+	}
+	path := seg.Lattice(sentence).Path()
+	if len(path) == 0 || path[0].Text() != "ＡＢＣ１２３" || path[0].RuneEnd() != 6 {
+		t.Fatalf("got path %v", path)
+	}
+	for _, span := range seg.Lattice(sentence).Spans() {
+		if sentence[span.Start():span.End()] != span.Text() {
+			t.Fatalf("got %s at [%d,%d)", span.Text(), span.Start(), span.End())
+		}
+	}
+	for _, token := range seg.Tokenize(sentence, SearchMode, false) {
+		if sentence[token.Start():token.End()] != token.Text() {
+			t.Fatalf("got %s at [%d,%d)", token.Text(), token.Start(), token.End())
+		}
+	}
+	expected = []string{"ＡＢＣ１２３", "手机", "", "Abc123", "重量", "5㎏"}
+	if result := seg.CutAll(sentence); strings.Join(result, "/") != strings.Join(expected, "/") {
+		t.Fatalf("got %v, expected %v", result, expected)
+	}
+}
+
+func TestNormalizedDictionary(t *testing.T) {
+	seg := loadTestSegmenter(t, "买 100\nT恤 100\nt恤 50\nX光 100\n")
+	seg.SetNormalization(true)
+	for sentence, expected := range map[string][]string{
+		"买T恤": {"买", "T恤"},
+		"买ｔ恤": {"买", "ｔ恤"},
+		"买Ｘ光": {"买", "Ｘ光"},
+	} {
+		if result := seg.Cut(sentence, false); strings.Join(result, "/") != strings.Join(expected, "/") {
+			t.Fatalf("got %v, expected %v", result, expected)
+		}
+	}
+	if freq, ok := seg.Dictionary().Normalized().Frequency("t恤"); !ok || freq != 150 {
+		t.Fatalf("got frequency %f of t恤, expected 150", freq)
+	}
+	seg.DeleteWord("X光")
+	if result := seg.Cut("买X光", false); len(result) != 3 {
+		t.Fatalf("got %v after deleting X光", result)
+	}
+}
@@ -0,0 +1,29 @@
+package posseg
+
+import (
+	"context"
+
+	"github.com/fumiama/jieba/util"
+)
+
+// BatchOptions controls how CutBatch cuts sentences.
+type BatchOptions struct {
+	// Workers is the number of goroutines to use,
+	// runtime.NumCPU() is used if it is not positive.
+	Workers int
+	// HMM controls whether to use the Hidden Markov Model.
+	HMM bool
+}
+
+// CutBatch cuts sentences concurrently, and the segments of sentences[i]
+// are stored in the i-th element of the result.
+//
+// It stops as soon as ctx is done and returns ctx.Err(), in which case
+// the sentences not yet cut are left nil in the result.
+func (seg *Segmenter) CutBatch(ctx context.Context, sentences []string, opts BatchOptions) ([][]Segment, error) {
+	results := make([][]Segment, len(sentences))
+	err := util.Parallel(ctx, len(sentences), opts.Workers, func(i int) {
+		results[i] = seg.Cut(sentences[i], opts.HMM)
+	})
+	return results, err
+}
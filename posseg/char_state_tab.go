@@ -44,6667 +44,7 @@ func newTag(position, pos string) (tag, error) {
 	return tag(positionIndex + posIndex), nil
 }
 
-type charStateTabMap map[rune][]uint16
-
-func (m charStateTabMap) get(key rune) []uint16 {
-	if value, ok := m[key]; ok {
-		return value
-	}
-	return probTransKeys
-}
-
 var (
-	charStateTab = charStateTabMap{
-		'\u4e00': []uint16{122, 422, 107, 100, 322, 125, 145, 238, 222, 307, 142, 138, 115, 256, 204, 315, 104, 143, 117, 120, 320, 106, 306, 327, 124, 243, 152, 325, 332, 200, 225, 252, 343, 207, 156, 112, 304, 227, 220, 130, 317, 224, 338, 127, 215, 331, 132, 228, 352, 229, 217, 330, 129, 131, 328, 329, 232, 135, 335, 324},
-		'\u4e01': []uint16{127, 427, 227, 225, 330, 325, 125, 327, 332, 229, 232, 207, 133, 122, 454, 331, 130, 215, 252, 322, 315, 426, 230, 329, 128, 320, 333, 132, 115, 152, 220, 143, 129, 104, 228},
-		'\u4e03': []uint16{122, 322, 422, 222, 143, 125, 130, 115, 315, 327, 132, 230, 227, 332, 330, 104, 325, 120, 243, 331, 225, 131, 117, 127, 320, 232, 324, 317},
-		'\u4e07': []uint16{122, 422, 222, 322, 125, 132, 127, 315, 104, 130, 143, 115, 107, 327, 227, 320, 215, 229, 120, 325, 124, 332, 129, 330, 135, 324, 100, 131, 117, 128, 329, 152, 220, 230, 328},
-		'\u4e08': []uint16{125, 222, 435, 225, 325, 215, 152, 227, 422, 315, 320, 132, 327, 229, 115, 220},
-		'\u4e09': []uint16{122, 422, 322, 124, 222, 125, 327, 130, 117, 132, 104, 330, 243, 143, 325, 115, 315, 332, 129, 120, 320, 207, 343, 238, 227, 225, 138, 317, 215, 127, 131, 331, 152, 232, 228, 217, 156, 142, 324, 220, 328, 307, 224, 352, 304},
-		'\u4e0a': []uint16{412, 152, 242, 130, 212, 252, 143, 125, 225, 207, 112, 104, 117, 243, 220, 131, 352, 315, 325, 320, 120, 230, 215, 127, 142, 327, 156, 132, 122, 129, 306, 100, 312, 204, 304, 330, 115, 227, 162, 343, 262, 322, 331, 229, 317, 124, 300, 329, 332, 324},
-		'\u4e0b': []uint16{412, 152, 252, 212, 225, 222, 242, 125, 143, 112, 325, 207, 322, 243, 320, 220, 215, 200, 204, 104, 120, 306, 352, 238, 315, 256, 130, 317, 156, 100, 307, 230, 330, 422, 327, 312, 343, 227, 115, 229, 304, 331, 135, 232, 129, 132, 356, 224, 324, 107, 332, 231},
-		'\u4e0d': []uint16{407, 152, 107, 100, 125, 106, 315, 320, 352, 122, 207, 115, 120, 108, 307, 220, 162, 325, 306, 143, 300, 330, 130, 104, 343, 142, 132, 156, 327, 206, 129, 332, 322, 217, 362, 101, 304, 329, 127, 317},
-		'\u4e0e': []uint16{434, 252, 106, 325, 115, 152, 145, 120, 315, 327, 125, 331, 107, 320, 332, 227, 130, 215},
-		'\u4e10': []uint16{225, 413, 327, 227, 125},
-		'\u4e11': []uint16{400, 125, 100, 225, 222, 252, 227, 320, 152, 315, 322, 115, 327, 243, 143, 120, 332, 215, 203, 217, 417, 343, 200},
-		'\u4e13': []uint16{125, 107, 407, 152, 156, 104, 100, 120, 320, 217, 101, 225, 317, 115, 132, 227, 327, 117, 331, 325, 204, 315, 304, 220, 231},
-		'\u4e14': []uint16{206, 406, 106, 207, 120, 130, 315, 252, 320, 227},
-		'\u4e15': []uint16{413, 227, 327, 228},
-		'\u4e16': []uint16{125, 426, 327, 252, 225, 332, 325, 229, 227, 343, 143, 120, 142, 315, 127, 131, 156, 243, 215, 117, 132, 320, 220, 217, 104, 230, 204, 324, 331, 115, 152, 207, 322, 122, 328, 352, 222, 232, 100, 256, 330, 329},
-		'\u4e18': []uint16{125, 225, 127, 230, 427, 227, 325, 435, 327, 330, 426, 229, 130, 315, 331, 332, 232, 129, 215, 132, 142, 329},
-		'\u4e19': []uint16{125, 325, 423, 332, 327, 122, 227, 132, 228, 315, 232},
-		'\u4e1a': []uint16{225, 325, 125, 252, 331, 256, 426, 217, 356, 112, 220, 232, 204, 227, 320, 352, 104, 107, 215, 332, 231, 425, 327, 315, 117, 330, 238, 229, 304, 120, 230, 343, 407, 132, 115, 228},
-		'\u4e1b': []uint16{225, 125, 427, 127, 112, 152, 227, 435, 325, 426, 320, 327, 322, 222, 135, 235, 315, 142, 130, 115, 362, 262},
-		'\u4e1c': []uint16{125, 230, 112, 130, 412, 225, 142, 227, 127, 330, 143, 327, 117, 132, 212, 331, 104, 131, 325, 152, 115, 229, 332, 242, 315, 320, 232, 312, 120, 129, 231, 228, 156, 329, 252, 128, 220, 118, 328, 317},
-		'\u4e1d': []uint16{225, 125, 425, 222, 122, 435, 325, 315, 252, 227, 120, 104, 327, 132, 362, 232, 224, 324, 229, 129, 320, 162, 262, 230, 220, 115, 332, 256, 422, 322, 330, 215, 135, 204, 329},
-		'\u4e1e': []uint16{125, 325, 327, 225, 228},
-		'\u4e22': []uint16{452, 152, 100, 120, 115, 127},
-		'\u4e24': []uint16{122, 422, 125, 112, 222, 322, 138, 117, 104, 130, 315, 225, 325, 120, 115, 320, 162, 317, 143, 215, 152, 327, 132, 331, 156, 332, 220, 227, 352, 129},
-		'\u4e25': []uint16{100, 101, 400, 127, 152, 200, 125, 225, 103, 207, 130, 327, 115, 227, 162, 362, 252, 215, 143, 332, 220, 107, 120, 315, 128, 325, 330, 117, 232, 153, 320, 156},
-		'\u4e27': []uint16{152, 454, 125, 200, 252, 315, 115, 256, 426, 320, 100, 104, 325, 120, 117, 332},
-		'\u4e2a': []uint16{222, 238, 435, 422, 125, 204, 322, 100, 207, 135, 235, 325, 307, 343, 243, 338, 117, 152, 320, 120, 138, 225, 130, 122, 317, 156, 352, 332, 327, 345},
-		'\u4e2b': []uint16{125, 327, 425, 325, 225, 426, 227, 352, 330},
-		'\u4e2d': []uint16{412, 130, 125, 238, 242, 131, 117, 112, 252, 132, 104, 212, 143, 152, 230, 331, 327, 225, 320, 207, 330, 325, 227, 317, 129, 229, 315, 100, 217, 243, 304, 120, 220, 107, 342, 156, 127, 232, 228, 200, 332, 215, 115, 142, 312, 231, 356, 204, 256, 352, 328, 343, 318},
-		'\u4e30': []uint16{100, 230, 402, 232, 127, 132, 227, 152, 125, 115, 130, 331, 427, 327, 330, 252, 229, 315, 120, 131, 156, 320, 220, 332, 129, 325, 228, 352, 215, 200, 329},
-		'\u4e32': []uint16{452, 225, 222, 152, 125, 252, 156, 104, 320, 256, 130, 115, 325, 227, 117, 232, 120, 435, 315},
-		'\u4e34': []uint16{252, 104, 152, 452, 130, 230, 156, 227, 125, 315, 327, 331, 129, 115, 332, 107, 325, 320, 132, 131, 120, 330, 117, 228, 232, 220},
-		'\u4e38': []uint16{225, 426, 435, 125, 227, 232, 327, 315, 325, 132},
-		'\u4e39': []uint16{230, 130, 227, 127, 427, 225, 125, 327, 417, 330, 332, 325, 252, 426, 132, 129, 315, 131, 229, 115, 331, 232, 320, 215, 120},
-		'\u4e3a': []uint16{434, 252, 206, 152, 207, 134, 138, 225, 315, 227, 320, 145, 327, 120, 215, 125, 115, 106, 230, 129, 220, 228, 325, 238, 232, 100, 300, 331, 330, 256},
-		'\u4e3b': []uint16{125, 104, 325, 252, 225, 425, 152, 200, 101, 156, 332, 215, 331, 227, 320, 330, 327, 120, 352, 117, 220, 129, 304, 356, 315, 230, 229, 253},
-		'\u4e3d': []uint16{200, 327, 227, 427, 130, 127, 262, 230, 315, 229, 329, 125, 330, 131, 225, 325, 129, 402, 215, 132, 332, 454, 252, 328, 320, 228, 100},
-		'\u4e3e': []uint16{152, 452, 252, 125, 207, 115, 238, 225, 315, 325, 227, 215, 120, 320, 220, 327, 107, 156, 332, 352, 331, 256, 228},
-		'\u4e43': []uint16{452, 106, 152, 327, 227, 325, 252, 125, 130, 330, 320, 315, 127},
-		'\u4e45': []uint16{400, 222, 200, 238, 152, 207, 107, 343, 204, 325, 243, 227, 315, 320, 327, 115, 215, 162, 322, 120, 220, 143, 252, 352, 201, 100, 129, 127, 132, 230, 225, 130, 330},
-		'\u4e47': []uint16{435, 227},
-		'\u4e48': []uint16{238, 338, 460, 427, 207, 220, 252, 206, 320, 227, 127, 327},
-		'\u4e49': []uint16{225, 125, 252, 426, 227, 325, 327, 427, 220, 232, 204, 230, 215, 330, 120, 256, 315, 130, 115, 200, 132, 332, 331, 100, 152, 431, 320, 229, 131, 352, 129, 228, 356, 156, 329, 127},
-		'\u4e4b': []uint16{445, 112, 138, 315, 320, 327, 206, 227, 106, 207, 352, 130, 215, 325, 322, 306, 125, 252, 324, 220, 234, 225, 228, 332, 342, 243, 127, 222, 115, 334, 330},
-		'\u4e4c': []uint16{130, 125, 127, 417, 129, 162, 327, 132, 115, 227, 330, 230, 315, 225, 329, 232, 131, 362, 262, 325, 229, 215, 117, 152, 320, 231},
-		'\u4e4d': []uint16{407, 452, 130, 315, 120, 227},
-		'\u4e4e': []uint16{207, 252, 460, 262, 362, 315, 320, 434, 215, 200, 220, 227, 206, 327, 256},
-		'\u4e4f': []uint16{252, 100, 200, 452, 325, 215, 120, 203, 315, 152, 332, 400, 454},
-		'\u4e50': []uint16{225, 125, 100, 325, 400, 200, 127, 256, 227, 230, 252, 152, 331, 130, 315, 332, 327, 330, 320, 215, 232, 243, 115, 229, 452, 162, 129, 132, 220, 120, 352, 204, 362, 262, 343, 231, 228, 317},
-		'\u4e52': []uint16{125, 417, 117, 333, 133, 331, 227, 217},
-		'\u4e53': []uint16{325, 433, 217, 333, 233, 331},
-		'\u4e54': []uint16{127, 227, 125, 427, 327, 129, 130, 115, 332, 229, 329, 426, 152, 117, 128, 132, 330, 131, 104},
-		'\u4e56': []uint16{400, 125, 100, 225, 207, 107, 252, 162, 227, 327, 315},
-		'\u4e58': []uint16{452, 125, 152, 107, 232, 252, 227, 134, 225, 115, 315, 120, 132, 262, 327, 215, 325, 224, 220, 156, 200, 104, 256, 320, 330},
-		'\u4e59': []uint16{125, 425, 325, 332, 227, 327, 132, 120, 122, 225, 422, 104, 423, 128, 317, 328, 232},
-		'\u4e5c': []uint16{427, 152, 127, 227, 327},
-		'\u4e5d': []uint16{122, 322, 422, 327, 130, 222, 143, 125, 132, 227, 117, 315, 127, 243, 115, 332, 131, 225, 220, 142, 325, 104, 320, 324, 330, 331, 217, 120, 232, 335, 343, 204, 129, 328},
-		'\u4e5e': []uint16{152, 125, 252, 130, 315, 225, 156},
-		'\u4e5f': []uint16{407, 107, 207, 160, 120, 330, 130, 215, 327, 320, 227, 331, 228, 315, 131, 220},
-		'\u4e60': []uint16{252, 125, 325, 452, 256, 225, 152, 127, 115, 227, 327, 162, 262, 454, 128, 343, 332, 130, 315, 215, 426, 427},
-		'\u4e61': []uint16{425, 225, 125, 252, 230, 142, 330, 222, 325, 215, 238, 227, 220, 315, 327, 232, 120, 332, 320, 229, 104, 422, 256, 322, 129, 328, 331, 130, 329},
-		'\u4e66': []uint16{225, 425, 125, 325, 252, 238, 327, 227, 331, 152, 256, 104, 232, 142, 332, 315, 107, 220, 130, 115, 215, 127, 117, 320, 228, 132, 230, 328, 330, 120},
-		'\u4e69': []uint16{459, 252},
-		'\u4e70': []uint16{452, 152, 252, 125, 325, 330, 230, 156, 315, 320, 327, 127, 256, 120, 329, 129, 220, 115, 104, 331, 332},
-		'\u4e71': []uint16{407, 200, 252, 225, 152, 125, 315, 215, 207, 162, 115, 256, 320, 220, 120, 352, 232, 325, 130, 201, 362, 227, 217, 203, 332, 100},
-		'\u4e73': []uint16{125, 426, 325, 225, 252, 232, 152, 454, 130, 104, 320, 227, 132, 315, 115, 256, 332},
-		'\u4e7e': []uint16{417, 127, 143, 327, 227, 125, 426, 132, 117, 130, 315, 230, 228},
-		'\u4e86': []uint16{449, 234, 152, 252, 220, 260, 100, 315, 245, 320, 207, 243, 115, 225, 215, 120, 352, 327, 127, 204, 125, 227, 228, 325, 256},
-		'\u4e88': []uint16{252, 152, 454, 452, 227, 327, 256, 315, 320, 115, 325, 228},
-		'\u4e89': []uint16{225, 256, 152, 232, 452, 156, 325, 125, 252, 220, 227, 115, 327, 107, 320, 215, 315, 100, 120, 162, 330, 352, 131, 217},
-		'\u4e8b': []uint16{125, 425, 225, 252, 325, 120, 238, 204, 215, 315, 107, 143, 320, 331, 332, 200, 152, 220, 115, 256, 207, 352, 327, 232, 227, 217, 330, 231, 317, 104, 356},
-		'\u4e8c': []uint16{122, 422, 322, 222, 125, 129, 104, 332, 243, 327, 130, 143, 138, 325, 106, 238, 227, 117, 225, 320, 343, 315, 215, 132, 120, 331, 127, 115, 152, 204, 307, 124, 217, 330, 317, 220, 324, 131, 228, 232, 230},
-		'\u4e8d': []uint16{459, 225},
-		'\u4e8e': []uint16{252, 434, 234, 206, 207, 106, 127, 315, 320, 225, 327, 128, 130, 220, 227, 107, 255, 325, 229, 120, 115, 129, 328},
-		'\u4e8f': []uint16{156, 452, 252, 152, 207, 125, 225, 320, 107, 220, 315, 100, 215},
-		'\u4e91': []uint16{425, 130, 227, 225, 125, 327, 127, 230, 330, 315, 152, 252, 132, 325, 131, 117, 215, 262, 232, 220, 320, 120, 332, 229, 115, 129, 328, 142, 228, 331, 145, 245},
-		'\u4e92': []uint16{207, 107, 152, 125, 407, 320, 252, 120, 325, 115, 156, 317, 331, 104, 130, 315, 227, 132},
-		'\u4e93': []uint16{427, 127, 327},
-		'\u4e94': []uint16{122, 322, 422, 125, 225, 222, 143, 243, 117, 130, 132, 115, 315, 104, 129, 327, 227, 124, 343, 120, 127, 325, 320, 330, 220, 332, 317, 156, 331, 228, 232, 131, 217, 215},
-		'\u4e95': []uint16{225, 425, 125, 127, 130, 230, 152, 325, 227, 142, 327, 252, 315, 115, 229, 332, 232, 330, 215, 129, 320, 132, 331, 162, 120},
-		'\u4e98': []uint16{252, 125, 115, 330},
-		'\u4e9a': []uint16{230, 130, 417, 125, 227, 327, 330, 127, 232, 229, 129, 331, 325, 117, 332, 131, 132, 352, 329, 225, 217, 317, 328, 228},
-		'\u4e9b': []uint16{238, 222, 435, 207, 322, 343, 122, 100, 107, 227, 422, 104},
-		'\u4e9f': []uint16{152, 407, 107, 120, 227},
-		'\u4ea1': []uint16{252, 454, 152, 256, 452, 325, 215, 225, 125, 220, 115, 332, 315, 320, 120},
-		'\u4ea2': []uint16{427, 100, 200, 152, 225, 127, 215, 115, 315, 227, 252, 327, 125},
-		'\u4ea4': []uint16{125, 152, 252, 325, 452, 156, 317, 331, 225, 117, 204, 120, 315, 256, 131, 352, 332, 215, 115, 320, 130, 227, 220, 143, 132, 104, 330, 327, 129, 217, 107, 100},
-		'\u4ea5': []uint16{332, 330, 222, 322, 320, 143, 315},
-		'\u4ea6': []uint16{407, 152, 227, 327, 315, 127, 320, 115, 138, 207, 130},
-		'\u4ea7': []uint16{125, 225, 256, 325, 152, 252, 217, 452, 117, 320, 331, 204, 156, 220, 120, 317, 332, 304, 215, 143, 227, 356, 352, 132, 327, 104, 343, 118, 330, 232},
-		'\u4ea8': []uint16{129, 427, 227, 327, 225, 330, 127, 315, 152, 130, 229},
-		'\u4ea9': []uint16{222, 435, 422, 235, 238, 225, 156, 117, 125, 152},
-		'\u4eab': []uint16{152, 252, 452, 125, 120, 454, 227, 327, 315, 156, 130, 325, 215, 320},
-		'\u4eac': []uint16{230, 417, 331, 130, 330, 125, 132, 252, 325, 227, 232, 327, 117, 332, 225, 131, 229, 228, 129, 217, 328, 315, 317},
-		'\u4ead': []uint16{426, 227, 125, 225, 327, 230, 232, 330, 229, 115, 315, 325, 130},
-		'\u4eae': []uint16{452, 200, 227, 252, 225, 125, 152, 262, 100, 327, 162, 362, 228, 120, 220, 325, 315, 132, 204, 330, 332, 215, 320},
-		'\u4eb2': []uint16{225, 125, 107, 452, 100, 152, 252, 327, 104, 227, 325, 120, 215, 315, 256, 320, 204, 115, 162, 362, 220, 200, 343, 232, 156},
-		'\u4eb3': []uint16{459, 130, 227},
-		'\u4eb5': []uint16{152, 252, 100},
-		'\u4eba': []uint16{425, 225, 125, 238, 325, 229, 315, 252, 227, 330, 200, 331, 320, 120, 204, 117, 115, 215, 131, 132, 107, 256, 220, 232, 327, 104, 332, 322, 228, 317, 130, 152, 240, 135, 352, 217, 122, 156, 300, 121, 343, 329, 304},
-		'\u4ebf': []uint16{122, 422, 322, 222, 320, 132, 327, 227, 104, 224, 125},
-		'\u4ec0': []uint16{138, 338, 320, 352, 330, 230, 229, 125, 329, 227, 325, 225, 130, 332, 129, 327, 232},
-		'\u4ec1': []uint16{227, 327, 426, 127, 225, 125, 130, 215, 252, 100, 315, 232, 330, 115, 230, 332, 331, 129, 325, 200, 229, 320, 329, 120, 402, 132},
-		'\u4ec3': []uint16{459, 330, 215, 200, 228, 227},
-		'\u4ec4': []uint16{225, 262, 125},
-		'\u4ec5': []uint16{407, 206, 207, 107, 152, 307, 320, 120, 315, 115, 227, 327},
-		'\u4ec6': []uint16{125, 426, 227, 225, 327, 315, 215, 332, 152, 330, 115},
-		'\u4ec7': []uint16{125, 252, 425, 127, 225, 315, 152, 215, 325, 220, 227, 256, 327, 156, 115, 427, 128},
-		'\u4ec9': []uint16{427, 327},
-		'\u4eca': []uint16{143, 444, 243, 207, 320, 225, 127, 125, 315, 227, 220, 115, 327, 215, 252, 325, 129, 217, 228},
-		'\u4ecb': []uint16{152, 327, 225, 125, 252, 325, 427, 227, 127, 217, 426, 320, 130, 315, 317, 117, 454, 332, 128, 256, 100},
-		'\u4ecd': []uint16{407, 107, 252, 227, 327},
-		'\u4ece': []uint16{434, 107, 106, 152, 252, 234, 127, 143, 225, 120, 104, 100, 115, 327, 207, 215, 315, 125, 320, 130, 156, 325, 227, 162, 362, 352, 256},
-		'\u4ed1': []uint16{330, 225, 230, 227, 235, 413, 325, 327, 232, 229},
-		'\u4ed3': []uint16{125, 427, 252, 426, 227, 325, 225, 101, 230, 127, 327, 100, 117, 156, 152, 162, 215, 330, 232, 130, 132, 229, 320, 115, 104, 129},
-		'\u4ed4': []uint16{101, 426, 227, 404, 225, 325, 332, 362, 162, 327, 330, 125, 230, 129},
-		'\u4ed5': []uint16{425, 125, 225, 227, 327, 252, 215},
-		'\u4ed6': []uint16{438, 138, 238, 125, 120, 338, 340, 325, 230, 156, 225, 330, 252, 320, 142, 220, 327, 115, 332, 315, 132, 152, 331},
-		'\u4ed7': []uint16{425, 252, 225, 100, 115, 452, 325, 227, 152, 220, 215, 315, 331, 320},
-		'\u4ed8': []uint16{252, 256, 152, 452, 225, 127, 325, 120, 125, 320, 115, 327, 227, 224, 315, 156, 356, 204},
-		'\u4ed9': []uint16{426, 125, 225, 227, 327, 130, 325, 330, 132, 252, 315, 332, 215, 152, 232, 120, 320, 230, 115, 131, 328, 220, 129},
-		'\u4edd': []uint16{227, 327, 427, 127},
-		'\u4ede': []uint16{435, 220, 227, 327, 230},
-		'\u4edf': []uint16{422, 322, 122, 227},
-		'\u4ee1': []uint16{132},
-		'\u4ee3': []uint16{243, 225, 125, 152, 252, 435, 222, 325, 356, 156, 332, 227, 127, 315, 327, 331, 320, 422, 215, 204, 217, 220, 212, 330, 120, 224, 352, 324, 229, 322, 317, 256, 329, 115, 129, 130, 132, 231, 232, 107, 117, 128, 304, 328},
-		'\u4ee4': []uint16{225, 452, 129, 325, 252, 120, 227, 125, 327, 206, 320, 115, 315, 230, 215, 127, 220, 235, 330, 304, 229, 331, 128, 232},
-		'\u4ee5': []uint16{434, 112, 252, 106, 206, 207, 152, 107, 320, 315, 143, 327, 130, 115, 120, 125, 132, 134, 131, 117, 325, 215, 127, 232, 227, 328, 225},
-		'\u4ee8': []uint16{422, 122, 225, 222},
-		'\u4eea': []uint16{125, 225, 227, 426, 232, 327, 419, 127, 130, 325, 132, 117, 252, 120, 115, 315, 331, 320, 215, 229, 156, 228, 330},
-		'\u4eeb': []uint16{132, 330},
-		'\u4eec': []uint16{238, 419, 225, 325, 330, 320, 230, 240, 332, 227},
-		'\u4ef0': []uint16{225, 452, 252, 152, 107, 120, 127, 227, 130, 315, 125, 256, 325, 115, 327, 320, 156, 220},
-		'\u4ef2': []uint16{327, 152, 227, 127, 427, 156, 225, 125, 143, 130, 332, 315, 117, 331, 129, 328},
-		'\u4ef3': []uint16{459},
-		'\u4ef5': []uint16{125, 427, 127, 459},
-		'\u4ef6': []uint16{225, 222, 224, 435, 422, 220, 325, 320, 207, 232, 217, 125, 252, 327, 227, 332, 315},
-		'\u4ef7': []uint16{125, 225, 252, 425, 320, 256, 325, 200, 315, 232, 120, 215, 115, 227, 220, 207, 100, 204, 331, 327, 332, 304, 217},
-		'\u4efb': []uint16{452, 225, 252, 138, 125, 152, 127, 325, 220, 107, 204, 222, 227, 128, 104, 100, 115, 315, 327, 215, 422, 156, 331, 320, 130, 120, 228, 256},
-		'\u4efd': []uint16{225, 222, 125, 435, 232, 325, 224, 204, 422, 320, 243, 231, 331, 235, 238, 132, 200, 324, 352, 252, 315, 330},
-		'\u4eff': []uint16{107, 152, 252, 452, 125, 225, 104, 156, 325, 327, 132, 227, 328, 425},
-		'\u4f01': []uint16{125, 426, 317, 152, 217, 325, 225, 417, 320, 327, 227, 332, 117, 331, 304, 315, 104, 156, 256},
-		'\u4f09': []uint16{125, 427, 227},
-		'\u4f0a': []uint16{130, 132, 127, 417, 129, 327, 227, 330, 229, 329, 125, 152, 332, 225, 331, 230, 131, 232, 117, 320, 222, 315},
-		'\u4f0d': []uint16{225, 127, 252, 422, 227, 325, 327, 122, 427, 322, 129, 330, 329, 130, 222, 125, 230, 128, 220},
-		'\u4f0e': []uint16{426, 125, 225, 227, 315},
-		'\u4f0f': []uint16{252, 452, 127, 130, 152, 217, 125, 215, 227, 343, 327, 222, 135, 220, 243, 315, 143, 320, 235, 132, 352, 435, 325, 225, 256, 100, 224, 131, 330, 156, 456, 120, 322},
-		'\u4f10': []uint16{225, 452, 252, 330, 217, 325, 332, 227, 327, 152, 315, 215, 125, 256, 156, 456, 220},
-		'\u4f11': []uint16{152, 452, 252, 156, 125, 227, 256, 220, 325, 215, 130, 115, 327, 352, 132, 225, 320, 129, 343, 230, 143, 330, 217, 232, 131, 317},
-		'\u4f17': []uint16{225, 426, 125, 122, 115, 252, 315, 215, 320, 332, 325, 227, 207, 231, 327, 200, 331, 317, 352, 204, 220, 217, 132, 330, 120, 256, 117, 322, 356, 232, 425},
-		'\u4f18': []uint16{125, 100, 152, 156, 104, 162, 402, 352, 204, 120, 325, 252, 207, 227, 115, 320, 217, 215, 225, 327, 129, 315, 132, 228, 317, 220, 256},
-		'\u4f19': []uint16{225, 125, 325, 252, 435, 238, 222, 224, 338, 152, 210, 227, 330, 327, 220, 352, 104, 256},
-		'\u4f1a': []uint16{452, 225, 252, 125, 325, 231, 217, 152, 322, 227, 320, 232, 143, 338, 331, 220, 222, 238, 332, 224, 135, 156, 356, 327, 130, 117, 330, 315, 215, 307, 230, 129, 100, 142, 132, 256, 120, 107, 352},
-		'\u4f1b': []uint16{152, 452},
-		'\u4f1e': []uint16{425, 125, 225, 252, 320, 227, 104, 152, 325, 232, 327},
-		'\u4f1f': []uint16{227, 100, 200, 327, 427, 125, 132, 320, 228, 328, 220, 315},
-		'\u4f20': []uint16{125, 152, 452, 156, 256, 252, 325, 327, 225, 227, 220, 232, 215, 100, 204, 320, 331, 120, 115, 315, 132, 304, 104, 332, 229, 117, 228, 143},
-		'\u4f22': []uint16{125},
-		'\u4f24': []uint16{252, 452, 125, 152, 225, 100, 156, 200, 325, 256, 203, 215, 115, 315, 120, 220, 142, 227, 332, 317, 327, 103, 320},
-		'\u4f25': []uint16{215, 125},
-		'\u4f26': []uint16{130, 330, 227, 327, 125, 230, 427, 127, 331, 315, 229, 131, 225, 129, 426, 262, 332, 329, 232, 215, 252, 325, 120, 220, 152, 228, 135},
-		'\u4f27': []uint16{252, 459, 228},
-		'\u4f2a': []uint16{125, 417, 152, 256, 200, 225, 252, 217, 130, 320, 117, 317, 227, 104, 325, 315, 327},
-		'\u4f2b': []uint16{152, 454, 227},
-		'\u4f2f': []uint16{327, 127, 230, 227, 330, 125, 225, 427, 325, 332, 130, 426, 329, 129, 232, 331, 229, 328, 228, 131, 115, 132},
-		'\u4f30': []uint16{125, 152, 256, 252, 452, 325, 320, 327, 225, 229, 156, 227},
-		'\u4f34': []uint16{152, 225, 452, 252, 125, 325, 227, 156, 327, 232},
-		'\u4f36': []uint16{100, 315, 225, 130, 115, 330, 362, 262, 327, 320, 332},
-		'\u4f38': []uint16{152, 252, 452, 227, 115, 125, 327, 104, 156, 215, 325, 225, 320, 228, 100, 132, 315},
-		'\u4f3a': []uint16{152, 107, 156, 252, 132},
-		'\u4f3c': []uint16{107, 252, 145, 200, 407, 454, 315, 325, 115, 120, 327, 227, 320, 352},
-		'\u4f3d': []uint16{127, 225, 129, 125, 130, 332, 325},
-		'\u4f43': []uint16{125, 452, 252, 325, 227, 327, 225},
-		'\u4f46': []uint16{406, 106, 206, 127, 152, 107, 129, 330, 125, 115, 120},
-		'\u4f4d': []uint16{225, 222, 152, 435, 125, 252, 241, 238, 422, 256, 325, 204, 320, 224, 127, 220, 227, 331, 120, 315, 215, 115, 317, 327, 207, 332, 130, 236, 232, 217},
-		'\u4f4e': []uint16{400, 252, 152, 125, 200, 100, 225, 107, 325, 104, 142, 317, 204, 214, 115, 362, 342, 103, 117, 132, 120, 162, 215, 101, 320, 304, 262, 217, 220, 315},
-		'\u4f4f': []uint16{452, 252, 125, 152, 207, 325, 200, 215, 352, 256, 220, 320, 315, 227, 132, 217, 317, 131, 304, 117, 156, 327, 331},
-		'\u4f50': []uint16{454, 252, 227, 327, 125, 129, 152, 130, 225, 156, 330, 127, 229, 329, 131, 228, 232},
-		'\u4f51': []uint16{252, 232, 228, 152, 256},
-		'\u4f53': []uint16{225, 125, 200, 152, 425, 426, 142, 325, 356, 207, 320, 204, 252, 327, 227, 156, 220, 127, 217, 306, 100, 307, 232, 315, 331, 231, 215, 352, 304, 117, 330, 120, 115, 332, 162, 362, 256, 132, 143, 317},
-		'\u4f55': []uint16{238, 138, 438, 107, 127, 106, 225, 220, 338, 152, 215, 125, 320, 252, 325, 227, 315, 115, 207, 327, 100, 128, 330, 120, 129, 228, 130, 332, 132},
-		'\u4f57': []uint16{227, 413, 228, 332},
-		'\u4f58': []uint16{127, 427, 130},
-		'\u4f59': []uint16{122, 422, 238, 322, 320, 222, 125, 127, 225, 252, 325, 200, 204, 130, 256, 152, 315, 215, 128, 227, 230, 138, 327, 220, 330, 129, 332, 243, 331, 120, 115, 156},
-		'\u4f5a': []uint16{454, 252, 125, 227, 225, 215, 327},
-		'\u4f5b': []uint16{132, 125, 425, 207, 130, 225, 252, 227, 331, 325, 327, 330, 332, 230, 229, 129, 215, 320, 232, 131, 315, 115, 329},
-		'\u4f5c': []uint16{256, 125, 152, 252, 452, 225, 325, 320, 315, 327, 230, 220, 215, 331, 115, 352, 117, 330, 227, 156, 200, 217, 132, 328, 332, 131, 204, 356, 104, 304},
-		'\u4f5d': []uint16{125, 152},
-		'\u4f5e': []uint16{225, 125, 200},
-		'\u4f5f': []uint16{127, 427, 227},
-		'\u4f60': []uint16{438, 138, 120, 115, 204, 320, 227, 327, 125, 304, 220, 325},
-		'\u4f63': []uint16{125, 252, 426, 320, 325, 256, 225, 327},
-		'\u4f64': []uint16{132, 417, 330, 229},
-		'\u4f65': []uint16{413, 227, 325, 125},
-		'\u4f69': []uint16{152, 327, 127, 252, 452, 227, 129, 125, 331, 330, 225, 130, 220, 329, 229, 132},
-		'\u4f6c': []uint16{426, 232, 332, 225, 220, 330, 227, 325},
-		'\u4f6f': []uint16{152, 252},
-		'\u4f70': []uint16{327, 227, 422, 322, 122, 222, 332},
-		'\u4f73': []uint16{262, 400, 125, 227, 327, 200, 217, 130, 252, 132, 315, 232, 131, 143, 325, 320, 229, 331, 115, 328},
-		'\u4f76': []uint16{227, 413, 115, 327},
-		'\u4f7b': []uint16{200, 413},
-		'\u4f7c': []uint16{125, 325},
-		'\u4f7e': []uint16{413},
-		'\u4f7f': []uint16{452, 152, 252, 206, 225, 125, 227, 120, 325, 200, 315, 215, 327, 331, 320, 107, 232, 352, 204},
-		'\u4f83': []uint16{252, 452, 227, 115, 315, 207, 327, 107, 120, 152, 328},
-		'\u4f84': []uint16{125, 225, 325},
-		'\u4f88': []uint16{325, 200, 454, 215, 152, 320, 100, 227},
-		'\u4f89': []uint16{125},
-		'\u4f8b': []uint16{225, 152, 425, 125, 422, 207, 222, 252, 320, 325, 156, 215, 115, 224, 304, 220, 256, 227},
-		'\u4f8d': []uint16{125, 427, 127, 152, 252, 225, 327, 454, 325},
-		'\u4f8f': []uint16{143, 125, 127, 104},
-		'\u4f91': []uint16{413, 227, 327},
-		'\u4f94': []uint16{227, 413},
-		'\u4f97': []uint16{417, 132, 227, 330, 327, 125},
-		'\u4f9b': []uint16{252, 156, 452, 125, 152, 120, 115, 320, 325, 225, 215, 117, 131, 317, 227, 204, 331},
-		'\u4f9d': []uint16{107, 152, 452, 125, 227, 162, 327, 134, 252, 127, 315, 120, 115, 262, 232, 330, 332, 130, 230, 220, 156, 132, 256, 329, 320, 331, 215, 225, 100, 129},
-		'\u4fa0': []uint16{227, 225, 427, 125, 325, 100, 426, 332, 327, 115, 320},
-		'\u4fa3': []uint16{225, 332, 125, 325},
-		'\u4fa5': []uint16{100, 101},
-		'\u4fa6': []uint16{152, 125, 454, 217, 156, 120, 332, 227, 252, 325, 132, 327},
-		'\u4fa7': []uint16{212, 452, 112, 125, 152, 225, 107, 215, 156, 242, 132, 252, 327, 315, 115, 435, 325},
-		'\u4fa8': []uint16{225, 125, 426, 152, 325, 417, 331, 132, 227, 217, 117, 427, 330, 327},
-		'\u4fa9': []uint16{225, 413, 325},
-		'\u4faa': []uint16{225, 125, 413, 238, 327},
-		'\u4fac': []uint16{413, 227, 229},
-		'\u4fae': []uint16{152, 252, 454, 225, 125, 132, 256},
-		'\u4faf': []uint16{127, 227, 225, 427, 327, 325, 426, 125, 130, 129, 230, 330, 215, 128, 120, 220},
-		'\u4fb5': []uint16{152, 252, 454, 156, 125, 325, 227, 320, 132, 130, 332},
-		'\u4fbf': []uint16{407, 152, 200, 100, 206, 207, 201, 252, 225, 125, 227, 122, 104, 320, 325, 362, 262, 156, 160, 220, 352, 315, 215, 330, 327, 115, 143, 322, 130, 232, 120},
-		'\u4fc3': []uint16{152, 252, 452, 200, 156, 125, 331, 201, 315, 100, 225, 132, 115, 317, 227, 120, 217},
-		'\u4fc4': []uint16{130, 417, 330, 230, 125, 117, 131, 132, 217, 107, 104, 331, 327, 325, 229},
-		'\u4fc5': []uint16{459, 227, 327},
-		'\u4fca': []uint16{327, 227, 427, 400, 100, 200, 315, 125, 127, 225, 132, 162, 328, 115, 129},
-		'\u4fce': []uint16{427, 315, 225, 215, 327},
-		'\u4fcf': []uint16{400, 200, 452, 100, 125, 215, 227, 252, 152, 325, 327, 162},
-		'\u4fd0': []uint16{200, 227, 215, 459, 315, 327, 220},
-		'\u4fd1': []uint16{426, 225, 325, 227, 125, 330},
-		'\u4fd7': []uint16{225, 200, 400, 152, 125, 120, 215, 252, 325, 315, 352, 100, 320, 227, 327, 115, 356, 220},
-		'\u4fd8': []uint16{125, 252, 452, 152, 225, 256, 325},
-		'\u4fda': []uint16{125, 200},
-		'\u4fdc': []uint16{459},
-		'\u4fdd': []uint16{152, 125, 452, 217, 252, 256, 325, 227, 156, 100, 130, 327, 127, 132, 129, 320, 225, 331, 232, 330, 107, 117, 120, 128, 317, 315, 104, 229, 131, 230, 115, 207, 332, 220},
-		'\u4fde': []uint16{127, 427, 327, 227, 128},
-		'\u4fdf': []uint16{459, 327, 229},
-		'\u4fe1': []uint16{125, 425, 252, 225, 152, 256, 227, 331, 232, 217, 325, 127, 200, 327, 130, 132, 315, 156, 115, 215, 231, 320, 332, 228, 131, 104, 229, 220, 107, 238, 120, 129, 317, 230, 117, 304, 330},
-		'\u4fe3': []uint16{459, 325, 327, 227},
-		'\u4fe6': []uint16{413, 227},
-		'\u4fe8': []uint16{152},
-		'\u4fe9': []uint16{422, 238, 225, 122, 222, 215, 322},
-		'\u4fea': []uint16{225, 413, 227, 327},
-		'\u4fed': []uint16{227, 200, 402, 315, 320, 100, 152, 327, 201, 215},
-		'\u4fee': []uint16{152, 452, 252, 125, 256, 127, 227, 156, 327, 325, 130, 100, 228, 332, 217, 220, 229, 315, 120, 129, 330, 352, 115, 317, 132, 320, 225},
-		'\u4fef': []uint16{152, 452, 115, 125, 227, 120, 320},
-		'\u4ff1': []uint16{409, 125, 315, 152, 320, 100, 227, 327, 331, 232, 317, 332},
-		'\u4ff3': []uint16{426, 125, 227},
-		'\u4ff8': []uint16{427, 125, 225, 327, 227},
-		'\u4ffa': []uint16{438, 138, 227},
-		'\u4ffe': []uint16{129, 454, 130},
-		'\u500c': []uint16{413, 225},
-		'\u500d': []uint16{422, 222, 435, 125, 152, 207, 325, 107, 215, 327, 204, 220, 227, 127, 304, 132, 332, 315, 225, 322, 320},
-		'\u500f': []uint16{107, 409},
-		'\u5012': []uint16{452, 152, 252, 207, 125, 100, 315, 120, 215, 107, 320, 325, 115, 200, 256, 106, 225, 220, 156, 130, 327, 153, 352, 332, 362, 262},
-		'\u5014': []uint16{100, 400},
-		'\u5018': []uint16{106, 406, 152},
-		'\u5019': []uint16{225, 252, 238, 220, 452, 207, 125, 104, 325, 256, 120, 152, 127, 227, 243, 204, 327, 331, 156, 332, 232},
-		'\u501a': []uint16{452, 152, 227, 215, 252, 115, 327, 220, 125, 315},
-		'\u501c': []uint16{227, 459, 315, 100, 327},
-		'\u501f': []uint16{152, 452, 125, 234, 252, 134, 107, 156, 115, 120, 334, 325, 320, 215, 256, 315},
-		'\u5021': []uint16{252, 152, 454, 125, 320, 325, 227, 327},
-		'\u5025': []uint16{100, 315, 459},
-		'\u5026': []uint16{200, 400, 252, 100, 125, 215},
-		'\u5028': []uint16{402, 100, 413, 315},
-		'\u5029': []uint16{227, 452, 327, 125, 427, 315},
-		'\u502a': []uint16{127, 427, 225, 227, 220, 459, 327, 128},
-		'\u502c': []uint16{413, 227, 327},
-		'\u502d': []uint16{426, 125, 252},
-		'\u502e': []uint16{413},
-		'\u503a': []uint16{125, 425, 225, 252, 256, 325, 115, 220, 320, 331, 120, 315},
-		'\u503c': []uint16{225, 252, 425, 152, 220, 325, 125, 100, 156, 320, 256, 120, 200, 315, 227, 215, 217, 452, 331, 327, 352, 332, 204},
-		'\u503e': []uint16{152, 125, 452, 352, 325, 204, 115, 315, 156, 320, 327, 162, 107, 215, 227, 120, 332},
-		'\u5043': []uint16{130, 115, 329, 125, 152},
-		'\u5047': []uint16{400, 125, 152, 225, 252, 106, 200, 143, 343, 243, 156, 315, 325, 320, 215, 104, 220, 120, 117, 115, 256, 162, 317, 227, 217, 327, 132, 331},
-		'\u5048': []uint16{413, 125, 227},
-		'\u504c': []uint16{104, 438, 227},
-		'\u504e': []uint16{452, 252, 152, 227, 220, 115, 315},
-		'\u504f': []uint16{407, 400, 125, 152, 107, 207, 100, 112, 315, 103, 325, 320, 132, 156, 162, 130, 115, 252, 225, 104, 120, 332},
-		'\u5055': []uint16{454, 152, 315, 330, 327},
-		'\u505a': []uint16{452, 152, 252, 125, 352, 320, 215, 315, 115, 100, 220, 227, 325, 120, 327},
-		'\u505c': []uint16{152, 452, 252, 207, 125, 325, 352, 100, 115, 315, 156, 227, 220, 120, 327, 317, 320, 225, 256, 356, 162, 362},
-		'\u5065': []uint16{100, 200, 152, 227, 427, 225, 452, 125, 201, 325, 327, 156, 252, 320, 132, 332, 232, 315, 228, 162, 362, 120, 130, 107, 331, 131, 304},
-		'\u506c': []uint16{200, 215, 459},
-		'\u5076': []uint16{107, 100, 225, 125, 407, 425, 252, 215, 101, 325, 104, 327, 152, 227, 115, 220, 217, 332, 320, 232, 132, 156},
-		'\u5077': []uint16{452, 152, 107, 207, 115, 225, 315, 156, 125, 117, 120, 153, 320, 215},
-		'\u507b': []uint16{252, 325, 225, 459},
-		'\u507e': []uint16{413},
-		'\u507f': []uint16{256, 252, 152, 207, 325, 454, 215, 125, 315, 426, 156, 332, 320, 204},
-		'\u5080': []uint16{125, 327},
-		'\u5085': []uint16{225, 127, 427, 227, 232, 327, 128, 315, 130, 320, 152},
-		'\u5088': []uint16{132, 427, 330, 227},
-		'\u508d': []uint16{143, 452, 315, 252, 227, 456, 115},
-		'\u50a3': []uint16{132, 417, 330, 125},
-		'\u50a5': []uint16{215, 406, 200},
-		'\u50a7': []uint16{125},
-		'\u50a8': []uint16{125, 156, 217, 452, 152, 252, 256, 325, 127, 331, 225, 317, 327, 232, 120, 227, 454, 117, 132, 304, 332},
-		'\u50a9': []uint16{413, 125},
-		'\u50ac': []uint16{152, 452, 125, 156, 115, 132, 327, 120, 356, 227},
-		'\u50b2': []uint16{200, 400, 100, 227, 107, 152, 125, 215, 127, 315, 115, 252, 327, 120, 220},
-		'\u50ba': []uint16{459},
-		'\u50bb': []uint16{400, 125, 152, 162, 120, 252, 200, 315, 215, 220, 115, 227, 327, 362},
-		'\u50cf': []uint16{452, 252, 225, 152, 325, 256, 207, 125, 100, 200, 300, 320, 120, 332, 132, 327, 315, 232, 229},
-		'\u50d6': []uint16{413, 327, 129, 227, 329},
-		'\u50da': []uint16{225, 125, 320, 325, 356},
-		'\u50e6': []uint16{413},
-		'\u50e7': []uint16{227, 425, 125, 225, 327, 232, 117, 229, 115, 127, 426, 320, 132, 325, 315},
-		'\u50ec': []uint16{459},
-		'\u50ed': []uint16{413},
-		'\u50ee': []uint16{225, 125},
-		'\u50f3': []uint16{232, 332, 330, 459},
-		'\u50f5': []uint16{125, 400, 152, 100, 162, 252, 320, 156, 200, 215, 401, 325, 327, 225, 362, 262},
-		'\u50fb': []uint16{200, 402, 100, 125, 315, 325, 327},
-		'\u5106': []uint16{413, 315, 352, 152, 227},
-		'\u5107': []uint16{413},
-		'\u510b': []uint16{130, 413, 227},
-		'\u5112': []uint16{125, 227, 426, 417, 327, 132, 225, 162, 215, 325, 315, 228, 328, 120, 220},
-		'\u5121': []uint16{225, 325, 459, 227},
-		'\u513f': []uint16{225, 425, 125, 238, 227, 222, 207, 325, 243, 252, 220, 235, 327, 343, 320, 330, 262, 200, 322, 232, 332, 143, 120, 224, 426, 315, 215, 131, 331, 115, 229, 242, 132, 217, 212, 152, 117, 352, 204, 233, 333, 317},
-		'\u5140': []uint16{402, 107, 252, 327, 227, 125, 325},
-		'\u5141': []uint16{152, 454, 227, 200, 252, 327, 127, 100, 220, 125, 115, 315, 256},
-		'\u5143': []uint16{222, 422, 235, 435, 225, 143, 125, 343, 327, 325, 127, 227, 232, 356, 132, 252, 320, 130, 243, 228, 204, 230, 330, 229, 100, 129, 332, 352, 215, 328, 135, 331},
-		'\u5144': []uint16{125, 225, 426, 227, 327, 325, 320, 317, 331, 115, 120},
-		'\u5145': []uint16{101, 152, 252, 100, 125, 452, 227, 107, 230, 325, 327, 320, 115, 315, 120, 132, 330, 156, 256, 104, 352},
-		'\u5146': []uint16{327, 422, 225, 135, 227, 232, 454, 125, 215, 132, 332, 222, 426, 328, 325, 136, 152},
-		'\u5148': []uint16{407, 125, 207, 107, 100, 327, 256, 252, 143, 152, 225, 227, 325, 104, 315, 115, 243, 220, 215, 320, 112, 135, 317, 228, 117, 331, 120, 130, 132, 217, 304, 229},
-		'\u5149': []uint16{225, 125, 425, 227, 252, 327, 152, 325, 100, 232, 315, 117, 206, 115, 256, 127, 162, 332, 230, 320, 120, 107, 131, 262, 132, 204, 329, 331, 243, 215, 130, 156, 122, 220, 328, 104, 322, 135, 200, 129, 330, 229, 362, 352, 300, 228, 317, 217, 128, 231, 143},
-		'\u514b': []uint16{327, 227, 225, 435, 330, 230, 325, 422, 222, 229, 127, 232, 152, 235, 129, 130, 332, 252, 329, 132, 135, 125, 331, 231, 115, 131, 328, 304, 215, 122, 200, 228, 315, 352, 322, 156, 256, 320, 204},
-		'\u514d': []uint16{252, 152, 207, 452, 125, 206, 220, 156, 215, 325, 256, 320, 107, 104, 227, 317, 315, 352, 115, 132, 120, 327},
-		'\u5151': []uint16{452, 152, 252, 256, 125, 227, 156, 325, 220},
-		'\u5154': []uint16{426, 125, 225, 227, 325, 215, 115, 327, 315, 252, 320, 120, 243, 143, 132},
-		'\u5155': []uint16{413, 227},
-		'\u5156': []uint16{130, 413, 132, 327, 117, 227, 131},
-		'\u515a': []uint16{225, 425, 125, 231, 325, 131, 331, 142, 252, 117, 238, 132, 127, 227, 230, 217, 232, 332, 320, 120, 315, 115, 215, 130, 256, 327, 304},
-		'\u515c': []uint16{452, 152, 225, 142, 125, 320, 325, 227, 222, 425, 422, 232, 322, 252},
-		'\u5162': []uint16{315, 215, 362, 262, 115, 228},
-		'\u5165': []uint16{252, 225, 452, 152, 125, 315, 325, 130, 227, 215, 320, 256, 220, 317, 327, 129, 115, 100, 217, 156, 332, 120, 304, 331, 117, 352},
-		'\u5168': []uint16{125, 400, 201, 122, 203, 101, 107, 252, 325, 104, 131, 200, 127, 132, 227, 115, 317, 315, 156, 327, 331, 120, 332, 215, 152, 320, 225, 220, 232, 117, 362, 262, 328, 322, 130, 230, 228, 129, 343, 330, 304},
-		'\u516b': []uint16{122, 322, 125, 422, 222, 315, 143, 117, 320, 325, 225, 130, 243, 327, 332, 215, 132, 120, 115, 104, 131, 330, 331, 227, 124, 304, 343, 335, 142, 362, 232, 129, 317},
-		'\u516c': []uint16{125, 325, 152, 101, 322, 135, 143, 104, 225, 426, 227, 335, 331, 122, 127, 327, 100, 256, 320, 404, 330, 156, 107, 315, 131, 115, 217, 332, 200, 120, 304, 252, 130, 215, 117, 207, 132, 317, 220, 356, 238, 438, 230, 232, 352, 229, 129, 328, 204, 103},
-		'\u516d': []uint16{122, 322, 422, 222, 125, 143, 130, 243, 117, 315, 327, 330, 115, 227, 132, 229, 320, 317, 104, 325, 331, 329, 220, 332, 230, 232, 124, 225, 128, 142, 215},
-		'\u516e': []uint16{460, 227, 362, 262, 215, 320, 327, 220},
-		'\u5170': []uint16{230, 227, 330, 327, 332, 127, 417, 325, 130, 225, 331, 125, 129, 232, 229, 329, 132, 427, 228, 131, 217, 135, 426, 322, 117, 152, 315, 122, 215},
-		'\u5171': []uint16{407, 125, 107, 331, 330, 152, 204, 217, 207, 325, 252, 320, 120, 315, 317, 104, 332, 327, 117, 132, 156, 131, 130, 215, 220, 227, 115, 225},
-		'\u5173': []uint16{125, 152, 252, 256, 134, 225, 452, 230, 127, 320, 130, 156, 325, 330, 220, 227, 112, 327, 142, 315, 215, 131, 243, 425, 231, 117, 331, 132, 128, 332, 120, 100, 304, 352, 204, 217, 115},
-		'\u5174': []uint16{152, 200, 204, 125, 452, 252, 230, 227, 130, 330, 327, 100, 256, 115, 220, 331, 225, 315, 232, 162, 325, 207, 320, 107, 117, 229, 120, 362, 132, 262, 127, 228, 231, 129, 317, 332, 131, 328, 201, 215},
-		'\u5175': []uint16{225, 425, 125, 252, 227, 325, 256, 315, 327, 115, 152, 232, 215, 332, 120, 317, 352, 330, 231, 217, 331, 320, 130, 131, 220, 228, 156, 356},
-		'\u5176': []uint16{438, 138, 207, 107, 206, 106, 315, 230, 112, 327, 143, 227, 330, 325, 320, 140, 307, 125, 115, 132, 306, 352, 332, 120, 328, 225, 331, 204, 228, 127},
-		'\u5177': []uint16{152, 225, 100, 454, 252, 222, 320, 220, 422, 325, 315, 106, 125, 327, 332, 227, 120, 232, 115, 330, 215, 352},
-		'\u5178': []uint16{125, 225, 230, 204, 426, 325, 227, 100, 152, 327, 331, 315, 332, 252, 215, 330, 156, 232},
-		'\u5179': []uint16{227, 327, 330, 439, 230, 235, 129, 229, 232, 329, 331, 115, 215, 315, 125, 225},
-		'\u517b': []uint16{252, 225, 452, 152, 156, 125, 325, 127, 327, 320, 256, 315, 115, 227, 120, 332, 100, 215, 352, 117, 304, 132, 220, 129, 331, 130, 317},
-		'\u517c': []uint16{452, 152, 315, 115, 320, 227, 252, 125, 327, 156, 207, 120, 215, 317},
-		'\u517d': []uint16{225, 426, 125, 227, 215, 315, 327, 325, 252, 132, 120, 220},
-		'\u5180': []uint16{130, 417, 127, 317, 427, 331, 252, 131, 217, 227, 330, 327, 125, 256, 117, 225, 132},
-		'\u5185': []uint16{412, 242, 125, 112, 212, 142, 325, 130, 245, 225, 342, 243, 330, 152, 104, 127, 232, 327, 100, 230, 229, 207, 332, 227, 129, 204, 115, 317, 131, 238, 156, 117, 320, 312, 217, 331, 329, 220, 252, 315, 307, 215, 256, 120, 200, 132, 162},
-		'\u5188': []uint16{230, 330, 130, 225, 229, 132, 129, 232, 127, 125, 325, 227, 329, 331},
-		'\u5189': []uint16{127, 207, 107, 227, 427, 327, 463},
-		'\u518c': []uint16{252, 225, 435, 222, 152, 325, 422, 125, 220, 227, 331, 327, 215, 217, 232, 130},
-		'\u518d': []uint16{407, 107, 207, 152, 106, 327, 115, 315, 125, 120, 227, 129, 215, 320, 325, 156, 332, 117},
-		'\u5192': []uint16{452, 152, 252, 125, 256, 100, 315, 115, 156, 325, 225, 362, 162, 320, 153, 317, 104, 356, 220, 227},
-		'\u5195': []uint16{252, 426, 225, 315, 227, 129, 130, 325, 327, 125},
-		'\u5197': []uint16{402, 100, 125, 200, 207},
-		'\u5199': []uint16{452, 152, 252, 125, 225, 325, 215, 156, 204, 227, 256, 217, 352, 315, 327, 332, 132},
-		'\u519b': []uint16{225, 125, 425, 227, 252, 217, 231, 331, 327, 142, 104, 325, 315, 117, 320, 120, 228, 256, 131, 215, 130, 152, 330, 115, 220, 304, 156, 317, 332, 232, 352, 343, 229, 132},
-		'\u519c': []uint16{125, 426, 117, 225, 331, 325, 127, 132, 120, 232, 131, 227, 252, 217, 152, 204, 332, 304, 327, 330, 104, 317, 320, 229, 130, 256, 220, 230, 129, 215, 315},
-		'\u51a0': []uint16{125, 452, 225, 327, 227, 152, 120, 315, 115, 252, 325, 132, 215, 204, 217, 320, 117, 332, 232, 328, 130, 417, 220, 330, 104, 156},
-		'\u51a2': []uint16{426, 327, 225, 229, 227, 115},
-		'\u51a4': []uint16{125, 452, 152, 252, 315, 117, 115, 225, 215, 320, 120},
-		'\u51a5': []uint16{426, 125, 120, 320, 200, 156, 227, 115, 100, 327, 315, 152, 252},
-		'\u51ac': []uint16{143, 444, 125, 252, 243, 152, 227, 225, 327, 117, 127, 120, 343, 320, 129, 130, 220, 104, 325, 132, 330, 315, 233, 156, 352, 100, 333, 262, 328, 332},
-		'\u51af': []uint16{127, 427, 422, 227, 327, 128, 130, 229, 315},
-		'\u51b0': []uint16{125, 425, 327, 227, 162, 143, 330, 252, 152, 325, 225, 127, 130, 256, 142, 115, 362, 262, 215, 131, 129, 315, 132, 228, 343, 320, 331},
-		'\u51b2': []uint16{452, 156, 152, 125, 225, 227, 252, 100, 134, 256, 215, 362, 262, 327, 315, 162, 325, 130, 230, 115, 228, 328, 132, 332, 120, 232, 103, 352, 330},
-		'\u51b3': []uint16{152, 252, 125, 407, 201, 256, 107, 452, 325, 352, 156, 215, 320, 115, 220, 200, 227, 315, 204, 327, 454, 317, 120, 332},
-		'\u51b5': []uint16{225, 206, 106, 220, 427, 127, 315, 320, 227, 325, 107, 426, 125, 327, 215, 331},
-		'\u51b6': []uint16{125, 217, 152, 454, 230, 327, 227, 252, 156, 330, 200, 331, 225, 256, 320, 328, 131, 100},
-		'\u51b7': []uint16{400, 125, 152, 100, 200, 127, 156, 262, 162, 227, 107, 230, 320, 252, 362, 225, 256, 325, 103, 115, 130, 327, 204, 352, 215, 143, 332, 315, 132, 117, 120, 217, 129, 104, 330, 307},
-		'\u51bb': []uint16{152, 452, 252, 125, 225, 256, 325, 343, 215, 327, 315, 320, 220, 227, 104, 332},
-		'\u51bc': []uint16{127, 427, 128},
-		'\u51bd': []uint16{200, 227, 413},
-		'\u51c0': []uint16{125, 400, 200, 152, 252, 325, 362, 262, 215, 227, 332, 330, 220, 315, 162, 327, 320, 130, 100, 232, 225, 132},
-		'\u51c4': []uint16{100, 402, 162, 103, 200, 115, 362, 315, 215, 262},
-		'\u51c6': []uint16{152, 225, 252, 400, 125, 100, 130, 325, 356, 332, 227, 320, 352, 200, 107, 156, 343, 331, 207, 132, 327, 204, 120, 101, 228, 117, 217, 215, 307, 315},
-		'\u51c7': []uint16{459, 225, 227, 327},
-		'\u51c9': []uint16{452, 200, 125, 227, 100, 262, 252, 327, 129, 330, 130, 230, 203, 162, 220, 325, 152, 132, 215, 243, 362, 417, 320, 225, 400, 156},
-		'\u51cb': []uint16{152, 100, 454, 315},
-		'\u51cc': []uint16{127, 143, 152, 100, 427, 227, 327, 252, 225, 330, 130, 315, 132, 230, 156, 256, 454, 125, 320, 128, 362, 232, 262, 332, 426, 129, 328, 120},
-		'\u51cf': []uint16{152, 252, 156, 452, 125, 256, 315, 117, 215, 325, 132, 120, 220, 332},
-		'\u51d1': []uint16{452, 152, 200, 100, 252, 304, 215, 227, 362, 162, 101, 256, 220},
-		'\u51db': []uint16{162, 315, 215, 262, 100, 327},
-		'\u51dd': []uint16{152, 325, 454, 125, 100, 256, 127, 227, 327, 132, 252, 315, 162, 120, 156, 225, 332, 200},
-		'\u51e0': []uint16{122, 107, 322, 422, 125, 130, 138, 222, 225, 327, 215, 330, 120, 220, 320, 206, 315, 238, 207, 124, 115, 227, 325, 229, 117, 152, 324},
-		'\u51e1': []uint16{407, 107, 262, 125, 227, 207, 130, 200, 127, 327, 230, 220, 252, 229, 315, 320, 115, 142, 215, 317, 129, 329, 122, 362, 100, 120, 325},
-		'\u51e4': []uint16{127, 327, 125, 227, 130, 426, 427, 225, 132, 325, 115, 315, 230, 215, 232, 129, 332, 330, 229, 320},
-		'\u51eb': []uint16{152},
-		'\u51ed': []uint16{434, 134, 125, 252, 107, 225, 152, 130, 215, 227, 315, 327, 104, 115, 325, 320},
-		'\u51ef': []uint16{227, 127, 129, 427, 327, 125, 132, 152, 130, 330, 232, 426, 115, 252, 230, 228, 325, 329, 229},
-		'\u51f0': []uint16{225, 330, 426, 332, 227, 220, 325, 232, 315, 327, 459},
-		'\u51f3': []uint16{225, 426, 125, 227, 220},
-		'\u51f6': []uint16{400, 100, 125, 225, 252, 115, 315, 103, 152, 101, 162, 320, 156},
-		'\u51f8': []uint16{152, 402, 125, 454, 225, 320, 332, 120, 100, 156, 327, 325, 227, 315},
-		'\u51f9': []uint16{400, 152, 125, 225, 120, 132, 200, 227, 156, 320, 228},
-		'\u51fa': []uint16{152, 252, 452, 156, 125, 200, 225, 100, 222, 115, 356, 315, 215, 325, 331, 256, 120, 320, 352, 117, 157, 122, 220, 322, 300, 104, 317, 422, 327, 227, 132, 129, 332, 204},
-		'\u51fb': []uint16{252, 256, 152, 325, 454, 215, 204, 320, 225, 220, 227, 327, 315, 125, 156, 232, 115, 331},
-		'\u51fc': []uint16{413, 130, 327, 227},
-		'\u51fd': []uint16{125, 425, 225, 320, 252, 325, 227, 152, 156, 327, 117, 331, 315},
-		'\u51ff': []uint16{452, 252, 200, 152, 125, 315, 215, 220, 225, 227, 115, 320, 120},
-		'\u5200': []uint16{425, 225, 125, 222, 252, 315, 115, 227, 325, 352, 327, 320, 215, 220, 104, 232, 422, 332, 435, 330, 128, 120},
-		'\u5201': []uint16{127, 400, 100, 152, 125, 115, 252, 427, 325, 227, 320, 128, 156},
-		'\u5203': []uint16{426, 225, 315, 325, 215, 125, 227, 327, 152, 252},
-		'\u5206': []uint16{152, 225, 156, 125, 435, 107, 222, 207, 325, 201, 252, 422, 220, 322, 122, 320, 224, 315, 135, 104, 120, 324, 100, 115, 200, 235, 227, 256, 130, 331, 327, 243, 215, 117, 300, 352, 132, 229, 204, 332, 142, 217, 129, 362, 262, 335},
-		'\u5207': []uint16{238, 201, 200, 152, 452, 101, 125, 252, 107, 315, 256, 215, 120, 327, 156, 325, 129, 227, 320, 225, 207, 362, 262, 115, 220, 130, 104, 132, 329, 230, 162, 100, 330, 131, 332, 229, 232, 127},
-		'\u5208': []uint16{454, 152, 227},
-		'\u520a': []uint16{225, 152, 125, 252, 426, 238, 454, 325, 227, 320, 327, 232, 117, 332},
-		'\u520d': []uint16{252, 152, 325, 125},
-		'\u520e': []uint16{252, 413},
-		'\u5211': []uint16{125, 425, 225, 252, 104, 117, 220, 127, 120, 325, 115, 315, 256, 215, 132, 152, 327, 331, 332},
-		'\u5212': []uint16{225, 152, 252, 452, 325, 320, 100, 104, 125, 232, 331, 315, 256, 220, 207, 217, 227, 304, 352, 204, 120, 156, 228, 115, 327, 332},
-		'\u5216': []uint16{413},
-		'\u5217': []uint16{222, 252, 452, 152, 225, 235, 125, 325, 204, 230, 127, 327, 330, 156, 130, 227, 129, 315, 422, 329, 352, 217, 220, 115, 331, 320, 229, 131, 122, 356, 256, 304, 135, 322, 332},
-		'\u5218': []uint16{127, 427, 128, 327, 227, 130, 125, 129, 131, 132, 117, 230, 330},
-		'\u5219': []uint16{407, 225, 206, 222, 327, 200, 307, 227, 252, 422, 325, 320, 230, 315, 330, 215, 145, 120, 128, 201, 220, 328, 329, 204},
-		'\u521a': []uint16{407, 107, 207, 143, 227, 125, 225, 100, 325, 130, 327, 115, 127, 215, 228, 330, 103, 200, 320, 315, 230, 131},
-		'\u521b': []uint16{125, 152, 156, 225, 454, 252, 117, 256, 325, 132, 232, 227, 217, 131, 332, 320, 327, 331, 315, 115, 317, 104, 204},
-		'\u521d': []uint16{412, 243, 107, 112, 207, 143, 125, 152, 104, 227, 204, 120, 127, 327, 343, 156, 315, 115, 232, 325, 252, 220, 332, 238, 224, 215, 320, 117, 228, 132, 217, 317},
-		'\u5220': []uint16{152, 452, 252, 115, 156, 125, 227},
-		'\u5224': []uint16{152, 256, 252, 452, 156, 125, 325, 225, 115, 220, 327, 227, 332},
-		'\u5228': []uint16{452, 125, 115, 152, 225, 325, 132},
-		'\u5229': []uint16{125, 225, 152, 330, 325, 230, 200, 425, 352, 256, 327, 227, 252, 100, 201, 127, 232, 320, 229, 130, 332, 220, 331, 315, 329, 129, 215, 132, 304, 356, 204, 120, 115, 131, 228, 217, 328, 362, 162, 231, 156, 343},
-		'\u522b': []uint16{207, 138, 407, 225, 252, 200, 125, 152, 330, 127, 100, 325, 115, 215, 352, 315, 106, 120, 256, 104, 332, 227, 327, 132, 107, 356, 320, 129, 331, 230, 162, 362, 220, 130, 329},
-		'\u522d': []uint16{413, 227},
-		'\u522e': []uint16{452, 152, 252, 125, 115, 325, 156, 315, 362, 132, 162, 225, 120, 262},
-		'\u5230': []uint16{452, 252, 107, 152, 220, 156, 320, 120, 315, 200, 256, 215, 125, 327, 325, 332, 317, 227},
-		'\u5233': []uint16{413},
-		'\u5236': []uint16{252, 152, 125, 225, 452, 256, 325, 156, 320, 204, 315, 227, 352, 217, 331, 304, 220, 232, 327, 332, 132, 317, 356, 215, 129, 200, 120, 307},
-		'\u5237': []uint16{252, 152, 452, 256, 325, 362, 262, 125, 225, 220, 162, 133, 332, 132},
-		'\u5238': []uint16{225, 125, 325, 331, 426, 231, 352, 232, 220, 332, 330, 320, 215, 317},
-		'\u5239': []uint16{143, 125, 452, 343, 225, 152, 320, 330, 227, 229, 252, 332},
-		'\u523a': []uint16{152, 452, 125, 252, 225, 100, 256, 162, 325, 320, 227, 327, 130, 315, 133, 352, 132, 220, 332, 232, 156},
-		'\u523b': []uint16{207, 225, 222, 200, 452, 238, 152, 125, 252, 215, 107, 343, 100, 115, 322, 325, 101, 220, 320, 315, 324, 227, 243, 156, 256, 129, 135},
-		'\u523d': []uint16{125},
-		'\u523f': []uint16{413},
-		'\u5240': []uint16{227, 459, 327},
-		'\u5241': []uint16{452, 152},
-		'\u5242': []uint16{225, 426, 125, 435, 232, 252, 325, 227, 320},
-		'\u5243': []uint16{452, 156, 125, 152, 325},
-		'\u524a': []uint16{152, 252, 452, 325, 200, 100, 204, 125, 115, 256, 227, 120, 225, 327, 332, 232, 104},
-		'\u524c': []uint16{452, 327, 233},
-		'\u524d': []uint16{412, 243, 212, 125, 112, 152, 252, 242, 143, 138, 142, 115, 200, 315, 227, 320, 104, 120, 207, 230, 215, 327, 204, 325, 117, 220, 130, 132, 229, 312, 330, 228, 342, 225, 129, 343, 332, 352, 201, 331, 256, 306, 131, 232},
-		'\u5250': []uint16{452, 215},
-		'\u5251': []uint16{425, 225, 227, 327, 125, 130, 131, 252, 232, 215, 256, 331, 315, 115, 220, 132, 325, 320, 328, 228, 127, 129},
-		'\u5254': []uint16{152, 252, 452, 315, 425, 220, 125, 100, 115, 132},
-		'\u5256': []uint16{152, 325, 125, 252, 452, 156, 215, 320, 115, 227, 327},
-		'\u525c': []uint16{452, 115},
-		'\u525e': []uint16{459},
-		'\u5261': []uint16{452, 130, 227},
-		'\u5265': []uint16{152, 452, 125, 252, 256, 156, 215, 227, 315, 320, 133, 132},
-		'\u5267': []uint16{225, 125, 252, 100, 425, 207, 325, 331, 152, 238, 320, 330, 352, 156, 232, 117, 332, 327},
-		'\u5269': []uint16{120, 152, 252, 452, 125, 156, 220, 315, 320, 327, 227},
-		'\u526a': []uint16{452, 125, 156, 152, 252, 256, 225, 132, 327, 115, 227, 332},
-		'\u526f': []uint16{404, 125, 222, 315, 204, 317, 104, 225, 325, 252, 320, 422, 327, 217, 215},
-		'\u5272': []uint16{252, 452, 152, 220, 225, 325, 125, 320, 156, 129, 215, 315, 256, 343, 115, 332},
-		'\u527d': []uint16{100, 152, 156, 125},
-		'\u527f': []uint16{452, 252, 152, 256, 156},
-		'\u5281': []uint16{452},
-		'\u5282': []uint16{459},
-		'\u5288': []uint16{452, 107, 152, 115, 125, 133, 225, 120, 333, 252, 215, 132, 156},
-		'\u5293': []uint16{413},
-		'\u529b': []uint16{225, 125, 425, 207, 252, 201, 325, 200, 152, 227, 220, 320, 327, 232, 215, 315, 132, 115, 204, 229, 332, 331, 256, 330, 231, 120, 328, 129, 128, 228, 304, 217, 329, 230, 352},
-		'\u529d': []uint16{452, 152, 252, 156, 227, 125, 132, 330, 220, 327, 230, 331, 115},
-		'\u529e': []uint16{125, 452, 252, 152, 156, 225, 325, 217, 256, 331, 204, 320, 227, 215, 231, 117, 317, 232, 132, 220, 327},
-		'\u529f': []uint16{125, 200, 425, 225, 325, 252, 227, 304, 315, 115, 228, 215, 352, 320, 327, 120, 256, 132, 330, 156, 220, 229, 232, 331},
-		'\u52a0': []uint16{152, 252, 207, 452, 156, 330, 130, 125, 325, 127, 230, 106, 327, 227, 129, 331, 315, 256, 232, 107, 352, 135, 229, 131, 225, 320, 132, 332, 104, 120, 215, 220, 329, 122, 115, 306, 322, 317, 117, 253, 217},
-		'\u52a1': []uint16{225, 256, 325, 331, 204, 407, 107, 152, 320, 220, 315, 217, 426, 327, 227, 215, 125, 332, 409, 229, 317, 252, 454, 130, 328, 304, 343},
-		'\u52a2': []uint16{413, 227},
-		'\u52a3': []uint16{200, 125, 225, 400, 104, 320, 217, 204, 402, 152, 426, 252, 315, 327, 115, 220},
-		'\u52a8': []uint16{256, 252, 125, 325, 225, 452, 200, 152, 207, 320, 201, 220, 204, 315, 215, 232, 356, 117, 103, 100, 332, 107, 304, 352, 120, 227, 331, 115, 156, 343, 330, 327, 300, 231, 203, 132, 104},
-		'\u52a9': []uint16{252, 256, 352, 125, 452, 152, 325, 156, 104, 225, 315, 227, 204, 215, 207, 320, 115, 120, 200, 117, 327, 304, 330, 220, 332, 132, 317},
-		'\u52aa': []uint16{101, 327, 452, 129, 227, 152, 330, 320, 130, 230, 329, 229, 325, 127},
-		'\u52ab': []uint16{252, 452, 152, 425, 125, 315, 225, 227, 325, 215, 156, 115, 143, 320, 256, 220, 120, 327},
-		'\u52ac': []uint16{413, 100, 227},
-		'\u52ad': []uint16{227, 413, 327, 228},
-		'\u52b1': []uint16{252, 256, 125, 115, 427, 127, 227, 325, 327, 152, 454, 220},
-		'\u52b2': []uint16{200, 425, 125, 252, 307, 225, 327, 152, 325, 227, 300, 100, 207, 220, 315, 215, 352, 132},
-		'\u52b3': []uint16{156, 125, 200, 320, 452, 127, 120, 225, 152, 100, 252, 327, 215, 325, 315, 331, 227, 330, 115, 329, 232, 131, 132, 229, 129, 220, 130, 143, 230, 201, 117, 332, 101, 217, 343, 256},
-		'\u52be': []uint16{252, 413, 227, 327},
-		'\u52bf': []uint16{225, 125, 426, 252, 207, 315, 107, 253, 115, 215, 325, 227, 320, 220, 100, 120, 327, 232, 330, 230, 200},
-		'\u52c3': []uint16{215, 315, 402, 156, 200, 327, 227, 132, 162, 115, 130, 320, 262, 232, 201, 225, 220, 127, 129, 152, 120, 330, 362, 125, 352, 229},
-		'\u52c7': []uint16{227, 125, 100, 425, 200, 152, 207, 327, 215, 315, 325, 225, 220, 204, 115, 228, 402, 101, 252, 320, 427, 407, 120, 352, 217},
-		'\u52c9': []uint16{152, 454, 201, 227, 252, 200, 115, 327, 362, 162, 215, 409, 130},
-		'\u52cb': []uint16{227, 125, 426, 225, 327, 132, 252},
-		'\u52d0': []uint16{413, 130, 227, 129, 327},
-		'\u52d2': []uint16{227, 452, 330, 327, 229, 232, 230, 225, 152, 129, 125, 325, 252, 329, 315, 331, 130, 332, 132},
-		'\u52d6': []uint16{413, 227, 327},
-		'\u52d8': []uint16{156, 152, 454, 252, 125, 325, 217, 327},
-		'\u52df': []uint16{152, 454, 200, 252, 256, 156, 125, 325},
-		'\u52e4': []uint16{225, 100, 400, 200, 125, 227, 152, 101, 327, 252, 256, 325, 120, 217, 317, 115, 130, 331, 162, 362, 230, 201, 229, 232, 117, 330, 315, 220, 332, 215, 320},
-		'\u52f0': []uint16{413, 227, 327},
-		'\u52fa': []uint16{435, 225, 125, 227, 222, 426, 327, 422, 252, 129, 232, 325},
-		'\u52fe': []uint16{152, 452, 125, 127, 129, 362, 262, 115, 315, 227, 162, 225, 232},
-		'\u52ff': []uint16{407, 207, 252, 125, 315, 327, 227, 115, 120, 320},
-		'\u5300': []uint16{200, 452, 100, 201, 252, 230, 107, 104, 256, 327, 120, 330, 332, 227, 400, 125, 262, 162},
-		'\u5305': []uint16{152, 452, 125, 225, 127, 252, 156, 325, 222, 130, 320, 132, 422, 315, 215, 115, 120, 327, 220, 227, 217, 232, 131, 104, 332, 200, 256, 128, 330},
-		'\u5306': []uint16{162, 262, 100, 307, 207, 362, 101, 215, 315, 327, 227},
-		'\u5308': []uint16{125, 130, 417, 132, 131},
-		'\u530d': []uint16{152, 125},
-		'\u530f': []uint16{459, 327},
-		'\u5310': []uint16{252, 459, 325},
-		'\u5315': []uint16{125, 315},
-		'\u5316': []uint16{252, 256, 125, 225, 325, 452, 217, 152, 332, 320, 230, 120, 227, 327, 331, 232, 220, 317, 127, 330, 315, 115, 215, 243, 200, 156, 204, 343, 231, 129, 130, 132, 131, 104, 229, 328, 207, 228},
-		'\u5317': []uint16{130, 412, 112, 230, 242, 212, 125, 143, 330, 312, 131, 127, 117, 142, 325, 132, 343, 152, 104, 331, 327, 227, 317, 225, 320, 120, 220, 215, 217, 315, 252, 342, 129, 232, 332, 229, 329, 115, 328},
-		'\u5319': []uint16{225, 325, 125, 229},
-		'\u531d': []uint16{435, 454, 125, 362, 262, 227, 225},
-		'\u5320': []uint16{225, 426, 125, 325, 227, 215, 315, 115, 320, 220, 230},
-		'\u5321': []uint16{327, 127, 427, 152, 227, 315, 454, 115},
-		'\u5323': []uint16{125, 225, 230, 325},
-		'\u5326': []uint16{413},
-		'\u532a': []uint16{225, 426, 125, 252, 115, 409, 320, 256, 227, 325},
-		'\u532e': []uint16{152, 454, 315, 215},
-		'\u5339': []uint16{222, 152, 225, 435, 332, 130, 422, 325, 125, 156, 315, 115, 331, 227, 330, 454, 107, 229, 132, 129},
-		'\u533a': []uint16{225, 125, 230, 425, 242, 152, 325, 128, 238, 231, 217, 227, 142, 104, 204, 127, 252, 331, 220, 232, 162, 262, 156, 320, 112, 120, 332, 304, 352, 327, 117, 215, 132, 229, 356, 256},
-		'\u533b': []uint16{125, 225, 452, 117, 325, 331, 252, 104, 152, 227, 332, 426, 156, 217, 320, 220, 327, 315, 130, 256, 215, 317, 132, 204},
-		'\u533e': []uint16{425, 125, 225, 327, 232},
-		'\u533f': []uint16{252, 454, 152, 315, 125, 227, 156, 325, 256},
-		'\u5341': []uint16{322, 122, 107, 422, 222, 143, 117, 125, 120, 162, 132, 320, 115, 325, 124, 327, 130, 215, 315, 329, 332, 243, 252, 331, 225, 152, 227, 324, 330, 220, 131, 104, 317, 352, 232},
-		'\u5343': []uint16{122, 322, 335, 222, 422, 115, 135, 125, 315, 320, 127, 130, 327, 120, 225, 215, 107, 124, 227, 332, 104, 325, 252, 324, 132, 228, 220, 328, 224, 330},
-		'\u5345': []uint16{332, 422, 222, 122, 322},
-		'\u5347': []uint16{252, 152, 452, 156, 227, 325, 125, 222, 327, 220, 120, 235, 129, 100, 232, 315, 132, 256, 230, 229, 228, 225, 117, 130, 215, 329, 320, 332, 104, 224},
-		'\u5348': []uint16{243, 143, 444, 125, 130, 332, 325, 152, 222, 343, 227, 327, 225, 322, 320, 220, 117},
-		'\u5349': []uint16{225, 426, 227, 325, 327, 331},
-		'\u534a': []uint16{122, 422, 343, 125, 222, 330, 325, 322, 207, 143, 115, 252, 142, 315, 320, 104, 120, 127, 130, 107, 243, 327, 212, 124, 152, 225, 215, 162, 227, 352, 362, 317, 132, 156},
-		'\u534e': []uint16{130, 417, 232, 132, 125, 227, 127, 330, 325, 331, 200, 252, 327, 225, 131, 100, 217, 230, 332, 228, 256, 315, 129, 117, 215, 229, 128, 322, 104, 231, 220, 115, 328, 120, 135, 329, 320, 343, 122, 262},
-		'\u534f': []uint16{125, 152, 331, 217, 454, 231, 252, 156, 332, 325, 132, 315, 227, 225, 131, 117, 320, 327, 107, 409, 317, 115, 104},
-		'\u5351': []uint16{225, 125, 100, 402, 330, 200, 315, 215, 130, 325, 120, 115, 227, 103, 332, 320},
-		'\u5352': []uint16{426, 225, 454, 227, 215, 200, 125, 315, 327, 252, 409, 152},
-		'\u5353': []uint16{100, 127, 227, 427, 327, 115, 315, 125, 162, 330, 152, 130, 129, 262, 132, 328, 120},
-		'\u5355': []uint16{125, 407, 200, 225, 104, 100, 107, 127, 252, 207, 217, 206, 152, 132, 325, 320, 115, 227, 362, 262, 327, 352, 230, 315, 120, 330, 130, 317, 322, 229, 356, 128, 304},
-		'\u5356': []uint16{452, 252, 152, 125, 325, 100, 315, 120, 115, 327, 156, 220, 256, 320, 225, 331, 215, 132, 332, 352, 232},
-		'\u5357': []uint16{230, 130, 412, 112, 330, 212, 242, 143, 125, 127, 325, 312, 331, 227, 327, 117, 225, 131, 132, 129, 156, 332, 152, 142, 229, 120, 320, 104, 315, 217, 115, 317, 234, 232, 342, 220, 329, 228, 128},
-		'\u535a': []uint16{125, 227, 127, 427, 152, 327, 330, 130, 252, 325, 100, 426, 156, 454, 230, 200, 417, 115, 129, 317, 120, 220, 232, 229, 132, 315, 217, 332, 215, 256, 117, 329, 331, 262, 228, 131, 320},
-		'\u535c': []uint16{225, 427, 127, 327, 252, 325, 227, 329, 125, 426, 454, 152, 328, 330, 315, 320, 220, 128, 229},
-		'\u535e': []uint16{127, 427, 227, 327},
-		'\u535f': []uint16{459},
-		'\u5360': []uint16{452, 152, 252, 127, 125, 325, 327, 227, 330, 256, 120, 315, 156, 332, 220, 215, 328},
-		'\u5361': []uint16{127, 425, 225, 125, 327, 129, 227, 130, 132, 230, 330, 325, 229, 252, 332, 152, 329, 235, 232, 135, 452, 320, 104, 238, 256, 217, 131, 331, 224},
-		'\u5362': []uint16{127, 130, 125, 417, 327, 227, 427, 225, 230, 129, 330, 128, 132, 325, 329, 229, 332, 135, 232},
-		'\u5363': []uint16{413, 227},
-		'\u5364': []uint16{125, 425, 452, 152, 225, 332, 325, 100, 132, 232},
-		'\u5366': []uint16{425, 225, 252, 325, 227, 330, 256, 125, 215, 435},
-		'\u5367': []uint16{452, 125, 130, 252, 152, 315, 104, 120, 115, 225, 227, 220, 325, 352, 156, 215, 330, 256},
-		'\u536b': []uint16{125, 252, 103, 325, 225, 452, 227, 127, 327, 256, 131, 331, 332, 217, 230, 232, 330, 229, 132, 104, 129, 130, 117, 320, 152, 220, 427, 315, 352, 215, 120, 143, 317, 328},
-		'\u536e': []uint16{413, 227},
-		'\u536f': []uint16{222, 252, 220, 225, 315, 320, 322, 115, 215, 143, 125},
-		'\u5370': []uint16{130, 125, 452, 152, 252, 225, 132, 156, 127, 325, 331, 227, 131, 117, 327, 232, 256, 320, 215, 332, 204, 317, 220, 315, 129},
-		'\u5371': []uint16{125, 103, 152, 100, 320, 225, 427, 115, 252, 200, 215, 315, 127, 130, 426, 227, 417, 132, 262, 256, 454, 402, 220, 327, 325, 117, 330, 131, 229, 228, 317},
-		'\u5373': []uint16{452, 207, 106, 107, 152, 315, 143, 252, 130, 104, 125, 320, 327, 215, 115, 120, 132},
-		'\u5374': []uint16{407, 107, 152, 252, 127, 256, 325, 315, 220, 115, 332, 327, 232, 330},
-		'\u5375': []uint16{425, 125, 252, 225, 325, 256, 104, 343, 327, 215, 320},
-		'\u5377': []uint16{222, 435, 422, 225, 125, 152, 252, 115, 320, 325, 232, 227, 315, 120, 204, 132, 238, 130, 327, 330, 256, 142, 215, 217, 156, 104},
-		'\u5378': []uint16{452, 152, 252, 256, 325, 327, 232, 132, 115, 315, 317},
-		'\u537a': []uint16{252, 413},
-		'\u537f': []uint16{227, 427, 225, 426, 127, 327, 330, 228, 115, 315, 215, 320},
-		'\u5382': []uint16{225, 125, 425, 252, 325, 231, 230, 238, 117, 352, 227, 330, 327, 217, 320, 130, 332, 104},
-		'\u5384': []uint16{417, 130, 125, 327, 227, 129, 229, 329, 230, 203, 225, 131, 132, 352},
-		'\u5385': []uint16{225, 425, 125, 231, 227, 230, 242, 304, 104, 332, 327, 117, 215, 232},
-		'\u5386': []uint16{125, 225, 152, 252, 107, 452, 243, 330, 127, 232, 104, 407, 227, 130, 115, 325, 120, 315, 331, 230, 207, 327, 329, 320, 454, 129, 343, 304, 220, 256, 204, 332},
-		'\u5389': []uint16{100, 201, 107, 200, 215, 127, 427, 125, 315, 327, 227, 152, 115, 128, 120, 402},
-		'\u538b': []uint16{125, 225, 252, 452, 152, 325, 256, 156, 107, 362, 262, 232, 204, 120, 315, 132, 332, 320, 104, 220, 217, 115},
-		'\u538c': []uint16{152, 252, 454, 315, 156, 215, 125, 225, 220, 320, 325, 200, 227},
-		'\u538d': []uint16{413, 127, 227},
-		'\u5395': []uint16{125, 225, 426, 325, 252},
-		'\u5398': []uint16{122, 135, 435, 322, 335, 230, 235, 156, 327, 330, 152, 225, 125, 215, 325, 315},
-		'\u539a': []uint16{400, 200, 125, 100, 227, 162, 362, 225, 327, 215, 132, 315, 115, 152, 252, 325, 220, 207, 107, 320, 204, 131, 262},
-		'\u539d': []uint16{330, 229},
-		'\u539f': []uint16{125, 225, 404, 107, 230, 152, 100, 325, 252, 127, 227, 120, 330, 104, 327, 115, 229, 156, 130, 331, 215, 132, 315, 256, 204, 232, 352, 320, 142, 231, 117, 332, 217, 220, 304},
-		'\u53a2': []uint16{225, 125, 435, 426, 332, 315, 227, 232, 104, 320, 330, 215, 229},
-		'\u53a3': []uint16{413},
-		'\u53a5': []uint16{225, 438, 252, 332, 227, 327, 325, 315},
-		'\u53a6': []uint16{130, 225, 417, 131, 230, 215, 231, 332, 132, 227, 327, 315, 125, 426, 232, 117},
-		'\u53a8': []uint16{125, 426, 225, 252, 232, 130, 117},
-		'\u53a9': []uint16{225, 125},
-		'\u53ae': []uint16{152, 225, 315, 156},
-		'\u53b6': []uint16{413},
-		'\u53bb': []uint16{452, 252, 243, 143, 152, 125, 315, 157, 215, 220, 320, 327, 325, 132, 362, 262, 227, 225, 115, 120},
-		'\u53bf': []uint16{230, 425, 125, 225, 238, 227, 104, 327, 325, 229, 330, 117, 252, 304, 156, 220, 231, 130, 331},
-		'\u53c1': []uint16{422, 122, 227, 222, 322},
-		'\u53c2': []uint16{152, 125, 425, 225, 325, 417, 156, 320, 227, 327, 100, 331, 115, 162, 315, 117, 330, 217, 132, 120, 232, 228, 454, 131},
-		'\u53c8': []uint16{407, 125, 320, 327, 120, 227, 315, 332, 127, 106},
-		'\u53c9': []uint16{425, 325, 252, 452, 225, 227, 152, 256, 125, 204, 327, 220, 320, 232},
-		'\u53ca': []uint16{406, 206, 252, 106, 101, 230, 215, 315, 330, 107, 134, 325, 152, 227, 125, 225, 234, 327, 332, 220, 331, 320, 228, 352, 304, 115},
-		'\u53cb': []uint16{225, 125, 100, 426, 227, 327, 425, 220, 252, 130, 232, 215, 325, 127, 152, 217, 332, 320, 331, 132, 330, 315, 129, 131, 228, 256, 117, 230, 229, 204},
-		'\u53cc': []uint16{125, 422, 122, 222, 104, 127, 132, 327, 238, 107, 130, 330, 322, 227, 315, 117, 152, 252, 143, 320, 215, 115, 217, 120, 131, 328, 228, 129, 317, 230, 325, 124, 332, 232},
-		'\u53cd': []uint16{152, 452, 107, 156, 252, 125, 325, 100, 106, 320, 315, 112, 115, 117, 206, 120, 215, 132, 204, 256, 217, 332, 104, 352, 220, 317, 131},
-		'\u53d1': []uint16{152, 156, 252, 125, 452, 325, 100, 120, 225, 217, 207, 331, 232, 256, 215, 422, 320, 227, 327, 222, 332, 315, 204, 117, 220, 115, 132, 330, 231, 317, 143, 104, 230, 304, 229, 352, 328, 130, 200, 224, 324, 228},
-		'\u53d4': []uint16{425, 227, 225, 125, 327, 325, 229, 129, 132, 328},
-		'\u53d6': []uint16{252, 152, 452, 125, 115, 315, 156, 227, 325, 320, 220, 327, 200, 215, 256, 117, 317, 120, 132, 229, 332, 331, 330},
-		'\u53d7': []uint16{452, 152, 252, 125, 200, 120, 325, 100, 315, 215, 156, 220, 115, 320, 327, 204, 332, 227, 256, 352, 130},
-		'\u53d8': []uint16{156, 152, 252, 452, 125, 320, 225, 256, 232, 215, 325, 120, 262, 115, 220, 327, 315, 200, 132, 332, 217, 227, 352, 204, 104, 304},
-		'\u53d9': []uint16{152, 452, 130, 125, 252, 156, 227, 327, 225, 325, 120, 320, 131, 215, 330},
-		'\u53db': []uint16{152, 125, 252, 315, 256, 156, 325, 215},
-		'\u53df': []uint16{413, 227, 225, 327, 315},
-		'\u53e0': []uint16{252, 452, 222, 152, 343, 125, 262, 325, 332, 304, 322, 362, 156, 162, 227, 315, 327, 225, 232, 256, 320, 220, 115, 130},
-		'\u53e3': []uint16{225, 256, 435, 125, 222, 242, 325, 230, 252, 315, 142, 152, 330, 331, 227, 207, 200, 156, 215, 220, 115, 304, 327, 320, 229, 130, 422, 120, 332, 324, 217, 352, 307, 224, 201, 232, 338, 204, 317, 107, 300, 104, 100},
-		'\u53e4': []uint16{125, 143, 400, 230, 325, 100, 127, 130, 330, 104, 256, 207, 227, 225, 320, 332, 243, 327, 152, 252, 315, 329, 132, 129, 115, 331, 343, 107, 229, 120, 131, 215, 262, 362, 162, 128, 220, 307, 317, 232, 200, 204},
-		'\u53e5': []uint16{222, 435, 225, 125, 306, 235, 135, 330, 215, 422, 315, 130, 252, 322, 220, 120, 207, 320, 325},
-		'\u53e6': []uint16{438, 106, 107, 125, 115, 152, 120, 227, 327, 315},
-		'\u53e8': []uint16{252, 262, 362, 152, 256},
-		'\u53e9': []uint16{152, 454, 125, 252, 327, 227},
-		'\u53ea': []uint16{407, 222, 107, 152, 106, 422, 225, 115, 206, 227, 104, 120, 207, 315, 125, 215, 325, 330, 352},
-		'\u53eb': []uint16{452, 152, 252, 125, 215, 325, 256, 227, 115, 156, 320, 327, 315, 100, 104, 262, 328, 130},
-		'\u53ec': []uint16{152, 427, 252, 227, 454, 426, 325, 327, 125, 156, 330, 230, 256, 115},
-		'\u53ed': []uint16{225, 433, 325, 333, 233, 125, 232, 133, 220},
-		'\u53ee': []uint16{152, 452, 133, 320, 333, 227, 120, 315, 327},
-		'\u53ef': []uint16{152, 452, 106, 252, 100, 125, 315, 120, 320, 327, 207, 307, 325, 227, 225, 256, 306, 115, 156, 215, 107, 104, 132, 229, 162, 330, 220, 352, 130, 332, 129, 331, 131, 228, 329, 230, 232, 317},
-		'\u53f0': []uint16{225, 130, 435, 252, 125, 222, 230, 142, 330, 422, 325, 231, 117, 227, 327, 127, 131, 220, 232, 332, 229, 217, 315, 104, 238, 331, 320, 317, 242, 132, 207, 304, 224, 228, 215, 204, 135, 352, 129, 122, 329, 322},
-		'\u53f1': []uint16{152, 115, 252},
-		'\u53f2': []uint16{225, 426, 125, 127, 325, 227, 320, 104, 330, 331, 232, 327, 115, 252, 220, 130, 315, 317, 215, 129, 132, 120, 204, 329, 128, 230, 217, 332},
-		'\u53f3': []uint16{222, 412, 125, 112, 322, 315, 142, 104, 325, 227, 225, 130, 327, 320, 220, 330, 317, 252, 204, 129, 215, 328, 229, 217, 132},
-		'\u53f5': []uint16{152, 315},
-		'\u53f6': []uint16{225, 127, 125, 425, 325, 243, 417, 227, 452, 222, 130, 327, 212, 427, 232, 315, 215, 422, 229, 129, 332, 230, 128, 132, 220, 115, 426, 330, 435, 331, 320, 343, 120},
-		'\u53f7': []uint16{225, 435, 422, 125, 152, 222, 252, 325, 232, 204, 315, 227, 120, 320, 220, 215, 356, 256, 332, 327, 304},
-		'\u53f8': []uint16{225, 125, 127, 426, 231, 325, 235, 425, 227, 327, 220, 252, 230, 115, 222, 129, 128, 131, 315, 332, 130, 330, 331, 352, 152, 117, 104, 217, 215, 132, 229, 304, 317, 232},
-		'\u53f9': []uint16{452, 252, 152, 227, 325, 215, 315, 115, 320, 200, 256, 125, 220},
-		'\u53fb': []uint16{413, 230, 330},
-		'\u53fc': []uint16{452},
-		'\u53fd': []uint16{133, 433, 333, 233, 225, 152, 120},
-		'\u5401': []uint16{252, 315, 454, 215, 362, 262, 152, 410, 227, 325, 433, 327},
-		'\u5403': []uint16{452, 152, 100, 252, 315, 200, 125, 120, 320, 225, 115, 325, 220, 215, 227, 117, 352},
-		'\u5404': []uint16{138, 438, 320, 120, 100, 115, 330, 229, 227, 230, 327, 152, 315, 329, 162, 325, 130, 104},
-		'\u5406': []uint16{152, 452, 125, 120, 156, 320, 227},
-		'\u5408': []uint16{252, 152, 256, 125, 325, 156, 100, 452, 331, 225, 320, 304, 130, 300, 227, 315, 215, 120, 332, 330, 327, 217, 115, 230, 204, 132, 104, 220, 232, 117, 107, 131, 352, 317, 356, 200, 207, 342, 229, 129, 362, 262, 228},
-		'\u5409': []uint16{127, 130, 327, 125, 417, 227, 330, 129, 230, 100, 332, 229, 132, 131, 215, 315, 325, 115, 225, 329, 262, 120, 252, 331, 117, 220, 320, 232, 228},
-		'\u540a': []uint16{452, 125, 152, 252, 315, 225, 132, 120, 115, 325, 156, 327, 130, 320, 215, 104},
-		'\u540c': []uint16{200, 434, 106, 125, 107, 117, 207, 152, 252, 225, 104, 112, 306, 325, 153, 315, 215, 230, 234, 320, 115, 227, 130, 327, 331, 156, 220, 256, 127, 132, 138, 120, 143, 355, 330, 129, 131, 332, 228, 122, 232, 352, 217, 304, 342},
-		'\u540d': []uint16{125, 252, 435, 222, 225, 200, 422, 152, 315, 325, 115, 327, 320, 215, 100, 120, 220, 227, 256, 230, 130, 330, 117, 204, 207, 132, 253, 127, 232, 300, 343, 129, 228},
-		'\u540e': []uint16{412, 212, 243, 125, 206, 207, 143, 112, 225, 138, 227, 200, 152, 252, 156, 242, 320, 127, 115, 315, 104, 220, 325, 215, 130, 204, 327, 312, 132, 117, 217, 317, 120, 342, 129, 343, 352, 331, 330, 142, 332, 329},
-		'\u540f': []uint16{225, 125, 426, 227, 215, 327, 130, 232},
-		'\u5410': []uint16{452, 130, 252, 152, 325, 315, 125, 215, 256, 225, 127, 132, 327, 227, 320, 133, 129, 115, 330, 331, 120, 329},
-		'\u5411': []uint16{434, 225, 252, 152, 207, 127, 107, 325, 125, 327, 204, 212, 134, 320, 200, 315, 217, 215, 304, 256, 130, 232, 328, 229, 104, 128, 227, 129, 132, 143, 332, 220, 156, 331, 115},
-		'\u5412': []uint16{229, 413, 227},
-		'\u5413': []uint16{452, 152, 252, 227, 256, 100, 325, 327, 315},
-		'\u5415': []uint16{127, 427, 327, 227, 130, 125, 329, 129, 225, 330, 229, 232, 315, 215},
-		'\u5416': []uint16{459, 125, 227},
-		'\u5417': []uint16{460, 252, 125, 325},
-		'\u541b': []uint16{425, 125, 227, 132, 225, 327, 130, 120, 315, 452, 252, 229, 325, 230, 330, 220, 129, 331, 232, 300, 332, 152, 426, 328, 115, 215, 127, 320, 238, 352},
-		'\u541d': []uint16{100, 152, 252, 200, 454, 125, 315, 227},
-		'\u541e': []uint16{152, 452, 315, 125, 252, 115, 156, 362, 262, 120, 325, 330, 130, 200, 225, 215},
-		'\u541f': []uint16{252, 452, 152, 362, 262, 227, 327, 215, 225, 228, 115, 315, 156, 232},
-		'\u5420': []uint16{454, 225, 125, 252, 215, 315, 320},
-		'\u5421': []uint16{125, 332, 132, 325},
-		'\u5423': []uint16{452, 227},
-		'\u5426': []uint16{252, 106, 152, 454, 460, 245, 352, 215, 125, 320, 315, 115, 227, 325, 225},
-		'\u5427': []uint16{460, 225, 133, 152, 125, 233, 227, 325, 220, 232},
-		'\u5428': []uint16{222, 435, 422, 325, 104, 125, 304, 235, 322, 225, 135, 117},
-		'\u5429': []uint16{152, 156, 225, 325, 125},
-		'\u542b': []uint16{452, 125, 252, 152, 100, 127, 115, 156, 227, 327, 362, 162, 225, 132, 325, 120, 300, 315, 320, 130, 129},
-		'\u542c': []uint16{452, 152, 252, 125, 200, 315, 227, 100, 225, 215, 115, 325, 127, 256, 327, 320, 156, 120, 352, 104, 132},
-		'\u542d': []uint16{152, 452, 220, 133, 315, 252, 227},
-		'\u542e': []uint16{452, 252, 152, 227},
-		'\u542f': []uint16{152, 452, 252, 327, 156, 227, 243, 125, 130, 120, 315, 320, 132, 104, 129, 328, 304},
-		'\u5431': []uint16{433, 152, 233, 133, 333, 125, 325, 227},
-		'\u5432': []uint16{125, 459},
-		'\u5434': []uint16{127, 427, 230, 130, 227, 125, 327, 128, 331, 332, 117, 115, 329, 131, 232, 129},
-		'\u5435': []uint16{452, 152, 252, 352, 256, 320, 227, 156, 125, 325},
-		'\u5438': []uint16{152, 452, 252, 125, 325, 320, 156, 220, 256, 132, 120, 225, 204, 104, 232, 315, 327, 304},
-		'\u5439': []uint16{452, 152, 252, 315, 125, 156, 115, 320, 325, 227, 327, 120, 215, 132},
-		'\u543b': []uint16{452, 225, 152, 252, 125, 325, 227},
-		'\u543c': []uint16{452, 152, 252, 125, 227, 325, 215, 225, 156},
-		'\u543e': []uint16{438, 332, 227, 330, 252, 327, 352, 138, 230, 331, 315, 228, 129, 320, 120},
-		'\u5440': []uint16{460, 210, 233, 333, 360, 260, 227, 325, 225, 327, 315},
-		'\u5443': []uint16{460, 152},
-		'\u5446': []uint16{452, 125, 362, 215, 252, 162, 100, 262, 325, 152, 227, 115, 117, 327, 315, 225},
-		'\u5448': []uint16{452, 152, 252, 327, 227, 225, 125, 215, 220, 315, 127, 130},
-		'\u544a': []uint16{225, 152, 252, 452, 325, 125, 120, 315, 320, 227, 256, 220, 332, 215, 115, 130, 343, 217, 327, 156},
-		'\u544b': []uint16{125, 325, 132, 332},
-		'\u5450': []uint16{152, 460, 225, 125, 156, 315, 120, 325, 227, 327},
-		'\u5453': []uint16{225, 125},
-		'\u5454': []uint16{410, 413},
-		'\u5455': []uint16{152, 460, 156, 115, 252, 215},
-		'\u5456': []uint16{233, 133},
-		'\u5457': []uint16{460, 233},
-		'\u5458': []uint16{225, 325, 331, 125, 435, 252, 222, 327, 220, 425, 227, 315, 256, 317, 320, 217, 127, 422, 215, 130, 304, 330},
-		'\u5459': []uint16{427},
-		'\u545b': []uint16{452, 252, 100, 152},
-		'\u545c': []uint16{433, 152, 120, 320, 110, 315, 227, 327},
-		'\u5462': []uint16{460, 125, 225, 260, 152, 132, 227, 156},
-		'\u5464': []uint16{225, 459, 232, 325, 227},
-		'\u5466': []uint16{410},
-		'\u5468': []uint16{243, 143, 125, 127, 427, 325, 112, 222, 212, 422, 227, 152, 225, 122, 100, 238, 130, 156, 132, 327, 322, 315, 128, 135, 230, 228, 320, 115, 129, 120, 435, 200, 312, 101, 229, 330, 217, 300, 252, 103, 215},
-		'\u5471': []uint16{133, 233, 362, 162, 152, 252, 115, 315, 333, 262},
-		'\u5472': []uint16{452},
-		'\u5473': []uint16{225, 352, 125, 426, 252, 207, 325, 215, 222, 200, 315, 232, 220, 227, 332, 331, 327, 320, 204, 132, 422, 115, 100, 330, 256, 300},
-		'\u5475': []uint16{452, 460, 152, 156, 362, 262, 315, 125, 227, 352, 130, 327, 200},
-		'\u5476': []uint16{413},
-		'\u5477': []uint16{452, 227},
-		'\u5478': []uint16{410},
-		'\u547b': []uint16{152, 315},
-		'\u547c': []uint16{152, 252, 452, 125, 127, 130, 225, 315, 320, 362, 115, 325, 133, 262, 156, 120, 215, 210, 117, 227, 162, 132, 333, 327, 352, 256, 129, 220, 330, 131},
-		'\u547d': []uint16{256, 225, 425, 125, 252, 152, 325, 232, 215, 331, 207, 332, 227, 220, 320, 315, 330, 200, 327, 204, 100, 352, 120, 262, 356, 104, 452, 156, 143, 115},
-		'\u5480': []uint16{152, 230, 315},
-		'\u5482': []uint16{452, 152, 325, 125},
-		'\u5484': []uint16{115, 315, 152},
-		'\u5486': []uint16{152, 120, 125},
-		'\u548b': []uint16{438, 138, 152, 362, 162},
-		'\u548c': []uint16{406, 125, 132, 330, 127, 252, 200, 325, 225, 100, 232, 327, 152, 130, 230, 227, 156, 332, 228, 331, 115, 256, 229, 120, 217, 215, 129, 315, 143, 362, 320, 162, 220, 262, 104, 328, 304, 131, 101, 204},
-		'\u548e': []uint16{252, 426, 215, 320, 115, 220, 315, 227},
-		'\u548f': []uint16{152, 225, 125, 252, 228, 325, 328},
-		'\u5490': []uint16{252, 256, 459, 220},
-		'\u5492': []uint16{452, 252, 125, 152, 225, 315, 156},
-		'\u5494': []uint16{433, 133, 125},
-		'\u5495': []uint16{133, 433, 152, 252, 233, 362, 333, 262, 220, 320},
-		'\u5496': []uint16{125, 132},
-		'\u5499': []uint16{225, 459},
-		'\u549a': []uint16{233, 433, 133, 333, 262, 327, 227},
-		'\u549b': []uint16{252, 459, 320, 220},
-		'\u549d': []uint16{433, 125, 325, 227},
-		'\u54a3': []uint16{433, 133, 227},
-		'\u54a4': []uint16{315, 252, 459},
-		'\u54a6': []uint16{410, 327},
-		'\u54a7': []uint16{452, 362, 262, 152, 315, 125, 325, 327},
-		'\u54a8': []uint16{156, 125, 117, 331, 217, 143, 332, 132},
-		'\u54a9': []uint16{433},
-		'\u54aa': []uint16{125, 225, 132, 233, 133, 332, 325, 227, 327},
-		'\u54ab': []uint16{315, 125, 115, 120},
-		'\u54ac': []uint16{452, 152, 115, 125, 252, 352, 120, 315, 227, 156, 327, 325, 320, 225},
-		'\u54ad': []uint16{433},
-		'\u54af': []uint16{133, 452, 233, 152, 225, 333, 232, 325, 460, 227, 327},
-		'\u54b1': []uint16{138, 438},
-		'\u54b3': []uint16{152, 452, 256, 252, 132, 225, 125, 325, 410, 115, 332},
-		'\u54b4': []uint16{459, 133, 333},
-		'\u54b8': []uint16{130, 400, 125, 127, 227, 427, 327, 132, 330, 320, 252, 426},
-		'\u54bb': []uint16{413, 262, 362},
-		'\u54bd': []uint16{452, 252, 125, 152, 220, 320, 215, 225, 325, 227},
-		'\u54bf': []uint16{133, 333, 115},
-		'\u54c0': []uint16{152, 454, 100, 125, 200, 203, 103, 252, 327, 227, 315, 130, 115, 132, 156, 162, 320, 402},
-		'\u54c1': []uint16{225, 125, 325, 452, 222, 152, 320, 331, 220, 217, 204, 252, 227, 352, 327, 232, 422, 330, 332, 156, 315, 115, 135, 238, 304, 317, 127, 129, 215, 104},
-		'\u54c2': []uint16{454, 152},
-		'\u54c4': []uint16{452, 152, 362, 262, 252, 156, 315, 115, 125, 227, 327, 256, 225},
-		'\u54c6': []uint16{152, 352, 162, 362},
-		'\u54c7': []uint16{460, 133, 230, 233, 330, 325, 229, 120, 110},
-		'\u54c8': []uint16{330, 417, 130, 127, 129, 233, 133, 327, 320, 120, 131, 132, 125, 325, 229, 227, 230, 329, 331, 333, 332, 232, 362, 262, 117, 210, 352, 225, 162, 110, 252, 152, 215, 128},
-		'\u54c9': []uint16{460, 227, 200, 220, 215, 315, 245, 327, 320},
-		'\u54cc': []uint16{125, 325, 332, 225, 132},
-		'\u54cd': []uint16{256, 452, 152, 225, 325, 252, 125, 222, 100, 220, 215, 227, 162, 130, 115, 422, 327, 400, 200},
-		'\u54ce': []uint16{410, 110, 160},
-		'\u54cf': []uint16{252},
-		'\u54d0': []uint16{433, 133},
-		'\u54d1': []uint16{125, 452, 200, 115, 325, 215, 225, 252, 162, 217, 120, 104, 352, 320, 132},
-		'\u54d2': []uint16{433, 233, 459, 252, 125, 333},
-		'\u54d4': []uint16{125},
-		'\u54d5': []uint16{413},
-		'\u54d7': []uint16{454, 133, 252, 152, 162, 333, 115, 362, 315, 433, 227, 327},
-		'\u54d9': []uint16{227, 413},
-		'\u54da': []uint16{225, 227},
-		'\u54dc': []uint16{413},
-		'\u54dd': []uint16{252, 459},
-		'\u54de': []uint16{433},
-		'\u54df': []uint16{460, 210, 227},
-		'\u54e5': []uint16{225, 125, 425, 130, 230, 227, 330, 327, 331, 325, 131, 127, 132, 104, 232, 329, 129, 315, 417, 332},
-		'\u54e6': []uint16{410, 252, 433},
-		'\u54e7': []uint16{233, 433, 333, 133},
-		'\u54e8': []uint16{426, 125, 225, 435, 252, 227, 233, 200, 262, 325, 362, 229},
-		'\u54e9': []uint16{460, 435, 227, 225, 362, 332, 162, 320},
-		'\u54ea': []uint16{138, 438, 106, 124, 129, 120, 327, 227},
-		'\u54ed': []uint16{452, 252, 152, 125, 315, 115, 120, 362, 162, 215, 220, 256, 320, 227, 156, 132, 327},
-		'\u54ee': []uint16{252, 125, 320, 325},
-		'\u54f2': []uint16{125, 427, 327, 227, 130, 129, 225, 332, 426, 331, 217, 315, 328, 117, 200, 228},
-		'\u54f3': []uint16{459},
-		'\u54fa': []uint16{125, 152, 454, 120, 215, 252, 156, 327},
-		'\u54fc': []uint16{452, 152, 252, 362, 262, 125, 162, 133, 315},
-		'\u54fd': []uint16{152, 452},
-		'\u54ff': []uint16{413, 327},
-		'\u5501': []uint16{252, 125, 256},
-		'\u5506': []uint16{252, 152, 325},
-		'\u5507': []uint16{225, 426, 125, 227, 132, 325, 315, 115, 327, 120, 320, 352, 117},
-		'\u5509': []uint16{410, 115, 152},
-		'\u550f': []uint16{152},
-		'\u5510': []uint16{127, 143, 427, 243, 417, 130, 227, 444, 232, 125, 200, 327, 332, 217, 129, 325, 330, 152, 329, 128, 431, 132, 131, 230, 229, 331, 228},
-		'\u5511': []uint16{225, 459, 232, 325, 332},
-		'\u5514': []uint16{460, 233},
-		'\u551b': []uint16{413},
-		'\u5520': []uint16{152, 452, 362, 162, 156, 252},
-		'\u5522': []uint16{125},
-		'\u5523': []uint16{200, 459},
-		'\u5524': []uint16{454, 252, 227, 152, 315, 256, 215, 327, 325, 320},
-		'\u5527': []uint16{133, 233, 252, 452, 125, 333, 262, 362, 152},
-		'\u552a': []uint16{413, 227},
-		'\u552c': []uint16{452, 252, 152},
-		'\u552e': []uint16{256, 252, 452, 325, 125, 152, 120, 156, 317, 320, 217, 220, 132, 332},
-		'\u552f': []uint16{104, 125, 407, 152, 325, 227, 107, 120, 100, 327, 320, 115, 315, 132, 130},
-		'\u5531': []uint16{452, 252, 152, 125, 325, 225, 256, 315, 120, 227, 320, 220, 331, 156, 115, 217, 332, 327, 352},
-		'\u5533': []uint16{215, 413},
-		'\u5537': []uint16{459, 227, 233},
-		'\u553c': []uint16{459},
-		'\u553e': []uint16{125, 452, 152, 115},
-		'\u553f': []uint16{459, 125},
-		'\u5541': []uint16{459, 133},
-		'\u5543': []uint16{452, 320, 120, 315, 152},
-		'\u5544': []uint16{452, 125, 152, 215, 233},
-		'\u5546': []uint16{125, 225, 152, 425, 252, 331, 131, 130, 325, 127, 256, 317, 143, 120, 227, 332, 117, 156, 217, 327, 320, 232, 330, 304, 243, 315, 343, 132, 104, 231, 220},
-		'\u5549': []uint16{225, 459, 227},
-		'\u554a': []uint16{460, 110, 227, 125, 327},
-		'\u5550': []uint16{452},
-		'\u5555': []uint16{207, 320, 252, 413},
-		'\u5556': []uint16{454, 227},
-		'\u555c': []uint16{454, 152, 125},
-		'\u5561': []uint16{225, 325, 459, 332, 227},
-		'\u5564': []uint16{125, 217, 331, 325, 225, 132},
-		'\u5565': []uint16{438, 238, 138, 125},
-		'\u5566': []uint16{460, 233, 333, 262, 227, 325, 332, 362, 125, 232, 225},
-		'\u5567': []uint16{233, 133, 433, 120, 320, 115, 315, 125, 325, 410},
-		'\u556a': []uint16{433, 233, 333, 133},
-		'\u556c': []uint16{200, 402, 325, 413, 327},
-		'\u556d': []uint16{413, 252},
-		'\u556e': []uint16{125, 454, 104, 152, 252},
-		'\u5575': []uint16{460},
-		'\u5576': []uint16{225, 459, 325, 232, 332},
-		'\u5577': []uint16{459, 233},
-		'\u5578': []uint16{225, 252, 452, 227, 327, 125, 220, 120, 152, 215, 228, 127},
-		'\u557b': []uint16{413, 252, 207, 327},
-		'\u557c': []uint16{152, 452, 362, 262, 225, 125, 115, 156, 227, 320, 315},
-		'\u557e': []uint16{459, 233},
-		'\u5580': []uint16{130, 417, 330, 327, 132, 227, 133, 225, 433, 125, 329, 332, 131, 129},
-		'\u5581': []uint16{413},
-		'\u5582': []uint16{452, 152, 156, 252, 227},
-		'\u5583': []uint16{233, 133, 225, 115, 315, 325, 252, 332, 227, 327, 256},
-		'\u5584': []uint16{252, 452, 152, 200, 125, 100, 227, 201, 327, 315, 230, 115, 409, 320, 330, 215, 225, 325, 402, 220, 130, 120, 352, 204, 229, 331},
-		'\u5587': []uint16{125, 427, 330, 227, 325, 327, 225, 233, 333, 320, 130},
-		'\u5588': []uint16{227, 459},
-		'\u5589': []uint16{125, 426, 225, 325, 252, 132, 215, 332, 220},
-		'\u558a': []uint16{452, 252, 152, 125, 315, 325, 227, 256, 156, 215, 320, 115, 327},
-		'\u558b': []uint16{115, 315, 152, 325},
-		'\u558f': []uint16{410, 252, 459, 227},
-		'\u5591': []uint16{152, 215},
-		'\u5594': []uint16{433, 410},
-		'\u5598': []uint16{452, 152, 225, 315, 325, 252, 215, 162, 156, 232, 362, 262, 352, 125},
-		'\u5599': []uint16{426, 215},
-		'\u559c': []uint16{152, 452, 125, 252, 225, 200, 227, 100, 115, 130, 327, 315, 215, 162, 220, 320, 325, 330, 207, 256, 120, 230, 362, 262, 132, 352, 129, 156, 228, 232, 229, 332, 103},
-		'\u559d': []uint16{454, 152, 252, 156, 220, 227, 320, 315, 325, 200, 120, 130, 125, 225, 215, 256},
-		'\u559f': []uint16{413, 152, 256},
-		'\u55a7': []uint16{152, 454, 156, 315, 125, 115, 252, 100, 227, 327, 402},
-		'\u55b1': []uint16{459, 332, 225, 325},
-		'\u55b3': []uint16{233, 333, 433, 133},
-		'\u55b5': []uint16{433},
-		'\u55b7': []uint16{152, 452, 125, 252, 104, 156, 352, 362, 262, 132, 232, 315, 162, 325, 115, 327, 204, 227, 120, 100, 320, 225, 304, 130},
-		'\u55b9': []uint16{125, 225, 132},
-		'\u55bb': []uint16{215, 225, 127, 252, 427, 315, 152, 227, 454, 426, 256, 320, 327, 130},
-		'\u55bd': []uint16{125, 460, 445, 327},
-		'\u55be': []uint16{413, 229, 227},
-		'\u55c4': []uint16{410, 413},
-		'\u55c5': []uint16{452, 125, 152},
-		'\u55c9': []uint16{125},
-		'\u55cc': []uint16{413},
-		'\u55cd': []uint16{452},
-		'\u55d1': []uint16{452, 252},
-		'\u55d2': []uint16{433, 233},
-		'\u55d3': []uint16{125, 435, 426, 325, 332, 225, 352},
-		'\u55d4': []uint16{152, 252, 315},
-		'\u55d6': []uint16{433, 233, 133, 362, 262},
-		'\u55dc': []uint16{454, 125, 152, 115},
-		'\u55dd': []uint16{252, 225, 125, 352},
-		'\u55df': []uint16{152, 115, 252, 315},
-		'\u55e1': []uint16{233, 133, 433},
-		'\u55e3': []uint16{327, 426, 227, 143, 225, 252, 328, 125},
-		'\u55e4': []uint16{454, 115, 233, 152},
-		'\u55e5': []uint16{413, 225, 152, 327},
-		'\u55e6': []uint16{252, 459, 200, 262},
-		'\u55e8': []uint16{410, 110},
-		'\u55ea': []uint16{225, 413, 325, 232},
-		'\u55eb': []uint16{152, 162, 362},
-		'\u55ec': []uint16{410, 110, 227},
-		'\u55ef': []uint16{410},
-		'\u55f2': []uint16{320, 120},
-		'\u55f3': []uint16{410, 125},
-		'\u55f5': []uint16{433},
-		'\u55f7': []uint16{233, 133, 152, 115, 352, 315},
-		'\u55fd': []uint16{252, 325},
-		'\u55fe': []uint16{413},
-		'\u5600': []uint16{152, 320, 362, 162, 133},
-		'\u5601': []uint16{333, 133},
-		'\u5608': []uint16{100, 162, 362},
-		'\u5609': []uint16{417, 327, 127, 130, 227, 143, 132, 125, 330, 230, 232, 152, 217, 200, 156, 129, 332, 331, 131, 229, 325},
-		'\u560c': []uint16{413, 125, 325, 332},
-		'\u560e': []uint16{433, 133, 327, 233, 330, 227, 333, 230, 132, 228, 129, 232, 130, 127, 162},
-		'\u560f': []uint16{413, 227},
-		'\u5618': []uint16{252, 452, 125, 325, 225, 115, 362, 262, 220, 410, 152, 320, 256},
-		'\u561b': []uint16{460, 225, 325, 230, 330},
-		'\u561e': []uint16{460},
-		'\u561f': []uint16{152, 233, 433, 133, 362, 162, 135, 333, 262, 227, 327},
-		'\u5623': []uint16{433, 362},
-		'\u5624': []uint16{433, 227},
-		'\u5627': []uint16{125, 459, 325, 227},
-		'\u562c': []uint16{452},
-		'\u562d': []uint16{433},
-		'\u5631': []uint16{152, 252, 225, 454, 156, 320, 325, 215},
-		'\u5632': []uint16{152, 252, 454, 156, 315, 220, 256, 125, 215},
-		'\u5634': []uint16{425, 125, 142, 252, 225, 230, 227, 315, 325, 100, 330, 220, 215, 152, 232, 320, 327, 207, 200, 120, 115, 352},
-		'\u5636': []uint16{454, 100, 152, 315, 225, 215, 327, 433},
-		'\u5639': []uint16{100},
-		'\u563b': []uint16{433, 362, 262, 152, 162, 410, 227, 115, 327, 210},
-		'\u563f': []uint16{233, 133, 410},
-		'\u564c': []uint16{433, 459},
-		'\u564d': []uint16{454},
-		'\u564e': []uint16{452, 152, 252, 315, 125},
-		'\u5654': []uint16{433, 233},
-		'\u5657': []uint16{433, 133},
-		'\u5658': []uint16{452, 152},
-		'\u5659': []uint16{452, 227},
-		'\u565c': []uint16{233, 225, 252, 333, 235, 100, 262, 220},
-		'\u5662': []uint16{460, 410},
-		'\u5664': []uint16{225, 115, 125, 220},
-		'\u5668': []uint16{225, 125, 425, 325, 232, 220, 227, 343, 320, 152, 231, 331, 252, 419, 200, 215, 327, 104, 352, 156, 115, 228, 204, 217, 315, 120, 332, 304, 330},
-		'\u5669': []uint16{125, 362, 262, 200, 215, 315},
-		'\u566a': []uint16{125, 252, 426, 200, 320, 332, 215, 454, 256, 225, 100, 325},
-		'\u566b': []uint16{410, 110},
-		'\u566c': []uint16{252, 454, 125, 320, 115, 215},
-		'\u5671': []uint16{125},
-		'\u5676': []uint16{330, 459, 129, 130, 325, 125, 227, 332, 132},
-		'\u567b': []uint16{125, 220, 225, 325},
-		'\u567c': []uint16{133, 333},
-		'\u5685': []uint16{252, 459, 262, 362},
-		'\u5686': []uint16{459},
-		'\u568e': []uint16{452, 152, 107, 252, 215, 225, 125, 227},
-		'\u568f': []uint16{225, 252, 125, 233},
-		'\u5693': []uint16{233, 433, 333},
-		'\u56a3': []uint16{252, 100, 256, 454, 315, 120, 227, 225, 325, 200},
-		'\u56af': []uint16{410},
-		'\u56b7': []uint16{452, 252, 152, 352, 362, 262, 256},
-		'\u56bc': []uint16{452, 252, 315, 125, 320, 152, 325},
-		'\u56ca': []uint16{225, 426, 125, 152, 142, 325, 200, 227, 315, 215, 362, 262, 115, 252, 120, 232, 129, 330, 130},
-		'\u56d4': []uint16{252, 262, 362, 152},
-		'\u56da': []uint16{125, 454, 152, 225, 252, 325, 156},
-		'\u56db': []uint16{122, 422, 322, 130, 222, 124, 125, 117, 112, 327, 243, 107, 143, 325, 115, 343, 152, 129, 332, 131, 225, 132, 227, 315, 320, 104, 215, 120, 230, 330, 238, 331, 229, 232, 317, 217, 304, 127, 220},
-		'\u56de': []uint16{152, 452, 252, 125, 222, 156, 224, 132, 256, 320, 130, 315, 330, 325, 115, 227, 243, 422, 215, 327, 362, 262, 220, 120, 225, 230, 204, 128, 304, 331},
-		'\u56df': []uint16{125},
-		'\u56e0': []uint16{106, 434, 225, 125, 306, 115, 327, 227, 229, 152, 352, 107, 315, 325, 215, 329, 320, 330, 232, 252, 120, 230, 129, 130, 134, 156, 132, 332, 228},
-		'\u56e1': []uint16{225},
-		'\u56e2': []uint16{225, 125, 425, 231, 325, 100, 222, 152, 252, 331, 422, 207, 107, 332, 238, 315, 232, 320, 227, 130, 215, 200, 220, 217, 115, 117, 352, 104, 327, 120, 131, 317, 304, 135, 162, 362, 156, 143, 356},
-		'\u56e4': []uint16{452, 152, 115, 225},
-		'\u56eb': []uint16{107, 115},
-		'\u56ed': []uint16{225, 125, 426, 230, 227, 330, 142, 325, 327, 217, 252, 435, 232, 229, 315, 332, 256, 238, 152, 356, 156, 231, 132, 104, 317},
-		'\u56f0': []uint16{103, 125, 200, 452, 100, 152, 252, 325, 315, 204, 215, 120, 256, 352, 115, 320, 217, 356, 327},
-		'\u56f1': []uint16{225, 459},
-		'\u56f4': []uint16{225, 212, 152, 452, 252, 125, 325, 227, 156, 320, 220, 115, 327, 120, 315, 230, 204, 330, 331, 130, 132, 232},
-		'\u56f5': []uint16{207, 315, 459},
-		'\u56f9': []uint16{315, 125},
-		'\u56fa': []uint16{100, 252, 125, 417, 200, 120, 152, 106, 325, 104, 454, 227, 130, 256, 215, 327, 320, 115, 230, 332, 132, 217, 229, 232, 201, 330, 129, 156, 225, 352, 315, 131},
-		'\u56fd': []uint16{230, 125, 225, 425, 331, 142, 325, 238, 131, 327, 252, 320, 156, 117, 132, 227, 332, 104, 231, 127, 200, 330, 143, 204, 152, 256, 243, 120, 229, 315, 232, 115, 215, 228, 130, 304, 317, 220, 129, 328, 242, 217, 352, 356, 329},
-		'\u56fe': []uint16{425, 125, 225, 252, 227, 129, 327, 325, 130, 230, 232, 256, 315, 152, 229, 330, 215, 331, 320, 332, 127, 329, 220, 217, 120, 115, 228, 156, 132, 135},
-		'\u56ff': []uint16{454, 225, 152},
-		'\u5703': []uint16{426, 225, 227, 327, 130, 325},
-		'\u5704': []uint16{215, 225, 459},
-		'\u5706': []uint16{125, 454, 225, 425, 325, 100, 227, 327, 252, 130, 262, 120, 162, 362, 152, 200, 452, 320, 315, 143, 104, 400, 215, 127, 232, 220, 132, 117, 228, 328, 343, 352},
-		'\u5708': []uint16{225, 222, 125, 435, 452, 152, 425, 325, 232, 352, 130, 156, 320, 422, 135, 252, 327, 227, 230, 132, 220},
-		'\u5709': []uint16{413, 327},
-		'\u570a': []uint16{413},
-		'\u571c': []uint16{459, 125, 227},
-		'\u571f': []uint16{125, 225, 425, 252, 130, 325, 330, 315, 132, 120, 320, 227, 331, 215, 327, 152, 115, 232, 256, 129, 352, 332, 100, 127, 217, 131, 362, 162, 104, 220, 229, 230, 156, 143, 117},
-		'\u5723': []uint16{125, 417, 130, 230, 227, 327, 200, 143, 129, 132, 252, 100, 225, 232, 127, 320, 331, 330, 325, 231, 315, 352, 215, 131, 343, 228, 329},
-		'\u5728': []uint16{434, 252, 243, 152, 207, 145, 225, 315, 204, 325, 156, 320, 104, 327, 352, 220, 362, 262, 115, 215, 138, 129, 100, 125, 227, 107, 120, 200, 332, 331, 228},
-		'\u5729': []uint16{125, 230, 130, 228},
-		'\u572a': []uint16{125, 130},
-		'\u572c': []uint16{413},
-		'\u572d': []uint16{230, 130, 417, 227, 125, 330, 327, 325, 225, 315, 129, 331, 131},
-		'\u572e': []uint16{413},
-		'\u572f': []uint16{227, 413},
-		'\u5730': []uint16{450, 125, 225, 142, 317, 242, 330, 238, 262, 252, 207, 325, 230, 215, 130, 152, 245, 315, 220, 232, 132, 320, 127, 332, 327, 227, 307, 229, 117, 120, 100, 129, 115, 104, 352, 217, 331, 342, 256, 131, 304, 235, 204, 306, 356, 343, 143, 156},
-		'\u5733': []uint16{230, 330, 331, 325},
-		'\u5739': []uint16{426, 225},
-		'\u573a': []uint16{225, 125, 222, 435, 242, 252, 325, 224, 142, 352, 230, 207, 238, 220, 320, 422, 215, 315, 112, 204, 217, 330, 322, 135, 235, 332, 327, 331, 200, 256, 231, 227, 232, 122, 317},
-		'\u573b': []uint16{230, 227, 413, 330, 327},
-		'\u573e': []uint16{225, 325, 459},
-		'\u5740': []uint16{225, 426, 252, 227, 230, 256, 330, 327, 325},
-		'\u5742': []uint16{330, 425, 225, 230, 127, 327, 229, 129, 130, 132},
-		'\u5747': []uint16{407, 200, 217, 100, 125, 325, 227, 152, 320, 207, 327, 352, 132, 101, 315, 130, 332, 156, 304, 230, 129},
-		'\u574a': []uint16{225, 426, 230, 125, 227, 232, 330, 327, 325, 130, 332, 304},
-		'\u574c': []uint16{413},
-		'\u574d': []uint16{152, 452, 252},
-		'\u574e': []uint16{426, 129, 100, 225, 227, 125, 130, 327, 135, 325, 229, 120, 329, 362, 162, 230},
-		'\u574f': []uint16{252, 400, 125, 152, 225, 325, 215, 320, 220, 317, 120, 262, 332, 362, 227, 315},
-		'\u5750': []uint16{452, 152, 252, 125, 115, 215, 325, 320, 225, 120, 315, 227, 156, 104, 132, 327, 256},
-		'\u5751': []uint16{425, 225, 125, 252, 230, 152, 120, 320, 315, 229, 220, 227, 325, 452, 330, 100},
-		'\u5757': []uint16{225, 222, 435, 238, 207, 242, 307, 125, 422, 252, 325, 215, 135, 332, 342, 232, 132, 320, 315},
-		'\u575a': []uint16{152, 100, 101, 227, 400, 115, 427, 125, 327, 225, 256, 325, 330, 153, 315, 252, 320, 215, 120, 129, 220, 331, 207, 131, 401, 135, 322, 230, 122, 426},
-		'\u575b': []uint16{225, 426, 435, 331, 230, 125, 227, 327, 120, 320, 330, 332, 232, 325, 315, 252, 130, 217},
-		'\u575c': []uint16{230, 330},
-		'\u575d': []uint16{225, 425, 125, 230, 227, 142, 330, 252, 325, 130, 331, 232, 229},
-		'\u575e': []uint16{426, 230, 225, 125, 330},
-		'\u575f': []uint16{425, 125, 225, 252, 315, 230, 325},
-		'\u5760': []uint16{452, 152, 215, 252, 125, 225, 325, 315, 232, 156},
-		'\u5761': []uint16{225, 425, 230, 125, 227, 252, 325, 330, 320, 331, 232, 229, 130, 228, 142, 256},
-		'\u5764': []uint16{130, 225, 230, 330, 232, 215, 125, 228, 227, 129},
-		'\u5766': []uint16{125, 230, 200, 227, 100, 330, 152, 417, 327, 325, 130, 331, 229, 232, 329, 332, 129, 101, 115, 162, 362, 132, 315, 320, 117, 262, 131},
-		'\u5768': []uint16{452, 435, 125, 325, 230},
-		'\u5769': []uint16{125},
-		'\u576a': []uint16{452, 230, 225, 227, 330, 130, 327, 426, 229, 232, 129, 125},
-		'\u576b': []uint16{413},
-		'\u576d': []uint16{413},
-		'\u576f': []uint16{225, 425, 125, 325, 252, 232},
-		'\u5773': []uint16{413, 225},
-		'\u5776': []uint16{459},
-		'\u5777': []uint16{200, 325, 320, 362, 262, 125},
-		'\u577b': []uint16{230, 330, 413},
-		'\u577c': []uint16{413},
-		'\u5782': []uint16{152, 452, 125, 252, 115, 227, 325, 225, 327, 315, 162, 320, 104},
-		'\u5783': []uint16{125, 225},
-		'\u5784': []uint16{152, 426, 125, 120, 325, 225, 227, 327},
-		'\u5786': []uint16{413, 327},
-		'\u578b': []uint16{225, 419, 204, 256, 125, 252, 325, 232, 238, 352, 227, 320, 330, 217, 327, 332, 356, 331},
-		'\u578c': []uint16{413, 227},
-		'\u5792': []uint16{225, 452, 252, 227, 125, 215, 152, 327, 325, 204, 232, 330, 331, 332, 315},
-		'\u5793': []uint16{413, 327},
-		'\u579b': []uint16{225, 452, 125, 325, 435, 227, 252},
-		'\u57a0': []uint16{200, 220, 227, 426, 215, 327},
-		'\u57a1': []uint16{413, 130},
-		'\u57a2': []uint16{426, 225, 315, 215, 252, 227},
-		'\u57a3': []uint16{225, 426, 227, 230, 330, 229, 130, 215, 327, 320, 220, 315, 232},
-		'\u57a4': []uint16{413, 327},
-		'\u57a6': []uint16{252, 452, 156, 225, 152, 125, 256, 325, 130, 129, 227, 331, 232, 330},
-		'\u57a7': []uint16{435, 222, 422},
-		'\u57a9': []uint16{325, 225, 413, 343},
-		'\u57ab': []uint16{452, 225, 125, 152, 325, 129, 252, 130, 232, 132},
-		'\u57ad': []uint16{413, 125, 327, 227},
-		'\u57ae': []uint16{452, 252, 152, 362, 262, 200},
-		'\u57b4': []uint16{413},
-		'\u57b8': []uint16{426, 225},
-		'\u57c2': []uint16{426, 225, 327, 230, 227, 125, 330},
-		'\u57c3': []uint16{130, 127, 230, 417, 225, 129, 327, 227, 330, 315, 331, 132, 435, 231, 329, 131, 125, 229, 332, 325, 117, 122},
-		'\u57cb': []uint16{152, 452, 252, 256, 315, 325, 115, 156, 100, 352, 125},
-		'\u57ce': []uint16{125, 425, 230, 225, 142, 130, 227, 252, 330, 152, 117, 325, 317, 229, 104, 327, 112, 132, 238, 329, 242, 315, 215, 331, 232, 332, 131, 120, 220, 256, 228, 115, 129, 217, 320, 328},
-		'\u57cf': []uint16{227, 413},
-		'\u57d2': []uint16{413, 227},
-		'\u57d4': []uint16{330, 230, 331, 413, 327, 227, 130, 325, 332},
-		'\u57d5': []uint16{413, 227},
-		'\u57d8': []uint16{425},
-		'\u57d9': []uint16{426, 225},
-		'\u57da': []uint16{225, 413, 227},
-		'\u57dd': []uint16{413},
-		'\u57df': []uint16{225, 230, 425, 227, 325, 220, 125, 327, 356, 142, 426, 229, 352},
-		'\u57e0': []uint16{426, 225, 230, 327, 330, 125, 238},
-		'\u57e4': []uint16{459},
-		'\u57ed': []uint16{413},
-		'\u57ef': []uint16{413},
-		'\u57f4': []uint16{413},
-		'\u57f8': []uint16{413},
-		'\u57f9': []uint16{152, 156, 256, 227, 125, 327, 452, 330, 320, 235, 252, 332, 220, 325, 132, 230, 129, 127},
-		'\u57fa': []uint16{125, 100, 426, 225, 132, 107, 227, 134, 325, 330, 327, 332, 331, 127, 229, 130, 120, 252, 230, 152, 129, 320, 232, 131, 143, 329, 117, 217, 317, 352, 315, 228, 256, 417},
-		'\u57fd': []uint16{413},
-		'\u5802': []uint16{225, 435, 227, 125, 230, 262, 315, 162, 252, 222, 115, 232, 327, 217, 320, 220, 325, 215, 231, 100, 200, 362, 330, 426, 207, 107, 242, 331, 129, 422, 229, 332, 228},
-		'\u5806': []uint16{452, 152, 225, 222, 125, 252, 435, 156, 220, 115, 227, 425, 230, 352, 330, 130, 232},
-		'\u5807': []uint16{225, 125},
-		'\u580b': []uint16{413, 227},
-		'\u5811': []uint16{225, 125, 220, 315, 325},
-		'\u5815': []uint16{152, 452, 315, 227},
-		'\u5819': []uint16{413},
-		'\u581e': []uint16{413, 225},
-		'\u5820': []uint16{413},
-		'\u5821': []uint16{230, 426, 227, 225, 125, 229, 331, 327, 330, 232, 332, 325, 132},
-		'\u5824': []uint16{225, 425, 125, 252, 142, 227, 327, 230, 232, 215, 229, 320, 130},
-		'\u582a': []uint16{252, 452, 152, 315, 227, 130, 220, 330, 215, 320, 200, 125, 327, 454, 230, 332, 120},
-		'\u5830': []uint16{426, 230, 227, 130, 225, 330, 132, 327, 232, 125},
-		'\u5835': []uint16{152, 452, 252, 222, 127, 256, 215, 217, 325, 156, 125, 330, 320, 327},
-		'\u5844': []uint16{413, 227},
-		'\u584c': []uint16{252, 452, 152, 315, 256, 362, 262, 100, 125, 156, 200, 132, 325},
-		'\u584d': []uint16{413, 227, 225, 230},
-		'\u5851': []uint16{125, 225, 152, 426, 325, 332, 252, 227, 320, 232, 132, 215, 256, 315, 327, 331, 117, 129, 217, 454},
-		'\u5854': []uint16{417, 225, 130, 327, 227, 127, 330, 129, 229, 125, 132, 230, 232, 332, 329, 325, 104, 304, 131, 331, 215, 231, 320},
-		'\u5858': []uint16{225, 417, 230, 330, 130, 327, 125, 227, 426, 229, 142, 332, 129},
-		'\u585e': []uint16{452, 252, 330, 225, 130, 327, 127, 227, 129, 142, 325, 125, 152, 256, 230, 229, 329, 332, 315, 200, 132, 331, 120, 117, 115, 131, 352, 217, 215, 210, 232},
-		'\u5865': []uint16{413},
-		'\u586b': []uint16{152, 452, 252, 125, 315, 227, 132, 104, 327, 325, 156, 215, 115, 120},
-		'\u586c': []uint16{426, 227, 230, 327},
-		'\u587e': []uint16{225, 125},
-		'\u5880': []uint16{227, 225, 413},
-		'\u5881': []uint16{452},
-		'\u5883': []uint16{225, 142, 426, 125, 242, 256, 252, 220, 325, 227, 217, 215, 331, 315, 327, 352, 343, 112, 232, 117},
-		'\u5885': []uint16{225, 459, 325, 330, 227, 304},
-		'\u5889': []uint16{227, 327, 413, 228},
-		'\u5892': []uint16{426, 252, 125, 227},
-		'\u5893': []uint16{425, 125, 225, 252, 325, 217, 256, 215, 230},
-		'\u5899': []uint16{225, 425, 125, 142, 252, 315, 227, 215, 325, 232, 115, 320, 220, 104, 132, 217, 230, 130},
-		'\u589a': []uint16{413},
-		'\u589e': []uint16{152, 125, 452, 252, 327, 227, 156, 315, 215, 325, 132, 130, 320, 230, 104, 317, 229, 120, 220, 256, 304},
-		'\u589f': []uint16{225, 426, 327, 130},
-		'\u58a8': []uint16{125, 130, 225, 425, 127, 252, 327, 227, 325, 129, 230, 320, 315, 330, 104, 162, 115, 215, 204, 131},
-		'\u58a9': []uint16{452, 225, 125, 227, 362, 435, 327, 262, 230, 426, 229, 325},
-		'\u58bc': []uint16{459},
-		'\u58c1': []uint16{225, 125, 426, 242, 227, 142, 230, 215, 315, 435, 325, 252, 327, 222, 330, 232, 152, 304, 120, 104, 220, 320, 130, 132, 332, 115},
-		'\u58c5': []uint16{413, 152},
-		'\u58d1': []uint16{426, 225, 227, 325, 215, 327, 315, 252, 220},
-		'\u58d5': []uint16{225, 125, 230, 332},
-		'\u58e4': []uint16{225, 252, 230, 125, 325, 315, 215, 330, 332, 130},
-		'\u58eb': []uint16{225, 125, 327, 426, 230, 227, 330, 127, 332, 325, 329, 222, 215, 331, 232, 252, 315, 220, 228, 120, 132, 229, 104, 129, 320, 130, 335, 115, 328},
-		'\u58ec': []uint16{122, 125, 130},
-		'\u58ee': []uint16{452, 200, 100, 152, 125, 330, 215, 327, 132, 227, 252, 315, 320, 325, 143, 101, 115, 120, 204, 220, 217, 201, 225, 352},
-		'\u58f0': []uint16{125, 225, 222, 435, 207, 252, 315, 201, 152, 227, 320, 325, 215, 115, 204, 220, 327, 322, 120, 330, 232, 135, 235, 117, 228, 256, 331, 362, 262, 332, 156, 229, 422, 132},
-		'\u58f3': []uint16{225, 425, 325, 125, 320, 132, 227, 232, 252, 215, 426, 131, 217, 331},
-		'\u58f6': []uint16{225, 435, 426, 130, 227, 125, 252, 315, 330},
-		'\u58f9': []uint16{422, 122, 227, 222, 327, 322},
-		'\u5904': []uint16{152, 425, 225, 242, 207, 222, 252, 125, 238, 156, 107, 422, 220, 215, 320, 325, 327, 352, 315, 231, 232, 104, 356, 115, 120, 256, 322, 204, 317, 227, 362, 262, 304, 143, 135, 230, 235, 332, 331, 117},
-		'\u5907': []uint16{252, 225, 256, 452, 325, 152, 200, 227, 125, 204, 156, 228, 331, 327, 220, 215, 315, 320, 162, 132, 232, 332, 107, 104, 115, 343},
-		'\u590d': []uint16{152, 252, 100, 207, 407, 125, 406, 327, 156, 320, 227, 315, 256, 404, 325, 409, 104, 427, 131, 132, 253, 215, 225, 331, 120, 220, 200, 229, 143, 343, 304, 130, 332, 117, 352, 228, 454},
-		'\u590f': []uint16{143, 127, 444, 225, 230, 227, 125, 243, 130, 330, 327, 331, 132, 332, 320, 228, 325, 252, 117, 329, 229, 256, 152, 232, 129, 131, 128, 120},
-		'\u5914': []uint16{427, 227, 130, 125},
-		'\u5915': []uint16{212, 125, 444, 243, 215, 225, 315, 330, 227, 331, 120, 343, 327, 220, 325, 130, 132, 115},
-		'\u5916': []uint16{125, 412, 206, 242, 212, 112, 256, 200, 252, 152, 207, 320, 325, 225, 104, 217, 220, 204, 331, 215, 131, 142, 315, 130, 227, 156, 117, 230, 317, 352, 132, 245, 327, 120, 332, 243, 100, 232, 115, 129, 229, 307, 362},
-		'\u5919': []uint16{125, 115},
-		'\u591a': []uint16{422, 122, 222, 322, 138, 107, 125, 207, 220, 252, 152, 104, 129, 100, 330, 315, 130, 200, 320, 120, 327, 156, 230, 329, 127, 132, 307, 229, 162, 225, 343, 115, 227, 325, 215, 332, 124, 238, 228, 232, 262, 331, 362, 217, 204, 117, 103},
-		'\u591c': []uint16{143, 243, 444, 225, 222, 125, 207, 252, 204, 152, 315, 215, 320, 220, 120, 115, 325, 227, 343, 127, 422, 104, 232, 327, 130, 129, 117, 156, 132},
-		'\u591f': []uint16{252, 452, 200, 152, 100, 120, 327, 320, 227},
-		'\u5924': []uint16{413, 143, 227, 152},
-		'\u5925': []uint16{413},
-		'\u5927': []uint16{125, 400, 200, 107, 122, 252, 138, 217, 130, 104, 331, 327, 330, 152, 132, 332, 204, 325, 115, 207, 315, 230, 127, 322, 320, 120, 101, 232, 225, 162, 117, 362, 156, 129, 227, 100, 131, 215, 143, 352, 231, 343, 304, 220, 256, 228, 142, 135, 317, 328, 329, 229, 112, 316, 262, 300, 356, 128, 307},
-		'\u5929': []uint16{125, 243, 222, 435, 238, 130, 225, 327, 422, 132, 104, 315, 325, 127, 135, 235, 115, 252, 142, 152, 227, 207, 320, 120, 215, 232, 129, 262, 332, 143, 230, 100, 304, 131, 330, 331, 117, 220, 352, 322, 307, 328, 107, 110, 112, 228, 128, 231, 256, 122},
-		'\u592a': []uint16{125, 407, 130, 327, 225, 325, 127, 227, 117, 129, 132, 100, 232, 332, 330, 142, 317, 331, 120, 329, 315, 352, 230, 228, 131, 115, 107, 217, 162, 362, 320, 152},
-		'\u592b': []uint16{225, 125, 227, 327, 425, 229, 230, 332, 325, 329, 130, 252, 426, 315, 330, 232, 129, 320, 331, 215, 220, 115, 228, 120},
-		'\u592d': []uint16{152, 454, 426, 215, 315, 227, 327, 100},
-		'\u592e': []uint16{225, 117, 331, 454, 231, 325, 330, 152, 227, 327, 131, 229, 317, 304, 220, 230},
-		'\u592f': []uint16{425, 152, 227, 125, 452, 256, 325},
-		'\u5931': []uint16{152, 252, 225, 452, 125, 156, 100, 315, 256, 215, 320, 352, 115, 325, 200, 220, 262, 362, 327, 229, 120, 132},
-		'\u5934': []uint16{225, 425, 252, 125, 222, 212, 242, 142, 325, 315, 320, 230, 100, 207, 204, 152, 115, 220, 227, 330, 104, 215, 307, 200, 256, 232, 112, 120, 327, 132, 143, 422, 352, 331, 322, 332, 107, 362, 229, 130, 343, 300},
-		'\u5937': []uint16{426, 227, 330, 230, 225, 252, 327, 315, 232, 130, 215, 152, 120, 125, 331, 402},
-		'\u5938': []uint16{152, 129, 452, 125, 252, 115, 315, 227, 327, 130, 325, 132, 220, 135, 156, 120},
-		'\u5939': []uint16{452, 152, 125, 225, 104, 320, 315, 130, 120, 325, 227, 156, 115, 132, 204, 232},
-		'\u593a': []uint16{252, 152, 452, 325, 315, 115, 215, 227, 320, 327, 256, 220, 307, 304, 156, 117, 130},
-		'\u593c': []uint16{413},
-		'\u5941': []uint16{413, 225},
-		'\u5942': []uint16{413, 227, 215, 327},
-		'\u5944': []uint16{315, 115, 215, 262, 162, 120, 320},
-		'\u5947': []uint16{152, 227, 200, 125, 402, 100, 225, 327, 229, 132, 115, 215, 104, 315, 325, 400, 427, 230, 232, 130, 332, 320, 220, 329, 129, 252, 304, 330, 162, 256, 156, 228, 401},
-		'\u5948': []uint16{207, 454, 315, 152, 327, 227, 307, 225, 331, 206, 200, 130, 215, 330, 129, 320, 230, 132, 125, 220, 252, 229},
-		'\u5949': []uint16{452, 152, 252, 130, 227, 327, 125, 315, 129, 320, 156, 225, 115, 325, 120},
-		'\u594b': []uint16{252, 152, 107, 200, 315, 325, 454, 115, 120, 220, 228, 320, 201, 227, 225, 327, 215, 132, 332, 125, 156, 256},
-		'\u594e': []uint16{227, 327, 427, 125, 129, 130, 329, 328, 230},
-		'\u594f': []uint16{452, 225, 252, 125, 325, 152, 227, 256, 204, 327, 215},
-		'\u5951': []uint16{125, 130, 426, 200, 227, 327, 152, 225, 127, 329, 454, 325, 252, 229, 156, 104, 132, 129, 201, 330, 230},
-		'\u5954': []uint16{452, 152, 252, 315, 227, 330, 162, 125, 320, 115, 229, 327, 132, 156, 131, 129, 232},
-		'\u5955': []uint16{215, 315, 162, 262},
-		'\u5956': []uint16{425, 225, 125, 232, 252, 156, 256, 152, 325, 227, 238, 332, 320, 120, 327, 217},
-		'\u5957': []uint16{222, 435, 125, 225, 200, 152, 422, 204, 232, 252, 325, 238, 230, 120, 220, 156, 227, 215, 132, 327, 315, 115, 104, 352, 322},
-		'\u5958': []uint16{227, 327, 413},
-		'\u595a': []uint16{427, 127, 152, 227, 327},
-		'\u5960': []uint16{152, 125, 454, 252, 327, 227, 256},
-		'\u5962': []uint16{100, 125, 402, 152, 315, 227, 156, 200, 120},
-		'\u5965': []uint16{417, 127, 130, 132, 125, 117, 129, 327, 227, 229, 331, 200, 329, 330, 332, 232, 131, 317, 100, 230, 325, 217, 225, 115},
-		'\u5973': []uint16{125, 225, 404, 325, 132, 227, 315, 220, 320, 215, 327, 127, 117, 331, 120, 104, 317, 152, 115, 330, 130, 343, 352, 204, 228, 232, 230, 332},
-		'\u5974': []uint16{125, 225, 426, 325, 120, 152, 227, 229, 327, 156, 330, 115, 129},
-		'\u5976': []uint16{125, 225, 425, 325, 252, 327, 227, 232, 320, 120, 220, 256, 130},
-		'\u5978': []uint16{125, 225, 400, 252, 152, 100, 215, 315, 325, 426, 320},
-		'\u5979': []uint16{438, 138},
-		'\u597d': []uint16{400, 200, 207, 252, 152, 125, 107, 100, 122, 262, 225, 260, 220, 120, 300, 315, 162, 325, 320, 115, 130, 227, 362, 132, 322, 215, 327, 110, 217, 331, 332, 256, 228, 124, 352},
-		'\u5981': []uint16{225},
-		'\u5982': []uint16{452, 106, 138, 252, 143, 152, 320, 315, 115, 206, 327, 125, 120, 107, 227, 306, 200, 238, 130, 162, 127, 300, 343, 215, 225, 220, 228, 352, 325, 100, 230, 132},
-		'\u5983': []uint16{225, 227, 125, 328, 325, 327, 228, 232},
-		'\u5984': []uint16{152, 407, 315, 200, 125, 204, 225, 115, 156, 320},
-		'\u5986': []uint16{252, 325, 426, 225, 315, 125, 454, 152, 327, 227, 256, 204, 320, 332},
-		'\u5987': []uint16{225, 125, 426, 417, 227, 325, 117, 120, 332, 115, 331, 215, 217, 315, 327, 320, 343},
-		'\u5988': []uint16{225, 425, 125, 227, 320, 325, 327, 130, 215, 315, 120, 129},
-		'\u598a': []uint16{152, 143, 156, 117},
-		'\u598d': []uint16{227, 427, 252, 327, 100, 200, 125},
-		'\u5992': []uint16{252, 152, 125, 315, 115, 325, 156},
-		'\u5993': []uint16{125, 225, 252},
-		'\u5996': []uint16{125, 100, 225, 132, 115, 325, 152, 315, 362, 162, 129},
-		'\u5997': []uint16{413, 327, 227},
-		'\u5999': []uint16{200, 400, 215, 125, 127, 315, 225, 227, 327, 115, 130, 204, 220, 100, 320, 103, 129, 325, 203, 120},
-		'\u599e': []uint16{125, 426, 227, 225, 325, 252, 327},
-		'\u59a3': []uint16{215, 413},
-		'\u59a4': []uint16{227, 225, 459, 327},
-		'\u59a5': []uint16{400, 200, 152, 100, 101, 252, 227, 232, 362, 125, 332, 162, 325, 225, 262, 130},
-		'\u59a8': []uint16{252, 152, 207, 238, 132},
-		'\u59a9': []uint16{100, 115, 327},
-		'\u59aa': []uint16{225, 413},
-		'\u59ab': []uint16{413, 127, 227},
-		'\u59ae': []uint16{225, 229, 125, 129, 227, 325, 327, 329, 232},
-		'\u59af': []uint16{125},
-		'\u59b2': []uint16{413, 227, 327},
-		'\u59b9': []uint16{225, 125, 425, 227, 327, 325},
-		'\u59bb': []uint16{125, 225, 426, 325, 227, 238, 252, 315, 115, 120, 215, 320, 220, 132, 229},
-		'\u59be': []uint16{425, 225, 252, 215},
-		'\u59c6': []uint16{227, 327, 459, 225, 229, 330, 230, 332, 325, 329, 129, 320, 232, 125, 331, 235, 127},
-		'\u59ca': []uint16{125, 425, 327, 325, 227},
-		'\u59cb': []uint16{252, 454, 107, 200, 152, 325, 125, 327, 320, 115, 315, 330, 232, 215, 256, 225, 207, 352, 230, 129, 227, 130, 329, 204, 220},
-		'\u59d0': []uint16{225, 125, 227, 425, 327, 325, 215, 230},
-		'\u59d1': []uint16{125, 225, 425, 325, 227, 327, 152, 107, 130, 330, 104, 115, 230, 409, 120, 320, 315},
-		'\u59d2': []uint16{413, 227},
-		'\u59d3': []uint16{225, 452, 125, 252, 204, 215, 228, 327, 230, 227, 325, 315, 220, 256},
-		'\u59d4': []uint16{125, 225, 331, 152, 417, 325, 217, 231, 317, 227, 130, 100, 156, 320, 117, 107, 115, 454, 327, 315, 101, 252, 120, 426, 232, 131, 304, 332, 228},
-		'\u59d7': []uint16{115, 315, 427, 227, 327},
-		'\u59d8': []uint16{125, 152},
-		'\u59da': []uint16{127, 227, 230, 422, 427, 122, 128, 325, 327, 330, 130, 222},
-		'\u59dc': []uint16{127, 425, 225, 327, 227, 125, 130, 128, 115},
-		'\u59dd': []uint16{413, 227, 327},
-		'\u59e3': []uint16{162, 125},
-		'\u59e5': []uint16{327, 227, 125, 225, 228, 328, 330, 130},
-		'\u59e8': []uint16{125, 327, 425, 225, 227, 325, 220},
-		'\u59ec': []uint16{427, 127, 227, 225, 327, 220, 228, 129, 128, 229},
-		'\u59f9': []uint16{115},
-		'\u59fb': []uint16{225, 125, 252, 325, 315, 327, 332},
-		'\u59ff': []uint16{125, 225, 426, 315, 200, 215, 320, 227, 220, 327, 325, 232},
-		'\u5a01': []uint16{156, 125, 225, 227, 230, 130, 426, 129, 427, 100, 330, 327, 252, 325, 152, 132, 232, 115, 215, 320, 127, 331, 315, 256, 131, 220, 329, 332, 120, 229, 228},
-		'\u5a03': []uint16{426, 225, 125, 227, 132, 325, 327, 229, 131, 232, 331},
-		'\u5a04': []uint16{127, 417, 130, 427, 330, 125, 225, 227, 352},
-		'\u5a05': []uint16{227, 459, 229, 327},
-		'\u5a06': []uint16{200, 459, 215},
-		'\u5a07': []uint16{100, 452, 227, 252, 125, 400, 152, 327, 162, 115, 315, 225, 215, 325, 362, 120, 200},
-		'\u5a08': []uint16{125, 327, 413},
-		'\u5a09': []uint16{162, 362},
-		'\u5a0c': []uint16{459, 225, 327, 227},
-		'\u5a11': []uint16{262, 125, 130, 120, 320},
-		'\u5a13': []uint16{120, 320, 115, 315, 107, 207, 227, 127, 327},
-		'\u5a18': []uint16{225, 425, 125, 227, 327, 325, 252, 220, 320, 330, 329},
-		'\u5a1c': []uint16{227, 459, 229, 327, 129, 232, 262, 200, 315, 362, 330, 230},
-		'\u5a1f': []uint16{100, 228, 225, 227, 229, 328},
-		'\u5a20': []uint16{252, 343, 256, 413},
-		'\u5a23': []uint16{413, 227},
-		'\u5a25': []uint16{225, 330, 320, 125, 227},
-		'\u5a29': []uint16{252, 454, 325},
-		'\u5a31': []uint16{156, 125, 454, 252, 225, 200, 227, 325, 327},
-		'\u5a32': []uint16{225, 459, 320, 227, 327},
-		'\u5a34': []uint16{100, 327},
-		'\u5a36': []uint16{452, 252, 152, 156, 225, 215, 220},
-		'\u5a3c': []uint16{125, 252, 225, 215, 256, 220, 325},
-		'\u5a40': []uint16{100, 115},
-		'\u5a46': []uint16{225, 125, 227, 426, 327, 325, 315, 129, 162, 115, 130, 228, 132, 332, 252, 230, 120, 220, 215},
-		'\u5a49': []uint16{200, 100, 327, 409, 402, 107, 152, 262, 120, 201, 227, 162, 125},
-		'\u5a4a': []uint16{125},
-		'\u5a55': []uint16{227, 459, 327, 125},
-		'\u5a5a': []uint16{252, 125, 426, 325, 225, 143, 117, 156, 256, 120, 315, 220, 152, 215, 104, 332, 132, 454},
-		'\u5a62': []uint16{225, 125, 315},
-		'\u5a67': []uint16{227, 413, 327},
-		'\u5a6a': []uint16{200, 459, 227, 315},
-		'\u5a74': []uint16{125, 426, 225, 227, 325, 252, 327, 229, 332, 117, 132},
-		'\u5a75': []uint16{125, 225, 327},
-		'\u5a76': []uint16{125, 225, 325, 327},
-		'\u5a77': []uint16{227, 327, 459, 232, 215, 315, 262, 362, 229},
-		'\u5a7a': []uint16{130, 125},
-		'\u5a7f': []uint16{225, 426, 252, 215, 227},
-		'\u5a92': []uint16{125, 225, 426, 325, 252, 220, 315, 215, 331, 152, 227},
-		'\u5a9a': []uint16{200, 454, 327, 252, 125, 227, 203, 315, 215, 100, 152},
-		'\u5a9b': []uint16{459, 227, 327, 229},
-		'\u5aaa': []uint16{413, 227, 327},
-		'\u5ab2': []uint16{152},
-		'\u5ab3': []uint16{125, 225, 325, 426, 327, 227, 320},
-		'\u5ab5': []uint16{413},
-		'\u5ab8': []uint16{413, 327, 225},
-		'\u5abe': []uint16{152, 252},
-		'\u5ac1': []uint16{452, 252, 152, 225, 125, 115, 215, 320, 325},
-		'\u5ac2': []uint16{125, 225, 426, 227, 325, 327},
-		'\u5ac9': []uint16{152, 454, 115, 252, 315, 256},
-		'\u5acc': []uint16{452, 125, 252, 152, 225, 200, 220, 227, 115, 327},
-		'\u5ad2': []uint16{459, 225, 227},
-		'\u5ad4': []uint16{227, 413, 225, 125, 327},
-		'\u5ad6': []uint16{452, 152, 125, 320, 156},
-		'\u5ad8': []uint16{413, 125},
-		'\u5adc': []uint16{413},
-		'\u5ae0': []uint16{413},
-		'\u5ae1': []uint16{125, 104, 152},
-		'\u5ae3': []uint16{228, 115, 104, 315, 162, 327, 120},
-		'\u5ae6': []uint16{125, 120, 327},
-		'\u5ae9': []uint16{400, 200, 125, 230, 130, 330, 104, 227, 327, 262, 162, 331, 362, 325, 217, 220},
-		'\u5aeb': []uint16{413},
-		'\u5af1': []uint16{413, 227, 327},
-		'\u5b09': []uint16{152, 454, 115, 120, 227, 327, 215, 125, 156},
-		'\u5b16': []uint16{413},
-		'\u5b17': []uint16{152, 252, 156},
-		'\u5b32': []uint16{413},
-		'\u5b34': []uint16{413, 127, 327, 227},
-		'\u5b37': []uint16{125, 225, 227, 327},
-		'\u5b40': []uint16{225, 152, 125},
-		'\u5b50': []uint16{225, 426, 227, 125, 325, 327, 222, 127, 220, 252, 320, 232, 230, 238, 315, 330, 215, 235, 322, 332, 352, 130, 243, 331, 229, 231, 143, 120, 207, 304, 115, 129, 356, 328, 317, 200, 329, 132, 128, 228},
-		'\u5b51': []uint16{125, 115},
-		'\u5b53': []uint16{225},
-		'\u5b54': []uint16{127, 225, 425, 125, 252, 227, 427, 327, 128, 204, 325, 130, 315, 215, 120, 256, 220, 229, 129, 232, 330, 104, 115, 329, 332},
-		'\u5b55': []uint16{252, 125, 152, 417, 143, 325, 256, 426, 225, 132, 343, 117, 454, 227, 327, 332},
-		'\u5b57': []uint16{425, 225, 125, 325, 252, 232, 320, 220, 315, 356, 256, 115, 215, 327, 352, 227, 331, 332, 304, 330, 204, 207, 132, 128, 130},
-		'\u5b58': []uint16{152, 252, 125, 452, 225, 156, 325, 327, 256, 227, 320, 315, 220, 215, 200, 117, 107, 343, 332, 304, 228, 120, 132, 128, 115},
-		'\u5b59': []uint16{127, 427, 227, 225, 125, 327, 128, 325, 330, 132, 230, 215, 315, 130, 320, 220, 228, 329},
-		'\u5b5a': []uint16{227, 454, 232, 129, 327, 331, 332, 320, 130, 330},
-		'\u5b5b': []uint16{413, 227},
-		'\u5b5c': []uint16{332, 330, 230, 232, 362, 262, 120, 320, 115, 315, 229, 125, 329, 343},
-		'\u5b5d': []uint16{426, 327, 227, 125, 152, 130, 252, 200, 329, 225, 129, 132, 215, 115, 320, 328, 325, 315, 127, 220, 156, 330},
-		'\u5b5f': []uint16{127, 417, 130, 327, 125, 227, 129, 132, 100, 325, 330, 329, 230, 143, 128, 331, 228, 115, 131, 229},
-		'\u5b62': []uint16{125, 225, 232, 332, 325},
-		'\u5b63': []uint16{125, 324, 243, 222, 225, 426, 127, 120, 327, 227, 204, 325, 220, 435, 422, 238, 320, 217, 252, 304, 132, 129, 427, 330, 329, 128, 229, 115, 156, 230, 328, 143},
-		'\u5b64': []uint16{400, 125, 100, 152, 426, 115, 127, 162, 120, 315, 227, 252, 327, 130, 362, 104, 225, 330, 220, 320, 101, 117},
-		'\u5b65': []uint16{413, 227},
-		'\u5b66': []uint16{225, 125, 325, 452, 231, 152, 331, 252, 327, 220, 320, 332, 227, 256, 217, 200, 315, 229, 232, 120, 317, 352, 115, 215, 304, 104, 117, 330, 132, 130, 128, 129, 156, 228, 230, 328, 143},
-		'\u5b69': []uint16{125, 225, 325, 332, 426, 327, 227, 352},
-		'\u5b6a': []uint16{104, 125},
-		'\u5b6c': []uint16{125},
-		'\u5b70': []uint16{438, 120, 320, 152},
-		'\u5b71': []uint16{100},
-		'\u5b73': []uint16{152},
-		'\u5b75': []uint16{152, 452, 143, 156, 125, 132},
-		'\u5b7a': []uint16{225, 125, 315, 228, 129, 327, 115},
-		'\u5b7d': []uint16{402, 225, 252, 125, 315, 132, 115},
-		'\u5b81': []uint16{227, 230, 130, 330, 417, 127, 327, 107, 200, 100, 229, 232, 325, 128, 331, 125, 152, 217, 228, 225, 215, 115, 206, 332, 315, 132, 129, 329, 407, 317, 131, 106, 320, 252, 120},
-		'\u5b83': []uint16{438, 138, 238, 225, 315},
-		'\u5b84': []uint16{459, 225},
-		'\u5b85': []uint16{225, 426, 125, 325, 227, 327, 315, 204, 229, 132, 130},
-		'\u5b87': []uint16{125, 227, 225, 127, 327, 120, 427, 132, 232, 315, 331, 426, 332, 330, 142, 215, 129, 231, 328, 229},
-		'\u5b88': []uint16{252, 452, 152, 225, 200, 125, 327, 256, 325, 227, 315, 100, 215, 115, 320, 120, 220, 156, 129, 330, 328, 127, 356},
-		'\u5b89': []uint16{152, 452, 103, 230, 130, 127, 225, 227, 200, 100, 330, 232, 325, 125, 327, 252, 331, 132, 229, 215, 129, 332, 115, 315, 117, 162, 220, 362, 320, 120, 131, 143, 262, 135, 128, 156, 317, 222, 235, 231, 329, 101, 228, 217, 256, 328, 224},
-		'\u5b8b': []uint16{127, 427, 243, 143, 227, 327, 125, 435, 128, 444, 130, 204, 129, 131, 325, 330, 120},
-		'\u5b8c': []uint16{152, 101, 452, 100, 252, 125, 320, 127, 115, 327, 129, 362, 162, 315, 130, 120, 227, 132, 156},
-		'\u5b8f': []uint16{125, 327, 100, 427, 227, 132, 417, 315, 200, 330, 232, 230, 332, 262, 220, 130, 120, 320, 328, 432, 331, 129, 228, 152},
-		'\u5b93': []uint16{427, 127, 227, 327},
-		'\u5b95': []uint16{413, 252, 320, 220, 129, 327},
-		'\u5b97': []uint16{125, 327, 227, 222, 435, 127, 225, 229, 232, 204, 328, 422, 315, 252, 215, 130, 132, 330, 322, 129, 131, 325, 331, 228},
-		'\u5b98': []uint16{125, 225, 425, 252, 127, 227, 327, 130, 220, 315, 325, 330, 152, 132, 120, 352, 230, 320, 115, 304, 129, 229, 331, 332, 156, 317},
-		'\u5b99': []uint16{225, 325, 320, 129, 227, 413, 327, 332},
-		'\u5b9a': []uint16{252, 207, 225, 200, 452, 325, 125, 152, 156, 107, 204, 256, 220, 320, 230, 327, 130, 227, 215, 330, 315, 104, 120, 203, 232, 129, 307, 304, 352, 332, 262, 331, 217, 328, 132},
-		'\u5b9b': []uint16{227, 127, 152, 230, 427, 130, 232, 407, 327, 153, 129, 332, 325, 330, 100},
-		'\u5b9c': []uint16{454, 252, 200, 225, 130, 215, 327, 100, 152, 227, 127, 330, 320, 125, 315, 132, 325, 129, 220, 229, 230, 352, 228, 232},
-		'\u5b9d': []uint16{127, 125, 425, 225, 227, 327, 132, 232, 130, 100, 325, 129, 229, 215, 315, 131, 330, 204, 252, 332, 220, 452, 230, 328, 304, 331, 115, 228, 317, 120},
-		'\u5b9e': []uint16{152, 125, 107, 207, 225, 200, 252, 201, 400, 156, 320, 325, 362, 227, 215, 262, 100, 315, 162, 115, 120, 327, 332, 220, 104, 301, 232, 331, 132, 142, 204, 143, 307, 228, 256, 101, 131},
-		'\u5ba0': []uint16{452, 152, 125, 252, 200, 256, 315, 227, 327, 215, 225, 115, 120},
-		'\u5ba1': []uint16{152, 156, 252, 452, 225, 256, 100, 125, 317, 325, 327, 115, 330, 204, 217, 331, 227, 132, 230, 117},
-		'\u5ba2': []uint16{125, 225, 426, 227, 252, 100, 325, 217, 152, 215, 327, 117, 229, 320, 162, 362, 200, 256, 220, 315, 331, 317, 232, 120, 332, 132, 330},
-		'\u5ba3': []uint16{152, 156, 125, 127, 327, 427, 227, 130, 143, 331, 215, 317, 320, 117, 332, 315, 217, 329},
-		'\u5ba4': []uint16{225, 425, 142, 125, 227, 232, 231, 327, 252, 325, 215, 315, 229, 217, 224, 331},
-		'\u5ba5': []uint16{413, 252, 225, 227},
-		'\u5ba6': []uint16{125, 427, 225, 127, 227, 327, 215, 152},
-		'\u5baa': []uint16{125, 227, 327, 217, 325, 427, 256, 225, 332, 417, 129, 252, 131, 426, 320, 215, 328, 130, 329},
-		'\u5bab': []uint16{125, 225, 427, 127, 142, 227, 426, 230, 252, 112, 327, 330, 129, 325, 352, 130, 229, 232, 120, 220, 329, 104, 315, 331},
-		'\u5bb0': []uint16{125, 452, 252, 127, 227, 152, 229, 325, 327, 315, 143, 330, 132},
-		'\u5bb3': []uint16{252, 152, 225, 200, 452, 325, 125, 220, 100, 256, 215, 315, 204, 332, 227, 115, 352, 217, 320, 356, 132},
-		'\u5bb4': []uint16{225, 125, 426, 252, 152, 427, 227, 327, 230, 320, 229},
-		'\u5bb5': []uint16{225, 444, 343, 227, 125, 243, 315, 152, 115, 327},
-		'\u5bb6': []uint16{225, 435, 125, 238, 222, 422, 142, 252, 327, 220, 325, 331, 117, 330, 230, 315, 228, 320, 227, 332, 207, 120, 304, 232, 115, 132, 152, 127, 215, 135, 235, 204, 100, 256, 317, 338, 104, 310, 352, 200, 129, 130, 322, 328, 229, 329},
-		'\u5bb8': []uint16{227, 413, 327, 332},
-		'\u5bb9': []uint16{225, 100, 252, 125, 452, 320, 152, 325, 227, 315, 127, 200, 256, 327, 307, 215, 262, 115, 220, 230, 130, 129, 330, 232, 362, 156, 229, 132, 317, 104, 352, 332},
-		'\u5bbd': []uint16{400, 100, 125, 252, 152, 227, 225, 327, 156, 115, 217, 162, 130, 362, 120, 215, 256, 104, 315, 320, 220, 132, 331},
-		'\u5bbe': []uint16{230, 125, 225, 425, 227, 327, 325, 127, 130, 330, 229, 252, 215, 331, 329, 131, 315, 256, 332, 129, 320, 115, 427, 220, 132, 120},
-		'\u5bbf': []uint16{125, 252, 452, 127, 225, 130, 435, 152, 227, 220, 325, 327, 215, 330, 230, 256, 315},
-		'\u5bc2': []uint16{100, 252, 200, 402, 162, 203, 215, 262, 227, 315, 152, 325, 120, 327},
-		'\u5bc4': []uint16{452, 152, 327, 125, 156, 252, 325, 115, 127, 256, 227, 130},
-		'\u5bc5': []uint16{423, 227, 328, 327, 222, 320, 143, 322, 115, 125, 243},
-		'\u5bc6': []uint16{125, 200, 101, 225, 400, 100, 152, 325, 201, 227, 130, 252, 327, 230, 162, 362, 256, 315, 229, 120, 330, 204, 215, 156, 132, 129, 127, 320, 331, 304, 131, 329, 104, 332, 115, 352, 232},
-		'\u5bc7': []uint16{225, 427, 127, 426, 229, 227, 327, 215, 315, 125},
-		'\u5bcc': []uint16{200, 225, 125, 400, 152, 100, 127, 330, 327, 227, 232, 252, 315, 130, 132, 115, 325, 220, 129, 131, 320, 229, 156, 120, 128, 230, 332, 215, 331, 328, 352, 104, 356, 228, 329},
-		'\u5bd0': []uint16{454, 315, 252, 215},
-		'\u5bd2': []uint16{125, 100, 402, 225, 400, 200, 152, 203, 204, 127, 252, 315, 427, 227, 327, 325, 143, 256, 215, 130, 243, 342, 220, 120, 320, 115, 132, 332},
-		'\u5bd3': []uint16{125, 225, 454, 152, 252, 325, 120, 115, 327, 227, 304},
-		'\u5bdd': []uint16{125, 426, 454, 225, 252, 120, 215, 315, 227, 115},
-		'\u5bde': []uint16{200, 215, 402, 227, 413},
-		'\u5bdf': []uint16{252, 325, 225, 417, 152, 256, 204, 227, 331, 130, 125, 330, 454, 327, 315, 115, 317, 129, 220, 332, 230, 320, 229, 156, 120},
-		'\u5be1': []uint16{125, 402, 315, 225, 115, 252, 320, 152, 325, 120, 100, 327, 204, 215, 220, 317},
-		'\u5be4': []uint16{152},
-		'\u5be5': []uint16{162, 262, 115, 315, 427, 200, 100, 327},
-		'\u5be8': []uint16{426, 225, 227, 230, 125, 330, 327, 229, 232, 215, 331, 325, 332, 220},
-		'\u5bee': []uint16{413, 230, 229},
-		'\u5bf0': []uint16{125, 215, 225, 220, 228, 327},
-		'\u5bf8': []uint16{225, 222, 435, 422, 315, 115, 125, 120, 200, 235, 320, 325, 220, 327, 252, 152, 215},
-		'\u5bf9': []uint16{434, 134, 207, 152, 125, 252, 156, 222, 234, 325, 107, 120, 320, 100, 112, 200, 422, 142, 215, 225, 306, 115, 220, 315, 304, 101, 132, 312, 322, 352, 327, 131, 256, 331, 122, 104, 332, 130, 227},
-		'\u5bfa': []uint16{426, 230, 125, 227, 225, 142, 327, 232, 238, 130, 229, 330, 129},
-		'\u5bfb': []uint16{152, 452, 252, 127, 100, 315, 320, 227, 115, 120, 327, 156, 125, 130, 215},
-		'\u5bfc': []uint16{225, 152, 125, 325, 252, 256, 452, 204, 217, 227, 156, 417, 320, 215, 327, 220, 331, 304, 332, 104},
-		'\u5bff': []uint16{125, 225, 227, 127, 327, 427, 130, 230, 426, 200, 325, 252, 315, 215, 115, 330, 454, 232, 204, 331, 320, 156},
-		'\u5c01': []uint16{435, 252, 125, 100, 152, 127, 222, 120, 230, 330, 225, 227, 325, 156, 304, 327, 256, 320, 143, 315, 130, 115, 422, 132, 215, 207, 117, 331, 220, 129, 204, 332},
-		'\u5c04': []uint16{252, 125, 325, 452, 156, 152, 225, 256, 220, 232, 320, 204, 332, 227, 130, 331, 215, 327, 352, 304, 315, 342, 120, 131, 132, 129},
-		'\u5c06': []uint16{407, 125, 225, 207, 143, 107, 325, 327, 315, 115, 215, 152, 227, 252, 220, 320, 130, 104, 129, 330},
-		'\u5c09': []uint16{225, 227, 127, 427, 327, 130, 125},
-		'\u5c0a': []uint16{152, 435, 125, 222, 252, 100, 225, 327, 227, 325, 315, 256, 422, 215, 156, 115, 320, 120, 220, 129},
-		'\u5c0f': []uint16{125, 400, 127, 327, 225, 204, 200, 152, 317, 252, 325, 207, 104, 107, 162, 362, 101, 320, 217, 262, 315, 115, 130, 304, 132, 129, 229, 120, 220, 227, 143, 352, 122, 100, 322, 335, 328, 331, 156, 117, 215, 330, 329, 128, 231, 230, 131, 332},
-		'\u5c11': []uint16{400, 252, 238, 207, 222, 125, 122, 327, 325, 152, 200, 100, 220, 120, 322, 215, 225, 320, 127, 107, 130, 227, 115, 117, 315, 331, 132, 129, 131, 104, 330, 317, 217, 124, 128, 229, 162, 362},
-		'\u5c14': []uint16{227, 330, 327, 230, 329, 427, 229, 332, 127, 325, 232, 207, 225, 331, 129, 231, 106, 328, 315, 335, 215, 461, 135, 320, 115, 125, 343, 100, 252, 130, 138},
-		'\u5c15': []uint16{130, 332, 330},
-		'\u5c16': []uint16{400, 100, 225, 125, 152, 325, 104, 252, 200, 130, 220, 227, 327, 120, 204, 320, 115, 300, 315, 304, 156, 217, 230, 330},
-		'\u5c18': []uint16{225, 426, 125, 315, 115, 227, 252, 325, 215, 152, 256, 327, 232, 220, 320, 332},
-		'\u5c1a': []uint16{407, 107, 225, 125, 152, 127, 327, 227, 200, 252, 106, 320, 229, 130, 315, 100, 115, 332, 129, 330, 128, 325, 352, 120, 110, 132, 215},
-		'\u5c1c': []uint16{459},
-		'\u5c1d': []uint16{152, 452, 207, 252, 156, 315, 327, 125, 227, 320, 120, 115},
-		'\u5c24': []uint16{107, 407, 127, 327, 225, 227, 129, 130, 125, 315, 215, 132, 252, 128, 325, 320, 229, 117, 330, 329},
-		'\u5c25': []uint16{152},
-		'\u5c27': []uint16{427, 227, 327, 125, 130, 330, 129, 225, 215, 329, 229},
-		'\u5c2c': []uint16{200, 459, 215, 203},
-		'\u5c31': []uint16{407, 107, 152, 207, 225, 320, 252, 106, 315, 306, 215, 125, 128, 307, 115, 325, 227, 120, 220, 327, 156, 132},
-		'\u5c34': []uint16{100, 315, 103},
-		'\u5c38': []uint16{125, 426, 225, 152, 252, 227, 315, 325, 115, 215, 120, 132, 332},
-		'\u5c39': []uint16{127, 427, 227, 225, 327, 232, 130, 128},
-		'\u5c3a': []uint16{125, 222, 225, 435, 227, 215, 235, 422, 325, 315, 220, 304, 320, 252, 115, 352, 332, 330},
-		'\u5c3b': []uint16{413},
-		'\u5c3c': []uint16{227, 330, 327, 417, 130, 127, 229, 325, 230, 125, 225, 332, 129, 232, 331, 329, 132, 131, 320, 117, 220},
-		'\u5c3d': []uint16{106, 452, 107, 252, 207, 152, 315, 112, 215, 120, 115, 200, 320, 220, 227, 327, 225, 262, 204, 100},
-		'\u5c3e': []uint16{425, 125, 225, 325, 215, 435, 252, 230, 222, 152, 229, 130, 327, 112, 243, 227, 315, 352, 422, 115, 330, 220, 426, 129, 256, 132},
-		'\u5c3f': []uint16{425, 325, 125, 252, 225, 452, 152, 332, 227, 315, 117},
-		'\u5c40': []uint16{225, 125, 425, 231, 217, 152, 238, 252, 222, 325, 227, 100, 422, 331, 117, 215, 220, 304, 115, 232, 104, 317, 315, 320, 330, 132},
-		'\u5c41': []uint16{125, 425, 252, 225, 327, 115, 227, 320, 325, 315, 352},
-		'\u5c42': []uint16{225, 435, 222, 125, 204, 212, 422, 304, 325, 135, 235, 320, 220, 115, 252, 322, 162, 227, 122, 207, 217, 132, 362, 262, 327, 315, 120, 232, 352, 152, 200},
-		'\u5c45': []uint16{125, 452, 252, 327, 152, 225, 107, 127, 328, 325, 227, 120, 130, 315, 115, 229, 117, 156, 135, 230, 217, 332, 256, 215, 129, 220, 320, 330, 232, 331, 204},
-		'\u5c48': []uint16{252, 125, 152, 127, 452, 315, 115, 215, 256, 225, 200, 327, 227, 130, 100, 325, 220},
-		'\u5c49': []uint16{225, 304, 125, 332},
-		'\u5c4a': []uint16{222, 238, 107, 422, 217, 204, 435, 332, 252, 152, 331, 125, 225, 215, 320, 142},
-		'\u5c4b': []uint16{225, 125, 142, 425, 252, 230, 232, 132, 315, 325, 327, 330, 227, 329, 215, 331, 130},
-		'\u5c4e': []uint16{425, 252, 225, 125, 325},
-		'\u5c4f': []uint16{125, 426, 225, 152, 227, 130, 327, 132, 330, 229, 252, 315, 115, 230, 232, 320, 120},
-		'\u5c50': []uint16{225, 413},
-		'\u5c51': []uint16{225, 425, 325, 252, 315, 426, 232, 200, 215},
-		'\u5c55': []uint16{256, 252, 152, 320, 325, 454, 125, 217, 231, 225, 156, 227, 331, 327, 127, 232, 215, 220, 200, 330, 426, 104, 332, 128, 362, 262, 328, 117, 132, 352},
-		'\u5c59': []uint16{413},
-		'\u5c5e': []uint16{452, 225, 152, 252, 125, 256, 325, 204, 220, 332, 227, 330, 320, 100, 327, 331, 215, 304, 217, 156},
-		'\u5c60': []uint16{156, 152, 125, 452, 127, 325, 227, 327, 225, 332, 215, 232, 315, 330, 229, 130},
-		'\u5c61': []uint16{407, 107, 207, 115, 120, 320},
-		'\u5c63': []uint16{413, 225, 220},
-		'\u5c65': []uint16{152, 125, 227, 454, 104, 225, 426, 327, 315, 320, 220, 156, 215, 325, 115},
-		'\u5c66': []uint16{413},
-		'\u5c6f': []uint16{452, 152, 125, 230, 227, 130, 327, 225, 330, 252, 426, 315, 156},
-		'\u5c71': []uint16{125, 230, 425, 130, 225, 227, 330, 142, 127, 252, 325, 327, 315, 129, 332, 215, 229, 320, 256, 232, 331, 132, 115, 131, 120, 112, 220, 152, 228, 328, 329, 117, 231, 217, 342},
-		'\u5c79': []uint16{227, 152, 454, 315, 327, 320, 107},
-		'\u5c7a': []uint16{413, 327},
-		'\u5c7f': []uint16{225, 426, 227, 230, 330, 327},
-		'\u5c81': []uint16{422, 222, 225, 435, 125, 143, 256, 325, 252, 243, 315, 227, 320, 220, 115, 215, 352, 120, 327, 132, 235},
-		'\u5c82': []uint16{407, 152, 107, 115, 106, 227, 327, 125, 328},
-		'\u5c88': []uint16{413},
-		'\u5c8c': []uint16{115, 315, 162, 362},
-		'\u5c8d': []uint16{413},
-		'\u5c90': []uint16{130, 426, 227, 327, 229, 129, 328},
-		'\u5c91': []uint16{127, 227, 427, 327, 128, 100, 130},
-		'\u5c94': []uint16{452, 125, 152, 330, 225, 252, 204, 130, 320, 342, 352, 230, 227},
-		'\u5c96': []uint16{200, 315, 459},
-		'\u5c97': []uint16{425, 125, 325, 230, 227, 252, 225, 256, 327, 330, 130, 142, 320, 229, 317, 120, 331, 220},
-		'\u5c98': []uint16{227, 413, 130, 230},
-		'\u5c99': []uint16{413, 327},
-		'\u5c9a': []uint16{227, 427, 130, 327, 230, 229, 225},
-		'\u5c9b': []uint16{230, 225, 425, 125, 142, 227, 325, 229, 330, 331, 327, 232, 217, 332, 228, 130, 127, 129, 315, 329},
-		'\u5c9c': []uint16{413},
-		'\u5ca2': []uint16{413, 327, 129, 227},
-		'\u5ca3': []uint16{413, 130},
-		'\u5ca9': []uint16{225, 125, 227, 427, 230, 327, 426, 330, 232, 130, 252, 325, 129, 220, 152, 115, 315, 229, 331, 328},
-		'\u5cab': []uint16{413, 130, 227, 327, 132},
-		'\u5cac': []uint16{125},
-		'\u5cad': []uint16{230, 425, 225, 227, 229, 125, 325, 330, 327, 215, 142, 130, 332, 220, 232, 131, 217, 252, 426, 331},
-		'\u5cb1': []uint16{228, 130, 129, 125, 328, 230, 330},
-		'\u5cb3': []uint16{127, 227, 125, 425, 225, 130, 230, 327, 330, 131, 426, 129, 229, 232, 215, 132},
-		'\u5cb5': []uint16{413, 227},
-		'\u5cb7': []uint16{130, 413, 227, 327, 132},
-		'\u5cb8': []uint16{225, 242, 212, 425, 142, 325, 125, 252, 227, 256, 327, 315, 229, 230, 200, 330, 215, 331, 127, 220, 328, 162, 232, 228},
-		'\u5cbd': []uint16{413, 227},
-		'\u5cbf': []uint16{115, 107},
-		'\u5cc1': []uint16{413, 227, 225, 327},
-		'\u5cc4': []uint16{413, 227, 130},
-		'\u5ccb': []uint16{459, 262, 220, 215, 227},
-		'\u5cd2': []uint16{413, 330, 230, 227},
-		'\u5cd9': []uint16{252, 454, 227, 330, 230, 327},
-		'\u5ce1': []uint16{225, 230, 125, 417, 227, 332, 325, 331, 330, 130, 327, 320, 426},
-		'\u5ce4': []uint16{227, 413, 327},
-		'\u5ce5': []uint16{162, 227, 427, 327, 315, 115},
-		'\u5ce6': []uint16{225, 413, 315, 227, 327, 320},
-		'\u5ce8': []uint16{130, 262, 125, 115, 330, 229},
-		'\u5cea': []uint16{413, 227, 330, 327, 230, 129, 229},
-		'\u5ced': []uint16{125, 200, 402, 315, 100, 227, 215, 262, 220, 327},
-		'\u5cf0': []uint16{225, 227, 125, 427, 230, 325, 435, 222, 330, 232, 327, 132, 229, 115, 315, 217, 252, 422, 426, 332, 130, 228, 220},
-		'\u5cfb': []uint16{200, 402, 315, 227, 262, 203, 327, 100, 125, 320, 152, 325, 330, 232},
-		'\u5d02': []uint16{413, 130},
-		'\u5d03': []uint16{413, 330, 230, 327, 227},
-		'\u5d06': []uint16{130, 413},
-		'\u5d07': []uint16{129, 152, 327, 427, 252, 100, 130, 227, 115, 132, 454, 125, 320, 143, 131, 256, 315, 402},
-		'\u5d0e': []uint16{100, 230, 330, 115, 229, 327, 227},
-		'\u5d14': []uint16{127, 427, 327, 128, 227},
-		'\u5d16': []uint16{426, 225, 125, 227, 315, 230, 327, 142, 130, 152, 232, 325, 330},
-		'\u5d1b': []uint16{152, 200},
-		'\u5d1e': []uint16{413},
-		'\u5d24': []uint16{413, 115},
-		'\u5d26': []uint16{459, 125},
-		'\u5d29': []uint16{152, 452, 315, 252, 225, 125, 325, 327, 156, 215},
-		'\u5d2d': []uint16{104, 115, 401, 362, 262, 152, 227},
-		'\u5d2e': []uint16{413, 230, 332},
-		'\u5d34': []uint16{452, 230, 227, 327},
-		'\u5d3d': []uint16{125, 325, 225},
-		'\u5d47': []uint16{127, 427, 132, 327, 227},
-		'\u5d4a': []uint16{413, 130, 129},
-		'\u5d4b': []uint16{413, 225, 325, 227, 327},
-		'\u5d4c': []uint16{452, 252, 152, 325, 227, 125, 132, 156},
-		'\u5d58': []uint16{262, 227, 459, 215, 315, 327},
-		'\u5d5b': []uint16{413, 330, 327},
-		'\u5d5d': []uint16{413, 230, 227},
-		'\u5d69': []uint16{130, 413, 227, 327, 332, 125, 228, 129},
-		'\u5d6b': []uint16{459, 225},
-		'\u5d6c': []uint16{413, 227, 330},
-		'\u5d6f': []uint16{459, 162},
-		'\u5d74': []uint16{413},
-		'\u5d82': []uint16{413, 225, 215, 227},
-		'\u5d99': []uint16{162, 320, 315},
-		'\u5d9d': []uint16{413},
-		'\u5db7': []uint16{413, 227, 330},
-		'\u5dc5': []uint16{125, 225, 426, 227},
-		'\u5dcd': []uint16{227, 427, 327, 162, 262, 362, 107, 130, 115, 127, 228},
-		'\u5ddd': []uint16{230, 225, 417, 330, 227, 130, 331, 127, 229, 327, 125, 232, 132, 117, 332, 325, 115, 220, 129, 215, 343, 207, 228, 315},
-		'\u5dde': []uint16{230, 425, 330, 227, 225, 125, 331, 327, 238, 232, 229, 325, 332, 104, 217, 315},
-		'\u5de1': []uint16{125, 152, 435, 156, 454, 252, 227, 120, 327, 132, 332, 317, 228, 256, 315},
-		'\u5de2': []uint16{225, 425, 127, 130, 125, 252, 227, 452, 327, 320, 325, 232, 132, 315, 330, 331, 215, 304},
-		'\u5de5': []uint16{125, 156, 225, 256, 325, 252, 425, 117, 331, 320, 120, 217, 227, 100, 332, 132, 232, 220, 104, 317, 315, 215, 207, 130, 152, 304, 327, 330, 231, 352, 115, 103, 200, 362, 162, 228, 131, 127, 229},
-		'\u5de6': []uint16{122, 412, 127, 125, 112, 322, 115, 142, 128, 130, 117, 327, 320, 330, 252, 230, 204, 120, 227, 104, 129, 229, 152, 315, 107, 132, 331, 100},
-		'\u5de7': []uint16{225, 200, 127, 400, 100, 207, 152, 125, 227, 401, 315, 115, 327, 215, 325, 252, 320, 328, 129, 201, 156},
-		'\u5de8': []uint16{100, 125, 104, 127, 402, 426, 200, 327, 132, 315, 227, 325, 156, 130, 152, 115, 129, 332, 131, 304, 120},
-		'\u5de9': []uint16{152, 127, 130, 227, 427, 125, 228, 327},
-		'\u5deb': []uint16{125, 427, 127, 130, 225, 426, 215, 327, 315, 330, 227, 115},
-		'\u5dee': []uint16{125, 400, 225, 120, 252, 200, 107, 152, 315, 320, 227, 115, 325, 156, 220, 215, 256, 132, 100, 129},
-		'\u5def': []uint16{125, 413, 227},
-		'\u5df1': []uint16{238, 438, 125, 215, 225, 315, 227, 325, 332, 220, 252, 122, 320, 138, 327, 115, 132},
-		'\u5df2': []uint16{407, 107, 152, 207, 260, 252, 129, 200, 320, 215, 220, 315, 238, 325, 143, 156, 125, 227, 327, 245, 225, 332},
-		'\u5df3': []uint16{423, 222, 227, 229, 327, 322, 143},
-		'\u5df4': []uint16{130, 225, 127, 417, 330, 327, 325, 129, 125, 230, 227, 152, 329, 262, 362, 132, 332, 229, 252, 117, 331, 131, 120, 207, 307, 200, 232, 320, 315, 352, 215, 220, 233, 235, 104, 204, 304, 162},
-		'\u5df7': []uint16{426, 225, 125, 215, 142, 152, 227, 315, 230, 325, 220, 130, 232, 327, 320, 330},
-		'\u5dfd': []uint16{413, 327, 227, 130},
-		'\u5dfe': []uint16{225, 426, 327, 227, 320, 115, 325, 104, 315, 125, 215, 132, 120},
-		'\u5e01': []uint16{225, 320, 425, 331, 325, 125, 252, 356, 217, 220, 227, 256, 232, 327, 127},
-		'\u5e02': []uint16{125, 225, 252, 230, 425, 142, 217, 325, 331, 152, 238, 352, 330, 104, 320, 117, 120, 327, 204, 215, 332, 227, 315, 130, 304, 229, 356, 220, 115, 122, 317, 135, 132, 256, 322, 129, 127},
-		'\u5e03': []uint16{252, 125, 127, 225, 425, 152, 330, 325, 130, 227, 327, 129, 230, 132, 329, 229, 215, 232, 304, 120, 315, 320, 156, 331, 256, 220, 117, 131, 452, 104, 115, 332},
-		'\u5e05': []uint16{225, 227, 400, 127, 325, 252, 327, 427, 100, 125, 454, 232, 426},
-		'\u5e06': []uint16{227, 425, 125, 232, 225, 315, 327, 252, 325, 228, 220},
-		'\u5e08': []uint16{225, 426, 125, 127, 227, 327, 252, 152, 325, 331, 230, 315, 215, 217, 231, 117, 330, 332, 320, 220, 104, 115, 317, 343, 304, 156, 238, 232, 256, 129, 120, 143},
-		'\u5e0c': []uint16{152, 130, 327, 127, 330, 417, 227, 125, 129, 452, 132, 332, 229, 232, 131, 100, 329, 120, 156, 115, 331, 320},
-		'\u5e0f': []uint16{125},
-		'\u5e10': []uint16{435, 125, 426, 225, 252, 222, 215, 132, 325},
-		'\u5e11': []uint16{413, 225},
-		'\u5e14': []uint16{413, 225, 215},
-		'\u5e15': []uint16{127, 426, 225, 327, 130, 227, 129, 329, 330, 332, 229, 132, 230, 232},
-		'\u5e16': []uint16{435, 225, 125, 426, 200, 227, 222, 252, 315, 362, 262, 325, 422},
-		'\u5e18': []uint16{225, 125, 435, 426, 325, 315, 220, 227, 252, 232},
-		'\u5e19': []uint16{435, 320, 225, 327},
-		'\u5e1a': []uint16{225, 426, 325, 315},
-		'\u5e1b': []uint16{426, 225, 227, 125, 327, 252, 215},
-		'\u5e1c': []uint16{225, 215, 426, 315, 435, 252, 227, 327},
-		'\u5e1d': []uint16{225, 125, 227, 417, 229, 252, 325, 426, 327, 127, 330, 332, 331, 232, 115, 129, 215, 320, 130, 217, 315},
-		'\u5e26': []uint16{452, 152, 225, 252, 325, 125, 304, 315, 227, 320, 107, 232, 256, 132, 327, 204, 229, 215, 207, 217, 156, 100, 332, 130, 331, 307},
-		'\u5e27': []uint16{435, 225, 222, 227, 422, 327},
-		'\u5e2d': []uint16{225, 252, 125, 435, 325, 222, 152, 320, 127, 422, 315, 115, 220, 227, 215, 331, 207, 107, 327, 330, 129, 128},
-		'\u5e2e': []uint16{152, 452, 125, 222, 225, 224, 217, 252, 227, 325, 320, 120, 156, 330, 327, 317, 232, 422},
-		'\u5e37': []uint16{125, 426, 315, 325, 227, 225, 327},
-		'\u5e38': []uint16{207, 200, 407, 125, 100, 107, 204, 152, 104, 127, 130, 325, 331, 243, 120, 117, 215, 252, 227, 225, 327, 156, 356, 304, 315, 320, 220, 229, 230, 262, 362, 128, 132, 352, 332, 129, 317, 256, 162, 330, 115},
-		'\u5e3b': []uint16{413, 227},
-		'\u5e3c': []uint16{315, 204, 320},
-		'\u5e3d': []uint16{225, 125, 425, 252, 325, 426, 320, 232, 327, 352, 330},
-		'\u5e42': []uint16{425, 125, 227, 225},
-		'\u5e44': []uint16{215, 413, 225, 325},
-		'\u5e45': []uint16{225, 207, 125, 222, 435, 307, 217, 204, 422, 238, 115, 215, 320, 327},
-		'\u5e4c': []uint16{125, 230, 330},
-		'\u5e54': []uint16{225, 125},
-		'\u5e55': []uint16{225, 435, 125, 252, 222, 325, 142, 422, 204, 227, 425, 130, 327, 317, 115, 320},
-		'\u5e5b': []uint16{225},
-		'\u5e5e': []uint16{413},
-		'\u5e61': []uint16{426, 225, 107, 327, 120, 227},
-		'\u5e62': []uint16{435, 222, 225, 422, 362, 227, 262},
-		'\u5e72': []uint16{452, 125, 152, 100, 225, 222, 107, 252, 322, 325, 330, 127, 162, 230, 362, 227, 220, 117, 320, 327, 315, 156, 200, 132, 215, 120, 115, 229, 217, 232, 130, 317, 262, 143, 329, 256, 332, 204, 228},
-		'\u5e73': []uint16{100, 225, 125, 135, 452, 227, 330, 143, 152, 322, 230, 127, 200, 325, 252, 130, 327, 122, 115, 315, 232, 204, 162, 332, 132, 215, 156, 320, 120, 362, 262, 107, 131, 229, 228, 104, 220, 331, 129, 117, 352, 217, 101, 328, 329, 317, 304, 128},
-		'\u5e74': []uint16{422, 222, 243, 435, 125, 143, 225, 238, 207, 100, 325, 117, 320, 343, 152, 104, 252, 227, 304, 235, 315, 217, 352, 120, 331, 135, 112, 220, 215, 122, 115, 127, 162, 232, 329, 228, 230, 356, 130, 330, 332, 327, 204, 224, 322, 212, 317, 132, 324, 256},
-		'\u5e76': []uint16{406, 152, 106, 252, 107, 125, 207, 156, 315, 120, 130, 325, 115, 320, 225, 327, 256, 317, 104, 227},
-		'\u5e78': []uint16{100, 225, 107, 200, 127, 426, 152, 252, 454, 125, 227, 115, 207, 201, 327, 120, 409, 215, 315, 256, 325, 130, 320},
-		'\u5e7a': []uint16{427, 327, 227, 225, 315},
-		'\u5e7b': []uint16{125, 225, 454, 152, 252, 127, 200, 315, 215, 325, 204, 320, 426, 227, 220, 132, 327, 332},
-		'\u5e7c': []uint16{125, 207, 402, 143, 100, 426, 225, 127, 325, 327, 217, 130, 215, 227, 220, 120, 320, 104, 117, 200, 315, 252, 317, 115},
-		'\u5e7d': []uint16{125, 100, 426, 402, 262, 152, 162, 327, 200, 362, 227, 454, 325, 215, 156, 103, 329, 252},
-		'\u5e7f': []uint16{130, 100, 125, 400, 104, 252, 132, 127, 156, 327, 232, 131, 227, 152, 217, 117, 200, 332, 315, 330, 331, 107, 120, 320, 215, 129, 417, 325, 228, 115, 143, 225, 328, 229, 231, 230, 317},
-		'\u5e80': []uint16{413},
-		'\u5e84': []uint16{227, 125, 127, 225, 427, 230, 100, 327, 130, 200, 330, 128, 426, 325, 232, 315, 252, 238, 329, 331, 332, 228, 402, 220, 229, 120},
-		'\u5e86': []uint16{230, 454, 227, 243, 327, 152, 225, 330, 343, 125, 331, 252, 332, 130, 132, 232, 215, 228, 325, 229, 156, 127, 231, 129, 128, 120, 131},
-		'\u5e87': []uint16{454, 152, 252, 327, 225, 125, 227, 325, 332},
-		'\u5e8a': []uint16{225, 125, 425, 252, 256, 232, 222, 325, 315, 320, 132, 332, 422},
-		'\u5e8b': []uint16{413},
-		'\u5e8f': []uint16{225, 125, 425, 200, 220, 252, 325, 320, 315, 352, 256, 227, 215, 327, 107, 304, 228, 332},
-		'\u5e90': []uint16{130, 426, 225, 227, 125, 215, 230, 330, 132, 327, 252, 115, 320, 131},
-		'\u5e91': []uint16{426, 225},
-		'\u5e93': []uint16{125, 225, 425, 127, 129, 130, 227, 232, 330, 327, 325, 252, 230, 329, 217, 220, 229, 331, 152, 332, 135, 131, 132},
-		'\u5e94': []uint16{152, 452, 252, 256, 156, 225, 325, 125, 220, 315, 327, 127, 207, 115, 320, 215, 227, 132, 130, 104, 230, 120, 232, 352, 228, 128, 332, 328, 330},
-		'\u5e95': []uint16{412, 243, 207, 225, 201, 125, 112, 252, 242, 325, 220, 215, 342, 130, 330, 230, 227, 315, 329, 204, 229, 120, 320, 256, 332, 132, 232, 327, 356, 362, 262},
-		'\u5e96': []uint16{426, 215, 125, 129, 115},
-		'\u5e97': []uint16{225, 425, 125, 230, 252, 330, 238, 232, 231, 325, 130, 304, 332, 327, 229},
-		'\u5e99': []uint16{425, 225, 125, 230, 227, 130, 327, 352, 330, 325},
-		'\u5e9a': []uint16{227, 327, 427, 122, 229, 132, 125, 325, 220, 232, 252, 332, 322, 129},
-		'\u5e9c': []uint16{225, 231, 426, 427, 227, 417, 220, 325, 125, 230, 327, 130, 330, 331, 204, 129, 315, 317, 215, 229, 117, 332},
-		'\u5e9e': []uint16{100, 127, 225, 427, 327, 115, 129, 227, 330, 130, 331, 125, 132, 329, 128},
-		'\u5e9f': []uint16{452, 152, 125, 252, 256, 325, 215, 200, 327, 104, 156, 225, 320, 315, 115, 402, 329, 117},
-		'\u5ea0': []uint16{413, 227, 125},
-		'\u5ea5': []uint16{413, 227, 327},
-		'\u5ea6': []uint16{225, 224, 207, 230, 435, 200, 222, 330, 325, 152, 220, 422, 252, 332, 256, 125, 227, 215, 204, 331, 315, 352, 327, 232, 156, 320, 115, 243, 229, 235, 217, 356, 120, 322},
-		'\u5ea7': []uint16{222, 435, 225, 125, 422, 252, 232, 227, 152, 325, 200, 304, 115, 220, 330, 332, 215, 132, 327, 320, 127},
-		'\u5ead': []uint16{225, 227, 125, 426, 325, 252, 230, 327, 315, 152, 215, 220, 207, 320, 330, 231, 242, 304, 232, 104, 352, 112, 217, 328},
-		'\u5eb3': []uint16{413},
-		'\u5eb5': []uint16{426, 227, 225, 327, 230, 125},
-		'\u5eb6': []uint16{125, 200, 106, 225, 104, 325, 327},
-		'\u5eb7': []uint16{200, 127, 125, 227, 417, 327, 225, 230, 427, 152, 130, 132, 232, 128, 330, 325, 100, 331, 220, 332, 129, 131, 156, 115, 229, 315, 362, 262, 143, 329},
-		'\u5eb8': []uint16{100, 200, 330, 225, 320, 207, 125, 315, 229, 228, 162, 115, 152, 362, 325, 252, 327},
-		'\u5eb9': []uint16{435, 427, 127},
-		'\u5ebe': []uint16{127, 427, 330, 327, 227},
-		'\u5ec9': []uint16{402, 100, 200, 125, 229, 152, 127, 227, 220, 327, 225, 315, 329, 120, 320, 330, 252, 130, 115, 215, 331},
-		'\u5eca': []uint16{225, 426, 125, 130, 227, 230},
-		'\u5ed1': []uint16{227, 413},
-		'\u5ed2': []uint16{413},
-		'\u5ed3': []uint16{225, 127, 152, 262, 200, 156, 330},
-		'\u5ed6': []uint16{127, 427, 327, 329, 120, 227, 330, 129},
-		'\u5edb': []uint16{225, 413},
-		'\u5ee8': []uint16{413},
-		'\u5eea': []uint16{413, 225, 227, 327},
-		'\u5ef6': []uint16{152, 227, 252, 130, 327, 417, 127, 125, 225, 129, 454, 132, 315, 115, 131, 320, 325, 330, 156, 230, 328, 200},
-		'\u5ef7': []uint16{225, 327, 413, 227, 230, 125, 325, 320, 331, 330, 229, 129, 127, 231},
-		'\u5efa': []uint16{152, 125, 156, 252, 452, 200, 327, 230, 117, 320, 325, 132, 217, 131, 227, 331, 225, 330, 130, 129, 332, 143, 127, 232, 328, 204, 104, 128, 115, 229, 228, 317, 256, 120, 315, 220, 343},
-		'\u5eff': []uint16{122, 422, 322, 222},
-		'\u5f00': []uint16{152, 252, 452, 125, 201, 130, 327, 132, 156, 100, 222, 315, 120, 320, 331, 225, 422, 325, 115, 227, 215, 330, 220, 129, 230, 332, 104, 135, 112, 352, 143, 304, 131, 217, 107, 228, 200, 232, 117, 229, 362, 162, 329, 145},
-		'\u5f01': []uint16{413, 227, 225},
-		'\u5f02': []uint16{125, 225, 100, 454, 200, 107, 252, 320, 325, 104, 115, 215, 227, 152, 315, 352, 262, 327, 220, 120, 132, 232, 156, 256, 402, 304, 332, 129},
-		'\u5f03': []uint16{252, 454, 325, 327, 152, 215, 315, 227, 256, 125, 115, 120, 320},
-		'\u5f04': []uint16{452, 252, 152, 125, 315, 115, 225, 230, 256, 327, 330, 130, 320},
-		'\u5f08': []uint16{252, 256, 454, 325, 125, 227, 327},
-		'\u5f0a': []uint16{426, 125, 225, 215, 252, 256, 325, 220},
-		'\u5f0b': []uint16{327, 426, 252, 130, 125, 227, 330},
-		'\u5f0f': []uint16{225, 419, 201, 204, 426, 220, 232, 327, 227, 200, 320, 125, 325, 352, 238, 152, 335, 207, 229, 332, 356, 331, 217, 315},
-		'\u5f11': []uint16{454, 152},
-		'\u5f13': []uint16{452, 125, 127, 425, 225, 227, 232, 252, 315, 215, 327, 325, 152, 220, 204, 230, 130},
-		'\u5f15': []uint16{152, 452, 252, 125, 325, 227, 115, 225, 256, 120, 327, 320, 117, 332, 156, 315, 215, 132, 232},
-		'\u5f17': []uint16{127, 409, 129, 327, 130, 227, 329, 232, 304, 229, 131, 330, 320, 315, 325, 132, 235, 100, 332},
-		'\u5f18': []uint16{127, 227, 327, 427, 152, 143, 125, 200, 132, 328},
-		'\u5f1b': []uint16{200, 454, 256, 125, 100, 227, 228, 220, 215},
-		'\u5f1f': []uint16{225, 125, 425, 227, 325, 220, 327, 215, 217, 315, 331, 320},
-		'\u5f20': []uint16{127, 435, 225, 222, 252, 200, 152, 128, 130, 227, 422, 315, 327, 325, 132, 115, 125, 262, 256, 230, 362, 215, 220, 238, 100, 320, 204, 304, 129, 330, 332},
-		'\u5f25': []uint16{152, 125, 407, 325, 402, 327, 225, 320, 227, 120, 454, 115, 132, 315, 130, 330, 129, 228},
-		'\u5f26': []uint16{425, 225, 325, 125, 227, 252, 315, 127, 115, 215, 327},
-		'\u5f27': []uint16{125, 425, 225, 325, 332, 104, 320},
-		'\u5f29': []uint16{426, 315, 125, 227, 325, 225},
-		'\u5f2a': []uint16{413},
-		'\u5f2d': []uint16{427, 252},
-		'\u5f2f': []uint16{152, 452, 252, 125, 225, 362, 162, 425, 315, 352, 325, 400, 220, 320, 256, 262, 215, 227, 100},
-		'\u5f31': []uint16{400, 200, 252, 125, 225, 152, 220, 201, 100, 320, 325, 262, 315, 215, 115, 104, 227, 317, 327, 332},
-		'\u5f39': []uint16{225, 252, 125, 452, 152, 325, 220, 156, 115, 227, 256, 215, 315, 120, 352, 332, 320, 317, 217},
-		'\u5f3a': []uint16{252, 400, 100, 152, 125, 200, 227, 225, 156, 120, 107, 127, 315, 320, 325, 228, 204, 104, 115, 215, 231, 327, 132, 229, 232, 217, 220, 362, 262, 332, 238, 230, 330, 304, 131, 328, 201, 117},
-		'\u5f3c': []uint16{227, 413, 327, 328, 252, 215, 228, 128},
-		'\u5f40': []uint16{425},
-		'\u5f52': []uint16{452, 152, 252, 156, 125, 315, 215, 325, 127, 115, 225, 207, 227, 120, 220, 327, 230, 130, 129, 320, 330, 132, 331, 256},
-		'\u5f53': []uint16{143, 434, 107, 152, 252, 200, 207, 142, 125, 352, 112, 225, 315, 234, 134, 115, 320, 206, 215, 130, 220, 233, 156, 362, 262, 330, 332, 325, 329, 229, 327, 227, 120, 133, 230, 333, 300, 312, 343},
-		'\u5f55': []uint16{252, 225, 452, 152, 125, 325, 227, 327, 256, 232, 156, 332, 320, 217, 315, 352, 331, 215},
-		'\u5f56': []uint16{413},
-		'\u5f57': []uint16{125, 325, 327},
-		'\u5f58': []uint16{413, 227},
-		'\u5f5d': []uint16{417, 132, 330, 227, 327, 130, 125, 120},
-		'\u5f62': []uint16{125, 152, 225, 426, 252, 204, 325, 320, 315, 120, 115, 307, 215, 330, 227, 230, 220, 232, 327, 256, 342, 104, 352, 332, 200},
-		'\u5f64': []uint16{228, 262, 362, 125},
-		'\u5f66': []uint16{413, 327, 227, 330, 128, 225, 332, 229},
-		'\u5f69': []uint16{125, 225, 426, 200, 104, 215, 227, 327, 204, 315, 252, 325, 232, 256, 320, 220, 120, 132, 152, 362, 129, 117, 330, 262, 332, 304, 115},
-		'\u5f6a': []uint16{227, 435, 327, 115, 100, 152, 228, 104},
-		'\u5f6c': []uint16{315, 115, 228, 232, 215, 130},
-		'\u5f6d': []uint16{127, 427, 130, 227, 422, 327, 129, 228, 232, 229, 128, 100},
-		'\u5f70': []uint16{252, 402, 130, 152, 215, 227, 327, 325, 200, 220},
-		'\u5f71': []uint16{156, 225, 125, 425, 325, 252, 104, 315, 227, 215, 256, 331, 152, 320, 327, 115, 426, 217, 120, 220, 454, 352, 330, 129, 332, 343, 117},
-		'\u5f73': []uint16{459, 125},
-		'\u5f77': []uint16{152},
-		'\u5f79': []uint16{225, 426, 252, 204, 232, 256, 325, 152, 238, 125, 227, 327, 320, 215, 129, 230, 330, 231},
-		'\u5f7b': []uint16{101, 252, 454, 330, 200, 227, 107, 315, 115, 327, 162, 331, 125, 329, 120, 156, 229, 362},
-		'\u5f7c': []uint16{138, 438, 330, 129, 315, 125, 130, 143, 215, 320, 327, 329, 115, 120, 332, 227},
-		'\u5f80': []uint16{434, 252, 207, 107, 143, 152, 243, 256, 156, 125, 215, 315, 153, 352, 227, 220, 320, 327, 104},
-		'\u5f81': []uint16{225, 152, 252, 452, 227, 125, 156, 325, 327, 315, 117, 230, 256, 232, 217, 215, 330, 331, 332, 228, 220, 132},
-		'\u5f82': []uint16{413},
-		'\u5f84': []uint16{225, 125, 426, 107, 204, 315, 227, 320, 220, 325, 215, 252, 327, 331, 130, 132},
-		'\u5f85': []uint16{252, 452, 125, 215, 152, 325, 315, 320, 225, 107, 156, 115, 327, 256, 227, 120, 220, 332},
-		'\u5f87': []uint16{454, 152, 115, 315, 120},
-		'\u5f88': []uint16{407, 122, 100, 107, 120, 322, 125, 327},
-		'\u5f89': []uint16{252, 459},
-		'\u5f8a': []uint16{252, 454, 459},
-		'\u5f8b': []uint16{225, 125, 427, 207, 327, 330, 325, 227, 426, 220, 252, 230, 331, 215, 454, 256, 232, 352, 320, 152, 204, 315, 332, 117},
-		'\u5f8c': []uint16{427, 227},
-		'\u5f90': []uint16{127, 427, 130, 227, 207, 327, 107, 128, 228, 125, 162, 129, 120, 320, 230, 131, 152, 315, 330, 262, 132},
-		'\u5f92': []uint16{225, 407, 125, 320, 227, 327, 107, 152, 215, 115, 252, 229, 330, 104, 329, 315, 325, 120, 130, 220, 206},
-		'\u5f95': []uint16{252, 459, 327},
-		'\u5f97': []uint16{446, 252, 152, 207, 307, 352, 325, 100, 200, 225, 320, 327, 330, 315, 125, 220, 215, 227, 300, 115, 229, 230, 130, 232, 120, 203, 329, 204, 132, 228, 131, 332, 256, 331, 129, 156},
-		'\u5f98': []uint16{152},
-		'\u5f99': []uint16{454, 225, 252, 315},
-		'\u5f9c': []uint16{152},
-		'\u5fa1': []uint16{454, 252, 125, 325, 327, 152, 227, 104, 129, 130, 156, 115, 332},
-		'\u5fa8': []uint16{252, 459},
-		'\u5faa': []uint16{156, 252, 454, 325, 315, 120, 115, 227, 152, 125, 107, 130, 327, 104, 215, 230, 330, 132, 304},
-		'\u5fad': []uint16{125, 320, 120},
-		'\u5fae': []uint16{125, 207, 156, 100, 407, 107, 400, 200, 152, 104, 227, 325, 120, 115, 327, 252, 122, 215, 135, 117, 262, 362, 162, 203, 220, 256, 315, 130, 204, 402, 232, 131, 320, 322, 222, 332, 132, 335, 330, 230},
-		'\u5fb5': []uint16{227, 413, 327},
-		'\u5fb7': []uint16{130, 227, 327, 417, 225, 229, 127, 330, 230, 125, 117, 129, 132, 243, 232, 131, 329, 215, 252, 332, 325, 115, 200, 331, 315, 217, 228, 328, 335, 104, 320, 220, 231, 128, 352, 120},
-		'\u5fbc': []uint16{413},
-		'\u5fbd': []uint16{230, 330, 125, 225, 417, 327, 227, 426, 232, 331, 130, 329, 325, 328},
-		'\u5fc3': []uint16{225, 125, 142, 252, 425, 200, 315, 152, 325, 115, 207, 215, 100, 201, 327, 220, 320, 227, 231, 242, 204, 127, 120, 104, 238, 232, 230, 330, 132, 256, 229, 156, 116, 117, 304, 352, 317, 331, 362, 262, 332, 328, 300, 228, 356},
-		'\u5fc5': []uint16{107, 207, 407, 100, 152, 120, 125, 320, 315, 327, 352, 156, 227, 325, 115, 215, 332, 330, 238, 132, 129},
-		'\u5fc6': []uint16{252, 225, 227, 325, 452, 315, 327, 152, 127, 120, 156, 220, 125, 454, 332},
-		'\u5fcc': []uint16{452, 225, 152, 252, 315, 215, 325, 220, 227, 156, 143, 256, 125},
-		'\u5fcd': []uint16{152, 452, 252, 200, 115, 315, 320, 215, 227, 107, 125, 220, 325, 327, 132, 156, 328},
-		'\u5fcf': []uint16{152, 156, 125, 252},
-		'\u5fd0': []uint16{115, 100},
-		'\u5fd1': []uint16{315, 200, 459},
-		'\u5fd2': []uint16{407, 327, 227, 262, 362},
-		'\u5fd6': []uint16{252, 152},
-		'\u5fd7': []uint16{225, 425, 327, 227, 125, 230, 325, 252, 331, 215, 115, 330, 127, 315, 143, 152, 332, 352, 220, 328, 130, 129, 128, 232, 120, 229, 228, 132, 304},
-		'\u5fd8': []uint16{452, 152, 252, 325, 315, 215, 115, 200, 104, 320, 125, 107, 256, 220, 120, 227},
-		'\u5fd9': []uint16{452, 207, 252, 201, 200, 100, 152, 362, 262, 227, 215, 315, 107, 225, 162, 220, 320, 115, 120, 327, 125, 325, 307},
-		'\u5fdd': []uint16{454, 227, 327},
-		'\u5fe0': []uint16{227, 427, 125, 327, 100, 152, 252, 115, 454, 315, 200, 104, 120, 225, 130, 330, 129, 320, 228, 230, 328, 127},
-		'\u5fe1': []uint16{215, 315, 225, 459},
-		'\u5fe4': []uint16{402, 152, 120, 327, 252},
-		'\u5fe7': []uint16{252, 452, 100, 152, 156, 125, 315, 225, 115, 215, 232, 227, 220, 426, 200, 325, 320, 103},
-		'\u5fea': []uint16{215, 200, 459},
-		'\u5feb': []uint16{400, 207, 107, 252, 200, 100, 125, 262, 162, 215, 220, 132, 320, 315, 362, 152, 156, 225, 115, 325, 331, 227, 204, 217, 117, 201, 327, 120, 300, 104, 103, 130},
-		'\u5fed': []uint16{413, 227},
-		'\u5fee': []uint16{413},
-		'\u5ff1': []uint16{225, 426, 227, 200, 215, 327},
-		'\u5ff5': []uint16{225, 452, 252, 325, 152, 125, 315, 115, 227, 327, 330, 127, 130, 352, 100, 332, 220, 320, 256, 104, 304, 215, 331},
-		'\u5ff8': []uint16{100, 115},
-		'\u5ffb': []uint16{130, 427, 127, 227, 327, 330},
-		'\u5ffd': []uint16{107, 407, 152, 320, 252, 162, 207, 362, 120, 327, 227, 315, 262, 322, 133, 256, 132, 200, 115},
-		'\u5ffe': []uint16{215, 413, 315},
-		'\u5fff': []uint16{100, 115, 315, 125},
-		'\u6000': []uint16{152, 452, 142, 125, 227, 252, 127, 327, 225, 256, 130, 315, 215, 320, 129, 120, 156, 115, 143, 220, 230, 207, 200, 330, 307, 228, 329, 132, 262, 328, 332},
-		'\u6001': []uint16{225, 125, 425, 252, 325, 220, 320, 215, 315, 426, 227, 352, 256, 200, 327, 332},
-		'\u6002': []uint16{454, 152, 227},
-		'\u6004': []uint16{452, 152},
-		'\u6005': []uint16{200, 100, 107, 115, 307},
-		'\u6006': []uint16{200, 413, 203},
-		'\u600a': []uint16{413},
-		'\u600d': []uint16{413},
-		'\u600e': []uint16{138, 438, 320, 106, 120, 327},
-		'\u600f': []uint16{262, 162, 115, 315, 362},
-		'\u6012': []uint16{252, 454, 125, 200, 115, 152, 130, 227, 315, 215, 225, 320, 132, 162, 327, 330, 120, 156, 325},
-		'\u6014': []uint16{452, 152, 252, 125, 227, 362, 262},
-		'\u6015': []uint16{452, 252, 207, 200, 206, 100, 320, 315, 215, 220, 152, 125, 217},
-		'\u6016': []uint16{200, 325, 454, 215, 220, 327, 227},
-		'\u6019': []uint16{413, 115},
-		'\u601b': []uint16{413, 227},
-		'\u601c': []uint16{252, 152, 200, 454, 362, 215, 352, 325, 115, 156, 227, 327, 320, 256, 315},
-		'\u601d': []uint16{125, 225, 152, 327, 227, 252, 452, 325, 315, 200, 220, 127, 215, 320, 132, 115, 229, 120, 130, 156, 129, 256, 332, 232, 330, 230, 328, 228, 329},
-		'\u6020': []uint16{402, 152, 200, 125, 100, 320, 227},
-		'\u6021': []uint16{454, 227, 100, 327, 132, 125, 215, 152, 115, 162, 129, 130, 131},
-		'\u6025': []uint16{452, 200, 107, 125, 152, 100, 162, 256, 215, 115, 104, 315, 252, 320, 362, 101, 120, 156, 225, 204, 317, 325, 262, 220, 132, 332, 117, 331},
-		'\u6026': []uint16{233, 133, 120, 162, 433},
-		'\u6027': []uint16{225, 125, 425, 204, 325, 207, 320, 227, 252, 352, 315, 220, 256, 156, 100, 232, 200, 426, 327, 356, 217, 215, 152, 332, 343, 120, 115},
-		'\u6028': []uint16{252, 452, 125, 225, 152, 115, 107, 200, 215, 315, 320, 456, 156, 325, 220},
-		'\u6029': []uint16{200, 459, 315},
-		'\u602a': []uint16{252, 452, 400, 125, 200, 225, 207, 215, 100, 107, 315, 152, 120, 220, 227, 320, 162, 362, 115, 232, 307, 352, 262, 130},
-		'\u602b': []uint16{413},
-		'\u602f': []uint16{400, 200, 100, 162, 262, 125, 252, 203, 152, 362},
-		'\u6035': []uint16{454, 252, 115},
-		'\u603b': []uint16{125, 404, 107, 152, 106, 325, 331, 227, 104, 225, 252, 327, 120, 117, 204, 332, 207, 320, 132, 256, 217, 330, 220, 131, 317, 130, 142, 156, 228},
-		'\u603c': []uint16{413, 252},
-		'\u603f': []uint16{413, 227},
-		'\u6041': []uint16{413, 145},
-		'\u6042': []uint16{227, 413, 327},
-		'\u6043': []uint16{454, 252, 315, 115, 227, 215},
-		'\u604b': []uint16{252, 152, 452, 125, 352, 256, 115, 315, 320, 227, 225, 325, 332, 327, 456},
-		'\u604d': []uint16{454, 100, 115, 107, 152, 315, 362, 162, 101, 227, 200, 327},
-		'\u6050': []uint16{107, 407, 100, 103, 200, 125, 252, 315, 215, 152, 225, 227, 132, 320, 327},
-		'\u6052': []uint16{125, 427, 132, 227, 117, 262, 103, 252, 325, 332, 130, 327, 426, 402, 215, 100, 320, 230, 101, 129, 131, 232, 115, 328, 228},
-		'\u6055': []uint16{454, 427, 252, 152, 227, 327, 120, 256},
-		'\u6059': []uint16{215, 262, 220, 125, 225},
-		'\u605a': []uint16{413, 227},
-		'\u6062': []uint16{152, 402, 125, 100, 143, 227, 327, 320, 215, 315, 104, 220},
-		'\u6063': []uint16{107, 152, 315, 115},
-		'\u6064': []uint16{252, 325, 256, 125},
-		'\u6067': []uint16{413},
-		'\u6068': []uint16{452, 252, 107, 225, 200, 256, 127, 215, 115, 152, 320, 332, 125, 315, 327, 325, 220},
-		'\u6069': []uint16{227, 327, 252, 427, 125, 426, 127, 225, 417, 229, 329, 315, 129, 130, 220, 152, 325, 343, 100, 156, 230, 331, 132, 115, 232, 330, 162, 362, 320},
-		'\u606a': []uint16{152, 228, 120},
-		'\u606b': []uint16{156},
-		'\u606c': []uint16{100, 228, 115, 315, 162, 200},
-		'\u606d': []uint16{100, 402, 152, 227, 315, 362, 162, 327, 200, 125, 215, 130, 332, 252, 120, 115, 320, 156, 228},
-		'\u606f': []uint16{225, 252, 425, 325, 204, 227, 125, 352, 215, 315, 356, 220, 115, 152, 332, 331, 200, 256, 232, 320, 343, 327, 130, 243, 231, 426, 454},
-		'\u6070': []uint16{107, 407, 207, 100, 152, 306, 106, 115, 130, 327, 227, 330, 229, 230, 127, 129},
-		'\u6073': []uint16{152, 200, 100, 362, 262, 125, 300},
-		'\u6076': []uint16{400, 125, 100, 152, 200, 252, 225, 315, 104, 120, 162, 115, 107, 215, 227, 325, 320, 327, 256, 220, 362, 103, 232, 203, 317},
-		'\u6078': []uint16{200, 413, 152},
-		'\u6079': []uint16{262, 162, 362},
-		'\u607a': []uint16{227, 413, 327, 328, 129},
-		'\u607b': []uint16{115, 402, 215, 200, 152, 327},
-		'\u607c': []uint16{200, 452, 152, 100, 252, 203, 115, 220, 256},
-		'\u607d': []uint16{127, 427, 227, 327, 128},
-		'\u607f': []uint16{252, 459, 227, 327},
-		'\u6083': []uint16{413},
-		'\u6084': []uint16{107, 362, 207, 162, 262, 120, 125, 325, 327, 227},
-		'\u6089': []uint16{252, 107, 401, 454, 130, 327, 227, 131, 120, 132, 330},
-		'\u608c': []uint16{413, 227, 327},
-		'\u608d': []uint16{200, 107, 203, 125, 215, 100, 115},
-		'\u6092': []uint16{162, 200, 262},
-		'\u6094': []uint16{252, 454, 152, 115, 315, 320, 325, 220, 215, 125, 256, 156, 327, 227},
-		'\u6096': []uint16{454, 125, 252, 352, 215, 152, 100},
-		'\u609a': []uint16{315, 413, 100, 200, 227},
-		'\u609b': []uint16{215, 413},
-		'\u609d': []uint16{227, 413},
-		'\u609f': []uint16{452, 252, 225, 215, 327, 152, 125, 229, 227, 200, 256, 331, 220, 330, 454, 325, 230},
-		'\u60a0': []uint16{100, 262, 162, 362, 252, 452, 115, 101, 103, 227, 152, 120, 327, 320, 325, 225},
-		'\u60a3': []uint16{125, 452, 225, 152, 215, 252, 115, 217, 315, 317, 325, 320, 216, 220},
-		'\u60a6': []uint16{200, 454, 227, 100, 315, 252, 132, 327, 130, 402, 325, 203},
-		'\u60a8': []uint16{438, 120, 138, 140, 320, 227},
-		'\u60ab': []uint16{402, 227},
-		'\u60ac': []uint16{452, 152, 125, 100, 115, 252, 320, 315, 227, 325, 215, 132, 327, 156},
-		'\u60ad': []uint16{100, 413},
-		'\u60af': []uint16{252, 413, 256, 262, 315, 227, 327},
-		'\u60b1': []uint16{315, 454, 100},
-		'\u60b2': []uint16{100, 125, 200, 403, 454, 103, 115, 152, 225, 215, 315, 327, 402, 120, 220, 162, 101, 362, 252, 320, 227},
-		'\u60b4': []uint16{200, 413, 220},
-		'\u60b8': []uint16{252, 215, 156, 225},
-		'\u60bb': []uint16{107, 207, 307},
-		'\u60bc': []uint16{325, 252, 152, 454, 327, 125, 256, 156},
-		'\u60c5': []uint16{125, 225, 425, 252, 200, 315, 325, 152, 207, 115, 215, 120, 320, 256, 220, 227, 100, 238, 143, 327, 331, 343, 332, 352, 132, 203, 317},
-		'\u60c6': []uint16{100},
-		'\u60ca': []uint16{100, 452, 152, 252, 200, 315, 115, 215, 125, 320, 227, 220, 101, 120, 156, 143, 327, 325},
-		'\u60cb': []uint16{100, 252},
-		'\u60d1': []uint16{252, 200, 256, 452, 315, 325, 220, 320, 152, 225, 227, 454, 327, 115},
-		'\u60d5': []uint16{252, 325, 413, 227, 215, 327, 315},
-		'\u60d8': []uint16{200, 162, 115},
-		'\u60da': []uint16{200, 215, 362, 262, 459, 201},
-		'\u60dc': []uint16{252, 454, 200, 152, 127, 227, 315, 215, 327, 220, 320, 115, 256, 100, 156},
-		'\u60dd': []uint16{100, 459},
-		'\u60df': []uint16{407, 152, 104, 206, 107, 406, 327, 227, 115, 315, 106, 252, 445},
-		'\u60e0': []uint16{256, 127, 327, 130, 427, 200, 227, 252, 426, 325, 132, 152, 225, 320, 232, 230, 217, 129, 215, 131, 115, 315, 454, 229, 330},
-		'\u60e6': []uint16{152, 452, 227},
-		'\u60e7': []uint16{203, 452, 252, 200, 152, 325, 215, 125, 327, 100},
-		'\u60e8': []uint16{100, 125, 400, 200, 152, 162, 115, 362, 262, 315, 156, 401, 215, 227},
-		'\u60e9': []uint16{152, 252, 454, 156, 125, 225, 315, 115, 120, 325},
-		'\u60eb': []uint16{252, 200, 320, 413, 100, 325},
-		'\u60ec': []uint16{100},
-		'\u60ed': []uint16{100, 200, 402, 315, 215, 227},
-		'\u60ee': []uint16{215, 252, 454},
-		'\u60ef': []uint16{225, 125, 452, 352, 152, 325, 252, 107, 215, 315, 156, 320},
-		'\u60f0': []uint16{125, 402, 200, 120, 132},
-		'\u60f3': []uint16{452, 152, 225, 252, 320, 125, 256, 120, 325, 215, 107, 315, 115, 227, 331, 220, 352, 232, 327, 300, 156, 356, 200},
-		'\u60f4': []uint16{115, 315, 162, 262, 362},
-		'\u60f6': []uint16{100, 315, 200, 115, 162, 215, 262, 201, 362},
-		'\u60f9': []uint16{452, 152, 252, 115, 120, 100, 315, 330, 230, 227, 327, 200, 320},
-		'\u60fa': []uint16{315, 362, 262, 115, 120, 320, 215, 100, 162},
-		'\u6100': []uint16{459},
-		'\u6101': []uint16{452, 252, 200, 125, 115, 100, 315, 225, 327, 320, 215, 120, 227, 330, 220},
-		'\u6106': []uint16{413, 225, 152},
-		'\u6108': []uint16{407, 107, 252, 207, 152, 120, 320, 227, 228, 315, 327, 220, 325, 328},
-		'\u6109': []uint16{100, 200},
-		'\u610d': []uint16{413, 327},
-		'\u610e': []uint16{413, 315, 203},
-		'\u610f': []uint16{125, 252, 225, 426, 207, 152, 130, 100, 325, 200, 215, 315, 320, 201, 300, 330, 120, 220, 115, 227, 352, 307, 156, 331, 204, 417, 132, 332, 131, 327, 256, 232, 117, 304},
-		'\u6115': []uint16{100, 200, 252},
-		'\u611a': []uint16{227, 100, 452, 125, 152, 115, 225, 127, 215, 327, 120, 143, 200, 315, 220},
-		'\u611f': []uint16{152, 125, 225, 454, 200, 252, 325, 100, 320, 120, 156, 115, 143, 204, 230, 315, 227, 132, 352, 330, 215, 220, 203, 232, 256, 332},
-		'\u6120': []uint16{152, 125},
-		'\u6123': []uint16{452, 152, 252, 362, 262, 162, 215},
-		'\u6124': []uint16{152, 200, 100, 225, 456, 107, 315, 207, 115, 156, 252, 220, 454, 256, 325, 125, 426, 201, 120, 203},
-		'\u6126': []uint16{413, 200},
-		'\u6127': []uint16{200, 252, 454, 215, 100, 207, 352, 152, 125, 315, 320, 227, 101, 220, 327, 256},
-		'\u612b': []uint16{413, 225, 227, 327},
-		'\u613f': []uint16{452, 152, 252, 225, 125, 325, 215, 207, 315, 331, 352, 220, 227, 256, 217, 327, 115, 320, 204},
-		'\u6148': []uint16{100, 129, 127, 402, 227, 125, 200, 327, 130, 315, 320, 229, 115, 332, 330, 232, 331},
-		'\u614a': []uint16{413, 227},
-		'\u614c': []uint16{400, 452, 101, 200, 203, 100, 315, 362, 162, 325, 201, 115, 262, 252, 152, 320, 225},
-		'\u614e': []uint16{200, 100, 207, 215, 152, 400, 127, 227, 220, 327, 427, 315, 120, 225, 402, 228, 115, 401},
-		'\u6151': []uint16{252, 454, 225, 256, 325, 152},
-		'\u6155': []uint16{127, 252, 130, 427, 327, 330, 426, 152, 227, 220, 225, 454, 256, 120, 331, 332, 125, 230},
-		'\u615d': []uint16{413},
-		'\u6162': []uint16{107, 207, 400, 200, 104, 252, 125, 162, 220, 225, 152, 115, 132, 325, 320, 256, 304, 120, 315, 227, 362, 106, 143, 327},
-		'\u6167': []uint16{127, 227, 225, 327, 426, 200, 129, 125, 320, 220, 330, 315, 100, 120, 132},
-		'\u6168': []uint16{200, 252, 315, 201, 152, 107, 320, 426, 215, 402, 156, 225, 115, 120, 327, 300, 227},
-		'\u6170': []uint16{252, 152, 200, 454, 227, 327, 125, 256, 156, 325, 215, 203},
-		'\u6175': []uint16{413, 100},
-		'\u6177': []uint16{100, 115, 101, 454, 315, 227, 220, 327},
-		'\u618b': []uint16{452, 100, 120},
-		'\u618e': []uint16{152, 454, 225, 200, 315, 215, 402, 327},
-		'\u6194': []uint16{100, 320},
-		'\u619d': []uint16{413},
-		'\u61a7': []uint16{152, 320, 220},
-		'\u61a8': []uint16{426, 100, 152, 200, 327, 125, 120, 362, 227, 162, 402},
-		'\u61a9': []uint16{252, 454, 152, 256, 227},
-		'\u61ac': []uint16{252, 152},
-		'\u61b7': []uint16{413, 252, 227},
-		'\u61be': []uint16{200, 225, 426, 252, 215, 402, 125, 220},
-		'\u61c2': []uint16{452, 152, 100, 252, 200, 220, 320, 215, 315, 262, 227, 325},
-		'\u61c8': []uint16{454, 200, 262, 100, 215, 320, 315, 252, 220},
-		'\u61ca': []uint16{152, 156},
-		'\u61cb': []uint16{413, 227, 327, 125, 132},
-		'\u61d1': []uint16{200, 413, 225},
-		'\u61d2': []uint16{400, 152, 125, 162, 100, 252, 200, 225, 215, 352, 315, 220, 327},
-		'\u61d4': []uint16{413, 227},
-		'\u61e6': []uint16{402, 101, 200, 125, 100, 327},
-		'\u61f5': []uint16{452, 152, 120, 320, 362, 162, 227},
-		'\u61ff': []uint16{413, 227, 327, 125},
-		'\u6208': []uint16{127, 125, 327, 227, 427, 252, 129, 225, 330, 229, 315, 332, 329, 426, 215, 220, 132, 230, 130, 325, 320, 232, 222, 128, 235},
-		'\u620a': []uint16{423, 125, 120, 325, 122, 132, 327, 332, 227, 232, 143},
-		'\u620b': []uint16{459},
-		'\u620c': []uint16{320, 222, 332, 325, 322, 143},
-		'\u620d': []uint16{454, 152, 252, 204, 125, 325, 227, 225, 331, 327},
-		'\u620e': []uint16{427, 127, 227, 125, 115, 327, 152, 315, 225, 215, 229, 252, 120},
-		'\u620f': []uint16{125, 225, 425, 252, 152, 331, 325, 220, 215, 132, 352, 315, 320, 232, 217, 117, 343, 131, 156, 332, 227},
-		'\u6210': []uint16{252, 152, 452, 125, 100, 228, 227, 222, 130, 320, 325, 127, 225, 315, 327, 332, 156, 229, 115, 256, 215, 422, 107, 204, 230, 232, 120, 328, 104, 220, 132, 143, 317, 352, 330, 117, 131, 200, 122, 300, 129, 322, 329, 128, 207, 124, 331},
-		'\u6211': []uint16{438, 138, 125, 238, 320, 315, 225, 325, 252, 115, 132, 204, 215, 120, 207, 227, 327, 229},
-		'\u6212': []uint16{225, 125, 452, 152, 256, 252, 325, 115, 215, 315, 156, 130, 220, 320, 343, 327, 100, 132, 227},
-		'\u6215': []uint16{152, 156},
-		'\u6216': []uint16{406, 106, 107, 206, 307, 207, 320, 227, 152, 125},
-		'\u6217': []uint16{452, 200},
-		'\u6218': []uint16{125, 252, 454, 225, 332, 156, 232, 256, 152, 325, 143, 217, 331, 127, 215, 320, 315, 204, 220, 227, 115, 229, 327, 162, 132, 200, 238, 228, 120, 117, 330, 317},
-		'\u621a': []uint16{225, 127, 427, 325, 227, 200, 315, 327, 130, 252, 220, 128, 125},
-		'\u621b': []uint16{130, 115, 325},
-		'\u621f': []uint16{426, 225, 227, 325, 215, 315, 327},
-		'\u6221': []uint16{454, 152, 227, 327},
-		'\u6222': []uint16{427},
-		'\u6224': []uint16{413},
-		'\u6225': []uint16{452},
-		'\u622a': []uint16{152, 452, 252, 222, 125, 315, 115, 107, 156, 325, 322, 207, 225, 332, 256, 435, 327},
-		'\u622c': []uint16{227, 413, 327},
-		'\u622e': []uint16{252, 256, 115, 315},
-		'\u6233': []uint16{452, 152, 225, 125, 252, 352, 327},
-		'\u6234': []uint16{452, 127, 252, 225, 315, 132, 330, 120, 327, 129, 227, 115, 128, 256, 152, 215, 325, 130, 320},
-		'\u6237': []uint16{225, 435, 125, 222, 252, 325, 422, 215, 315, 230, 142, 238, 220, 117, 127, 330, 327, 132, 207, 227, 332, 130, 135, 235, 256, 232, 217, 229, 115},
-		'\u623d': []uint16{452},
-		'\u623e': []uint16{413, 200, 262},
-		'\u623f': []uint16{125, 225, 117, 425, 252, 142, 325, 256, 127, 130, 330, 227, 152, 230, 132, 315, 327, 232, 217, 204, 220, 332, 156, 331, 131, 320},
-		'\u6240': []uint16{445, 106, 225, 125, 104, 252, 152, 315, 217, 306, 231, 222, 320, 422, 325, 115, 230, 120, 238, 215, 107, 327, 232, 130, 227, 129, 342, 330, 229, 220},
-		'\u6241': []uint16{400, 125, 104, 127, 162, 227, 120, 152, 327, 362, 325},
-		'\u6243': []uint16{413},
-		'\u6247': []uint16{435, 225, 125, 222, 452, 152, 425, 252, 422, 215, 315, 115, 327, 232, 325, 132, 130},
-		'\u6248': []uint16{127, 200, 125, 427, 215, 330, 329, 130, 227, 327},
-		'\u6249': []uint16{225, 125},
-		'\u624b': []uint16{125, 425, 225, 252, 142, 152, 207, 222, 325, 315, 104, 422, 332, 115, 200, 320, 215, 204, 220, 132, 100, 362, 352, 120, 107, 227, 322, 327, 256, 304, 117, 231, 330, 229, 300, 232, 130},
-		'\u624d': []uint16{407, 225, 243, 227, 207, 152, 125, 315, 327, 252, 215, 107, 115, 200, 330, 220, 325, 120, 256, 127, 228, 320, 130, 329, 328},
-		'\u624e': []uint16{252, 452, 100, 152, 130, 327, 330, 129, 225, 227, 156, 315, 229, 127, 162, 362, 230, 215, 125, 329, 325, 132, 256, 115},
-		'\u6251': []uint16{452, 152, 133, 225, 252, 125, 115, 325, 120, 362, 262, 162, 320, 315, 256, 327, 100},
-		'\u6252': []uint16{452, 152, 125, 315, 330, 227, 225, 156},
-		'\u6253': []uint16{152, 452, 252, 125, 315, 120, 225, 222, 115, 320, 156, 331, 215, 234, 325, 232, 220, 132, 352, 227, 117, 327, 256, 230, 422, 207, 332, 107, 130, 330},
-		'\u6254': []uint16{452, 152},
-		'\u6258': []uint16{252, 452, 225, 152, 325, 127, 125, 227, 327, 129, 229, 330, 256, 352, 230, 332, 329, 130, 156, 204, 331, 315, 132, 232, 217, 120, 117},
-		'\u625b': []uint16{452, 152, 120},
-		'\u6263': []uint16{152, 452, 225, 252, 125, 215, 220, 115, 227, 325, 232, 320, 156, 327},
-		'\u6266': []uint16{152, 125},
-		'\u6267': []uint16{152, 156, 452, 125, 252, 100, 107, 325, 200, 227, 315, 327, 115, 120, 331, 225, 117, 132, 320, 330},
-		'\u6269': []uint16{152, 454, 227, 125, 156, 317, 325, 120, 104, 132, 256},
-		'\u626a': []uint16{115, 315},
-		'\u626b': []uint16{152, 452, 252, 125, 100, 315, 156, 120, 352, 320, 115, 325, 332, 232, 117, 256},
-		'\u626c': []uint16{252, 130, 454, 152, 315, 227, 215, 125, 132, 127, 115, 200, 327, 230, 330, 229, 256, 329, 225, 325, 320, 156, 107, 452, 129, 120, 228, 352, 232, 362, 262},
-		'\u626d': []uint16{152, 452, 125, 200, 120, 362, 262, 252, 100, 162, 115, 315, 320, 132},
-		'\u626e': []uint16{252, 152, 452, 215, 125, 320},
-		'\u626f': []uint16{452, 252, 152, 320, 220, 215, 315, 156},
-		'\u6270': []uint16{252, 452, 152, 156, 256, 325, 220, 332, 125, 215, 227, 315, 327},
-		'\u6273': []uint16{452, 125, 152, 225, 456},
-		'\u6276': []uint16{452, 152, 252, 130, 127, 125, 156, 227, 325, 115, 204, 315, 327, 162, 107, 120, 331, 232, 117, 132, 332, 131, 320},
-		'\u6279': []uint16{222, 152, 435, 252, 125, 156, 207, 107, 322, 325, 422, 204, 320, 225, 120, 256, 122, 330, 117, 115},
-		'\u627c': []uint16{152, 454, 100, 320, 101, 120, 156},
-		'\u627e': []uint16{452, 152, 252, 125, 200, 320, 132, 120},
-		'\u627f': []uint16{152, 252, 327, 125, 427, 325, 227, 156, 454, 127, 225, 130, 417, 215, 220, 115, 320, 132, 256, 232, 104, 100, 315, 120, 328, 304, 352, 332},
-		'\u6280': []uint16{125, 225, 325, 232, 426, 331, 120, 117, 417, 304, 217, 231, 330, 315, 215, 317, 320, 332, 327, 152, 227, 220, 252, 352, 104},
-		'\u6284': []uint16{452, 152, 252, 125, 325, 225, 204, 120, 227, 156, 256},
-		'\u6289': []uint16{152, 156, 454},
-		'\u628a': []uint16{434, 222, 152, 125, 225, 325, 320, 252, 352, 232, 422, 215, 220, 322, 343, 315, 327, 132, 332, 227, 120, 115},
-		'\u6291': []uint16{152, 252, 454, 100, 125, 106, 115, 132, 156, 327, 120, 325, 256, 227, 332, 320},
-		'\u6292': []uint16{152, 125, 156, 454, 315, 227},
-		'\u6293': []uint16{152, 452, 252, 125, 320, 115, 220, 132, 120, 215, 156},
-		'\u6295': []uint16{156, 125, 152, 452, 252, 217, 315, 331, 115, 117, 317, 132, 332, 325, 330, 230, 320, 231, 215, 327, 120, 100, 256},
-		'\u6296': []uint16{452, 252, 152, 315, 115, 362, 262, 156, 227, 120, 327},
-		'\u6297': []uint16{152, 452, 252, 156, 125, 132, 256, 325, 120, 117, 225, 131, 227, 320, 327, 315, 215, 104, 331, 232, 330, 332, 204},
-		'\u6298': []uint16{152, 452, 125, 225, 200, 252, 422, 222, 256, 325, 156, 315, 127, 215, 352, 227, 104, 220, 327, 362, 262, 320, 130, 132, 115},
-		'\u629a': []uint16{454, 152, 225, 252, 327, 130, 227, 256, 125, 330, 156, 115, 325, 132, 131, 331, 315},
-		'\u629b': []uint16{452, 152, 125, 156, 115, 120},
-		'\u629f': []uint16{452, 227},
-		'\u62a0': []uint16{452, 100, 152, 120, 320},
-		'\u62a1': []uint16{452, 227},
-		'\u62a2': []uint16{152, 452, 100, 252, 125, 227, 315, 320, 120, 217, 325, 132, 130, 327, 156},
-		'\u62a4': []uint16{252, 325, 125, 152, 452, 256, 225, 132, 156, 327, 227, 320, 330, 230, 332, 232, 215, 331},
-		'\u62a5': []uint16{225, 125, 152, 425, 252, 238, 256, 232, 325, 156, 315, 231, 217, 331, 227, 320, 120, 215, 115, 220, 327, 130, 230, 132, 332},
-		'\u62a8': []uint16{152},
-		'\u62ab': []uint16{152, 452, 125, 252, 115, 315, 225, 120, 327, 132, 227, 330, 131},
-		'\u62ac': []uint16{152, 452, 315, 125, 120, 252, 320, 325},
-		'\u62b1': []uint16{452, 152, 252, 225, 100, 125, 115, 227, 315, 327, 120, 320, 304, 220, 130, 129},
-		'\u62b5': []uint16{152, 156, 452, 252, 125, 207, 320, 225, 107, 204},
-		'\u62b9': []uint16{452, 252, 152, 222, 125, 315, 215, 227, 320, 352},
-		'\u62bb': []uint16{452},
-		'\u62bc': []uint16{252, 452, 256, 152, 125, 325, 100, 327, 227, 225, 156},
-		'\u62bd': []uint16{152, 452, 100, 125, 120, 156, 315, 132, 362, 162, 104, 232, 331, 143, 115, 107},
-		'\u62bf': []uint16{452, 227, 125},
-		'\u62c2': []uint16{452, 252, 143, 152, 115, 327, 125, 256, 227, 315},
-		'\u62c4': []uint16{452, 152},
-		'\u62c5': []uint16{152, 252, 156, 225, 452, 125, 222, 435, 325, 320, 115, 227, 220, 422, 315, 235},
-		'\u62c6': []uint16{152, 452, 252, 125, 325, 156, 315, 332, 120, 352, 225, 117, 227, 327},
-		'\u62c7': []uint16{325, 125},
-		'\u62c8': []uint16{452, 152, 115, 315},
-		'\u62c9': []uint16{452, 330, 152, 327, 229, 325, 127, 227, 130, 230, 129, 329, 125, 332, 225, 132, 252, 235, 117, 232, 331, 320, 120, 256, 315, 362, 262, 217, 222, 233, 322, 115, 304, 333, 131, 156, 162, 215, 200, 220, 101, 100},
-		'\u62ca': []uint16{413},
-		'\u62cc': []uint16{452, 252, 152, 325, 156, 256, 320, 225, 332},
-		'\u62cd': []uint16{152, 452, 252, 125, 225, 325, 115, 315, 227, 320, 120, 220, 352, 331, 256, 327},
-		'\u62ce': []uint16{452, 125, 227},
-		'\u62d0': []uint16{125, 452, 152, 115, 142, 325, 227, 225, 320, 252, 327, 217, 220, 317},
-		'\u62d2': []uint16{152, 454, 252, 120, 130, 115, 215, 320, 132, 327},
-		'\u62d3': []uint16{152, 252, 452, 127, 125, 325, 227, 315, 320, 132, 327, 200, 232, 304},
-		'\u62d4': []uint16{225, 452, 252, 152, 327, 200, 315, 227, 115, 215, 100, 320, 156, 325, 125, 232},
-		'\u62d6': []uint16{452, 152, 125, 325, 217, 156, 320, 220, 132, 115, 100, 227, 162, 362, 252, 327, 322, 315, 230, 120, 122, 231},
-		'\u62d7': []uint16{400, 125, 200, 252, 152, 100, 327},
-		'\u62d8': []uint16{152, 454, 100, 252, 315, 125, 217, 332, 156},
-		'\u62d9': []uint16{200, 402, 100, 215, 130, 227, 125, 252, 315},
-		'\u62da': []uint16{152},
-		'\u62db': []uint16{152, 125, 425, 156, 131, 117, 452, 127, 222, 352, 225, 115, 120, 315, 252, 227, 325, 217, 101, 327, 130, 320, 220, 132, 215, 330, 422, 256, 332},
-		'\u62dc': []uint16{452, 230, 152, 252, 225, 330, 125, 325, 227, 327, 129, 130, 131, 343, 215, 229, 220, 315, 132, 127, 243, 256, 332},
-		'\u62df': []uint16{452, 252, 152, 204, 107, 125, 256, 215, 325, 304, 332, 104, 352, 156},
-		'\u62e2': []uint16{252, 452, 320, 227, 152, 107, 256},
-		'\u62e3': []uint16{452, 252, 152, 315, 362, 262, 325, 327},
-		'\u62e5': []uint16{152, 452, 252, 100, 315, 125, 227, 215, 327, 115, 120, 352, 156, 320, 217},
-		'\u62e6': []uint16{152, 452, 252, 107, 125, 327, 132, 156, 232, 227},
-		'\u62e7': []uint16{452, 152, 120, 320, 227},
-		'\u62e8': []uint16{452, 152, 252, 115, 227, 325, 315, 125, 256, 107, 117, 132},
-		'\u62e9': []uint16{252, 452, 325, 152, 220, 315, 256, 107, 327, 227, 115, 156, 125, 120, 225, 130, 132},
-		'\u62ec': []uint16{252, 452, 125, 227, 325, 200, 332, 228, 315},
-		'\u62ed': []uint16{454, 252, 115, 152},
-		'\u62ee': []uint16{100, 125, 152},
-		'\u62ef': []uint16{152, 227, 454, 327},
-		'\u62f1': []uint16{452, 152, 125, 327, 130, 252, 325, 225, 227, 120, 232, 131, 104, 315},
-		'\u62f3': []uint16{125, 225, 425, 222, 252, 315, 227, 215, 115, 452, 232, 152, 325, 320},
-		'\u62f4': []uint16{452, 227, 125, 327, 225, 152},
-		'\u62f6': []uint16{413, 252},
-		'\u62f7': []uint16{454, 152, 125, 156, 352, 132},
-		'\u62fc': []uint16{152, 452, 107, 252, 125, 120, 325, 315, 156, 115, 227, 225, 132, 327},
-		'\u62fd': []uint16{452, 220, 227},
-		'\u62fe': []uint16{252, 452, 152, 215, 120, 115, 315, 227, 124, 220, 422, 125, 327},
-		'\u62ff': []uint16{452, 152, 330, 252, 125, 100, 120, 332, 325, 227, 315, 331, 327, 225, 230, 256, 320, 130, 229, 115, 127, 129, 228},
-		'\u6301': []uint16{252, 152, 153, 452, 125, 325, 227, 100, 315, 200, 225, 327, 256, 115, 143, 304, 215, 120, 320},
-		'\u6302': []uint16{452, 152, 252, 125, 225, 315, 215, 132, 256, 224, 320, 227, 332, 325, 120, 322, 104, 352, 122, 115, 232},
-		'\u6307': []uint16{125, 152, 452, 225, 156, 217, 325, 252, 232, 332, 320, 315, 115, 215, 330, 120, 352, 227, 327, 107, 331, 132, 256, 220},
-		'\u6308': []uint16{413, 252, 315},
-		'\u6309': []uint16{434, 134, 152, 107, 115, 125, 225, 156, 120, 327, 227, 325, 104, 320, 215},
-		'\u630e': []uint16{452, 125},
-		'\u6311': []uint16{156, 152, 452, 125, 315, 115, 320, 200, 120, 252, 352, 362, 327, 162, 225, 262, 100},
-		'\u6316': []uint16{452, 152, 125, 252, 115, 227, 132, 120, 325},
-		'\u631a': []uint16{200, 402, 125, 227, 327, 100},
-		'\u631b': []uint16{256, 325, 152, 252, 225},
-		'\u631d': []uint16{230, 459, 332, 330, 331},
-		'\u631e': []uint16{252, 413, 315, 152, 327},
-		'\u631f': []uint16{452, 152, 252, 115, 256},
-		'\u6320': []uint16{452, 252, 215, 152, 225, 125, 315, 130, 220},
-		'\u6321': []uint16{452, 252, 152, 225, 125, 215, 320, 325, 156, 115},
-		'\u6322': []uint16{413},
-		'\u6323': []uint16{152, 452, 252, 315, 227},
-		'\u6324': []uint16{452, 152, 252, 200, 156, 115, 315, 120, 132, 125, 256, 362, 162},
-		'\u6325': []uint16{252, 452, 152, 325, 225, 125, 227, 115, 120, 215, 315, 327, 331, 220, 320, 332, 156},
-		'\u6328': []uint16{452, 152, 107, 315, 115, 200, 227, 307, 120, 362, 262},
-		'\u632a': []uint16{130, 452, 152, 252, 125, 131, 417, 132, 327, 315, 320},
-		'\u632b': []uint16{252, 452, 125, 152, 215, 220, 120},
-		'\u632f': []uint16{152, 452, 327, 225, 252, 125, 156, 227, 200, 325, 220, 215, 115, 315, 127, 132, 454, 320, 332, 120, 130, 328, 330},
-		'\u6332': []uint16{252, 459},
-		'\u6339': []uint16{125, 413, 152},
-		'\u633a': []uint16{407, 152, 452, 227, 200, 100, 262, 115, 362, 327, 320, 252, 162, 215, 125, 228, 315},
-		'\u633d': []uint16{152, 452, 125, 315, 327, 332},
-		'\u6342': []uint16{452, 152, 327},
-		'\u6343': []uint16{413},
-		'\u6345': []uint16{452, 152, 120},
-		'\u6346': []uint16{452, 152, 222, 104, 252, 125, 325, 232, 422},
-		'\u6349': []uint16{452, 252, 152, 315, 115, 120, 156, 330},
-		'\u634b': []uint16{452, 152, 315},
-		'\u634c': []uint16{422, 122, 227, 322, 222},
-		'\u634d': []uint16{152, 125},
-		'\u634e': []uint16{452, 152, 107},
-		'\u634f': []uint16{452, 152, 252, 120, 200, 362, 262, 315, 320, 132, 115},
-		'\u6350': []uint16{152, 452, 125, 315, 256, 225, 120, 325, 252, 215},
-		'\u6355': []uint16{252, 152, 452, 125, 225, 115, 325, 156, 132, 256, 320},
-		'\u635e': []uint16{452, 252, 152, 315, 330, 256, 325, 120, 225, 125, 130},
-		'\u635f': []uint16{125, 152, 256, 252, 454, 456, 225, 156, 325, 115, 215, 227, 352, 320, 220, 200, 327, 315},
-		'\u6361': []uint16{452, 152, 252, 120},
-		'\u6362': []uint16{252, 452, 152, 132, 106, 320, 315, 129, 325, 256, 156, 125, 215, 332, 327, 227, 317, 220},
-		'\u6363': []uint16{152, 452, 252, 125, 352, 315},
-		'\u6367': []uint16{452, 252, 152, 227, 315, 120, 156, 327, 256},
-		'\u6369': []uint16{252, 413, 325},
-		'\u636d': []uint16{315},
-		'\u636e': []uint16{225, 234, 434, 152, 252, 325, 107, 125, 330, 220, 115, 200, 256, 320, 215, 315, 352, 327},
-		'\u6376': []uint16{452, 152, 115, 315},
-		'\u6377': []uint16{130, 200, 417, 227, 225, 327, 125, 252, 262, 132, 232, 220, 115, 330, 325, 207, 329, 356, 320, 215, 127, 315, 152, 131, 129, 229, 228},
-		'\u637a': []uint16{315, 425, 452, 252},
-		'\u637b': []uint16{452, 125, 225, 152, 325},
-		'\u6380': []uint16{152, 452, 115},
-		'\u6382': []uint16{452, 152, 352, 115},
-		'\u6387': []uint16{252, 413},
-		'\u6388': []uint16{225, 152, 454, 252, 227, 320, 125, 156, 315, 104, 327, 256, 325, 115, 132, 304, 220, 331},
-		'\u6389': []uint16{452, 252, 152, 115, 320, 215, 120},
-		'\u638a': []uint16{413},
-		'\u638c': []uint16{152, 125, 425, 225, 452, 252, 117, 127, 215, 104, 327, 325, 227, 156, 315, 232, 320, 115, 220, 120},
-		'\u638e': []uint16{115, 413},
-		'\u638f': []uint16{152, 452, 227},
-		'\u6390': []uint16{452, 152, 115, 320},
-		'\u6392': []uint16{152, 252, 125, 452, 256, 222, 156, 225, 132, 242, 207, 422, 115, 320, 317, 120, 315, 217, 143, 325, 231, 227, 130, 331, 117, 352, 330, 100},
-		'\u6396': []uint16{452, 230, 252, 130, 330, 256},
-		'\u6398': []uint16{252, 452, 152, 325, 125, 327, 315, 256, 332},
-		'\u63a0': []uint16{152, 452, 252, 325, 125, 220, 156, 256, 315, 320, 107, 104, 115},
-		'\u63a2': []uint16{152, 452, 125, 252, 156, 256, 127, 225, 325, 115, 227, 315, 327, 320, 132, 120, 143},
-		'\u63a3': []uint16{452, 152, 215, 320, 156, 252},
-		'\u63a5': []uint16{152, 201, 452, 252, 106, 204, 125, 107, 306, 325, 120, 225, 315, 115, 256, 156, 320, 215, 117, 227, 132, 332, 327, 104, 304, 317, 220},
-		'\u63a7': []uint16{152, 256, 252, 452, 125, 217, 156, 204, 225, 325, 332, 132, 232, 120, 331, 117},
-		'\u63a8': []uint16{152, 452, 125, 156, 256, 115, 252, 325, 220, 315, 227, 320, 132, 332, 204, 352, 120, 215},
-		'\u63a9': []uint16{152, 452, 252, 125, 156, 115, 352, 315, 332, 215, 120, 256, 220, 327},
-		'\u63aa': []uint16{125, 225, 215, 252, 152, 115, 454, 156, 227, 129, 327, 130, 325, 120, 127},
-		'\u63ac': []uint16{452, 215, 220, 327},
-		'\u63ad': []uint16{452},
-		'\u63ae': []uint16{125},
-		'\u63b0': []uint16{452, 152, 327, 252},
-		'\u63b3': []uint16{454, 152},
-		'\u63b4': []uint16{452},
-		'\u63b7': []uint16{452, 252, 215, 125, 315, 115, 152},
-		'\u63b8': []uint16{452, 130, 325, 125},
-		'\u63ba': []uint16{152, 452, 125, 132, 120},
-		'\u63be': []uint16{413},
-		'\u63c4': []uint16{252, 413},
-		'\u63c6': []uint16{413, 227},
-		'\u63c9': []uint16{452, 152, 315, 227},
-		'\u63cd': []uint16{452, 252},
-		'\u63ce': []uint16{452, 115},
-		'\u63cf': []uint16{152, 252, 225, 452, 325, 315, 125, 227, 156, 115},
-		'\u63d0': []uint16{152, 452, 225, 125, 325, 156, 252, 227, 327, 204, 315, 115, 230, 330, 320, 220, 104, 215, 229, 129, 329, 130, 304, 232, 120, 331, 256, 132, 332, 107, 317},
-		'\u63d2': []uint16{452, 152, 125, 252, 115, 315, 156, 225, 320, 132, 325, 120, 107, 130},
-		'\u63d6': []uint16{454, 327, 252, 227, 215, 152},
-		'\u63e0': []uint16{115},
-		'\u63e1': []uint16{252, 452, 152, 220, 132, 215, 115, 325, 125, 327},
-		'\u63e3': []uint16{452, 152, 252, 227},
-		'\u63e9': []uint16{452, 152, 227},
-		'\u63ea': []uint16{452, 100, 152, 115},
-		'\u63ed': []uint16{152, 452, 256, 252, 125, 130, 115, 120, 127, 215, 129, 315},
-		'\u63f2': []uint16{413},
-		'\u63f4': []uint16{252, 156, 454, 256, 152, 125, 225, 327, 317, 325, 215, 227, 332, 228, 220, 315, 217, 107},
-		'\u63f6': []uint16{152},
-		'\u63fd': []uint16{452, 320, 252, 152, 220, 215, 227, 315, 332, 327, 335},
-		'\u6400': []uint16{452, 152, 227},
-		'\u6401': []uint16{452, 252, 152, 132, 227},
-		'\u6402': []uint16{452, 152, 252, 125},
-		'\u6405': []uint16{452, 152, 252, 125, 315, 227, 156},
-		'\u640b': []uint16{452},
-		'\u640f': []uint16{152, 454, 252, 225, 426, 156, 325, 227, 332, 320, 220, 215, 327},
-		'\u6410': []uint16{252, 413, 152},
-		'\u6413': []uint16{452, 252, 152, 125, 132, 115},
-		'\u6414': []uint16{452, 152, 315, 115, 325},
-		'\u641b': []uint16{452},
-		'\u641c': []uint16{152, 452, 156, 132, 125, 115, 327, 320, 220, 131},
-		'\u641e': []uint16{452, 152, 252, 100, 200, 125, 120},
-		'\u6420': []uint16{452, 227, 327},
-		'\u6421': []uint16{252, 452, 352, 227},
-		'\u6426': []uint16{413, 252},
-		'\u642a': []uint16{125, 152, 452, 252},
-		'\u642c': []uint16{452, 152, 252, 156, 125, 315, 115, 120, 227},
-		'\u642d': []uint16{452, 152, 252, 125, 156, 325, 320, 362, 262, 120, 227, 315},
-		'\u6434': []uint16{413},
-		'\u643a': []uint16{152, 452, 304, 125, 252, 315, 204, 132, 120, 227, 325, 115, 320, 327},
-		'\u643d': []uint16{452, 152, 115, 227, 327},
-		'\u643f': []uint16{413},
-		'\u6441': []uint16{452},
-		'\u6444': []uint16{152, 125, 454, 252, 122, 322, 104, 135, 325, 315, 331, 228, 115, 132, 156},
-		'\u6445': []uint16{413},
-		'\u6446': []uint16{152, 452, 252, 125, 320, 225, 352, 215, 120, 315, 156, 104, 256, 332, 325},
-		'\u6447': []uint16{152, 452, 352, 252, 327, 125, 115, 315, 162, 362, 227, 215, 204, 120, 201, 320, 220, 256, 132, 156, 304, 325},
-		'\u6448': []uint16{152, 454},
-		'\u644a': []uint16{125, 425, 452, 152, 222, 225, 252, 256, 325, 142, 352, 220, 227, 422},
-		'\u6452': []uint16{152, 454, 120},
-		'\u6454': []uint16{452, 152, 225, 156, 220, 125},
-		'\u6458': []uint16{452, 152, 125, 252, 225, 156, 120, 315, 256, 227, 325},
-		'\u645e': []uint16{222, 452, 435},
-		'\u6467': []uint16{152, 454, 215, 156, 115, 125, 315, 227},
-		'\u6469': []uint16{132, 417, 125, 327, 152, 252, 130, 156, 129, 230, 227, 229, 330, 115, 100, 232, 127, 332, 325, 320, 452, 256, 117, 162, 331, 224, 329, 131, 315},
-		'\u646d': []uint16{413, 227},
-		'\u6478': []uint16{452, 252, 152, 125, 315, 120, 207, 215, 320, 227, 325, 362, 332, 162, 220, 304, 115, 256},
-		'\u6479': []uint16{452, 252, 152, 125, 227, 327},
-		'\u647a': []uint16{452, 152, 125},
-		'\u6482': []uint16{452, 152, 227},
-		'\u6484': []uint16{413, 327},
-		'\u6485': []uint16{452, 425, 262, 362},
-		'\u6487': []uint16{152, 452, 425, 222, 325, 435, 220, 320},
-		'\u6491': []uint16{252, 452, 152, 325, 125, 225, 227, 332, 120, 256},
-		'\u6492': []uint16{452, 152, 330, 125, 130, 230, 129, 252, 132, 229, 120, 227, 107, 327, 225, 325, 127, 115, 315, 329},
-		'\u6495': []uint16{452, 152, 120, 232},
-		'\u6496': []uint16{413},
-		'\u6499': []uint16{152},
-		'\u649e': []uint16{452, 152, 252, 256, 362, 262, 200, 215, 156, 325, 125, 315, 225, 220, 227},
-		'\u64a4': []uint16{152, 452, 252, 156, 227, 132},
-		'\u64a9': []uint16{452, 152, 100},
-		'\u64ac': []uint16{452, 152, 125},
-		'\u64ad': []uint16{256, 152, 452, 252, 325, 125, 331, 130, 229, 225, 220, 156, 227, 232, 304, 332, 315},
-		'\u64ae': []uint16{452, 435, 152, 222, 125, 156, 322, 327},
-		'\u64b0': []uint16{454, 152, 252, 125, 227, 325},
-		'\u64b5': []uint16{452, 152},
-		'\u64b7': []uint16{454, 152, 252},
-		'\u64b8': []uint16{125},
-		'\u64ba': []uint16{152},
-		'\u64bc': []uint16{252, 452, 152, 315, 325, 115, 120, 327, 320, 227},
-		'\u64c0': []uint16{452, 125, 227, 332},
-		'\u64c2': []uint16{152, 452, 215, 125, 352, 252, 115, 130},
-		'\u64c5': []uint16{454, 152, 107, 252, 115},
-		'\u64cd': []uint16{152, 452, 227, 125, 225, 127, 120, 325, 220, 115, 320, 252, 315, 425, 256, 327, 331, 100, 156},
-		'\u64ce': []uint16{225, 227, 452, 152, 327, 125, 325, 315},
-		'\u64d0': []uint16{413},
-		'\u64d2': []uint16{452, 252, 152, 227, 315, 327, 120, 215, 115, 320, 132, 125},
-		'\u64d8': []uint16{413, 225, 152},
-		'\u64de': []uint16{459, 215, 315, 252},
-		'\u64e2': []uint16{413, 152, 252, 227, 115},
-		'\u64e4': []uint16{452},
-		'\u64e6': []uint16{452, 252, 256, 152, 325, 120, 125, 315, 220, 320, 225, 115, 132},
-		'\u6500': []uint16{152, 452, 130, 227, 327, 252, 125, 215, 115, 156, 320, 120, 131},
-		'\u6509': []uint16{452},
-		'\u6512': []uint16{452, 252, 320, 152, 125, 227, 315},
-		'\u6518': []uint16{454, 315, 215, 327, 120, 252, 262, 152},
-		'\u6525': []uint16{452},
-		'\u652b': []uint16{152},
-		'\u652e': []uint16{452, 125},
-		'\u652f': []uint16{152, 125, 222, 225, 435, 156, 252, 325, 422, 127, 330, 317, 315, 227, 232, 115, 352, 327, 132, 117, 230, 256, 215, 238, 217, 120, 320, 220, 162},
-		'\u6536': []uint16{125, 152, 252, 452, 325, 225, 156, 256, 315, 115, 320, 227, 220, 317, 217, 132, 215, 120, 327, 332, 117, 304, 143},
-		'\u6538': []uint16{152, 315, 130, 320},
-		'\u6539': []uint16{152, 156, 452, 252, 125, 320, 217, 327, 115, 107, 117, 227, 325, 315, 256, 215, 332, 132, 220, 317, 331, 130, 120},
-		'\u653b': []uint16{152, 252, 452, 256, 125, 156, 325, 227, 315, 115, 327, 215, 120, 320, 132},
-		'\u653e': []uint16{152, 252, 452, 325, 125, 331, 332, 220, 227, 107, 343, 315, 120, 128, 100, 156, 215, 262, 200, 115, 320, 327, 304, 204, 330, 256, 117, 228, 232, 352, 230, 356, 132, 225, 130, 317},
-		'\u653f': []uint16{125, 225, 325, 331, 252, 227, 426, 320, 327, 117, 152, 120, 217, 317, 132, 332, 215, 330, 256, 304, 130, 321, 131, 228, 220, 156, 315, 229, 231, 352, 115, 328},
-		'\u6545': []uint16{406, 125, 225, 107, 252, 130, 215, 207, 152, 106, 425, 315, 330, 115, 132, 120, 325, 220, 327, 304, 200, 320},
-		'\u6548': []uint16{125, 200, 225, 252, 325, 152, 204, 426, 215, 320, 327, 227, 315, 220, 217, 332, 256, 352, 232, 230},
-		'\u6549': []uint16{413, 327},
-		'\u654c': []uint16{125, 426, 252, 225, 100, 215, 142, 315, 256, 152, 120, 227, 320, 325, 454, 331, 220, 332, 115},
-		'\u654f': []uint16{227, 100, 402, 200, 327, 325, 252, 125, 230, 330, 204, 232, 256, 320, 225, 220, 215, 228, 332},
-		'\u6551': []uint16{452, 252, 152, 156, 125, 315, 256, 120, 325, 320, 115, 332, 215, 327, 225, 330, 317, 131, 104},
-		'\u6555': []uint16{426, 125, 454, 227, 130},
-		'\u6556': []uint16{127, 427, 227, 125, 327, 331, 130, 454, 132},
-		'\u6559': []uint16{125, 156, 232, 225, 452, 252, 325, 320, 152, 217, 331, 227, 327, 256, 117, 315, 215, 317, 220, 231, 330, 143, 120, 131, 332, 104, 304, 115, 129, 328},
-		'\u655b': []uint16{252, 454, 152, 256, 215, 200, 327, 115, 227},
-		'\u655d': []uint16{438, 200, 142, 215, 125, 138, 115, 315, 320},
-		'\u655e': []uint16{452, 200, 152, 104, 100, 320, 227, 125, 327, 228},
-		'\u6562': []uint16{207, 452, 125, 200, 152, 252, 320, 107, 115, 315, 227, 327, 120, 325, 352},
-		'\u6563': []uint16{252, 452, 152, 125, 200, 325, 215, 256, 100, 225, 315, 227, 104, 327, 220, 156, 217, 115, 352, 362, 262, 329, 320, 425, 332, 304, 328},
-		'\u6566': []uint16{230, 130, 227, 152, 331, 427, 125, 327, 225, 417, 330, 100, 328, 131, 229, 315, 162, 156, 362, 129, 402},
-		'\u656b': []uint16{413},
-		'\u656c': []uint16{152, 252, 327, 452, 127, 200, 227, 125, 362, 262, 215, 115, 156, 315, 120, 220, 320, 330, 228},
-		'\u6570': []uint16{225, 125, 222, 122, 422, 325, 322, 220, 252, 207, 320, 215, 117, 152, 232, 200, 120, 352, 156, 227, 115, 315, 327, 331, 143, 104, 129, 332, 132},
-		'\u6572': []uint16{452, 152, 252, 125, 115, 120, 315, 132, 220, 320, 215},
-		'\u6574': []uint16{256, 125, 104, 152, 200, 107, 252, 156, 122, 422, 100, 207, 452, 322, 325, 362, 162, 120, 132, 315, 222, 115, 262, 343, 227, 327, 220, 225, 332, 320, 331},
-		'\u6577': []uint16{452, 152, 252, 315, 227, 115, 125, 327, 132, 325, 156},
-		'\u6587': []uint16{125, 225, 327, 426, 325, 227, 127, 232, 132, 120, 252, 320, 238, 222, 117, 331, 130, 332, 315, 131, 330, 329, 142, 115, 243, 100, 328, 200, 230, 129, 229, 228, 352, 215, 162, 128, 217, 235, 422, 356, 152, 317, 256, 143, 304, 156, 362},
-		'\u658b': []uint16{426, 227, 152, 252, 225, 125, 232, 332, 327, 143, 343, 230, 130, 127, 320},
-		'\u658c': []uint16{227, 427, 327, 228},
-		'\u6590': []uint16{417, 327, 227, 130, 162, 129, 329},
-		'\u6591': []uint16{125, 225, 425, 162, 325, 262, 220, 320, 315, 362, 327, 215, 332, 130, 115},
-		'\u6593': []uint16{459, 262, 215, 220, 227, 327},
-		'\u6597': []uint16{156, 256, 452, 325, 252, 225, 125, 320, 222, 152, 227, 215, 332, 315, 107, 327, 130, 115, 132, 200, 220, 232, 330, 422, 228, 352, 304},
-		'\u6599': []uint16{225, 425, 252, 220, 152, 325, 320, 215, 207, 232, 256, 315, 125, 452, 227, 204, 115, 331, 230, 162, 332, 304, 330},
-		'\u659b': []uint16{435, 227, 225, 127},
-		'\u659c': []uint16{452, 252, 125, 152, 225, 362, 262, 325, 400, 112, 320, 104, 327, 132, 330, 115, 207, 232},
-		'\u659f': []uint16{152, 452, 315, 227, 327},
-		'\u65a1': []uint16{330, 152, 332, 156},
-		'\u65a4': []uint16{222, 435, 235, 422, 315, 125, 115, 325, 225, 224, 320, 328},
-		'\u65a5': []uint16{252, 152, 454, 256, 315, 125, 325, 120, 320},
-		'\u65a7': []uint16{426, 125, 225, 232, 325, 215, 227, 315, 156},
-		'\u65a9': []uint16{452, 152, 115, 252, 315, 320, 204, 325, 232},
-		'\u65ab': []uint16{454, 327, 227},
-		'\u65ad': []uint16{207, 252, 452, 152, 125, 215, 325, 225, 107, 200, 315, 115, 220, 256, 330, 320, 201, 156, 104, 230, 227, 304, 120, 132, 352, 327},
-		'\u65af': []uint16{227, 330, 230, 327, 127, 332, 229, 329, 225, 232, 130, 427, 438, 325, 331, 129, 439, 125, 417, 328, 132, 100, 131, 315, 426, 115, 352, 220, 231, 320},
-		'\u65b0': []uint16{400, 125, 252, 130, 207, 104, 152, 200, 132, 100, 131, 327, 325, 204, 227, 332, 129, 232, 256, 330, 230, 127, 115, 215, 143, 107, 225, 217, 315, 156, 343, 120, 331, 220, 117, 317, 328, 320, 228, 329, 229, 304, 262, 128},
-		'\u65b9': []uint16{125, 225, 435, 212, 242, 335, 306, 322, 222, 127, 325, 100, 327, 238, 320, 324, 107, 227, 235, 207, 315, 143, 331, 200, 215, 132, 252, 232, 332, 204, 217, 330, 130, 115, 362, 422, 230, 262, 304, 220, 352, 128, 120, 328, 307, 162, 329, 224, 231, 256, 228, 131, 129},
-		'\u65bc': []uint16{427, 127, 410, 327, 227, 315},
-		'\u65bd': []uint16{225, 252, 152, 156, 127, 452, 327, 125, 227, 215, 129, 315, 230, 220, 330, 128, 130, 325, 229, 131, 256, 352, 132, 329, 332, 320, 232, 115},
-		'\u65c1': []uint16{112, 412, 212, 242, 138, 152, 125, 315, 130, 115, 320, 227, 225, 325, 330, 217, 120, 156},
-		'\u65c3': []uint16{413, 325, 125, 227},
-		'\u65c4': []uint16{413, 315},
-		'\u65c5': []uint16{156, 125, 425, 152, 225, 217, 130, 331, 132, 332, 227, 117, 325, 131, 252, 232, 231, 327},
-		'\u65c6': []uint16{413, 227},
-		'\u65cb': []uint16{152, 125, 407, 252, 225, 325, 107, 256, 227, 315, 104, 327, 304, 132, 330, 120, 115},
-		'\u65cc': []uint16{125, 320, 130, 152, 225, 129, 115},
-		'\u65ce': []uint16{459, 200, 215, 320},
-		'\u65cf': []uint16{225, 232, 426, 330, 325, 125, 238, 227, 435, 332, 320, 331, 252, 217, 352, 327, 304, 356, 215, 220},
-		'\u65d2': []uint16{413, 225},
-		'\u65d6': []uint16{100, 315, 120},
-		'\u65d7': []uint16{125, 225, 425, 227, 230, 331, 315, 327, 115, 330, 252, 320, 325, 256, 332, 232, 231, 129, 220},
-		'\u65e0': []uint16{452, 152, 107, 315, 125, 106, 115, 252, 104, 120, 100, 320, 122, 162, 130, 132, 143, 327, 127, 156, 204, 325, 227, 332, 220, 217, 207, 215, 101, 117, 304, 331, 322, 131, 103, 328},
-		'\u65e2': []uint16{406, 106, 104, 315, 115, 125, 143, 227, 327, 120},
-		'\u65e5': []uint16{422, 243, 130, 125, 417, 225, 222, 107, 143, 238, 332, 117, 104, 256, 207, 131, 152, 229, 315, 325, 320, 215, 330, 232, 204, 322, 115, 327, 127, 252, 220, 132, 122, 129, 227, 331, 120, 230, 329, 228, 135, 235, 100, 162, 328, 217, 343, 317, 156, 352, 304},
-		'\u65e6': []uint16{207, 243, 225, 227, 331, 230, 417, 215, 426, 315, 435, 343, 232, 143, 325, 327, 125, 330, 217, 454, 252, 320},
-		'\u65e7': []uint16{400, 125, 262, 207, 143, 200, 252, 225, 130, 104, 325, 230, 204, 217, 215, 320, 227, 315, 256, 115, 220, 330, 120, 129, 152, 317, 327},
-		'\u65e8': []uint16{426, 225, 152, 125, 252, 227, 327, 325, 215},
-		'\u65e9': []uint16{400, 143, 107, 207, 125, 243, 152, 112, 252, 220, 227, 320, 200, 315, 338, 115, 327, 131, 117, 225, 120, 307, 331, 156, 132, 332, 100, 127, 129, 232},
-		'\u65ec': []uint16{243, 435, 222, 125, 426, 217, 130, 227, 322, 327},
-		'\u65ed': []uint16{227, 327, 427, 328, 129, 125, 120, 130, 228, 115, 127},
-		'\u65ee': []uint16{125, 327},
-		'\u65ef': []uint16{225, 459, 227},
-		'\u65f0': []uint16{413, 315},
-		'\u65f1': []uint16{200, 125, 400, 225, 325, 156, 256, 252, 120, 117, 143, 343, 352, 227, 220, 327, 320},
-		'\u65f6': []uint16{125, 426, 206, 243, 238, 207, 225, 204, 201, 107, 325, 320, 338, 252, 307, 327, 230, 152, 315, 200, 343, 127, 120, 128, 215, 222, 100, 227, 304, 332, 220, 143, 224, 115, 331, 235, 217, 229, 228, 162, 362, 131, 352, 132, 256, 130, 129, 104, 322, 330, 328},
-		'\u65f7': []uint16{400, 127, 125, 200, 227, 427, 115, 315, 327, 104, 100, 107, 152, 362, 262, 215, 320},
-		'\u65fa': []uint16{400, 100, 125, 227, 200, 330, 327, 127, 152, 320, 230, 129, 220, 215, 229, 225, 317, 228, 130},
-		'\u6600': []uint16{227, 413, 327},
-		'\u6602': []uint16{100, 452, 227, 200, 230, 152, 215, 327, 127, 362, 262, 229, 129, 225, 454, 315, 232, 120, 332, 115, 329, 162, 130, 330, 131, 228},
-		'\u6603': []uint16{413, 215},
-		'\u6606': []uint16{130, 125, 417, 227, 327, 330, 332, 117, 131, 129, 127, 228, 217, 230, 331, 132, 320},
-		'\u660a': []uint16{427, 227, 327, 130, 132, 232, 228},
-		'\u660c': []uint16{230, 227, 327, 127, 130, 330, 417, 229, 427, 320, 332, 132, 325, 100, 228, 232, 129, 315, 125, 331, 215, 131},
-		'\u660e': []uint16{252, 143, 100, 152, 225, 227, 400, 200, 101, 127, 125, 327, 407, 325, 207, 230, 232, 330, 107, 243, 315, 228, 215, 115, 120, 162, 130, 132, 220, 320, 362, 262, 343, 402, 331, 117, 156, 332, 229, 352, 129, 307, 329, 317, 304, 201, 256, 128, 104, 356, 235, 131, 328},
-		'\u660f': []uint16{243, 152, 452, 100, 162, 252, 315, 120, 156, 362, 125, 320, 115, 444, 215, 307, 107, 327, 456, 325, 227, 400, 225},
-		'\u6613': []uint16{225, 256, 325, 200, 400, 127, 331, 207, 220, 152, 315, 125, 227, 215, 100, 232, 327, 330, 229, 130, 329, 132, 332, 320, 204, 343, 252, 115, 120, 128, 156, 131, 104, 129, 328, 230},
-		'\u6614': []uint16{143, 444, 243, 327, 315, 227, 225, 215, 130, 207},
-		'\u6615': []uint16{227, 413, 327},
-		'\u6619': []uint16{115, 125},
-		'\u661d': []uint16{427, 127},
-		'\u661f': []uint16{225, 125, 425, 227, 232, 143, 325, 327, 304, 200, 132, 115, 127, 315, 207, 330, 331, 104, 320, 252, 152, 130, 220, 215, 204, 120, 224, 343, 362, 262, 332, 230, 217, 322, 222, 328, 129, 231, 324, 228},
-		'\u6620': []uint16{252, 452, 152, 325, 127, 125, 215, 227, 120, 327, 315, 256, 232},
-		'\u6625': []uint16{444, 125, 143, 227, 327, 230, 225, 127, 243, 330, 343, 256, 315, 252, 228, 132, 325, 115, 152, 217, 117, 120, 220, 332, 320, 232, 331, 215, 156, 130, 129, 317, 229, 131, 304, 328},
-		'\u6627': []uint16{200, 452, 225, 315, 104, 215, 325, 227},
-		'\u6628': []uint16{143, 444, 227, 327},
-		'\u662d': []uint16{454, 227, 327, 129, 152, 330, 130, 132, 315, 125, 262, 162, 115, 100, 402, 329, 320},
-		'\u662f': []uint16{452, 206, 225, 207, 252, 152, 320, 220, 120, 238, 106, 306, 125, 215, 315, 143, 115, 232, 327, 217, 325, 138, 328, 352},
-		'\u6631': []uint16{227, 413, 327},
-		'\u6634': []uint16{413, 227},
-		'\u6635': []uint16{454, 252, 200, 125, 227},
-		'\u6636': []uint16{227, 413, 327},
-		'\u663c': []uint16{125, 225, 426, 227, 120, 143, 115, 444},
-		'\u663e': []uint16{152, 200, 452, 101, 100, 125, 252, 227, 327, 115, 127, 104, 132, 325, 315, 320, 232, 120, 117, 130, 225, 352, 230, 328, 329},
-		'\u6641': []uint16{127, 427, 227, 327},
-		'\u6643': []uint16{452, 252, 362, 152, 262, 227, 315, 327, 162, 100, 200, 232, 125, 325, 107},
-		'\u664b': []uint16{417, 243, 127, 130, 217, 227, 152, 225, 327, 117, 143, 132, 131, 452, 129, 125, 230, 330, 156, 320, 331, 228, 315, 115},
-		'\u664c': []uint16{222, 143, 435, 444, 243, 322, 252, 227},
-		'\u664f': []uint16{127, 227, 427, 327, 152, 330, 315, 215, 229, 402, 128},
-		'\u6652': []uint16{452, 252, 152, 125, 325, 215, 315, 229, 327, 227, 332, 132},
-		'\u6653': []uint16{327, 152, 252, 127, 454, 227, 215, 243, 328, 115, 220, 320, 130, 325, 232, 225, 125, 129, 256, 228},
-		'\u6654': []uint16{227, 413, 327},
-		'\u6655': []uint16{452, 252, 152, 225, 315, 115, 200, 162, 362, 227, 325, 320, 120, 215, 125},
-		'\u6656': []uint16{227, 427, 225, 327, 228, 332},
-		'\u6657': []uint16{227, 413, 327},
-		'\u665a': []uint16{143, 444, 243, 125, 207, 220, 225, 238, 152, 317, 315, 232, 227, 327, 325, 343, 332, 215, 228, 320, 117},
-		'\u665f': []uint16{227, 413, 327},
-		'\u6661': []uint16{413},
-		'\u6664': []uint16{252, 454, 325, 330, 331, 152, 227, 327},
-		'\u6666': []uint16{103, 200, 100, 215, 162, 315, 252, 125, 143},
-		'\u6668': []uint16{243, 125, 427, 227, 232, 332, 327, 444, 143, 152, 315, 228, 115, 331},
-		'\u666e': []uint16{101, 100, 427, 125, 227, 127, 327, 152, 132, 325, 130, 330, 156, 229, 332, 129, 230, 225, 204, 329, 232, 331, 131, 162, 362, 135, 115, 104, 402, 120, 117, 217, 228},
-		'\u666f': []uint16{225, 125, 426, 127, 325, 327, 100, 227, 130, 300, 132, 252, 315, 330, 230, 320, 152, 332, 220, 215, 256, 304, 232, 231, 128, 329},
-		'\u6670': []uint16{200, 252, 320, 325, 413, 227, 356},
-		'\u6674': []uint16{452, 227, 132, 125, 100, 327, 115, 120, 252, 152, 215, 220, 130, 225, 129, 162},
-		'\u6676': []uint16{125, 225, 325, 427, 227, 100, 327, 362, 262, 132, 332, 229, 115, 426, 117, 217, 120, 232, 228},
-		'\u6677': []uint16{413, 225, 215},
-		'\u667a': []uint16{125, 426, 227, 225, 127, 427, 327, 200, 130, 152, 417, 325, 215, 315, 328, 252, 320, 204, 115, 104, 220, 131, 232, 156, 317, 201, 217, 304, 117, 402, 229, 228},
-		'\u667e': []uint16{452, 152, 327, 227, 125},
-		'\u6682': []uint16{107, 407, 152, 200, 104, 125, 327, 227, 156},
-		'\u6684': []uint16{252, 413, 227},
-		'\u6687': []uint16{413, 225, 207, 215, 315, 227, 320},
-		'\u668c': []uint16{454, 152},
-		'\u6691': []uint16{252, 225, 143, 402, 444, 330, 125, 243, 325, 327, 220, 227, 320, 256, 315},
-		'\u6696': []uint16{400, 252, 203, 125, 327, 162, 200, 100, 362, 225, 325, 152, 320, 332, 227, 104, 215, 315, 204, 130, 256, 317, 132},
-		'\u6697': []uint16{107, 452, 152, 125, 200, 207, 225, 100, 400, 252, 262, 315, 156, 104, 115, 327, 215, 220, 320, 325, 401, 142, 162, 227},
-		'\u669d': []uint16{413},
-		'\u66a7': []uint16{100, 125, 115},
-		'\u66a8': []uint16{406, 131, 230, 117, 127, 330, 130, 325, 227, 327},
-		'\u66ae': []uint16{444, 125, 143, 315, 402, 225, 120, 327, 243, 215, 227, 115, 325},
-		'\u66b4': []uint16{152, 125, 225, 200, 425, 127, 100, 156, 315, 320, 115, 325, 252, 120, 400, 227, 215, 327, 201, 401},
-		'\u66b9': []uint16{130, 459, 227},
-		'\u66be': []uint16{413, 227},
-		'\u66d9': []uint16{327, 125, 132, 330, 328},
-		'\u66db': []uint16{413},
-		'\u66dc': []uint16{413, 227, 327, 232},
-		'\u66dd': []uint16{152, 454, 132, 125, 227, 315},
-		'\u66e6': []uint16{227, 427, 225, 327},
-		'\u66e9': []uint16{413},
-		'\u66f0': []uint16{454, 252, 327, 227, 206, 220, 315, 215},
-		'\u66f2': []uint16{225, 125, 435, 252, 100, 325, 127, 130, 222, 227, 230, 315, 362, 262, 162, 320, 330, 152, 327, 332, 115, 229, 220, 200, 120, 331, 129, 422, 204, 256, 132},
-		'\u66f3': []uint16{252, 125, 152, 325, 362, 320, 262, 132, 315},
-		'\u66f4': []uint16{407, 107, 152, 252, 243, 120, 100, 315, 115, 327, 125, 156, 215, 106, 332, 325, 127, 227, 320, 104, 132, 229, 143},
-		'\u66f7': []uint16{413},
-		'\u66f9': []uint16{127, 427, 130, 327, 227, 225, 128, 230, 315, 125, 115, 238, 320, 132},
-		'\u66fc': []uint16{227, 330, 130, 229, 402, 127, 230, 327, 325, 225, 129, 125, 329, 232, 332, 331, 300, 131, 100, 128, 200, 117, 203, 132, 315, 152},
-		'\u66fe': []uint16{407, 107, 207, 127, 227, 125, 130, 229, 327, 315, 128, 115, 228, 129},
-		'\u66ff': []uint16{434, 252, 152, 125, 225, 115, 156, 215, 256, 325, 220, 332, 327, 320, 452, 232, 132},
-		'\u6700': []uint16{407, 100, 107, 112, 143, 125, 162, 104, 152, 131, 120, 207, 331, 142, 227, 327, 117},
-		'\u6708': []uint16{422, 425, 125, 243, 222, 225, 143, 238, 117, 343, 327, 435, 325, 252, 332, 227, 207, 315, 215, 232, 330, 352, 127, 115, 220, 130, 324, 132, 228, 320, 229, 224, 331, 256, 120, 230},
-		'\u6709': []uint16{452, 252, 138, 152, 100, 204, 125, 156, 107, 325, 120, 256, 206, 104, 315, 320, 327, 115, 215, 207, 127, 200, 122, 331, 352, 227, 220, 328, 304, 232, 306, 132, 343, 356, 332, 322, 117, 228, 217},
-		'\u670a': []uint16{413},
-		'\u670b': []uint16{125, 325, 426, 320, 225, 227, 315, 327, 352, 115, 215, 132},
-		'\u670d': []uint16{156, 225, 252, 125, 152, 452, 200, 325, 256, 320, 227, 222, 215, 315, 220, 362, 327, 232, 262, 100, 217, 162, 127, 332, 331, 422, 115, 143},
-		'\u6710': []uint16{413, 230, 330},
-		'\u6714': []uint16{417, 125, 315, 230, 143, 227, 130, 228, 327, 330, 232, 243, 132, 200},
-		'\u6715': []uint16{413, 227, 125, 327},
-		'\u6717': []uint16{227, 200, 230, 427, 327, 330, 152, 329, 129, 332, 225, 262, 162, 356, 229, 215, 320, 222, 325, 315, 130, 232, 228, 100, 115, 402, 331, 125, 156, 362, 220, 132},
-		'\u671b': []uint16{252, 452, 225, 200, 152, 127, 125, 227, 325, 315, 115, 256, 215, 327, 130, 232, 220, 243, 204, 320, 120, 132, 230, 229, 330, 329, 331, 332, 328, 143},
-		'\u671d': []uint16{434, 243, 125, 225, 130, 327, 227, 152, 134, 132, 315, 252, 330, 115, 224, 220, 131, 217, 117, 325, 320, 332, 127, 328, 156, 331, 215, 129, 230},
-		'\u671f': []uint16{225, 212, 256, 243, 125, 207, 204, 112, 435, 252, 152, 217, 222, 325, 320, 343, 238, 422, 143, 331, 227, 117, 315, 232, 352, 215, 104, 156, 332, 327, 115, 224},
-		'\u6726': []uint16{100, 162, 362, 262, 125, 327, 227},
-		'\u6728': []uint16{125, 225, 425, 330, 127, 227, 327, 325, 230, 426, 252, 104, 315, 400, 320, 215, 232, 162, 115, 130, 229, 120, 100, 328, 132, 332, 129, 200, 331, 217, 329, 362, 204, 256, 220, 231},
-		'\u672a': []uint16{407, 143, 207, 152, 107, 315, 125, 320, 115, 227, 327, 325, 156, 130, 129, 120, 222, 329, 132, 328, 322, 232, 104},
-		'\u672b': []uint16{412, 243, 125, 206, 225, 112, 327, 227, 343, 143, 315, 329, 230, 325, 215, 320, 212, 200, 107, 120, 238, 330, 220},
-		'\u672c': []uint16{225, 138, 200, 438, 230, 325, 125, 143, 107, 220, 307, 330, 207, 222, 331, 320, 327, 204, 252, 227, 315, 238, 132, 134, 356, 127, 130, 422, 115, 152, 129, 229, 332, 215, 117, 328, 120, 304, 104, 228, 256, 329, 162, 362, 232, 224, 100},
-		'\u672d': []uint16{435, 426, 225, 125, 327, 130, 227, 129},
-		'\u672f': []uint16{225, 325, 452, 252, 426, 125, 331, 227, 220, 143, 320, 327, 330, 217, 215, 315, 204, 352, 332, 304},
-		'\u6731': []uint16{127, 427, 125, 227, 327, 128, 104, 129, 130, 315, 115, 131, 325, 225, 330, 132},
-		'\u6734': []uint16{200, 100, 127, 125, 427, 227, 225, 327, 115, 315, 129, 320, 262, 162, 362, 215, 128, 328, 228, 203},
-		'\u6735': []uint16{225, 222, 435, 227, 422, 230, 235, 135, 322, 320, 327, 125, 325, 129, 220, 132, 152},
-		'\u673a': []uint16{125, 225, 426, 325, 207, 204, 100, 256, 252, 104, 320, 315, 152, 220, 232, 156, 227, 142, 332, 330, 215, 238, 304, 331, 120, 217, 115, 131, 327, 229, 352, 132, 117, 317, 222, 101, 130},
-		'\u673d': []uint16{200, 262, 452, 225, 152, 127, 215, 320, 325, 252, 456, 115, 315},
-		'\u6740': []uint16{452, 152, 252, 125, 256, 115, 156, 315, 225, 325, 120, 132, 320, 215, 200, 332, 327, 232, 352, 130, 330, 220},
-		'\u6742': []uint16{200, 125, 452, 252, 400, 325, 156, 215, 100, 152, 115, 352, 315, 262, 204, 120, 320, 362, 256, 225, 220, 331, 232, 162, 201, 217, 327, 130, 132},
-		'\u6743': []uint16{225, 125, 425, 252, 325, 127, 227, 320, 217, 152, 327, 228, 315, 220, 107, 256, 332, 115, 331, 232, 221, 230, 330, 117, 207, 132, 120, 215, 317, 229, 143, 100},
-		'\u6746': []uint16{225, 125, 435, 222, 320, 325, 230, 132, 327, 232, 227, 204, 304, 422, 252},
-		'\u6748': []uint16{225, 425, 252},
-		'\u6749': []uint16{225, 426, 227, 330, 125, 232, 132, 325, 127, 331, 131, 327, 130, 129, 229},
-		'\u674c': []uint16{413, 125},
-		'\u674e': []uint16{127, 427, 128, 225, 227, 327, 125, 325, 130, 230, 315, 132, 215, 330, 131, 220, 115, 129},
-		'\u674f': []uint16{426, 125, 227, 225, 327, 130, 325, 104, 315, 232, 120},
-		'\u6750': []uint16{225, 125, 325, 425, 252, 227, 426, 320, 332, 232, 215, 315, 327, 220, 231, 331, 217, 132},
-		'\u6751': []uint16{125, 225, 425, 227, 230, 238, 142, 117, 104, 232, 327, 325, 229, 252, 130, 330, 120, 127, 156, 228, 220, 329, 320, 256, 331, 152, 332},
-		'\u6753': []uint16{413, 327, 227},
-		'\u6756': []uint16{225, 426, 327, 227, 252, 215, 330, 120},
-		'\u675c': []uint16{127, 125, 427, 152, 327, 227, 329, 130, 132, 332, 129, 454, 128, 331, 330, 131, 315, 229, 115, 120},
-		'\u675e': []uint16{130, 225, 325, 115, 125, 227, 131},
-		'\u675f': []uint16{252, 256, 225, 452, 152, 222, 325, 435, 115, 156, 127, 200, 125, 215, 204, 227, 327, 129, 422, 120, 320, 132},
-		'\u6760': []uint16{125, 225, 452, 252, 227, 230, 327},
-		'\u6761': []uint16{125, 222, 435, 225, 422, 224, 325, 200, 320, 307, 315, 120, 220, 238, 330, 362, 262, 252, 215, 207, 327, 229, 152, 332, 204, 227, 115, 230, 232, 130, 117, 352, 132, 331, 122},
-		'\u6765': []uint16{252, 452, 243, 152, 207, 212, 145, 125, 307, 220, 256, 206, 227, 143, 215, 230, 330, 315, 325, 320, 204, 229, 327, 225, 306, 127, 115, 352, 130, 362, 162, 332, 160, 232, 100, 120, 132, 228, 317, 129, 331, 156, 304, 200},
-		'\u6768': []uint16{127, 427, 227, 125, 225, 128, 327, 130, 325, 232, 330, 132, 230, 315, 215, 332, 228, 129, 120},
-		'\u6769': []uint16{459},
-		'\u676a': []uint16{413, 327, 227},
-		'\u676d': []uint16{127, 130, 417, 332, 125, 227, 230, 330, 327, 128, 131, 132, 229, 129},
-		'\u676f': []uint16{222, 225, 435, 125, 252, 232, 115, 325, 422, 142, 227, 200, 217, 322, 230, 320, 332, 235, 135, 215},
-		'\u6770': []uint16{227, 100, 327, 127, 427, 225, 125, 129, 426, 229, 130, 220, 215, 228, 315, 217, 332, 402, 329, 232},
-		'\u6772': []uint16{227, 427, 327},
-		'\u6773': []uint16{115, 402, 327, 227, 120, 152},
-		'\u6775': []uint16{452, 225, 125, 426, 227, 215, 327, 315},
-		'\u6777': []uint16{225, 459, 325, 330},
-		'\u677c': []uint16{413, 225, 227, 327, 215},
-		'\u677e': []uint16{200, 452, 225, 125, 130, 227, 100, 127, 252, 152, 327, 325, 256, 330, 229, 362, 129, 262, 132, 230, 232, 162, 131, 228, 117, 220, 304, 331, 332, 329},
-		'\u677f': []uint16{225, 125, 426, 325, 252, 232, 200, 227, 104, 130, 230, 315, 132, 100, 327, 320, 129, 330, 115, 332, 220, 328, 215, 217, 229, 352, 362, 262},
-		'\u6781': []uint16{201, 407, 107, 225, 125, 100, 325, 230, 200, 252, 145, 315, 122, 156, 152, 330, 320, 227, 215, 104, 204, 115, 331, 327, 356, 217, 352, 332, 129, 130, 117, 132, 228},
-		'\u6784': []uint16{225, 152, 125, 325, 454, 252, 120, 156, 426, 220, 227, 231, 327, 320, 256, 229, 356, 332},
-		'\u6787': []uint16{125, 130},
-		'\u6789': []uint16{454, 252, 315, 152, 325, 162, 227, 320, 115},
-		'\u678b': []uint16{413, 227, 327, 130, 225},
-		'\u6790': []uint16{256, 325, 252, 454, 220, 152, 320, 225, 215, 227, 327, 232, 332, 315},
-		'\u6795': []uint16{125, 452, 142, 225, 315, 252, 227, 327, 115, 152, 215, 426, 230, 220, 132, 120, 232, 104},
-		'\u6797': []uint16{225, 127, 227, 125, 426, 230, 327, 232, 330, 325, 332, 252, 142, 331, 229, 152, 130, 128, 217, 317, 220, 228, 129, 215, 132, 329, 112, 320, 117, 315, 231, 120, 131, 352, 304, 328},
-		'\u6798': []uint16{413},
-		'\u679a': []uint16{422, 222, 435, 325, 315, 227, 252, 228, 327, 132},
-		'\u679c': []uint16{206, 225, 107, 125, 426, 306, 325, 100, 230, 252, 227, 232, 215, 327, 330, 101, 115, 106, 127, 320, 315, 117, 217, 130, 129, 331, 104, 332, 317, 132, 229, 329, 152, 220, 343, 228},
-		'\u679d': []uint16{225, 435, 125, 222, 315, 227, 330, 325, 327, 130, 215, 115, 252, 422, 320, 426, 417, 162, 362, 220, 331, 204},
-		'\u679e': []uint16{130, 125},
-		'\u67a2': []uint16{125, 225, 325, 320, 220, 132, 120, 332, 315},
-		'\u67a3': []uint16{225, 425, 125, 130, 104, 129, 325, 327, 215, 230},
-		'\u67a5': []uint16{413, 215, 227, 130, 252},
-		'\u67a7': []uint16{413, 327},
-		'\u67a8': []uint16{413},
-		'\u67aa': []uint16{425, 225, 125, 222, 252, 220, 152, 325, 315, 227, 156, 232, 215, 115, 422, 320, 120, 132, 352},
-		'\u67ab': []uint16{427, 227, 125, 130, 327, 426, 225, 132},
-		'\u67ad': []uint16{125, 152, 115, 225},
-		'\u67af': []uint16{125, 452, 152, 100, 143, 252, 162, 315, 325, 115, 227, 120, 103, 262},
-		'\u67b0': []uint16{426, 227},
-		'\u67b3': []uint16{125},
-		'\u67b5': []uint16{413},
-		'\u67b6': []uint16{225, 435, 422, 222, 252, 125, 152, 325, 142, 156, 230, 330, 232, 320, 315, 256, 122, 322, 227, 135, 130, 352, 215, 132, 332, 304},
-		'\u67b7': []uint16{125, 315, 225},
-		'\u67b8': []uint16{125, 132, 327},
-		'\u67c1': []uint16{413},
-		'\u67c3': []uint16{459},
-		'\u67c4': []uint16{425, 225, 227, 327, 215, 426, 232, 125},
-		'\u67cf': []uint16{130, 127, 327, 227, 225, 330, 125, 426, 427, 230, 132, 331, 228, 229, 325, 131, 129, 232},
-		'\u67d0': []uint16{138, 438, 227, 238, 327, 225, 329, 229},
-		'\u67d1': []uint16{125, 426, 225, 327},
-		'\u67d2': []uint16{422, 122, 227, 322, 222},
-		'\u67d3': []uint16{252, 256, 125, 325, 452, 152, 225, 215, 204, 227, 232, 320, 156, 132, 332, 220, 327, 343, 304},
-		'\u67d4': []uint16{100, 200, 452, 125, 315, 227, 327, 230, 400, 103, 129, 120, 325, 330, 162, 362, 115, 152, 332, 220, 331, 320},
-		'\u67d8': []uint16{413, 130, 327, 330, 227},
-		'\u67d9': []uint16{413, 227},
-		'\u67da': []uint16{426, 125, 225, 327},
-		'\u67dc': []uint16{225, 125, 426, 325, 227, 215, 232, 252, 142, 104, 132},
-		'\u67dd': []uint16{413, 327},
-		'\u67de': []uint16{125, 130},
-		'\u67e0': []uint16{125, 132},
-		'\u67e2': []uint16{413, 215},
-		'\u67e5': []uint16{256, 152, 252, 452, 127, 325, 129, 220, 227, 327, 331, 130, 330, 320, 156, 329, 225, 332, 315, 120, 230, 125, 229, 132},
-		'\u67e9': []uint16{225},
-		'\u67ec': []uint16{130, 417, 225, 131, 327, 125, 227, 132},
-		'\u67ef': []uint16{127, 132, 227, 425, 327, 225, 129, 315, 332, 232, 330, 128, 325, 427, 131, 229},
-		'\u67f0': []uint16{413},
-		'\u67f1': []uint16{225, 425, 227, 125, 325, 327, 132, 222, 215, 256, 330, 328, 230, 235, 315, 422, 435, 232, 228},
-		'\u67f3': []uint16{127, 425, 125, 426, 225, 130, 327, 227, 330, 129, 128, 117, 315, 115, 325, 229, 215, 104, 120, 132, 332, 230, 131, 328, 320},
-		'\u67f4': []uint16{125, 425, 127, 225, 130, 252, 232, 325, 215, 115, 330, 315, 331, 120, 129, 227, 327, 217},
-		'\u67fd': []uint16{125, 459, 227},
-		'\u67ff': []uint16{426, 125, 225, 327, 227, 130},
-		'\u6800': []uint16{125, 426, 327},
-		'\u6805': []uint16{426, 225, 125, 227, 325, 330, 327, 232},
-		'\u6807': []uint16{125, 225, 252, 452, 325, 152, 217, 227, 156, 100, 220, 327, 331, 115, 120, 320, 132, 304, 315, 131, 307, 256, 352, 425, 317, 104, 232},
-		'\u6808': []uint16{225, 125, 426, 227, 315, 252},
-		'\u6809': []uint16{413, 315, 152, 227, 115, 327},
-		'\u680a': []uint16{413, 227},
-		'\u680b': []uint16{222, 227, 435, 238, 422, 327, 215, 125, 315, 115, 120},
-		'\u680c': []uint16{459, 225, 227},
-		'\u680e': []uint16{426, 225, 227, 327, 125, 130},
-		'\u680f': []uint16{225, 125, 425, 238, 252, 325, 227, 207, 230, 330, 327, 320, 256},
-		'\u6811': []uint16{125, 225, 452, 327, 142, 152, 227, 230, 325, 330, 252, 315, 320, 115, 130, 120, 104, 220, 215, 229, 228, 132, 332, 256, 329, 128, 328, 343, 232},
-		'\u6813': []uint16{225, 125, 426, 227, 325, 252, 327},
-		'\u6816': []uint16{152, 454, 204, 227, 327, 320, 125, 130, 325, 230, 330},
-		'\u6817': []uint16{225, 127, 125, 252, 227, 427, 215, 426, 327, 330, 230, 325, 256, 315, 115},
-		'\u681d': []uint16{125, 459},
-		'\u6821': []uint16{225, 125, 426, 217, 220, 152, 325, 238, 252, 231, 227, 327, 204, 142, 156, 452, 256, 112, 132, 117, 104, 331, 332, 130},
-		'\u6829': []uint16{115, 315, 162, 362},
-		'\u682a': []uint16{222, 435, 225, 130, 220, 125, 152, 156, 422, 315, 238, 227, 327, 115, 131, 331, 215},
-		'\u6832': []uint16{125},
-		'\u6833': []uint16{225, 459},
-		'\u6837': []uint16{238, 245, 207, 125, 225, 222, 435, 220, 320, 352, 325, 252, 215, 227, 426, 200, 204, 327, 256, 315, 322, 422, 332, 135, 235, 445, 324, 304, 224},
-		'\u6838': []uint16{125, 425, 225, 252, 152, 256, 156, 325, 120, 331, 320, 227, 117, 352, 304, 204, 327, 317, 332},
-		'\u6839': []uint16{134, 100, 125, 222, 435, 225, 230, 232, 330, 227, 332, 422, 252, 224, 327, 315, 152, 307, 320, 325, 229, 115, 331, 215, 207, 329, 129, 352, 130, 104, 156, 256},
-		'\u683c': []uint16{225, 125, 201, 327, 330, 127, 252, 417, 227, 107, 425, 132, 325, 229, 130, 129, 332, 331, 230, 232, 329, 215, 152, 115, 220, 131, 315, 352, 320, 235, 200, 356, 204, 335, 117},
-		'\u683d': []uint16{156, 452, 152, 225, 120, 252, 125, 320, 227, 327},
-		'\u683e': []uint16{127, 130, 427, 327, 227},
-		'\u6840': []uint16{427, 227, 115, 100, 327, 125, 315},
-		'\u6841': []uint16{125, 104},
-		'\u6842': []uint16{227, 130, 127, 327, 125, 417, 427, 225, 217, 331, 426, 132, 332, 117, 325, 229, 330, 215, 328, 252, 232, 128, 352, 131, 115},
-		'\u6843': []uint16{225, 125, 425, 130, 325, 227, 330, 315, 327, 115, 232, 332, 320, 220, 104, 230},
-		'\u6844': []uint16{125, 452},
-		'\u6845': []uint16{426, 125, 225, 227, 327},
-		'\u6846': []uint16{125, 225, 452, 325, 435, 152, 232, 222, 422, 227, 332},
-		'\u6848': []uint16{225, 125, 426, 252, 238, 325, 222, 152, 315, 331, 256, 217, 215, 320, 220, 356, 227, 422, 317, 117, 115},
-		'\u6849': []uint16{125, 132, 225},
-		'\u684a': []uint16{413},
-		'\u684c': []uint16{125, 142, 225, 426, 222, 252, 352, 435, 325, 130, 327, 132, 422, 232},
-		'\u684e': []uint16{125, 152},
-		'\u6850': []uint16{225, 130, 227, 125, 426, 325, 327, 217, 132, 230, 127, 330},
-		'\u6851': []uint16{127, 125, 330, 225, 227, 426, 427, 130, 327, 229, 315, 132, 230, 215, 129, 325, 331, 220, 128, 232, 217, 131, 329, 332, 120},
-		'\u6853': []uint16{127, 413, 227, 327, 252, 225, 130},
-		'\u6854': []uint16{125, 427, 225, 104, 227, 426, 129, 327},
-		'\u6855': []uint16{225, 413, 325},
-		'\u6860': []uint16{225, 125},
-		'\u6861': []uint16{125},
-		'\u6862': []uint16{227, 413, 243, 327},
-		'\u6863': []uint16{125, 425, 204, 225, 222, 435, 252, 422, 331, 322, 320, 227, 217, 304, 256, 352, 132, 426, 215},
-		'\u6864': []uint16{413, 125, 327},
-		'\u6865': []uint16{425, 225, 230, 125, 227, 252, 142, 331, 232, 330, 327, 229, 332, 130, 325, 132, 215, 328, 315, 127, 220, 228, 129, 120},
-		'\u6866': []uint16{427, 125, 225, 227, 130, 325, 132, 327, 129, 232},
-		'\u6867': []uint16{227, 413, 327, 228, 125},
-		'\u6868': []uint16{225, 425, 125, 252, 232, 327, 227},
-		'\u6869': []uint16{222, 435, 425, 225, 252, 215, 125, 227, 325, 422},
-		'\u686b': []uint16{125},
-		'\u6874': []uint16{413},
-		'\u6876': []uint16{435, 222, 225, 238, 422, 104, 252, 325, 129, 232},
-		'\u6877': []uint16{413, 325},
-		'\u6881': []uint16{127, 225, 425, 130, 227, 327, 230, 315, 325, 330, 128, 252, 129, 125, 215, 220, 232, 332, 229, 320, 228, 115},
-		'\u6883': []uint16{125},
-		'\u6885': []uint16{227, 127, 125, 225, 426, 327, 427, 130, 129, 132, 230, 232, 325, 315, 330, 331, 329, 229, 332, 252, 417, 220, 343, 128, 228},
-		'\u6886': []uint16{125, 433, 325, 362, 262, 327, 162, 227},
-		'\u688f': []uint16{225, 413, 252},
-		'\u6893': []uint16{225, 129, 228, 230, 125, 252, 227, 130, 330},
-		'\u6897': []uint16{452, 152, 325, 225, 125, 252, 327, 215, 217, 100, 200},
-		'\u68a2': []uint16{426, 225, 327, 125, 227, 220, 215, 252, 320, 325},
-		'\u68a6': []uint16{425, 125, 327, 225, 252, 152, 232, 127, 227, 315, 325, 215, 115, 320, 132, 129, 220, 229, 120, 332, 330, 331},
-		'\u68a7': []uint16{125, 130, 200, 229, 325, 228, 330},
-		'\u68a8': []uint16{425, 125, 225, 227, 327, 325, 332, 130, 230, 115, 132, 330, 120, 232},
-		'\u68ad': []uint16{125, 426, 252, 227, 435, 225, 229, 129, 327, 232, 325, 132, 215, 130, 204},
-		'\u68af': []uint16{225, 125, 426, 325, 332, 304, 227, 327, 127, 435, 230, 320, 232, 330},
-		'\u68b0': []uint16{225, 325, 352, 356, 320, 304, 252, 331, 220, 152, 332, 156, 256},
-		'\u68b3': []uint16{152, 452, 125, 204, 225, 115, 132, 232, 227, 256, 327, 325, 156},
-		'\u68b5': []uint16{132, 130, 125, 133, 325, 129, 131},
-		'\u68c0': []uint16{156, 152, 125, 252, 452, 217, 104, 325, 331, 317, 256, 227, 327, 225, 132, 332},
-		'\u68c2': []uint16{413, 225, 327, 227},
-		'\u68c9': []uint16{125, 426, 225, 132, 104, 325, 230, 130, 227, 217, 252, 327, 232, 330, 332, 231, 331},
-		'\u68cb': []uint16{125, 425, 225, 315, 252, 227, 220, 327, 152, 325, 115, 215, 331, 130, 117},
-		'\u68cd': []uint16{426, 225, 125, 325, 220, 315, 320},
-		'\u68d2': []uint16{400, 225, 125, 215, 325, 227, 232, 327, 152, 332, 252, 315, 200, 130, 115, 162},
-		'\u68d5': []uint16{125, 426, 104, 225, 227, 130, 332, 325, 204, 327},
-		'\u68d8': []uint16{426, 225, 100, 125, 120, 215, 227, 327, 325, 104, 320},
-		'\u68da': []uint16{225, 425, 125, 142, 227, 252, 220, 327, 230, 325},
-		'\u68e0': []uint16{225, 228, 325, 227, 125, 230, 130},
-		'\u68e3': []uint16{328, 225, 230, 125, 330},
-		'\u68ee': []uint16{125, 227, 327, 427, 330, 100, 127, 229, 232, 200, 362, 262, 315, 117, 230, 162, 331, 129, 320, 329, 132, 325, 402, 332, 115, 130},
-		'\u68f0': []uint16{452, 225, 330},
-		'\u68f1': []uint16{426, 125, 227, 315, 325, 204, 132, 327, 115, 330, 225, 320, 252, 332, 230, 200},
-		'\u68f5': []uint16{222, 435, 330, 422, 125, 227, 327, 320},
-		'\u68f9': []uint16{413, 227},
-		'\u68fa': []uint16{125, 426, 225, 327, 252, 315, 320, 132},
-		'\u68fc': []uint16{413},
-		'\u6901': []uint16{413, 225, 227, 327},
-		'\u6905': []uint16{225, 125, 426, 325, 227, 232, 327},
-		'\u690b': []uint16{459, 125, 327, 227},
-		'\u690d': []uint16{125, 252, 325, 320, 452, 317, 152, 227, 120, 127, 327, 132, 230, 156, 330, 331, 104, 129, 256, 220, 143},
-		'\u690e': []uint16{320, 225, 125, 325},
-		'\u6910': []uint16{413},
-		'\u6912': []uint16{225, 426, 325, 227, 130, 327, 230, 125, 232},
-		'\u691f': []uint16{413},
-		'\u6920': []uint16{413},
-		'\u6924': []uint16{459, 225, 227},
-		'\u692d': []uint16{125},
-		'\u6930': []uint16{125, 426, 325, 332, 225, 227, 327, 130},
-		'\u6934': []uint16{125, 225},
-		'\u6939': []uint16{227, 459, 225, 332},
-		'\u693d': []uint16{426, 125, 227, 327},
-		'\u693f': []uint16{413, 227, 125, 327, 225, 325},
-		'\u6942': []uint16{225, 459, 325, 362, 262},
-		'\u6954': []uint16{125, 452, 120, 225, 232},
-		'\u6957': []uint16{413, 327},
-		'\u695a': []uint16{200, 127, 427, 417, 125, 130, 327, 362, 262, 227, 220, 225, 315, 115, 330, 243, 325, 129, 132, 215, 229, 230, 328, 128},
-		'\u695d': []uint16{325, 225, 125},
-		'\u695e': []uint16{330, 225, 125, 325, 230, 252, 362, 262},
-		'\u6960': []uint16{227, 125, 425, 327, 427, 130, 228, 129},
-		'\u6963': []uint16{413, 225, 227, 327},
-		'\u6966': []uint16{426, 225, 125, 327, 227},
-		'\u696b': []uint16{225, 426, 227, 327},
-		'\u696e': []uint16{427},
-		'\u6977': []uint16{125, 227, 427, 225, 426, 220, 417, 327},
-		'\u6978': []uint16{413, 225, 232, 125, 327},
-		'\u6979': []uint16{435, 227, 327, 125},
-		'\u697c': []uint16{125, 225, 425, 142, 252, 230, 227, 127, 332, 130, 315, 215, 112, 327, 325, 238, 228, 330, 128, 232, 120, 229, 320, 129, 231},
-		'\u6980': []uint16{435},
-		'\u6982': []uint16{125, 207, 152, 407, 225, 315, 320, 120, 426, 115, 227, 106, 117},
-		'\u6984': []uint16{325, 225, 413, 330, 327, 227},
-		'\u6986': []uint16{130, 426, 125, 227, 327, 225, 330, 230, 115, 215},
-		'\u6987': []uint16{413, 227, 225, 327},
-		'\u6988': []uint16{325, 225, 459, 330, 232, 332},
-		'\u6989': []uint16{225, 459, 125, 227},
-		'\u698d': []uint16{459},
-		'\u6994': []uint16{225, 125},
-		'\u6995': []uint16{125, 417, 426, 130, 227, 327, 132},
-		'\u699b': []uint16{125, 132, 228},
-		'\u699c': []uint16{425, 125, 252, 225, 227, 120, 315, 320, 215, 327, 115, 325, 228, 232, 229},
-		'\u69a7': []uint16{225, 125},
-		'\u69a8': []uint16{152, 125, 452, 252, 256, 156, 332, 204, 227, 315},
-		'\u69ab': []uint16{125, 225, 325, 252},
-		'\u69ad': []uint16{413, 225, 230, 330, 229, 227, 327, 215, 315},
-		'\u69b1': []uint16{413},
-		'\u69b4': []uint16{125, 325, 225, 227},
-		'\u69b7': []uint16{413, 252, 227},
-		'\u69bb': []uint16{425, 225, 252, 125, 325},
-		'\u69c1': []uint16{413, 200, 125, 115, 227, 315},
-		'\u69ca': []uint16{413, 227, 327, 315},
-		'\u69cc': []uint16{426, 225, 252, 315},
-		'\u69ce': []uint16{426, 225, 227},
-		'\u69d0': []uint16{125, 427, 225, 227, 426, 330, 215, 327, 130},
-		'\u69d4': []uint16{459},
-		'\u69db': []uint16{225, 413, 325},
-		'\u69df': []uint16{125, 225, 325},
-		'\u69e0': []uint16{225, 413, 227},
-		'\u69ed': []uint16{413},
-		'\u69f2': []uint16{125, 132},
-		'\u69fd': []uint16{426, 225, 125, 252, 227, 327, 325, 232, 132, 256},
-		'\u69ff': []uint16{459, 227, 225, 327, 325},
-		'\u6a0a': []uint16{127, 130, 230, 427, 330, 125, 227, 327},
-		'\u6a17': []uint16{413, 327, 125},
-		'\u6a18': []uint16{435},
-		'\u6a1f': []uint16{125, 130, 225, 230, 332},
-		'\u6a21': []uint16{225, 125, 204, 152, 426, 100, 227, 315, 220, 320, 104, 162, 362, 352, 120, 325, 207, 115, 327, 217, 156, 132, 356, 229, 252, 232},
-		'\u6a28': []uint16{225, 227, 459},
-		'\u6a2a': []uint16{452, 152, 125, 252, 130, 200, 315, 115, 227, 107, 320, 325, 327, 132, 120, 220, 104, 100, 232, 352, 215, 127, 201, 129},
-		'\u6a2f': []uint16{426, 225, 227, 125},
-		'\u6a31': []uint16{125, 427, 426, 130, 227, 127, 327, 325, 132},
-		'\u6a35': []uint16{125, 225, 330, 152, 229},
-		'\u6a3d': []uint16{426, 327, 125, 227, 315},
-		'\u6a3e': []uint16{413, 227, 327},
-		'\u6a44': []uint16{125, 325, 130},
-		'\u6a47': []uint16{413, 225},
-		'\u6a50': []uint16{433, 328},
-		'\u6a58': []uint16{225, 125, 104, 130, 127},
-		'\u6a59': []uint16{125, 426, 225, 104, 227, 405, 232, 327, 332, 362, 262, 132},
-		'\u6a5b': []uint16{125},
-		'\u6a61': []uint16{125, 325, 320, 132, 120},
-		'\u6a65': []uint16{413},
-		'\u6a71': []uint16{125, 225, 426},
-		'\u6a79': []uint16{426, 227, 327, 225},
-		'\u6a7c': []uint16{459, 225, 332, 227},
-		'\u6a80': []uint16{427, 227, 125, 225, 327, 426, 127, 130, 325},
-		'\u6a84': []uint16{125},
-		'\u6a8e': []uint16{459, 225, 227},
-		'\u6a90': []uint16{225, 426, 315, 125, 227, 325, 320},
-		'\u6a91': []uint16{125, 413},
-		'\u6a97': []uint16{225, 459},
-		'\u6aa0': []uint16{413},
-		'\u6aa9': []uint16{125, 426, 227},
-		'\u6aab': []uint16{413},
-		'\u6aac': []uint16{225, 459, 325, 332, 227},
-		'\u6b20': []uint16{452, 152, 252, 225, 125, 315, 227, 120, 100, 156, 327, 115},
-		'\u6b21': []uint16{222, 238, 207, 435, 422, 143, 206, 332, 125, 225, 352, 243, 204, 307, 104, 224, 152, 322, 252, 215, 325, 330, 327, 220, 235, 320, 230, 315, 135, 324, 107, 132, 120, 227, 129, 130},
-		'\u6b22': []uint16{252, 152, 400, 100, 125, 227, 315, 115, 225, 130, 325, 327, 120, 352, 256, 320, 215, 220, 162, 362, 156, 332},
-		'\u6b23': []uint16{152, 100, 227, 427, 454, 107, 327, 315, 115, 132, 200, 125, 129, 307, 230, 228, 328},
-		'\u6b24': []uint16{460, 227},
-		'\u6b27': []uint16{130, 125, 230, 417, 127, 117, 131, 132, 325, 129, 217, 227, 225, 104, 128, 331, 120, 327, 152, 330, 229, 252, 256, 232, 115, 135},
-		'\u6b32': []uint16{407, 225, 315, 125, 252, 215, 320, 227, 120, 115, 152, 327, 325},
-		'\u6b37': []uint16{459, 227},
-		'\u6b39': []uint16{402, 410},
-		'\u6b3a': []uint16{152, 456, 156, 315, 115, 125, 454, 252, 100, 120, 215},
-		'\u6b3e': []uint16{225, 252, 425, 222, 422, 125, 325, 152, 204, 217, 238, 220, 256, 332, 232, 320, 227, 156, 100, 200, 120},
-		'\u6b43': []uint16{152, 115},
-		'\u6b46': []uint16{227, 413, 327, 100},
-		'\u6b47': []uint16{452, 152, 252, 325, 225, 330, 327, 125, 227, 130, 304, 331, 329, 352},
-		'\u6b49': []uint16{252, 200, 426, 125, 100, 402, 220, 156, 152},
-		'\u6b4c': []uint16{125, 225, 425, 152, 252, 227, 320, 222, 129, 115, 315, 327, 331, 215, 325, 232, 132, 422, 330, 229, 104, 130, 256, 127},
-		'\u6b59': []uint16{413, 130, 125, 227},
-		'\u6b62': []uint16{252, 452, 152, 245, 220, 225, 215, 107, 125, 325, 256, 156, 320, 127, 315, 227, 327, 120, 115, 132},
-		'\u6b63': []uint16{407, 100, 207, 152, 101, 107, 125, 227, 252, 162, 327, 200, 143, 104, 315, 225, 228, 232, 304, 325, 130, 112, 132, 156, 332, 115, 215, 127, 120, 362, 262, 320, 328, 256, 129, 243, 117, 230, 124, 307, 331, 204, 131, 201, 220, 231, 330, 343},
-		'\u6b64': []uint16{438, 206, 138, 238, 207, 106, 143, 306, 142, 320, 220, 315, 115, 124, 215, 120, 132, 227, 152, 327},
-		'\u6b65': []uint16{207, 222, 125, 256, 225, 435, 252, 152, 253, 325, 320, 315, 127, 220, 135, 235, 327, 322, 215, 422, 132, 115, 332, 328, 227, 120, 317, 232, 352, 330, 230, 107, 117},
-		'\u6b66': []uint16{125, 130, 127, 227, 152, 325, 225, 427, 327, 120, 320, 330, 117, 417, 252, 200, 243, 230, 131, 315, 132, 426, 229, 329, 331, 217, 215, 402, 100, 232, 304, 129, 156, 228, 128, 101, 317, 256, 352, 343},
-		'\u6b67': []uint16{225, 152, 125, 402, 320, 227, 327, 156, 315, 325, 132, 304},
-		'\u6b6a': []uint16{452, 152, 362, 162, 215, 125, 115, 200, 262, 227, 320, 220, 327, 315, 400, 156},
-		'\u6b79': []uint16{225, 125, 215, 402, 100, 315, 207},
-		'\u6b7b': []uint16{452, 252, 152, 125, 325, 315, 225, 115, 207, 215, 227, 162, 107, 362, 220, 320, 200, 100, 120, 256, 117, 352, 262, 156, 327, 332, 204, 130},
-		'\u6b7c': []uint16{152, 454, 252, 125, 256},
-		'\u6b81': []uint16{413},
-		'\u6b82': []uint16{413},
-		'\u6b83': []uint16{252, 454, 152, 315, 225, 115},
-		'\u6b84': []uint16{413, 315, 227},
-		'\u6b86': []uint16{152, 407, 200, 215},
-		'\u6b87': []uint16{454, 225, 327, 227},
-		'\u6b89': []uint16{152, 454, 125, 315, 320},
-		'\u6b8a': []uint16{200, 407, 325, 125, 152, 227, 220, 115, 100, 225, 327, 215, 320, 352, 107, 120, 331, 356, 332},
-		'\u6b8b': []uint16{125, 400, 100, 152, 252, 315, 256, 156, 200, 115, 325, 120, 217, 225, 331, 327, 332, 143, 320, 117, 162, 132, 204, 215, 317},
-		'\u6b8d': []uint16{225, 459, 315},
-		'\u6b92': []uint16{152, 215},
-		'\u6b93': []uint16{252, 454},
-		'\u6b96': []uint16{125, 256, 252, 325, 152, 220, 156, 225, 204, 352, 232, 320, 332},
-		'\u6b9a': []uint16{115},
-		'\u6b9b': []uint16{413},
-		'\u6ba1': []uint16{152, 252, 125, 156, 256},
-		'\u6baa': []uint16{413},
-		'\u6bb3': []uint16{427, 127, 227},
-		'\u6bb4': []uint16{152, 454, 252, 220},
-		'\u6bb5': []uint16{225, 222, 435, 325, 127, 212, 243, 238, 125, 320, 252, 422, 215, 227, 130, 256, 220, 128, 135, 217, 327},
-		'\u6bb7': []uint16{127, 100, 427, 125, 227, 104, 352, 143, 262, 162, 327, 101, 115, 433, 129},
-		'\u6bbf': []uint16{425, 225, 227, 125, 230, 327, 142, 152, 232, 215, 304, 426, 332, 330},
-		'\u6bc1': []uint16{252, 452, 152, 125, 115, 227, 156, 320, 325, 315, 332, 120},
-		'\u6bc2': []uint16{459, 225, 125, 227, 315},
-		'\u6bc5': []uint16{227, 200, 327, 107, 427, 125, 328, 115, 228, 100, 320},
-		'\u6bcb': []uint16{120, 407, 127, 106, 115, 315, 107, 327, 427, 227},
-		'\u6bcd': []uint16{225, 125, 404, 227, 325, 127, 327, 426, 104, 220, 320, 330, 232, 217, 115, 143, 230, 332, 117, 215, 315},
-		'\u6bcf': []uint16{138, 438, 134, 152, 207, 107, 120, 115, 320, 125, 132, 227, 327},
-		'\u6bd2': []uint16{125, 225, 252, 425, 256, 325, 152, 220, 200, 232, 320, 203, 352, 100, 315, 204, 217, 332, 215, 452, 115, 156, 304, 117, 162, 120, 132, 343},
-		'\u6bd3': []uint16{413, 327, 227, 132, 315},
-		'\u6bd4': []uint16{434, 107, 125, 217, 252, 152, 156, 330, 225, 130, 120, 127, 262, 327, 129, 320, 229, 230, 325, 331, 256, 315, 215, 115, 134, 227, 329, 332, 232, 352, 131, 235, 122, 207, 322, 132, 104, 220},
-		'\u6bd5': []uint16{107, 152, 454, 252, 125, 127, 315, 115, 327, 104, 130, 225, 227, 230, 132, 128, 427, 325, 129},
-		'\u6bd6': []uint16{413, 315},
-		'\u6bd7': []uint16{152, 129, 125, 225, 332, 156, 330},
-		'\u6bd9': []uint16{252, 452, 152, 215, 220},
-		'\u6bdb': []uint16{125, 427, 225, 127, 325, 227, 130, 327, 315, 104, 135, 115, 252, 162, 204, 132, 215, 220, 229, 100, 352, 362, 332, 331, 232, 117, 120, 129, 330, 320, 128},
-		'\u6be1': []uint16{426, 125, 225, 215, 325},
-		'\u6bea': []uint16{413},
-		'\u6beb': []uint16{122, 135, 152, 120, 222, 107, 125, 435, 407, 225, 322, 215, 315, 115, 252, 335, 227, 320, 426, 327},
-		'\u6bef': []uint16{225, 125, 304, 325, 232},
-		'\u6bf3': []uint16{413},
-		'\u6bf5': []uint16{459},
-		'\u6bf9': []uint16{225, 459},
-		'\u6bfd': []uint16{352, 125, 252},
-		'\u6c05': []uint16{413, 225, 327},
-		'\u6c06': []uint16{125},
-		'\u6c07': []uint16{225, 459},
-		'\u6c0d': []uint16{125, 459},
-		'\u6c0f': []uint16{227, 426, 125, 327, 225, 322, 330, 204, 325, 229, 332, 232, 335, 230, 331},
-		'\u6c10': []uint16{413},
-		'\u6c11': []uint16{225, 125, 325, 426, 331, 227, 330, 104, 100, 320, 332, 252, 131, 315, 120, 117, 327, 132, 229, 256, 130, 152, 230, 115, 220, 215, 228, 352, 317, 143, 321, 232, 356, 217, 156, 343, 328},
-		'\u6c13': []uint16{225, 459, 220, 325, 227},
-		'\u6c14': []uint16{225, 125, 425, 252, 325, 200, 315, 320, 100, 115, 215, 152, 220, 162, 203, 327, 232, 356, 104, 231, 256, 331, 304, 207, 262, 330, 362, 217, 227, 120, 352, 332, 132, 156, 245, 201},
-		'\u6c15': []uint16{413},
-		'\u6c16': []uint16{125, 325},
-		'\u6c18': []uint16{413, 125},
-		'\u6c19': []uint16{125},
-		'\u6c1a': []uint16{413},
-		'\u6c1b': []uint16{225, 125},
-		'\u6c1f': []uint16{425, 125, 325, 132, 332, 225, 227, 232},
-		'\u6c21': []uint16{413},
-		'\u6c22': []uint16{125, 425, 225, 325, 252, 332, 227, 327, 232, 132},
-		'\u6c24': []uint16{125, 152},
-		'\u6c26': []uint16{425, 225, 125},
-		'\u6c27': []uint16{325, 125, 152, 425, 225, 332, 252, 256, 204, 156, 227, 327, 132},
-		'\u6c28': []uint16{125, 425, 325, 225, 332, 132, 129, 152},
-		'\u6c29': []uint16{425, 132, 125},
-		'\u6c2a': []uint16{413},
-		'\u6c2e': []uint16{425, 125, 225, 325, 232, 152, 229, 132, 332},
-		'\u6c2f': []uint16{125, 425, 325, 152, 132, 332, 225, 232},
-		'\u6c30': []uint16{332, 125, 325, 132, 152, 320, 232},
-		'\u6c32': []uint16{459, 225, 327, 252, 227},
-		'\u6c34': []uint16{125, 225, 425, 325, 252, 142, 230, 227, 104, 127, 256, 327, 330, 120, 315, 115, 152, 320, 215, 204, 132, 130, 332, 331, 232, 229, 129, 220, 343, 217, 162, 117, 156, 131, 100, 352, 304, 317},
-		'\u6c38': []uint16{107, 327, 130, 407, 227, 132, 127, 125, 104, 162, 152, 143, 120, 129, 128, 200, 328, 115, 229, 320, 315, 230, 228, 131, 215, 156, 330},
-		'\u6c3d': []uint16{452},
-		'\u6c40': []uint16{230, 130, 229, 125, 232, 225, 228, 330, 129, 325, 332},
-		'\u6c41': []uint16{225, 426, 125, 227, 215, 232, 325, 315, 327, 252},
-		'\u6c42': []uint16{252, 225, 452, 152, 325, 315, 215, 220, 256, 227, 156, 125, 115, 330, 320, 327, 132, 120, 352, 331, 232, 129},
-		'\u6c46': []uint16{452},
-		'\u6c47': []uint16{452, 125, 225, 152, 156, 132, 325, 252, 331, 230, 131, 227, 332, 327, 229, 232, 330, 320, 142, 120, 256, 217, 220, 115, 315, 130},
-		'\u6c49': []uint16{417, 125, 243, 132, 225, 127, 230, 130, 143, 227, 327, 330, 117, 217, 129, 232, 332, 331, 325, 152, 320, 229, 104, 215, 131, 220, 315, 228, 329, 128, 328, 352, 120, 252},
-		'\u6c4a': []uint16{125, 225, 330},
-		'\u6c50': []uint16{225, 325, 413},
-		'\u6c54': []uint16{413},
-		'\u6c55': []uint16{130, 230, 417, 227, 330, 131, 125, 327},
-		'\u6c57': []uint16{425, 230, 125, 227, 225, 252, 220, 115, 325, 320, 327, 162, 330, 120, 229, 315, 152},
-		'\u6c5b': []uint16{143, 225, 426, 256, 227, 327, 252, 325, 125},
-		'\u6c5c': []uint16{413, 227, 327, 130},
-		'\u6c5d': []uint16{327, 127, 438, 427, 130, 227, 125, 132, 328, 320, 439},
-		'\u6c5e': []uint16{425, 125, 225, 232, 335, 227},
-		'\u6c5f': []uint16{230, 130, 227, 427, 330, 125, 127, 327, 117, 225, 142, 232, 332, 212, 331, 132, 315, 325, 228, 128, 217, 229, 320, 129, 131, 115, 352, 215, 342, 317, 329, 220, 120},
-		'\u6c60': []uint16{225, 426, 230, 125, 227, 427, 127, 330, 325, 435, 220, 130, 327, 129, 232, 142, 320, 315, 332, 229, 224, 252},
-		'\u6c61': []uint16{156, 125, 252, 325, 426, 225, 152, 315, 256, 304, 215, 100, 454, 115, 200, 352, 332, 132, 317, 227},
-		'\u6c64': []uint16{425, 127, 225, 125, 130, 227, 327, 232, 132, 252, 325, 315, 129, 215, 128, 330, 320, 220, 117, 331},
-		'\u6c68': []uint16{130},
-		'\u6c69': []uint16{233, 133},
-		'\u6c6a': []uint16{127, 452, 125, 262, 362, 162, 427, 115, 222, 315, 128, 227, 327, 230, 120, 330, 435, 129, 422},
-		'\u6c70': []uint16{252, 220, 454, 325, 200, 152, 320},
-		'\u6c72': []uint16{152, 127, 452, 227, 327, 115, 328},
-		'\u6c74': []uint16{413, 130, 327, 129, 227, 330},
-		'\u6c76': []uint16{130, 330, 413, 227, 225, 327, 232, 129, 331},
-		'\u6c79': []uint16{152, 215, 315, 125, 262, 162, 320, 115, 100, 362},
-		'\u6c7d': []uint16{125, 217, 225, 331, 325, 320, 152, 117, 332, 132, 130, 231, 330},
-		'\u6c7e': []uint16{130, 230, 125, 332, 330, 327},
-		'\u6c81': []uint16{130, 452, 227, 330, 230, 327, 115, 232, 152, 125, 120},
-		'\u6c82': []uint16{417, 230, 130, 330, 125, 327, 227, 325},
-		'\u6c83': []uint16{127, 200, 232, 132, 327, 427, 227, 130, 330, 129, 125, 230, 229, 115, 329, 454, 332, 131, 331, 315},
-		'\u6c85': []uint16{130, 417, 227, 327},
-		'\u6c86': []uint16{115, 125},
-		'\u6c88': []uint16{127, 130, 417, 427, 132, 332, 131, 327, 227, 230, 217, 125, 117, 128, 330},
-		'\u6c89': []uint16{152, 100, 452, 125, 200, 252, 262, 162, 362, 156, 315, 320, 115, 227, 215, 101, 225, 120, 327, 220, 256, 304, 228},
-		'\u6c8c': []uint16{200, 459, 215, 315, 362, 262},
-		'\u6c8f': []uint16{452, 152},
-		'\u6c90': []uint16{152, 454, 132, 227, 320, 327, 252, 115, 129, 315},
-		'\u6c93': []uint16{435, 222, 200, 315},
-		'\u6c94': []uint16{413, 129, 227, 130},
-		'\u6c99': []uint16{125, 127, 425, 225, 230, 330, 130, 227, 325, 132, 327, 232, 129, 152, 100, 252, 315, 215, 229, 220, 331, 329, 332, 131, 320, 256, 233, 133, 156, 115},
-		'\u6c9b': []uint16{200, 402, 227, 130, 327, 315, 220, 127, 252, 129, 228},
-		'\u6c9f': []uint16{425, 152, 230, 225, 125, 330, 142, 227, 129, 327, 252, 315, 325, 332, 130, 229, 352},
-		'\u6ca1': []uint16{152, 407, 120, 352, 252, 215, 100, 320, 207, 115, 315, 107, 101, 125, 300, 132, 327, 317, 156, 325, 227},
-		'\u6ca3': []uint16{427, 227, 130, 327},
-		'\u6ca4': []uint16{452, 156, 327},
-		'\u6ca5': []uint16{125, 426, 315, 233, 227, 362, 262, 327, 325, 152, 232, 225, 330, 132},
-		'\u6ca6': []uint16{152, 252, 125, 120, 156, 200, 320, 232},
-		'\u6ca7': []uint16{130, 330, 125, 115, 315, 230, 320, 131, 228, 327},
-		'\u6ca9': []uint16{413, 130, 125},
-		'\u6caa': []uint16{417, 117, 125, 227, 332, 217, 132, 327, 130, 129, 330, 328, 228},
-		'\u6cab': []uint16{225, 327, 352, 325, 320, 125, 215, 332},
-		'\u6cad': []uint16{130, 417, 330, 227, 230},
-		'\u6cae': []uint16{100, 125},
-		'\u6cb1': []uint16{330, 130, 132, 230, 125, 220, 200, 325, 131},
-		'\u6cb3': []uint16{130, 230, 125, 425, 225, 227, 330, 142, 252, 232, 325, 131, 127, 327, 229, 215, 228, 217, 315, 331, 132, 152, 332, 120, 129, 317, 220, 320, 117, 115, 256, 156, 343, 329},
-		'\u6cb8': []uint16{125, 152, 315, 454, 115, 252, 215, 327, 132},
-		'\u6cb9': []uint16{225, 125, 425, 325, 252, 331, 217, 232, 256, 162, 152, 115, 120, 320, 132, 117, 315, 215, 227, 362, 262, 220, 100, 332, 327, 330, 107, 231, 130, 204, 104, 156, 304, 317},
-		'\u6cbb': []uint16{225, 152, 252, 325, 452, 330, 256, 320, 125, 331, 243, 227, 327, 332, 232, 220, 130, 230, 315, 229, 215, 156, 120, 352, 115, 132, 329, 328, 117, 304, 217, 228, 129, 317},
-		'\u6cbc': []uint16{125, 225, 426, 227, 327, 229},
-		'\u6cbd': []uint16{417, 230, 152, 330, 115, 325, 129, 215, 227, 130, 327, 315},
-		'\u6cbe': []uint16{452, 152, 315, 115, 227, 130, 327, 120, 215},
-		'\u6cbf': []uint16{112, 434, 134, 152, 125, 242, 107, 225, 104, 327, 142, 227, 130, 234, 215, 230, 325, 332},
-		'\u6cc4': []uint16{454, 252, 152, 315, 325, 125, 156, 327, 115, 120, 256},
-		'\u6cc5': []uint16{152, 452},
-		'\u6cc9': []uint16{225, 425, 227, 232, 130, 125, 230, 330, 325, 426, 327, 342, 315, 152, 229, 331, 332, 215, 220},
-		'\u6cca': []uint16{225, 426, 252, 330, 125, 454, 230, 227, 327, 332, 152, 325, 200, 320, 130, 331, 256, 315},
-		'\u6ccc': []uint16{252, 225, 325, 125, 132, 152, 130, 332},
-		'\u6cd0': []uint16{413, 227},
-		'\u6cd3': []uint16{435, 227, 327},
-		'\u6cd4': []uint16{125, 325},
-		'\u6cd5': []uint16{225, 125, 417, 130, 252, 256, 325, 200, 207, 204, 320, 127, 227, 104, 331, 132, 327, 232, 215, 131, 238, 220, 315, 330, 129, 332, 217, 135, 352, 117, 152, 322, 317, 122, 356, 230, 156, 329, 120, 228, 229, 115, 304, 335},
-		'\u6cd6': []uint16{413},
-		'\u6cd7': []uint16{130, 417, 227, 325, 327, 229, 125, 330, 129, 230, 315},
-		'\u6cdb': []uint16{200, 452, 152, 117, 125, 317, 227, 325, 327, 207, 107, 320, 115, 252, 132, 130, 156, 120},
-		'\u6cde': []uint16{225, 413, 200},
-		'\u6ce0': []uint16{427, 331, 227, 232, 162, 327},
-		'\u6ce1': []uint16{125, 452, 225, 252, 152, 132, 227, 425, 325, 120, 315, 204, 332, 327, 220, 230, 256},
-		'\u6ce2': []uint16{130, 156, 225, 125, 417, 227, 230, 127, 132, 327, 325, 252, 152, 425, 315, 330, 131, 129, 120, 329, 332, 229, 215, 115, 104, 220, 331, 320, 228, 232, 117},
-		'\u6ce3': []uint16{252, 426, 115, 454, 325, 152, 215, 315, 220, 225, 256, 125, 227},
-		'\u6ce5': []uint16{125, 225, 425, 325, 143, 252, 227, 231, 315, 327, 330, 215, 232, 115, 130, 220, 120, 152, 452, 331, 320, 332, 100},
-		'\u6ce8': []uint16{152, 252, 452, 125, 315, 225, 325, 227, 215, 320, 232, 332, 220, 327, 331, 256, 104, 132, 156, 352},
-		'\u6cea': []uint16{225, 125, 425, 252, 320, 120, 162, 315, 115, 325, 215, 220, 227},
-		'\u6ceb': []uint16{413},
-		'\u6cee': []uint16{227, 427, 127, 232, 327, 132},
-		'\u6cef': []uint16{454, 152, 220, 327, 227},
-		'\u6cf0': []uint16{232, 130, 417, 127, 227, 125, 327, 132, 330, 230, 129, 162, 315, 332, 229, 225, 331, 329, 115, 325, 131, 215, 231, 104, 320, 228, 120, 200},
-		'\u6cf1': []uint16{115, 315, 262, 162, 327, 227},
-		'\u6cf3': []uint16{256, 225, 325, 454, 125, 252, 227, 327, 220, 328, 331, 332, 330},
-		'\u6cf5': []uint16{425, 225, 125, 232, 452, 227, 132, 304},
-		'\u6cf7': []uint16{413},
-		'\u6cf8': []uint16{130, 129, 132},
-		'\u6cfa': []uint16{413, 327, 130},
-		'\u6cfb': []uint16{252, 452, 125, 225, 152, 315, 320, 325, 425, 256, 220},
-		'\u6cfc': []uint16{452, 200, 125, 100, 152, 252, 220, 143, 327, 315, 227, 362, 262, 320, 133, 115, 352, 325, 225, 132, 330},
-		'\u6cfd': []uint16{327, 225, 227, 427, 325, 330, 127, 230, 125, 252, 426, 129, 232, 130, 229, 315, 215, 332},
-		'\u6cfe': []uint16{130, 230, 115, 325, 330, 331},
-		'\u6d01': []uint16{200, 227, 402, 162, 325, 100, 252, 327, 262, 320, 125, 115, 132, 220, 225, 232, 332, 215, 352, 152, 228, 315, 217, 331, 120},
-		'\u6d04': []uint16{152, 413, 320, 327},
-		'\u6d07': []uint16{452, 327},
-		'\u6d0b': []uint16{225, 230, 125, 325, 402, 330, 232, 331, 204, 320, 315, 227, 427, 262, 362, 215, 152, 332, 130, 142, 327, 115, 252, 228, 404, 220, 162, 100, 117, 352, 256, 200, 120, 104, 132, 304},
-		'\u6d0c': []uint16{413, 200, 327, 227},
-		'\u6d0e': []uint16{227, 413},
-		'\u6d12': []uint16{452, 200, 252, 152, 100, 138, 315, 215, 227, 120, 125, 362, 262, 327, 320},
-		'\u6d17': []uint16{152, 452, 252, 125, 256, 132, 204, 325, 100, 227, 156, 115, 215, 120, 225, 320, 232, 220, 327, 352, 315, 117, 200, 332},
-		'\u6d19': []uint16{227, 413, 327},
-		'\u6d1b': []uint16{130, 330, 327, 417, 227, 127, 129, 329, 125, 230, 332, 325, 132, 229, 131, 331, 232, 115},
-		'\u6d1e': []uint16{425, 225, 125, 227, 230, 152, 130, 327, 362, 262, 325, 200, 252, 115, 315, 330, 320, 117, 156, 220, 129, 352},
-		'\u6d25': []uint16{230, 417, 330, 227, 331, 125, 327, 130, 315, 115, 229, 217, 117, 132, 252, 332, 220, 325, 215, 225, 362, 262, 320, 231, 131, 129, 127, 228},
-		'\u6d27': []uint16{413, 227, 129, 327},
-		'\u6d2a': []uint16{127, 125, 227, 327, 427, 130, 252, 256, 143, 100, 325, 132, 225, 426, 320, 330, 115, 120, 230, 417, 332, 128, 131, 229, 315, 331, 152, 228, 232},
-		'\u6d2b': []uint16{225, 413},
-		'\u6d2e': []uint16{130, 230, 125, 330},
-		'\u6d31': []uint16{130, 325, 230, 330, 129},
-		'\u6d32': []uint16{230, 225, 325, 331, 426, 227, 330, 435, 217, 104, 232, 332, 327, 125, 304, 229},
-		'\u6d33': []uint16{459, 225, 327, 227},
-		'\u6d35': []uint16{227, 413, 327},
-		'\u6d39': []uint16{413, 227},
-		'\u6d3b': []uint16{256, 156, 152, 425, 125, 200, 252, 325, 452, 225, 100, 107, 320, 207, 104, 215, 120, 162, 315, 117, 352, 343, 227, 115, 220, 327, 330, 143, 132, 356, 229, 400},
-		'\u6d3c': []uint16{125, 200, 400, 225, 320, 220, 325, 227, 327, 230, 130, 232, 330, 203},
-		'\u6d3d': []uint16{252, 152, 427, 125, 327, 227, 317, 156, 325, 402},
-		'\u6d3e': []uint16{452, 225, 152, 125, 252, 227, 222, 204, 200, 325, 238, 256, 422, 232, 327, 132, 320, 129, 315, 220, 331, 130, 231, 228},
-		'\u6d41': []uint16{225, 125, 152, 156, 452, 325, 252, 256, 330, 204, 315, 120, 320, 100, 200, 215, 227, 230, 115, 327, 238, 217, 104, 220, 130, 207, 342, 332, 304, 132, 331, 352, 162, 362, 232, 135, 117},
-		'\u6d43': []uint16{315, 413, 327},
-		'\u6d45': []uint16{400, 125, 104, 100, 225, 200, 252, 362, 127, 162, 215, 220, 227, 320, 152, 315, 327, 129, 130, 262, 156, 115, 328},
-		'\u6d46': []uint16{225, 425, 125, 325, 252, 132, 152, 130, 256, 215, 452, 315, 227, 232},
-		'\u6d47': []uint16{452, 152, 325, 315, 227, 125, 120, 100},
-		'\u6d48': []uint16{413, 227, 327},
-		'\u6d4a': []uint16{403, 200, 125, 252, 327, 227, 325, 402, 315, 225, 130, 203, 204, 132},
-		'\u6d4b': []uint16{252, 256, 156, 152, 452, 325, 125, 132, 215, 227, 220, 229, 331, 217, 315, 225, 332, 327, 320},
-		'\u6d4d': []uint16{413, 227},
-		'\u6d4e': []uint16{225, 325, 130, 417, 320, 327, 227, 252, 331, 332, 454, 315, 200, 215, 230, 152, 220, 232, 330, 300, 328, 129, 132, 204, 125, 131, 127, 115, 317, 217, 229, 352, 304},
-		'\u6d4f': []uint16{130, 152, 125, 156},
-		'\u6d51': []uint16{125, 400, 100, 130, 225, 162, 262, 227, 115, 120, 362, 327, 252, 200, 315, 320},
-		'\u6d52': []uint16{332, 225, 413, 227, 232, 130, 129, 327},
-		'\u6d53': []uint16{100, 125, 400, 152, 162, 362, 325, 120, 115, 156, 104, 227, 262, 327, 315, 232, 304, 320, 330, 220, 132},
-		'\u6d54': []uint16{417, 130, 230, 327, 227, 330},
-		'\u6d59': []uint16{130, 417, 217, 131, 132, 232, 227, 327, 125, 331, 117},
-		'\u6d5a': []uint16{426, 252, 227, 130, 327, 228},
-		'\u6d5c': []uint16{230, 225, 132},
-		'\u6d5e': []uint16{452, 227},
-		'\u6d60': []uint16{413, 130, 227, 327},
-		'\u6d63': []uint16{454, 125, 325, 327, 227},
-		'\u6d66': []uint16{130, 230, 330, 131, 127, 417, 227, 427, 332, 217, 327, 232, 331, 117, 229, 129, 128, 132},
-		'\u6d69': []uint16{227, 427, 330, 100, 402, 315, 327, 115, 125, 152, 130, 325, 320, 352, 162, 132, 120, 156, 232, 331, 228},
-		'\u6d6a': []uint16{425, 225, 125, 232, 152, 100, 325, 252, 215, 315, 227, 320, 220, 132, 327, 332, 256, 120, 200, 330, 331, 115, 304, 230, 130, 229, 131},
-		'\u6d6e': []uint16{452, 125, 152, 252, 156, 100, 120, 325, 200, 227, 127, 320, 130, 230, 115, 330, 315, 332, 327, 225, 256, 132, 232, 204, 215, 229},
-		'\u6d6f': []uint16{413, 327, 130, 227},
-		'\u6d74': []uint16{252, 125, 456, 225, 227, 454, 256, 332, 115, 152, 327, 217, 325, 156},
-		'\u6d77': []uint16{125, 230, 425, 142, 225, 330, 130, 212, 227, 331, 127, 132, 327, 131, 252, 232, 325, 129, 332, 152, 242, 215, 156, 135, 117, 122, 315, 229, 342, 120, 320, 115, 256, 104, 217, 317, 328, 322, 204, 228, 220, 329, 128, 335},
-		'\u6d78': []uint16{152, 452, 252, 225, 125, 156, 327, 132, 325, 362, 262, 320},
-		'\u6d7c': []uint16{413},
-		'\u6d82': []uint16{452, 200, 125, 152, 127, 225, 262, 315, 325, 215, 256, 252, 230, 362, 227, 229, 115, 330, 130, 327, 232, 132, 220, 156},
-		'\u6d85': []uint16{426, 327, 227, 454, 330, 329, 229, 130, 129, 232, 230},
-		'\u6d88': []uint16{125, 156, 152, 252, 452, 100, 325, 120, 104, 315, 220, 320, 117, 132, 331, 327, 227, 225},
-		'\u6d89': []uint16{152, 252, 454, 156, 125, 325, 215, 320, 315, 227, 327, 256, 130, 220, 417, 115},
-		'\u6d8c': []uint16{152, 452, 252, 125, 215, 225, 227, 220, 232, 315, 200, 327, 229, 130, 330, 230},
-		'\u6d8e': []uint16{252, 315, 125, 325, 152, 115},
-		'\u6d91': []uint16{413, 130},
-		'\u6d93': []uint16{262, 162, 125, 327, 115, 227},
-		'\u6d94': []uint16{413, 327, 330, 227, 362, 262},
-		'\u6d95': []uint16{225, 426, 252, 315, 215, 125, 152, 115, 227},
-		'\u6d9b': []uint16{227, 225, 427, 315, 320, 125, 327, 426, 228, 232},
-		'\u6d9d': []uint16{225, 452, 252, 125, 320, 256, 327},
-		'\u6d9e': []uint16{413, 130, 327, 227, 129},
-		'\u6d9f': []uint16{130, 125, 262, 162, 362, 225},
-		'\u6da0': []uint16{413},
-		'\u6da1': []uint16{125, 225, 130, 320, 325},
-		'\u6da3': []uint16{152, 315, 115},
-		'\u6da4': []uint16{252, 125, 426, 325, 454, 327, 227, 256, 152, 315, 332, 132},
-		'\u6da6': []uint16{225, 252, 452, 325, 200, 327, 227, 125, 152, 232, 156, 220, 130, 132, 331, 332, 230, 256, 215, 330, 362, 120, 262},
-		'\u6da7': []uint16{426, 225, 227, 130, 327, 230, 229, 330},
-		'\u6da8': []uint16{252, 452, 125, 152, 200, 117, 315, 156, 227, 327, 325, 220, 132, 320},
-		'\u6da9': []uint16{200, 400, 252, 125, 215, 129, 152, 327, 130, 325},
-		'\u6daa': []uint16{130, 129},
-		'\u6dab': []uint16{413, 327, 227},
-		'\u6dae': []uint16{452, 125, 252, 352, 152},
-		'\u6daf': []uint16{225, 426, 215, 315, 320, 252, 227, 220, 332, 327},
-		'\u6db2': []uint16{225, 125, 426, 156, 232, 252, 325, 220, 227, 132, 256, 315, 215, 332},
-		'\u6db5': []uint16{225, 152, 125, 252, 227, 327, 454, 127, 256, 228, 325, 100},
-		'\u6db8': []uint16{252, 115},
-		'\u6dbf': []uint16{130, 125},
-		'\u6dc0': []uint16{125, 252, 225, 230, 325, 330, 130, 256, 331, 320},
-		'\u6dc4': []uint16{130, 230, 330, 329},
-		'\u6dc5': []uint16{130, 362, 133, 162, 125},
-		'\u6dc6': []uint16{252, 315, 152, 320},
-		'\u6dc7': []uint16{413, 227, 325, 130, 327, 132, 332},
-		'\u6dcb': []uint16{125, 452, 362, 262, 152, 115, 315, 320, 227, 156, 225, 215, 327, 220, 132, 325, 332, 100},
-		'\u6dcc': []uint16{452, 252, 330, 327},
-		'\u6dd1': []uint16{125, 200, 328, 315, 225, 129},
-		'\u6dd6': []uint16{413, 330, 225, 227, 327, 125},
-		'\u6dd8': []uint16{152, 452, 100, 125, 327, 320, 227, 325, 332, 132, 315},
-		'\u6dd9': []uint16{233, 133},
-		'\u6ddd': []uint16{413, 130},
-		'\u6dde': []uint16{413, 327, 227, 330},
-		'\u6de0': []uint16{413, 130},
-		'\u6de1': []uint16{125, 400, 200, 162, 152, 262, 362, 104, 252, 315, 100, 225, 327, 227, 115, 220, 120, 143, 130, 320, 330, 117, 325, 203, 156},
-		'\u6de4': []uint16{452, 152, 125, 252, 327, 225, 456, 320, 227, 220},
-		'\u6de6': []uint16{427, 227, 327},
-		'\u6deb': []uint16{100, 252, 402, 125, 315, 152, 200, 256, 332, 215, 156, 227, 220},
-		'\u6dec': []uint16{156, 132, 152},
-		'\u6dee': []uint16{130, 417, 217, 330, 227, 132, 327, 125, 230, 228, 317, 229, 129, 332, 131},
-		'\u6df1': []uint16{400, 152, 130, 100, 125, 117, 142, 225, 107, 207, 131, 104, 132, 200, 115, 143, 252, 204, 227, 315, 120, 320, 215, 228, 327, 243, 332, 220, 307, 325, 229, 162, 362, 232, 129, 330},
-		'\u6df3': []uint16{227, 402, 100, 229, 127, 132, 130, 327, 129, 330, 232},
-		'\u6df7': []uint16{125, 156, 452, 152, 100, 104, 115, 252, 315, 120, 200, 132, 227, 332, 320, 217, 327, 225, 204, 117, 325, 162, 362},
-		'\u6df9': []uint16{152, 452, 227, 225, 320, 125, 129, 252},
-		'\u6dfb': []uint16{452, 252, 152, 125, 315, 227, 115, 156, 120, 327, 320, 228},
-		'\u6dfc': []uint16{200, 215, 228},
-		'\u6e05': []uint16{100, 400, 125, 143, 152, 252, 227, 327, 243, 156, 225, 130, 204, 162, 362, 131, 127, 220, 320, 343, 330, 117, 120, 115, 325, 200, 256, 315, 230, 132, 104, 129, 262, 215, 331, 228, 332, 229, 217, 203, 103, 232, 101, 328, 329},
-		'\u6e0a': []uint16{227, 426, 125, 327, 225, 100, 320, 230, 330, 315, 220, 215, 328, 127, 229, 228, 115},
-		'\u6e0c': []uint16{413, 262, 362, 227, 327},
-		'\u6e0d': []uint16{452, 225, 252, 352, 125, 325},
-		'\u6e0e': []uint16{252, 156, 152, 330, 125},
-		'\u6e10': []uint16{207, 407, 107, 152, 125, 227, 315, 327, 115, 320, 215, 220, 228},
-		'\u6e11': []uint16{130, 413},
-		'\u6e14': []uint16{125, 417, 217, 227, 426, 317, 115, 327, 252, 331, 215, 330, 325, 225, 315, 120, 320, 130, 332, 152, 454, 228},
-		'\u6e17': []uint16{152, 452, 125, 252, 325, 227, 232, 332, 156, 132, 117, 256},
-		'\u6e1a': []uint16{413, 230, 330, 327, 227, 130},
-		'\u6e1d': []uint16{417, 227, 131, 130, 332, 215, 220, 217, 327, 129},
-		'\u6e20': []uint16{125, 425, 225, 227, 230, 127, 315, 325, 130, 327, 330, 152, 232},
-		'\u6e21': []uint16{252, 452, 152, 125, 325, 227, 225, 327, 130, 230, 330, 132, 256, 332, 232, 315, 320, 127, 229, 228, 220, 129, 329},
-		'\u6e23': []uint16{225, 425, 131, 132, 125, 325, 130, 227, 331, 232, 327},
-		'\u6e24': []uint16{125, 130, 330, 317},
-		'\u6e25': []uint16{130, 200, 331},
-		'\u6e29': []uint16{125, 225, 452, 100, 127, 252, 103, 130, 227, 325, 327, 232, 200, 115, 320, 152, 162, 129, 204, 332, 330, 120, 426, 362, 229, 117, 217, 230, 331, 315, 220, 104, 131, 132, 317},
-		'\u6e2b': []uint16{413},
-		'\u6e2d': []uint16{130, 228, 315, 229, 330, 230},
-		'\u6e2f': []uint16{230, 125, 417, 225, 122, 322, 331, 325, 330, 227, 117, 332, 238, 232, 252, 130, 327, 217, 317, 104, 132, 220, 120, 304},
-		'\u6e32': []uint16{152, 454, 227, 327},
-		'\u6e34': []uint16{152, 400, 252, 225, 215, 200, 220, 452, 315, 327, 227, 156},
-		'\u6e38': []uint16{125, 225, 452, 256, 152, 212, 325, 156, 252, 127, 104, 227, 217, 320, 115, 331, 330, 232, 352, 315, 120, 230, 220, 327, 332, 215, 229, 317},
-		'\u6e3a': []uint16{100, 402, 200, 215, 115, 315, 120, 227, 262, 327},
-		'\u6e43': []uint16{252, 459, 215, 227, 327, 220, 200},
-		'\u6e44': []uint16{130, 125, 129},
-		'\u6e4d': []uint16{402, 125, 100, 227, 225, 327},
-		'\u6e4e': []uint16{459, 252, 315},
-		'\u6e53': []uint16{413},
-		'\u6e54': []uint16{413},
-		'\u6e56': []uint16{130, 230, 125, 425, 225, 227, 142, 132, 330, 325, 327, 131, 332, 115, 220, 212, 315, 252, 317, 217, 331, 320, 232, 215, 104, 117, 229, 120},
-		'\u6e58': []uint16{417, 130, 117, 327, 227, 230, 125, 131, 132, 217, 332, 330, 331},
-		'\u6e5b': []uint16{130, 200, 127, 162, 227, 427, 327, 417, 331, 229, 362, 330},
-		'\u6e5f': []uint16{130, 413, 227, 327},
-		'\u6e6b': []uint16{413, 232, 227, 327},
-		'\u6e6e': []uint16{152, 115},
-		'\u6e7e': []uint16{230, 225, 452, 330, 227, 332, 331, 130, 125, 327, 435, 325, 232, 229, 242, 129, 304},
-		'\u6e7f': []uint16{152, 400, 125, 200, 452, 252, 225, 100, 325, 162, 320, 329, 262, 204, 227, 232, 362, 220, 332, 104, 317},
-		'\u6e83': []uint16{252, 152, 454, 125, 325, 115, 215},
-		'\u6e85': []uint16{452, 252, 152, 327},
-		'\u6e86': []uint16{413, 130, 327, 227},
-		'\u6e89': []uint16{256, 454, 252, 325, 220},
-		'\u6e8f': []uint16{413, 220},
-		'\u6e90': []uint16{225, 426, 152, 125, 227, 325, 352, 230, 252, 220, 232, 327, 115, 330, 315, 331, 332, 304, 215, 229, 204, 107, 207, 129, 132, 256, 228, 231, 217, 130, 328, 317},
-		'\u6e98': []uint16{115},
-		'\u6e9c': []uint16{452, 362, 262, 222, 152, 307, 225, 200, 162, 435, 252, 320, 115, 327, 227, 233, 125, 325, 220, 315},
-		'\u6e9f': []uint16{413, 227, 327},
-		'\u6ea2': []uint16{125, 252, 452, 152, 227, 120, 220, 327, 256, 215, 325, 320},
-		'\u6ea5': []uint16{227, 127, 327, 427},
-		'\u6ea7': []uint16{130, 413},
-		'\u6eaa': []uint16{425, 227, 230, 125, 225, 327, 330, 130, 229, 232, 332, 325, 426, 142, 331},
-		'\u6eaf': []uint16{252, 454, 152, 320, 227, 327, 315, 332, 256},
-		'\u6eb1': []uint16{413},
-		'\u6eb2': []uint16{413},
-		'\u6eb4': []uint16{426, 125, 132, 332},
-		'\u6eb6': []uint16{125, 152, 452, 325, 227, 225, 252, 320, 332, 232, 327, 262, 162, 204},
-		'\u6eb7': []uint16{413, 100},
-		'\u6eba': []uint16{454, 152, 252, 125, 225},
-		'\u6ebd': []uint16{125, 100},
-		'\u6ec1': []uint16{130, 125},
-		'\u6ec2': []uint16{320, 100, 125},
-		'\u6ec7': []uint16{417, 130, 129, 117, 125, 227, 327, 132},
-		'\u6ecb': []uint16{152, 125, 325, 452, 362, 262, 320, 227, 230, 327, 156, 120, 129, 232, 330, 132},
-		'\u6ecf': []uint16{130, 413, 227},
-		'\u6ed1': []uint16{252, 452, 152, 125, 200, 325, 100, 156, 127, 256, 130, 225, 120, 262, 132, 315, 162, 332, 227, 215, 327, 220, 320, 115, 330},
-		'\u6ed3': []uint16{413, 225, 330, 327},
-		'\u6ed4': []uint16{162, 262, 315, 227, 115, 454, 327, 120, 125, 320},
-		'\u6ed5': []uint16{127, 427, 227, 327, 332, 125, 130, 329, 229, 330},
-		'\u6ed7': []uint16{452},
-		'\u6eda': []uint16{452, 152, 162, 262, 125, 252, 315, 120, 320, 225, 156, 325, 104, 132, 362, 220, 115, 327, 227},
-		'\u6ede': []uint16{252, 152, 454, 125, 200, 315, 325, 256, 225, 156},
-		'\u6edf': []uint16{220, 262, 459},
-		'\u6ee0': []uint16{413},
-		'\u6ee1': []uint16{400, 152, 252, 200, 125, 130, 107, 127, 132, 227, 327, 315, 320, 115, 120, 162, 362, 104, 330, 325, 217, 215, 143, 100, 225, 243, 332, 230, 117, 207, 331, 262, 128, 352, 228},
-		'\u6ee2': []uint16{227, 413, 327},
-		'\u6ee4': []uint16{452, 252, 125, 325, 152, 132, 332, 225, 227, 129},
-		'\u6ee5': []uint16{252, 152, 401, 400, 315, 220, 125, 120, 115, 215, 225, 320, 352, 156},
-		'\u6ee6': []uint16{130, 417, 230, 320, 331, 327, 129, 330, 227, 317},
-		'\u6ee8': []uint16{125, 230, 225, 426, 130, 330, 227, 327, 331, 332, 242, 127, 325, 229},
-		'\u6ee9': []uint16{225, 426, 125, 230, 227, 252, 327, 325, 331, 330, 229, 332, 232},
-		'\u6ef4': []uint16{452, 125, 225, 222, 152, 133, 215, 435, 325, 162, 115, 362, 262, 322, 333, 422, 227, 315, 130, 120, 332, 156, 220, 132, 356, 320, 327},
-		'\u6ef9': []uint16{130},
-		'\u6f02': []uint16{100, 152, 452, 125, 225, 227, 362, 162, 115, 200, 156, 132, 327, 325, 315},
-		'\u6f06': []uint16{225, 425, 162, 125, 127, 452, 362, 262, 232, 115, 215, 252, 325, 315, 332, 229},
-		'\u6f09': []uint16{262, 362, 452},
-		'\u6f0f': []uint16{452, 252, 125, 152, 225, 132, 215, 120, 256, 115, 315, 156, 317, 352, 143, 325, 232},
-		'\u6f13': []uint16{252, 315, 130, 215, 220, 417, 227, 200},
-		'\u6f14': []uint16{152, 252, 125, 256, 225, 452, 156, 325, 320, 332, 327, 227, 215, 232, 331, 328, 117},
-		'\u6f15': []uint16{152, 125, 130, 330},
-		'\u6f20': []uint16{225, 230, 125, 200, 162, 130, 352, 252, 152, 325, 262, 115, 356, 120, 156, 228},
-		'\u6f24': []uint16{452},
-		'\u6f29': []uint16{125},
-		'\u6f2a': []uint16{327, 225, 413, 227, 125},
-		'\u6f2b': []uint16{100, 200, 325, 252, 452, 125, 225, 115, 152, 162, 156, 327, 220, 227, 262, 215, 120, 320, 130, 104, 332, 330},
-		'\u6f2d': []uint16{459},
-		'\u6f2f': []uint16{130},
-		'\u6f31': []uint16{452, 252, 152, 327, 325, 227, 125, 332},
-		'\u6f33': []uint16{130, 417, 230, 132, 330, 227, 125, 327},
-		'\u6f36': []uint16{252, 459},
-		'\u6f3e': []uint16{252, 452, 227, 130, 327},
-		'\u6f46': []uint16{459, 327},
-		'\u6f47': []uint16{100, 130, 227, 327, 162, 262, 417, 362, 131, 115},
-		'\u6f4b': []uint16{320, 162, 459, 227},
-		'\u6f4d': []uint16{130},
-		'\u6f58': []uint16{127, 427, 227, 130, 327, 229, 132, 128, 129, 330, 225, 125, 230, 329, 332},
-		'\u6f5c': []uint16{125, 452, 152, 104, 325, 256, 227, 107, 252, 130, 143, 156, 327, 115, 332, 132, 315, 120, 331},
-		'\u6f5e': []uint16{413, 132, 130, 327, 227},
-		'\u6f62': []uint16{413, 225, 130, 227, 320, 327, 252},
-		'\u6f66': []uint16{100, 315, 320},
-		'\u6f6d': []uint16{425, 230, 225, 330, 227, 125, 327, 315, 229, 332, 130, 331, 325, 215, 220},
-		'\u6f6e': []uint16{225, 425, 125, 227, 100, 130, 252, 152, 325, 327, 215, 320, 315, 332, 231, 120, 129, 232, 230, 156, 162, 352, 331, 228, 220},
-		'\u6f72': []uint16{452, 125},
-		'\u6f74': []uint16{152, 130},
-		'\u6f78': []uint16{115, 162, 262, 327, 227},
-		'\u6f7a': []uint16{262, 162, 233, 115, 133, 315},
-		'\u6f7c': []uint16{130, 230, 330, 413, 229, 227, 327},
-		'\u6f84': []uint16{152, 452, 227, 130, 327, 100, 362, 262, 162, 417, 132, 129, 200},
-		'\u6f88': []uint16{200, 413, 262, 320, 227, 327},
-		'\u6f89': []uint16{130, 413},
-		'\u6f8c': []uint16{413, 327, 227},
-		'\u6f8d': []uint16{227, 327, 413},
-		'\u6f8e': []uint16{130, 152, 125, 315, 227, 417, 327, 427, 320},
-		'\u6f9c': []uint16{130, 225, 215, 228, 320, 315, 232, 227, 200, 325},
-		'\u6fa1': []uint16{252, 426, 125, 325, 225, 332, 454},
-		'\u6fa7': []uint16{130, 417, 227, 230, 327},
-		'\u6fb3': []uint16{130, 417, 125, 217, 131, 230, 330, 322, 132, 135, 227, 317, 122, 327, 329, 117, 129, 232, 229, 331},
-		'\u6fb6': []uint16{413, 130, 227},
-		'\u6fb9': []uint16{127, 100},
-		'\u6fc0': []uint16{252, 100, 125, 152, 325, 452, 315, 320, 120, 200, 156, 227, 115, 220, 327, 225, 132, 256, 332, 304},
-		'\u6fc2': []uint16{413, 227, 327},
-		'\u6fc9': []uint16{413, 130, 227},
-		'\u6fd1': []uint16{130, 127, 229, 129, 230},
-		'\u6fd2': []uint16{152, 454, 156, 104, 227, 327, 125},
-		'\u6fde': []uint16{459, 227, 230},
-		'\u6fe0': []uint16{413, 227, 327, 130, 131},
-		'\u6fe1': []uint16{454, 315, 152, 327, 227},
-		'\u6fee': []uint16{130, 427, 127, 327, 227},
-		'\u6fef': []uint16{454, 252, 152, 327, 132},
-		'\u7011': []uint16{125, 426, 225, 330, 227, 327},
-		'\u701a': []uint16{227, 200, 125, 327, 413, 262, 315},
-		'\u701b': []uint16{413, 327, 227, 130, 225, 125, 132},
-		'\u7023': []uint16{315, 225},
-		'\u7035': []uint16{413},
-		'\u7039': []uint16{413},
-		'\u704c': []uint16{125, 156, 452, 152, 252, 225, 327, 227, 320, 130, 325, 117, 129, 315, 317, 120, 256, 104, 132, 332},
-		'\u704f': []uint16{413, 227, 327},
-		'\u705e': []uint16{459, 130},
-		'\u706b': []uint16{125, 425, 225, 252, 100, 325, 315, 152, 162, 320, 200, 107, 104, 215, 117, 115, 227, 204, 256, 130, 220, 120, 229, 327, 332, 330, 362, 262, 131, 132, 331, 232, 143, 300, 127, 128, 156, 230, 352, 217, 317},
-		'\u706d': []uint16{252, 452, 152, 325, 215, 227, 115, 315, 125, 220, 256, 352, 320, 232},
-		'\u706f': []uint16{225, 125, 425, 252, 315, 325, 227, 256, 143, 232, 115, 130, 332, 327, 230, 320, 215, 220, 132},
-		'\u7070': []uint16{125, 425, 325, 225, 162, 315, 402, 115, 152, 400, 320, 215, 227, 204, 332, 252, 220, 132, 200, 327, 217, 330, 100},
-		'\u7075': []uint16{125, 100, 225, 400, 227, 127, 130, 327, 200, 252, 325, 115, 230, 330, 215, 315, 129, 152, 320, 362, 262, 232, 229, 220, 104, 332, 156, 228, 132, 329},
-		'\u7076': []uint16{425, 225, 125, 252, 215, 227, 330, 327, 220},
-		'\u7078': []uint16{225, 413, 325, 227},
-		'\u707c': []uint16{454, 162, 200, 152, 262, 125, 256, 325, 315, 227, 252, 402, 327},
-		'\u707e': []uint16{125, 425, 225, 320, 256, 315, 252, 325, 215, 220, 120, 227},
-		'\u707f': []uint16{100, 227, 402, 362, 262, 315, 327, 115, 132, 120, 220, 320, 162},
-		'\u7080': []uint16{327, 129, 413, 227},
-		'\u7085': []uint16{413, 227, 327},
-		'\u7089': []uint16{225, 425, 125, 252, 132, 232, 227, 325, 115, 315, 220, 142, 327, 426, 130},
-		'\u708a': []uint16{125, 454, 225, 215, 252, 120},
-		'\u708e': []uint16{225, 426, 125, 100, 227, 327, 252, 129, 256, 232, 419, 262, 162, 315, 117, 325, 130, 132, 320, 120},
-		'\u7092': []uint16{152, 452, 252, 125, 156, 225, 227, 325, 120, 327},
-		'\u7094': []uint16{225, 125, 332},
-		'\u7095': []uint16{425, 125, 225, 142, 327, 252},
-		'\u7096': []uint16{452, 125, 252, 152, 132},
-		'\u7099': []uint16{315, 115, 100, 252, 152, 215},
-		'\u709c': []uint16{227, 427, 327},
-		'\u709d': []uint16{452},
-		'\u70ab': []uint16{152, 454, 100, 227, 327, 125, 132},
-		'\u70ac': []uint16{225, 332, 413, 215, 327, 227, 325, 315},
-		'\u70ad': []uint16{225, 425, 125, 325, 343, 252, 215, 152, 331, 132, 330, 130, 220, 332},
-		'\u70ae': []uint16{225, 125, 425, 227, 252, 152, 220, 325, 452, 217, 130, 331, 320, 315, 115, 215, 156, 330},
-		'\u70af': []uint16{315, 262, 162, 228, 115, 215},
-		'\u70b1': []uint16{413},
-		'\u70b3': []uint16{227, 327, 413, 130, 252, 315, 328},
-		'\u70b7': []uint16{435, 227},
-		'\u70b8': []uint16{252, 125, 452, 325, 152, 320, 225, 215, 304, 256, 227, 327},
-		'\u70b9': []uint16{225, 422, 222, 435, 152, 207, 125, 322, 352, 252, 256, 224, 307, 325, 315, 227, 327, 220, 215, 320, 331, 120, 324, 115, 122, 135, 232, 132, 304, 104, 338, 362, 262, 243, 204, 156, 200, 332, 217, 235},
-		'\u70bb': []uint16{459, 125},
-		'\u70bc': []uint16{252, 152, 452, 125, 156, 325, 227, 200, 256, 215, 117, 232, 327, 315, 332, 132, 331, 115, 220},
-		'\u70bd': []uint16{100, 325, 402, 227, 200, 327, 204},
-		'\u70c0': []uint16{452},
-		'\u70c1': []uint16{252, 402, 362, 262, 413, 315, 227, 327},
-		'\u70c2': []uint16{452, 252, 200, 400, 225, 125, 315, 262, 162, 215, 152, 362, 320, 327, 220, 325, 115, 227, 100, 132, 256, 120, 352},
-		'\u70c3': []uint16{225, 425, 125, 227, 327},
-		'\u70c8': []uint16{200, 400, 125, 227, 215, 315, 220, 225, 327, 201, 230, 104, 329, 162, 262, 204, 320, 330, 325, 132, 120, 115, 228, 252},
-		'\u70ca': []uint16{459, 252, 327, 227},
-		'\u70d8': []uint16{452, 152, 362, 262, 125, 320, 200, 227, 156, 115, 327},
-		'\u70d9': []uint16{125, 452, 225, 227, 325, 327},
-		'\u70db': []uint16{225, 426, 125, 252, 227, 152, 215, 315, 327, 320, 156},
-		'\u70df': []uint16{125, 425, 225, 252, 325, 130, 315, 320, 115, 227, 132, 207, 256, 232, 327, 215, 152, 120, 204, 131, 332, 162, 220, 331, 317},
-		'\u70e4': []uint16{452, 125, 252, 152, 325, 330, 256, 232, 132},
-		'\u70e6': []uint16{100, 452, 203, 200, 252, 220, 315, 103, 215, 152, 125, 227, 325, 320, 327, 156, 400, 230, 330},
-		'\u70e7': []uint16{452, 252, 152, 125, 325, 225, 315, 330, 204, 156, 215, 100, 220, 120, 327, 320, 132, 256},
-		'\u70e8': []uint16{227, 427, 327},
-		'\u70e9': []uint16{125, 452, 225, 327, 227},
-		'\u70eb': []uint16{452, 262, 152, 100, 252, 225, 104, 204, 125, 220, 327},
-		'\u70ec': []uint16{225, 220, 413, 227},
-		'\u70ed': []uint16{125, 400, 100, 252, 325, 200, 152, 225, 262, 162, 120, 130, 115, 352, 215, 256, 320, 330, 156, 362, 220, 132, 315, 332, 101, 327, 129, 232, 229, 227, 104, 304, 203, 230, 329, 117, 204, 317, 356, 127},
-		'\u70ef': []uint16{225, 459, 125, 325, 332, 232, 227, 327, 132},
-		'\u70f7': []uint16{225, 125, 425, 232, 227, 327, 325, 332, 132},
-		'\u70f9': []uint16{152, 452, 156, 125, 331, 215, 320, 132, 315, 227},
-		'\u70fd': []uint16{125, 230, 330, 115, 228},
-		'\u7109': []uint16{452, 130, 215, 227, 315, 115, 327, 460, 220, 438},
-		'\u710a': []uint16{152, 452, 125, 225, 256, 156, 325, 204, 232, 132, 252, 227, 320},
-		'\u7110': []uint16{452},
-		'\u7113': []uint16{413, 227, 327},
-		'\u7115': []uint16{227, 454, 152, 327, 315, 115, 162, 402, 328},
-		'\u7116': []uint16{452, 252, 227, 125},
-		'\u7118': []uint16{227, 427},
-		'\u7119': []uint16{452, 152, 252, 327, 132, 227, 125},
-		'\u711a': []uint16{152, 454, 252, 125, 215, 115, 227, 327, 120, 220, 156},
-		'\u7126': []uint16{125, 100, 400, 127, 130, 252, 225, 256, 325, 115, 135, 200, 162, 152, 156, 227, 427, 417, 327, 232, 132, 426, 131, 320, 215, 315},
-		'\u712f': []uint16{452, 227, 327},
-		'\u7130': []uint16{225, 125, 325, 228, 220, 315, 227},
-		'\u7131': []uint16{413, 227, 327},
-		'\u7136': []uint16{207, 206, 106, 201, 325, 204, 200, 320, 438, 315, 262, 215, 227, 225, 252, 306, 220, 332, 343, 327, 331, 330, 238, 352, 253, 228, 229, 406, 232, 362},
-		'\u7145': []uint16{452, 152},
-		'\u714a': []uint16{227, 459, 100, 327},
-		'\u714c': []uint16{230, 200, 402, 413, 215, 227, 325, 330, 327, 331},
-		'\u714e': []uint16{452, 152, 252, 156, 125, 325, 362, 262, 225, 132, 327, 320},
-		'\u715c': []uint16{228},
-		'\u715e': []uint16{452, 162, 120, 125, 115, 215, 152, 252, 409, 225, 315, 327, 227},
-		'\u7164': []uint16{125, 425, 225, 117, 252, 217, 325, 132, 332, 256, 331, 152, 227, 131, 232, 130},
-		'\u7166': []uint16{227, 327, 427, 200, 362, 262, 100},
-		'\u7167': []uint16{234, 152, 452, 125, 252, 225, 107, 325, 227, 327, 217, 315, 320, 330, 215, 156, 220, 332, 104, 115, 256, 120, 132},
-		'\u7168': []uint16{452, 227},
-		'\u716e': []uint16{452, 152, 252, 327, 227, 315, 332},
-		'\u7172': []uint16{452, 225, 125, 232},
-		'\u7173': []uint16{400, 227},
-		'\u7178': []uint16{452, 125, 252},
-		'\u717a': []uint16{452},
-		'\u717d': []uint16{152, 100, 120, 125, 156},
-		'\u7184': []uint16{152, 452, 125, 320, 225, 325, 156, 252, 327},
-		'\u718a': []uint16{127, 425, 125, 225, 325, 262, 162, 227, 327, 229, 315, 130, 115, 232, 320, 332, 252, 131, 331, 132},
-		'\u718f': []uint16{452, 156, 152, 252, 320, 125, 227, 132, 232, 327},
-		'\u7194': []uint16{125, 152, 452, 225, 120, 327, 156, 252, 227, 325, 232, 132, 320},
-		'\u7198': []uint16{452, 327},
-		'\u7199': []uint16{225, 232, 132, 115, 315, 328, 229, 228, 243, 162, 227, 127, 125, 330, 331},
-		'\u719f': []uint16{200, 152, 400, 452, 125, 100, 252, 315, 230, 325, 225, 215, 262, 220, 227, 115, 320, 120, 330, 256, 204, 332},
-		'\u71a0': []uint16{262, 162, 115, 315, 327, 227},
-		'\u71a8': []uint16{452, 152, 325, 125, 100, 327},
-		'\u71ac': []uint16{452, 252, 152, 200, 256, 227},
-		'\u71b3': []uint16{459, 200, 227},
-		'\u71b5': []uint16{413, 225, 227},
-		'\u71b9': []uint16{227, 132, 129, 100, 232},
-		'\u71c3': []uint16{125, 152, 252, 454, 325, 204, 115, 200, 320, 215, 227, 332, 256, 327, 232, 117, 304},
-		'\u71ce': []uint16{452, 215, 152, 220, 120, 125, 262, 320, 227, 327},
-		'\u71d4': []uint16{413},
-		'\u71d5': []uint16{127, 130, 427, 125, 227, 132, 327, 225, 131, 315, 426, 229, 325, 228, 115, 230, 120},
-		'\u71e0': []uint16{100, 402, 227},
-		'\u71e5': []uint16{200, 402, 100, 225, 125, 227, 220, 332, 325, 315, 215},
-		'\u71e7': []uint16{225, 125, 129, 228},
-		'\u71ee': []uint16{227, 413, 327},
-		'\u71f9': []uint16{225, 413},
-		'\u7206': []uint16{152, 200, 125, 452, 252, 156, 325, 232, 332, 204, 104, 220, 225, 256, 362, 262, 217, 227, 120, 327},
-		'\u721d': []uint16{459},
-		'\u7228': []uint16{413, 227},
-		'\u722a': []uint16{426, 130, 125, 225, 215, 227, 329, 325, 332, 330},
-		'\u722c': []uint16{452, 152, 125, 252, 320, 215, 325, 120, 156},
-		'\u7230': []uint16{413, 227, 327},
-		'\u7231': []uint16{452, 125, 252, 327, 100, 200, 127, 152, 130, 225, 227, 132, 204, 129, 115, 330, 156, 220, 131, 256, 215, 120, 331, 300, 320, 315, 362, 262, 217, 229, 232, 325, 332, 329, 143, 128},
-		'\u7235': []uint16{225, 227, 426, 125, 252, 327, 215},
-		'\u7236': []uint16{125, 225, 426, 238, 227, 104, 325, 252, 327, 229, 320, 120, 117, 317, 315, 215, 143, 232, 332, 230, 115},
-		'\u7237': []uint16{225, 426, 227, 125, 325, 327, 330, 138, 320},
-		'\u7238': []uint16{225, 125, 425, 227, 327},
-		'\u7239': []uint16{425, 225, 125, 227, 327},
-		'\u723b': []uint16{413, 125, 227, 225},
-		'\u723d': []uint16{200, 100, 427, 227, 215, 132, 327, 252, 125, 262, 156, 362, 320, 129, 300, 115, 107, 325, 304, 402},
-		'\u723f': []uint16{435, 227, 327},
-		'\u7247': []uint16{225, 222, 435, 122, 125, 332, 252, 325, 100, 104, 422, 232, 238, 331, 322, 220, 142, 132, 315, 256, 115, 362, 262, 204, 320, 107, 127, 215, 327, 227, 230, 304, 152, 135, 352, 129},
-		'\u7248': []uint16{252, 425, 125, 325, 225, 331, 330, 256, 204, 238, 320, 130, 232, 220, 324, 132},
-		'\u724c': []uint16{225, 252, 425, 125, 204, 232, 325, 220, 215, 227, 331, 217, 356, 320, 229, 130, 330},
-		'\u724d': []uint16{225, 413, 215, 325, 315},
-		'\u7252': []uint16{413, 225, 220, 227},
-		'\u7256': []uint16{413, 225},
-		'\u7259': []uint16{230, 425, 125, 225, 330, 325, 252, 227, 315, 332, 130, 320, 331, 215, 220, 156, 232, 229, 132, 152, 131, 200, 120, 115},
-		'\u725b': []uint16{425, 125, 127, 225, 330, 252, 325, 227, 130, 232, 135, 131, 315, 327, 115, 230, 143, 332, 331, 320, 352, 120, 129, 132, 215, 220, 100, 231, 243, 256, 128, 317},
-		'\u725d': []uint16{125},
-		'\u725f': []uint16{127, 427, 230, 152, 325, 330, 120, 227, 130, 454, 327, 128},
-		'\u7261': []uint16{125, 130, 327, 120, 132, 131},
-		'\u7262': []uint16{400, 100, 207, 107, 125, 252, 225, 152, 352, 330, 227, 215, 327, 325, 200, 315, 115, 101, 426},
-		'\u7266': []uint16{125, 132},
-		'\u7267': []uint16{125, 325, 252, 417, 225, 127, 217, 227, 317, 427, 327, 152, 256, 332, 454, 156, 230, 331, 426, 352},
-		'\u7269': []uint16{225, 125, 325, 426, 220, 252, 120, 330, 217, 320, 231, 215, 238, 152, 227, 332, 331, 115, 315, 132, 232, 327, 229, 117, 204, 156, 317},
-		'\u726e': []uint16{413},
-		'\u726f': []uint16{125, 325},
-		'\u7272': []uint16{252, 125, 325, 225, 215, 220, 343, 332},
-		'\u7275': []uint16{152, 452, 125, 156, 132, 115, 227, 315, 100, 120, 252, 320, 215, 101},
-		'\u7279': []uint16{125, 107, 227, 100, 407, 104, 229, 327, 200, 330, 230, 127, 152, 325, 129, 232, 329, 332, 225, 320, 130, 331, 117, 235, 162, 132, 304, 156, 317, 315, 115, 120, 131, 224, 217, 222, 231},
-		'\u727a': []uint16{152, 125, 315, 320, 117},
-		'\u727e': []uint16{454, 252},
-		'\u7280': []uint16{125, 426, 100, 225, 227, 325, 315, 327, 115},
-		'\u7281': []uint16{425, 230, 330, 125, 227, 152, 325, 225, 452, 132, 327, 115, 252, 228},
-		'\u7284': []uint16{125},
-		'\u728a': []uint16{426, 225, 125, 220, 325, 320, 315},
-		'\u728b': []uint16{435},
-		'\u728d': []uint16{130, 125},
-		'\u728f': []uint16{459},
-		'\u7292': []uint16{152, 125},
-		'\u729f': []uint16{400, 200, 125, 152, 227},
-		'\u72ac': []uint16{426, 225, 125, 325, 227, 315, 115, 215, 320, 220},
-		'\u72af': []uint16{452, 156, 252, 225, 152, 125, 215, 207, 325, 315, 120, 320, 115, 227, 220, 256},
-		'\u72b0': []uint16{125},
-		'\u72b4': []uint16{227, 225, 459},
-		'\u72b6': []uint16{125, 225, 426, 325, 320, 232, 252, 204, 227, 215, 152, 327, 220, 332, 256, 132, 131},
-		'\u72b7': []uint16{200, 100},
-		'\u72b8': []uint16{325, 225, 459},
-		'\u72b9': []uint16{100, 152, 407, 125, 132, 320, 315, 115, 130, 120, 362, 162, 229, 227, 330, 327, 325, 252, 131, 103},
-		'\u72c1': []uint16{459},
-		'\u72c2': []uint16{200, 407, 125, 100, 152, 115, 215, 315, 252, 120, 156, 225, 325, 320, 220, 103, 227, 232, 327, 201},
-		'\u72c3': []uint16{413},
-		'\u72c4': []uint16{127, 427, 227, 327, 426, 330, 332, 129},
-		'\u72c8': []uint16{201, 203, 459, 315},
-		'\u72cd': []uint16{125},
-		'\u72ce': []uint16{152, 100},
-		'\u72d0': []uint16{125, 427, 232, 152, 426, 225, 115, 327, 325, 315, 227, 252, 331},
-		'\u72d2': []uint16{125, 225},
-		'\u72d7': []uint16{425, 125, 225, 227, 327, 315, 115, 215, 120, 127, 132, 325, 152, 252, 220, 232, 320, 243, 332, 143, 130},
-		'\u72d9': []uint16{152, 125},
-		'\u72de': []uint16{200, 152, 220, 100, 320, 227},
-		'\u72e0': []uint16{407, 107, 207, 362, 262, 100, 200, 152, 103, 252, 315, 320, 307, 101, 162, 215, 400},
-		'\u72e1': []uint16{100, 153, 115, 125, 152},
-		'\u72e9': []uint16{125, 232, 152, 129},
-		'\u72ec': []uint16{152, 100, 125, 207, 107, 407, 115, 400, 315, 200, 156, 327, 104, 130, 120, 117, 101, 132, 320, 127, 325, 227, 206, 217, 252, 330, 230, 129, 352, 215, 331, 328},
-		'\u72ed': []uint16{100, 104, 402, 162, 200, 125, 115},
-		'\u72ee': []uint16{125, 425, 225, 130, 327, 230, 227, 426, 325, 232, 252, 332, 330, 129, 120, 315, 256},
-		'\u72ef': []uint16{200, 413},
-		'\u72f0': []uint16{100, 320, 120, 327},
-		'\u72f1': []uint16{225, 252, 426, 142, 125, 256, 215, 325, 231, 315, 230},
-		'\u72f2': []uint16{459, 225, 320},
-		'\u72f3': []uint16{225},
-		'\u72f4': []uint16{125, 459},
-		'\u72f7': []uint16{459},
-		'\u72f8': []uint16{225, 125, 325, 315, 227, 327},
-		'\u72fa': []uint16{459},
-		'\u72fb': []uint16{459, 327, 125},
-		'\u72fc': []uint16{425, 225, 125, 115, 101, 227, 315, 325, 103, 130, 100, 230, 320, 327, 232, 330},
-		'\u7301': []uint16{225, 459},
-		'\u7303': []uint16{413},
-		'\u730a': []uint16{225, 227, 459},
-		'\u730e': []uint16{125, 252, 225, 426, 152, 325, 454, 227, 256, 327, 131, 156, 315, 220, 320},
-		'\u7315': []uint16{125},
-		'\u7316': []uint16{100, 101},
-		'\u7317': []uint16{460, 230, 330, 410, 129, 327, 227},
-		'\u731b': []uint16{407, 107, 200, 100, 152, 125, 227, 400, 315, 320, 143, 101, 201, 327, 225, 156, 130, 352, 203, 131},
-		'\u731c': []uint16{452, 152, 156, 252, 332, 227, 215, 315, 120, 229, 256},
-		'\u731d': []uint16{115, 152, 262, 107, 409, 125},
-		'\u731e': []uint16{125},
-		'\u7321': []uint16{459},
-		'\u7322': []uint16{125, 320, 120},
-		'\u7325': []uint16{152, 103, 200},
-		'\u7329': []uint16{225, 125, 162, 325, 327, 227},
-		'\u732a': []uint16{425, 125, 225, 252, 325, 227, 132, 332, 327, 315, 320, 120, 243, 143, 117},
-		'\u732b': []uint16{425, 225, 125, 452, 227, 232, 130, 327, 320, 152, 120, 315, 325, 115, 331},
-		'\u732c': []uint16{225, 413},
-		'\u732e': []uint16{225, 452, 327, 152, 252, 325, 227, 129, 125, 130, 331, 215, 315, 352, 320, 120},
-		'\u7331': []uint16{413},
-		'\u7334': []uint16{426, 125, 225, 132, 325, 327, 227, 252, 315, 120, 215, 243, 143, 320, 115, 352, 130},
-		'\u7337': []uint16{227, 413, 327},
-		'\u733e': []uint16{200, 215, 413},
-		'\u733f': []uint16{426, 225, 125, 227, 325, 315, 215},
-		'\u734d': []uint16{413},
-		'\u7350': []uint16{125, 115, 325},
-		'\u7352': []uint16{413, 227},
-		'\u7357': []uint16{200, 459},
-		'\u7360': []uint16{125, 315},
-		'\u736c': []uint16{459, 125},
-		'\u736d': []uint16{225, 413, 325},
-		'\u736f': []uint16{459, 132},
-		'\u737e': []uint16{413, 225, 125},
-		'\u7384': []uint16{127, 327, 400, 125, 227, 130, 100, 315, 328, 215, 115, 225, 325},
-		'\u7387': []uint16{225, 452, 152, 107, 232, 252, 200, 204, 227, 325, 327, 217, 220, 100, 320, 207, 201, 332, 125, 120, 115, 315, 256},
-		'\u7389': []uint16{227, 125, 327, 425, 127, 130, 225, 132, 315, 215, 115, 229, 232, 129, 152, 325, 328, 330, 120, 230, 329, 228, 320, 217, 331, 332},
-		'\u738b': []uint16{127, 427, 125, 225, 229, 227, 327, 232, 325, 128, 252, 130, 330, 331, 320, 315, 230, 215, 120, 332, 220, 115, 228, 129, 132, 117, 328, 343, 143},
-		'\u738e': []uint16{459},
-		'\u7391': []uint16{225, 227, 413, 232, 215, 327},
-		'\u7396': []uint16{422, 227, 122, 327, 222},
-		'\u739b': []uint16{227, 127, 427, 327, 330, 125, 130, 229, 129, 132, 325, 232, 230, 332, 329, 331},
-		'\u73a2': []uint16{459, 327, 227},
-		'\u73a9': []uint16{452, 125, 152, 352, 252, 225, 115, 200, 315, 332, 300, 227, 325, 120, 327, 132, 156},
-		'\u73ab': []uint16{125, 327, 132, 225},
-		'\u73ae': []uint16{427, 227, 327, 228, 200},
-		'\u73af': []uint16{125, 117, 452, 256, 225, 152, 320, 227, 230, 325, 130, 132, 204, 215, 327, 330, 332, 232, 331, 120, 242, 317, 143, 304, 220, 131, 315, 228},
-		'\u73b0': []uint16{252, 143, 125, 444, 152, 142, 156, 104, 107, 325, 215, 256, 317, 227, 320, 115, 327, 304, 217, 262, 131, 331, 220, 315, 330, 100, 352, 332, 120, 225},
-		'\u73b2': []uint16{227, 327, 100, 427, 315, 115, 228},
-		'\u73b3': []uint16{125, 325},
-		'\u73b7': []uint16{152},
-		'\u73ba': []uint16{227, 426, 225, 125, 327, 228},
-		'\u73bb': []uint16{125, 417, 130, 325, 129, 120, 320, 331, 132, 332, 227, 117, 131, 156},
-		'\u73c0': []uint16{225, 229, 129, 325, 130, 132, 330, 329, 125},
-		'\u73c2': []uint16{228},
-		'\u73c8': []uint16{413, 225, 229, 330, 327, 227},
-		'\u73c9': []uint16{227, 427},
-		'\u73ca': []uint16{125, 230, 332, 252, 228, 327, 329, 129, 229, 325, 215, 330, 115, 315},
-		'\u73cd': []uint16{227, 100, 125, 152, 426, 204, 327, 130, 454, 320, 225, 132, 115, 325, 215, 315, 129, 229, 200, 156, 304, 228, 232},
-		'\u73cf': []uint16{227, 327, 413},
-		'\u73d0': []uint16{125},
-		'\u73d1': []uint16{200, 215, 315, 459, 227},
-		'\u73d9': []uint16{125, 413, 227, 130, 327},
-		'\u73de': []uint16{132, 225, 129, 130},
-		'\u73e0': []uint16{225, 426, 130, 125, 227, 325, 132, 417, 117, 232, 327, 330, 332, 215, 331, 115, 231, 230, 315, 304, 220, 320, 217, 229, 131, 228},
-		'\u73e5': []uint16{225, 413},
-		'\u73e7': []uint16{327, 413, 227, 325},
-		'\u73e9': []uint16{227, 413, 152, 132},
-		'\u73ed': []uint16{330, 425, 125, 252, 225, 325, 127, 222, 256, 227, 332, 142, 320, 132, 152, 232, 327, 230, 130, 422, 215, 229, 129, 331, 238, 143, 217, 115, 117, 120, 204, 253, 329, 100},
-		'\u73ee': []uint16{327},
-		'\u73f2': []uint16{413, 130, 227},
-		'\u7403': []uint16{225, 125, 425, 325, 252, 356, 227, 331, 332, 330, 232, 230, 256, 327, 217, 120, 220, 231, 132, 352, 343},
-		'\u7405': []uint16{225, 315, 130, 115, 228, 325, 362, 162},
-		'\u7406': []uint16{225, 125, 256, 252, 325, 152, 200, 425, 331, 320, 452, 227, 215, 230, 220, 115, 315, 327, 332, 217, 317, 229, 304, 330, 238, 120, 156, 129, 356, 127, 207, 352, 130, 132, 201, 100, 107, 232, 131, 300, 228, 117, 104},
-		'\u7409': []uint16{125, 130, 132, 327, 325},
-		'\u740a': []uint16{413},
-		'\u740f': []uint16{227, 413, 327, 225},
-		'\u7410': []uint16{200, 402, 100, 125, 203, 227, 327},
-		'\u741a': []uint16{427, 227},
-		'\u741b': []uint16{413, 227, 230, 327, 130},
-		'\u7422': []uint16{152, 452, 252, 327, 227, 215, 120, 352, 315},
-		'\u7425': []uint16{125},
-		'\u7426': []uint16{327, 227, 427, 232},
-		'\u7428': []uint16{227, 413, 327},
-		'\u742a': []uint16{227, 327, 413, 325, 115, 315, 228, 229},
-		'\u742c': []uint16{227, 413, 327},
-		'\u742e': []uint16{227, 413, 327},
-		'\u7430': []uint16{227, 413, 327},
-		'\u7433': []uint16{227, 229, 129, 327, 427, 115, 330, 332, 125, 230, 329, 228},
-		'\u7434': []uint16{225, 425, 227, 125, 325, 330, 252, 327, 232, 129, 115, 215, 230, 220, 320, 130, 228, 315, 329, 128, 229, 235},
-		'\u7435': []uint16{125, 130, 320},
-		'\u7436': []uint16{225, 459, 325, 330, 320},
-		'\u743c': []uint16{332, 227, 417, 130, 129, 427, 132, 327, 125, 426, 115, 127, 330, 315, 229, 325},
-		'\u7441': []uint16{227, 225, 459},
-		'\u7455': []uint16{125, 262, 332, 215, 115, 225},
-		'\u7457': []uint16{227, 413},
-		'\u7459': []uint16{225, 330, 125, 229, 129, 325, 230, 329},
-		'\u745a': []uint16{225, 325, 232, 459, 227, 125, 327},
-		'\u745b': []uint16{227, 327, 413},
-		'\u745c': []uint16{227, 427, 125, 327, 320, 228, 215, 315},
-		'\u745e': []uint16{130, 227, 417, 327, 127, 117, 330, 232, 125, 131, 129, 132, 332, 229, 200, 331, 426, 325, 329, 225, 228, 135, 252},
-		'\u745f': []uint16{426, 227, 327, 262, 229, 329, 162, 325, 152, 225, 129, 232, 330, 215, 230, 130},
-		'\u746d': []uint16{227, 413, 327},
-		'\u7470': []uint16{225, 325, 162, 125, 227, 100},
-		'\u7476': []uint16{227, 417, 327, 125, 132, 330, 130, 315, 115},
-		'\u7477': []uint16{459, 327, 227},
-		'\u747e': []uint16{227, 427, 327},
-		'\u7480': []uint16{162, 120, 320},
-		'\u7481': []uint16{413, 227, 327},
-		'\u7483': []uint16{225, 325, 320, 330, 220, 231, 332, 459, 227, 232},
-		'\u7487': []uint16{413, 227, 327, 125, 228},
-		'\u748b': []uint16{227, 413, 327, 252},
-		'\u748e': []uint16{125},
-		'\u7490': []uint16{227, 427, 325, 327},
-		'\u749c': []uint16{227, 427, 327, 225, 229},
-		'\u749e': []uint16{427, 227, 426, 125, 327, 315},
-		'\u74a7': []uint16{225, 315, 230, 228, 215, 252, 130, 330},
-		'\u74a8': []uint16{262, 320, 413, 220, 227},
-		'\u74a9': []uint16{227, 427, 127, 327},
-		'\u74ba': []uint16{425},
-		'\u74d2': []uint16{227, 427, 327},
-		'\u74dc': []uint16{225, 425, 125, 152, 330, 325, 230, 130, 129, 315, 215, 327, 115, 227, 132, 332, 331, 329, 229, 320},
-		'\u74de': []uint16{413},
-		'\u74e0': []uint16{413, 125, 227},
-		'\u74e2': []uint16{425, 222, 125, 115, 225, 320, 152, 215, 227, 435, 220},
-		'\u74e3': []uint16{225, 435, 426, 125, 325, 204, 320},
-		'\u74e4': []uint16{426, 125, 225, 325},
-		'\u74e6': []uint16{222, 425, 127, 327, 125, 230, 330, 227, 152, 129, 225, 229, 235, 325, 329, 322, 422, 130, 315, 335, 332, 232, 132, 135, 452, 331, 162, 215, 320, 435, 115, 252},
-		'\u74ee': []uint16{425, 130, 120, 320, 225, 115, 215, 327},
-		'\u74ef': []uint16{417, 225, 130, 230, 227, 125, 330},
-		'\u74f4': []uint16{413, 215},
-		'\u74f6': []uint16{425, 125, 225, 222, 325, 104, 215, 227, 422, 238, 320, 252, 330, 332, 327, 232},
-		'\u74f7': []uint16{225, 125, 426, 325, 232, 227, 220, 104, 100, 132, 327},
-		'\u74ff': []uint16{413},
-		'\u7504': []uint16{127, 152, 427, 227, 327, 156},
-		'\u750d': []uint16{413},
-		'\u750f': []uint16{426},
-		'\u7511': []uint16{427, 227, 125},
-		'\u7513': []uint16{413},
-		'\u7518': []uint16{130, 125, 127, 152, 427, 315, 417, 252, 330, 129, 217, 454, 227, 320, 327, 100, 325, 317, 132, 115, 401, 256, 332, 200, 104, 229, 331, 131, 120, 117},
-		'\u7519': []uint16{413, 232, 225},
-		'\u751a': []uint16{107, 409, 138, 162, 152, 315, 320, 106, 200, 227, 306, 207, 215, 115, 104, 245, 125, 327},
-		'\u751c': []uint16{125, 400, 227, 100, 200, 162, 362, 327, 115, 315, 220, 225, 215, 132, 104},
-		'\u751f': []uint16{156, 252, 125, 225, 152, 452, 325, 227, 204, 120, 203, 320, 331, 315, 100, 215, 352, 256, 327, 127, 207, 200, 143, 115, 232, 343, 222, 332, 117, 229, 362, 262, 220, 107, 243, 217, 304, 317, 104, 238, 228, 330, 132, 230, 307, 322, 300},
-		'\u7525': []uint16{225, 325, 125},
-		'\u7528': []uint16{252, 434, 225, 152, 256, 125, 325, 204, 200, 207, 220, 107, 120, 156, 320, 327, 227, 230, 331, 231, 215, 232, 315, 238, 100, 115, 352, 332, 132, 328, 317, 356, 304, 131},
-		'\u7529': []uint16{452, 152, 125, 120, 227, 132, 156},
-		'\u752b': []uint16{227, 407, 327, 225, 230, 232, 332, 329, 129, 328},
-		'\u752c': []uint16{125, 417, 130, 327, 330, 227, 117, 132},
-		'\u752d': []uint16{452, 407, 106, 152, 227},
-		'\u7530': []uint16{425, 127, 225, 125, 227, 230, 327, 232, 252, 330, 320, 231, 130, 128, 142, 332, 325, 331, 229, 129, 120, 215, 220, 152, 217, 315, 117, 131, 228, 356, 156, 329},
-		'\u7531': []uint16{434, 106, 200, 225, 107, 325, 307, 127, 315, 252, 207, 327, 120, 234, 125, 320, 331, 220, 356, 227, 352, 115, 304, 330, 332, 228, 128},
-		'\u7532': []uint16{425, 125, 204, 225, 325, 227, 132, 327, 230, 122, 120, 332, 104, 252, 330, 452, 315, 422, 220, 423, 215, 322, 217, 130, 232, 129, 320, 131, 143, 117, 331, 128},
-		'\u7533': []uint16{152, 127, 452, 132, 252, 227, 125, 327, 222, 215, 156, 325, 130, 322, 232, 230, 131, 143, 417, 129, 332, 329, 128, 117, 217},
-		'\u7535': []uint16{125, 425, 225, 325, 252, 217, 256, 331, 156, 332, 104, 352, 320, 120, 232, 204, 152, 220, 132, 117, 317, 315, 231, 304, 227, 327, 115, 129, 330, 356, 131, 130, 143},
-		'\u7537': []uint16{125, 404, 227, 115, 120, 320, 325, 225, 117, 315, 327, 331, 152, 104, 332, 131},
-		'\u7538': []uint16{230, 225, 362, 262, 325, 125, 330, 228, 331, 229, 130, 332},
-		'\u753a': []uint16{230, 330, 127, 130},
-		'\u753b': []uint16{225, 452, 125, 252, 325, 152, 315, 115, 331, 227, 215, 327, 320, 220, 120, 132, 352, 304, 232, 256, 142, 204, 330},
-		'\u753e': []uint16{413, 125, 325, 332},
-		'\u7540': []uint16{413},
-		'\u7545': []uint16{200, 400, 100, 152, 115, 227, 127, 125, 252, 315, 101, 330, 162, 120, 156, 327, 228, 320, 215},
-		'\u7548': []uint16{435, 227},
-		'\u754b': []uint16{227, 413, 152},
-		'\u754c': []uint16{225, 425, 332, 325, 125, 320, 238, 129, 331, 252, 132, 419, 152, 204, 227, 232, 230, 304, 215, 327, 217, 142, 220, 130, 330, 104, 256},
-		'\u754e': []uint16{413},
-		'\u754f': []uint16{454, 152, 252, 315, 100, 215, 227, 115, 220, 352, 256, 225, 327, 125, 204},
-		'\u7554': []uint16{225, 426, 230, 227, 242, 327},
-		'\u7559': []uint16{152, 252, 452, 256, 125, 325, 327, 227, 320, 343, 130, 315, 156, 215, 120, 115, 230, 204, 330, 129, 225, 331, 132},
-		'\u755a': []uint16{125},
-		'\u755b': []uint16{413, 125, 327, 227},
-		'\u755c': []uint16{125, 225, 426, 217, 325, 252, 425, 152, 232, 132, 315, 227},
-		'\u7565': []uint16{225, 407, 252, 152, 325, 107, 256, 227, 207, 201, 200, 125, 330, 215, 115, 315, 327, 229, 230, 130, 120, 320, 220, 129, 329},
-		'\u7566': []uint16{435, 426, 225, 227, 125},
-		'\u756a': []uint16{222, 435, 125, 130, 230, 207, 252, 243, 325, 315, 330, 220, 227, 215, 327, 322, 132, 331, 422},
-		'\u7572': []uint16{417, 132, 330, 227},
-		'\u7574': []uint16{227, 225, 413, 143, 327, 315},
-		'\u7578': []uint16{125, 426, 252, 152, 115, 315},
-		'\u7579': []uint16{130},
-		'\u757f': []uint16{413, 125, 232, 227, 229, 330, 327},
-		'\u7583': []uint16{413, 227},
-		'\u7586': []uint16{230, 125, 242, 330, 225, 227, 417, 331, 215, 325, 315, 332, 252, 327, 217, 320, 220},
-		'\u758b': []uint16{413},
-		'\u758f': []uint16{152, 456, 454, 200, 225, 125, 100, 156, 227, 130, 315, 120, 262, 215, 325, 115, 317, 362, 162, 327, 252, 117, 220, 129, 132},
-		'\u7591': []uint16{252, 207, 125, 200, 152, 325, 454, 320, 215, 426, 225, 220, 362, 262, 227, 315, 256, 115, 120, 327, 203},
-		'\u7594': []uint16{225},
-		'\u7596': []uint16{125},
-		'\u7597': []uint16{252, 225, 125, 325, 152, 454, 256, 320, 220, 217, 332},
-		'\u7599': []uint16{125, 320, 325, 362, 162, 327, 315},
-		'\u759a': []uint16{200, 413, 203, 201, 325},
-		'\u759d': []uint16{125},
-		'\u759f': []uint16{125, 225, 325},
-		'\u75a0': []uint16{225, 413},
-		'\u75a1': []uint16{225, 413, 325},
-		'\u75a3': []uint16{413, 225, 125},
-		'\u75a4': []uint16{425, 225, 125, 252},
-		'\u75a5': []uint16{125, 315},
-		'\u75ab': []uint16{125, 256, 252, 325, 225, 426, 220, 331},
-		'\u75ac': []uint16{225, 459},
-		'\u75ae': []uint16{225, 425, 125, 315, 115, 215, 325, 332},
-		'\u75af': []uint16{100, 452, 125, 252, 152, 327, 162, 362, 220, 320, 315, 225, 325},
-		'\u75b0': []uint16{125},
-		'\u75b1': []uint16{125, 225, 425, 325},
-		'\u75b2': []uint16{100, 400, 152, 315, 120, 125, 115, 215, 101},
-		'\u75b3': []uint16{125, 225},
-		'\u75b4': []uint16{225, 413},
-		'\u75b5': []uint16{225, 125, 215},
-		'\u75b8': []uint16{225, 459},
-		'\u75b9': []uint16{225, 125, 352, 325},
-		'\u75bc': []uint16{125, 452, 252, 400, 200, 152, 100, 262, 315, 325, 362, 220, 103, 156},
-		'\u75bd': []uint16{225, 413},
-		'\u75be': []uint16{125, 426, 325, 225, 152, 315, 227, 320, 107, 115, 262, 215, 409, 101, 331, 332, 402},
-		'\u75c2': []uint16{425, 252, 225, 227},
-		'\u75c3': []uint16{459, 225},
-		'\u75c4': []uint16{125},
-		'\u75c5': []uint16{225, 125, 425, 252, 152, 325, 238, 315, 256, 227, 207, 156, 117, 220, 115, 100, 215, 317, 120, 162, 217, 320, 132, 362, 331, 104},
-		'\u75c7': []uint16{225, 125, 426, 252, 232, 215, 220, 315, 217, 332},
-		'\u75c8': []uint16{125, 315},
-		'\u75c9': []uint16{156, 125, 325},
-		'\u75ca': []uint16{152},
-		'\u75cd': []uint16{315, 225, 215, 413},
-		'\u75d2': []uint16{400, 200, 252, 100, 215, 225, 262, 220, 320, 162},
-		'\u75d4': []uint16{125, 225, 132},
-		'\u75d5': []uint16{125, 225, 426, 320, 227},
-		'\u75d6': []uint16{413},
-		'\u75d8': []uint16{225, 125, 252, 232, 325},
-		'\u75db': []uint16{225, 103, 400, 200, 152, 100, 252, 125, 315, 115, 256, 325, 162, 362, 207, 220, 120, 215, 107, 320, 227, 156, 203, 332},
-		'\u75de': []uint16{125, 225, 325},
-		'\u75e2': []uint16{125, 325, 225},
-		'\u75e3': []uint16{425, 225},
-		'\u75e4': []uint16{125},
-		'\u75e6': []uint16{125},
-		'\u75e7': []uint16{256, 225, 252},
-		'\u75e8': []uint16{125, 225},
-		'\u75ea': []uint16{252, 459},
-		'\u75eb': []uint16{225, 459, 325, 332},
-		'\u75f0': []uint16{425, 252, 225, 125, 325, 115, 132},
-		'\u75f1': []uint16{125},
-		'\u75f4': []uint16{400, 162, 125, 152, 225, 362, 115, 215, 127, 262, 100, 315, 252, 227, 325, 320, 156, 327, 200, 401, 120},
-		'\u75f9': []uint16{252, 426, 315, 256, 200, 225, 125, 325},
-		'\u75fc': []uint16{125},
-		'\u75ff': []uint16{252, 413, 125},
-		'\u7600': []uint16{413, 220, 225, 125, 252, 325},
-		'\u7601': []uint16{215, 454, 252, 200},
-		'\u7605': []uint16{459},
-		'\u760a': []uint16{125},
-		'\u760c': []uint16{225, 325, 125},
-		'\u7610': []uint16{459},
-		'\u7615': []uint16{413},
-		'\u7617': []uint16{413},
-		'\u7618': []uint16{413, 225, 125},
-		'\u7619': []uint16{100, 413},
-		'\u761b': []uint16{459},
-		'\u761f': []uint16{125, 452, 225, 325, 362},
-		'\u7620': []uint16{200, 402, 100, 352},
-		'\u7622': []uint16{125},
-		'\u7624': []uint16{225, 425, 220, 325, 125, 227, 426, 331},
-		'\u7626': []uint16{400, 100, 200, 125, 162, 262, 215, 362, 132, 225, 120, 315, 130, 104, 327, 220},
-		'\u7629': []uint16{225, 220, 262, 362, 325, 459, 215, 227},
-		'\u762a': []uint16{400, 200, 125, 362, 162, 152, 262, 252},
-		'\u762b': []uint16{152, 452, 225, 252, 125, 256},
-		'\u7630': []uint16{125},
-		'\u7633': []uint16{427},
-		'\u7634': []uint16{315, 125, 225},
-		'\u7635': []uint16{413},
-		'\u7638': []uint16{452, 125},
-		'\u763c': []uint16{413},
-		'\u763e': []uint16{425, 225, 252, 200, 325, 125, 220},
-		'\u763f': []uint16{413, 225, 227, 325},
-		'\u7640': []uint16{232, 459},
-		'\u7643': []uint16{125, 413},
-		'\u764c': []uint16{225, 125, 425, 256, 252, 325, 331},
-		'\u764d': []uint16{425},
-		'\u7654': []uint16{125, 132},
-		'\u7656': []uint16{426, 225, 125},
-		'\u765c': []uint16{325, 225, 413},
-		'\u765e': []uint16{125, 120},
-		'\u7663': []uint16{225, 425, 115},
-		'\u766b': []uint16{125, 227, 100, 454, 252, 362, 262, 325, 132},
-		'\u766f': []uint16{413, 200},
-		'\u7678': []uint16{423, 122, 227, 132, 327, 322},
-		'\u767b': []uint16{152, 452, 252, 125, 327, 227, 156, 130, 107, 132, 229, 230, 129, 315, 330, 200, 120, 232, 115, 215, 331, 325, 225, 329, 320, 362, 262},
-		'\u767d': []uint16{125, 127, 252, 400, 325, 225, 130, 262, 143, 227, 200, 327, 330, 162, 132, 152, 220, 362, 204, 320, 115, 230, 107, 315, 207, 215, 331, 100, 129, 128, 104, 120, 232, 228, 256, 229, 332, 201, 131, 342, 352, 156},
-		'\u767e': []uint16{322, 125, 122, 222, 325, 422, 327, 315, 115, 132, 130, 320, 120, 107, 232, 104, 332, 217, 227, 215, 317, 331, 220, 124, 330, 131, 117, 127, 224},
-		'\u7682': []uint16{225, 125, 320, 325, 152, 315, 130, 132},
-		'\u7684': []uint16{448, 238, 225, 262, 245, 207, 145, 220, 107, 320, 325, 232, 228, 330, 306, 252, 204, 125, 227, 130, 327, 215, 315, 307, 120, 332, 200},
-		'\u7686': []uint16{407, 315, 227, 327, 115, 320, 129},
-		'\u7687': []uint16{125, 426, 225, 327, 227, 330, 130, 331, 325, 201, 215, 232, 132, 315, 127, 200, 329, 256, 100, 115, 320, 207, 152, 362, 230, 262, 128, 229, 131},
-		'\u7688': []uint16{152, 325},
-		'\u768b': []uint16{227, 230, 413, 130, 327, 330, 129, 228, 220},
-		'\u768e': []uint16{162},
-		'\u7691': []uint16{262, 162, 215, 315, 362},
-		'\u7693': []uint16{228, 132, 315, 115, 125, 100},
-		'\u7696': []uint16{417, 130, 125, 132, 217, 227, 117, 327, 330, 331},
-		'\u7699': []uint16{227, 262, 413, 327},
-		'\u76a4': []uint16{413, 227},
-		'\u76ae': []uint16{125, 225, 425, 127, 325, 200, 320, 252, 327, 112, 220, 227, 129, 315, 115, 232, 215, 120, 229, 329, 204, 130, 330, 100, 230, 332, 352, 132, 256},
-		'\u76b1': []uint16{225, 452, 125, 425, 152, 227, 162, 215, 232, 362, 252, 315},
-		'\u76b2': []uint16{152},
-		'\u76b4': []uint16{425, 452, 152, 125},
-		'\u76bf': []uint16{225, 459, 227, 327},
-		'\u76c2': []uint16{225, 413, 327, 130},
-		'\u76c5': []uint16{435, 225, 426, 227, 125},
-		'\u76c6': []uint16{125, 225, 330, 425, 435, 222, 343, 230, 130, 220, 227, 315, 327, 262, 320, 252, 325, 152, 232},
-		'\u76c8': []uint16{125, 325, 454, 327, 227, 200, 220, 320, 262, 152, 252, 162, 362, 132, 130, 104, 215, 225, 315, 304, 232, 256, 332},
-		'\u76ca': []uint16{225, 325, 207, 252, 227, 127, 352, 200, 427, 327, 125, 130, 315, 132, 256, 220, 215, 107, 426, 320, 129, 152, 409, 120, 230, 454, 217, 332, 330, 262, 232, 115, 328, 343},
-		'\u76cd': []uint16{413},
-		'\u76ce': []uint16{135, 162, 132, 322, 320, 122, 315},
-		'\u76cf': []uint16{435, 222, 225, 325, 232, 422, 327},
-		'\u76d0': []uint16{425, 125, 225, 130, 325, 117, 252, 232, 227, 152, 330, 320, 327, 132, 215, 129, 315, 156},
-		'\u76d1': []uint16{156, 225, 125, 317, 417, 152, 327, 227, 331, 325, 426, 454, 130, 217, 117, 252, 256, 332, 132, 115, 131, 330},
-		'\u76d2': []uint16{225, 435, 125, 222, 426, 104, 422, 325, 232, 327, 238},
-		'\u76d4': []uint16{225, 125, 315, 132},
-		'\u76d6': []uint16{452, 252, 225, 325, 127, 125, 227, 152, 315, 129, 327, 130, 228, 230, 229, 220, 330, 115, 320, 128, 132, 256, 232},
-		'\u76d7': []uint16{454, 152, 225, 125, 256, 156, 252, 215, 315, 325, 120, 332, 115},
-		'\u76d8': []uint16{225, 435, 252, 325, 152, 125, 142, 222, 330, 127, 227, 207, 130, 156, 256, 315, 327, 320, 232, 422, 129, 215, 115, 100, 230, 220, 332, 229, 132, 143},
-		'\u76db': []uint16{452, 152, 200, 125, 127, 227, 232, 330, 104, 327, 262, 130, 143, 320, 204, 115, 331, 252, 120, 132, 315, 325, 332, 215, 220, 128, 229, 230, 129, 328},
-		'\u76df': []uint16{225, 217, 125, 325, 425, 252, 230, 231, 331, 220, 227, 426, 215, 332, 330, 256, 232, 152, 327, 315, 356},
-		'\u76e5': []uint16{104, 125, 152},
-		'\u76ee': []uint16{143, 225, 125, 426, 315, 215, 252, 152, 201, 115, 222, 200, 227, 325, 220, 320, 230, 330, 422, 253, 327, 120, 435, 204, 232, 256, 229, 130, 156},
-		'\u76ef': []uint16{452, 152, 262, 362},
-		'\u76f1': []uint16{130},
-		'\u76f2': []uint16{125, 101, 426, 225, 100, 252, 325, 152, 454, 117, 115, 327, 156, 331},
-		'\u76f4': []uint16{207, 101, 407, 152, 125, 252, 104, 130, 200, 315, 100, 227, 162, 115, 217, 262, 156, 327, 325, 120, 362, 132, 330, 320, 225, 131, 107, 215, 230, 117, 129, 232, 317, 331, 352, 304, 204},
-		'\u76f8': []uint16{152, 107, 407, 100, 225, 207, 125, 315, 120, 252, 327, 227, 127, 115, 320, 325, 204, 306, 106, 200, 130, 232, 215, 330, 156, 256, 220, 132, 328, 332, 229},
-		'\u76f9': []uint16{426, 252, 125, 352},
-		'\u76fc': []uint16{452, 152, 252, 127, 227, 215, 125, 327, 315, 320, 232, 132, 256},
-		'\u76fe': []uint16{203, 435, 125, 225, 227, 220, 215, 325, 332, 422, 222, 232, 330, 327},
-		'\u7701': []uint16{230, 425, 125, 225, 238, 331, 217, 252, 152, 104, 117, 142, 330, 100, 227, 231, 315, 229, 352, 215, 115, 132, 327, 325, 156, 317, 256, 120, 328, 220, 320, 200, 332},
-		'\u7704': []uint16{454},
-		'\u7707': []uint16{413, 227},
-		'\u7708': []uint16{215, 315, 459},
-		'\u7709': []uint16{125, 425, 227, 315, 225, 115, 330, 252, 352, 230, 327, 320, 130, 142, 215, 325, 220},
-		'\u770b': []uint16{452, 152, 252, 245, 125, 200, 220, 215, 320, 120, 156, 206, 315, 306, 352, 115, 227, 327, 225},
-		'\u770d': []uint16{452},
-		'\u7719': []uint16{230, 330, 413},
-		'\u771a': []uint16{426},
-		'\u771f': []uint16{107, 407, 125, 100, 201, 227, 252, 327, 200, 325, 127, 232, 207, 320, 315, 115, 225, 120, 204, 215, 332, 132, 206, 362, 129, 104, 256, 229, 262, 220, 162, 307, 330, 152, 300, 331, 131, 228, 329},
-		'\u7720': []uint16{252, 454, 256, 325, 227, 220, 343, 320, 215, 230, 225, 130, 327, 228, 332, 125, 115},
-		'\u7722': []uint16{413},
-		'\u7726': []uint16{413, 315, 252},
-		'\u7728': []uint16{452, 152, 315, 120},
-		'\u7729': []uint16{152, 454, 215, 252, 125, 315, 220, 227},
-		'\u772c': []uint16{252},
-		'\u772d': []uint16{427, 327},
-		'\u772f': []uint16{452, 362, 262, 152, 227, 327},
-		'\u7735': []uint16{413, 225},
-		'\u7736': []uint16{225, 426, 220, 315, 252},
-		'\u7737': []uint16{225, 125, 152, 156, 315, 215},
-		'\u7738': []uint16{125, 225, 252, 315},
-		'\u773a': []uint16{152, 252, 454, 227, 327, 215},
-		'\u773c': []uint16{125, 425, 143, 222, 225, 142, 207, 152, 252, 200, 320, 325, 315, 107, 220, 352, 215, 343, 120, 115, 100, 132, 227, 327, 332, 243, 422, 300, 330, 204, 104, 331},
-		'\u7740': []uint16{451, 252, 234, 206, 152, 220, 100, 200, 320, 107, 125, 222, 225, 238, 207, 352, 325, 315, 260, 201, 160, 245, 422, 215, 332, 327, 156, 162, 362},
-		'\u7741': []uint16{452, 207, 307, 125, 215, 327, 315, 227},
-		'\u7743': []uint16{452},
-		'\u7747': []uint16{454, 252},
-		'\u7750': []uint16{252, 320, 413, 227},
-		'\u7751': []uint16{225, 413, 125},
-		'\u775a': []uint16{115, 152, 413, 129},
-		'\u775b': []uint16{225, 215, 207, 426, 227, 327, 232, 125, 320},
-		'\u7761': []uint16{452, 152, 252, 125, 256, 156, 220, 227, 132, 115, 325},
-		'\u7762': []uint16{427, 130, 227, 252, 459},
-		'\u7763': []uint16{225, 256, 332, 454, 152, 325, 125, 331, 227, 156, 327, 217, 232},
-		'\u7765': []uint16{115, 459, 120},
-		'\u7766': []uint16{402, 200, 125, 327, 120, 315, 227, 220, 362, 215, 256, 262, 332},
-		'\u7768': []uint16{454, 252, 315, 320},
-		'\u776b': []uint16{125, 215, 325, 132, 225},
-		'\u776c': []uint16{252, 452, 256, 327, 215},
-		'\u7779': []uint16{252, 215, 452, 220, 115, 454, 320, 315, 325, 327},
-		'\u777d': []uint16{215, 315, 413},
-		'\u777e': []uint16{125, 225, 325, 332},
-		'\u777f': []uint16{100, 228, 129, 329, 320},
-		'\u7780': []uint16{413},
-		'\u7784': []uint16{152, 452, 132, 125, 227},
-		'\u7785': []uint16{452, 152, 227, 327, 315},
-		'\u778c': []uint16{152, 352, 125},
-		'\u778d': []uint16{413},
-		'\u778e': []uint16{452, 327, 125, 152, 315, 320, 325, 225, 456, 252, 120},
-		'\u7791': []uint16{152, 315},
-		'\u7792': []uint16{452, 252, 320, 152, 115, 315},
-		'\u779f': []uint16{452, 152},
-		'\u77a0': []uint16{120, 152, 115},
-		'\u77a2': []uint16{413},
-		'\u77a5': []uint16{452, 152, 225, 435, 456},
-		'\u77a7': []uint16{452, 152, 252, 220, 227, 300},
-		'\u77a9': []uint16{152, 315, 320, 215},
-		'\u77aa': []uint16{452, 315, 152, 362, 262, 325, 352, 200},
-		'\u77ac': []uint16{143, 322, 243, 115, 320, 315, 343, 207, 107, 120, 125},
-		'\u77ad': []uint16{152, 125},
-		'\u77b0': []uint16{252, 413, 325},
-		'\u77b3': []uint16{125, 252, 325},
-		'\u77b5': []uint16{454},
-		'\u77bb': []uint16{325, 454, 225, 152, 315, 115, 227, 215, 327, 220, 125, 156},
-		'\u77bd': []uint16{413},
-		'\u77bf': []uint16{127, 427, 128, 227, 327, 125},
-		'\u77c7': []uint16{152},
-		'\u77cd': []uint16{100},
-		'\u77d7': []uint16{152, 252},
-		'\u77db': []uint16{103, 425, 125, 320, 225, 315, 227, 327, 325, 215, 427},
-		'\u77dc': []uint16{100, 454, 227, 200, 327, 152, 252},
-		'\u77e2': []uint16{426, 125, 227, 115, 315, 215, 152, 225},
-		'\u77e3': []uint16{460, 215, 227, 145},
-		'\u77e5': []uint16{152, 252, 125, 452, 225, 100, 315, 215, 325, 227, 327, 220, 320, 200, 256, 120, 115, 156, 352, 331, 228, 330, 230, 104, 131, 130, 132},
-		'\u77e9': []uint16{225, 125, 426, 227, 362, 262, 220, 215, 332, 104, 200, 327, 315},
-		'\u77eb': []uint16{152, 427, 100, 127, 156, 115, 125, 227, 104, 402, 132, 327, 200},
-		'\u77ec': []uint16{125},
-		'\u77ed': []uint16{104, 400, 125, 252, 100, 162, 152, 262, 225, 317, 362, 215, 200, 325, 315, 115, 320, 304, 135, 132, 352, 232, 220, 217, 327, 332, 117, 156},
-		'\u77ee': []uint16{400, 452, 200, 125, 100, 325, 162, 152, 225, 362, 104},
-		'\u77f3': []uint16{125, 225, 426, 127, 227, 130, 117, 325, 327, 317, 330, 230, 425, 343, 331, 315, 232, 132, 417, 131, 215, 252, 143, 320, 104, 115, 332, 152, 128, 256, 229, 220, 304, 228, 217, 120, 129},
-		'\u77f6': []uint16{413, 230, 330, 227, 331},
-		'\u77f8': []uint16{125, 325},
-		'\u77fd': []uint16{125, 132},
-		'\u77fe': []uint16{325, 225, 125, 332, 130},
-		'\u77ff': []uint16{125, 225, 425, 325, 252, 320, 217, 204, 117, 232, 256, 230, 152, 104, 317, 327, 227, 238, 231, 331, 330, 120, 220},
-		'\u7800': []uint16{130, 413, 227},
-		'\u7801': []uint16{225, 125, 452, 207, 325, 252, 220, 152, 435, 320, 330, 256, 217, 130, 332},
-		'\u7802': []uint16{125, 425, 225, 325, 315, 227, 132, 232, 204, 332, 230, 330, 252, 327},
-		'\u7809': []uint16{433, 327},
-		'\u780c': []uint16{452, 252, 152, 204, 125, 220},
-		'\u780d': []uint16{452, 152, 125, 252, 156, 115, 320, 315},
-		'\u7811': []uint16{413},
-		'\u7812': []uint16{125},
-		'\u7814': []uint16{156, 125, 152, 117, 225, 452, 256, 331, 252, 325, 317, 217, 227, 132, 142, 327, 332, 330, 104},
-		'\u7816': []uint16{125, 425, 225, 227, 325, 252, 132, 315, 327, 232, 104, 320},
-		'\u7817': []uint16{459, 125},
-		'\u7818': []uint16{452},
-		'\u781a': []uint16{426, 225, 327, 125, 227, 330},
-		'\u781c': []uint16{413, 232, 225, 227},
-		'\u781d': []uint16{125},
-		'\u781f': []uint16{125},
-		'\u7823': []uint16{452, 225, 125},
-		'\u7825': []uint16{315, 426, 152, 156, 327, 227, 115},
-		'\u7826': []uint16{413, 225, 227},
-		'\u7827': []uint16{125, 426, 225, 227},
-		'\u7829': []uint16{413},
-		'\u782c': []uint16{125, 413},
-		'\u782d': []uint16{454, 252, 315, 230, 125, 256},
-		'\u7830': []uint16{433, 227, 327},
-		'\u7834': []uint16{152, 452, 256, 252, 125, 325, 315, 115, 100, 229, 156, 215, 120, 327, 320, 162, 362, 227, 220, 130, 352, 143, 225, 331},
-		'\u7837': []uint16{425, 125, 227, 225, 325, 120},
-		'\u7838': []uint16{452, 152, 115, 317, 320},
-		'\u7839': []uint16{413},
-		'\u783a': []uint16{426, 252, 454, 227, 327, 256, 125},
-		'\u783b': []uint16{452, 330, 230, 132, 227, 327, 125, 130},
-		'\u783c': []uint16{425, 232},
-		'\u783e': []uint16{125, 225, 325, 252},
-		'\u7840': []uint16{225, 325, 426, 220, 320, 227, 252, 327, 125, 331},
-		'\u7845': []uint16{425, 125, 225, 132, 325, 232, 332, 117, 330, 227},
-		'\u7847': []uint16{130, 125},
-		'\u784c': []uint16{452},
-		'\u784e': []uint16{413, 227},
-		'\u7850': []uint16{413},
-		'\u7852': []uint16{425, 232, 125},
-		'\u7855': []uint16{125, 402, 227, 200, 327, 100, 229, 115, 120, 330},
-		'\u7856': []uint16{413, 130, 227},
-		'\u7857': []uint16{459},
-		'\u785d': []uint16{125, 425, 225, 325, 120, 152, 332, 132, 327, 232, 452},
-		'\u786a': []uint16{413},
-		'\u786b': []uint16{125, 425, 152, 225, 252, 325, 332, 132, 232, 227, 130},
-		'\u786c': []uint16{400, 125, 200, 152, 107, 120, 320, 325, 100, 162, 217, 252, 317, 104, 315, 262, 132, 156, 227, 215, 131, 331},
-		'\u786d': []uint16{459},
-		'\u786e': []uint16{152, 200, 201, 101, 407, 325, 207, 100, 125, 301, 215, 130, 320, 307, 227, 120, 107, 352, 117},
-		'\u7877': []uint16{425, 452},
-		'\u787c': []uint16{425, 125, 225, 325, 227, 232, 132, 332, 327},
-		'\u7887': []uint16{413, 225},
-		'\u7889': []uint16{125},
-		'\u788c': []uint16{200, 362, 262, 252, 207, 125, 115, 315, 129, 256, 130, 320},
-		'\u788d': []uint16{225, 252, 452, 325, 100, 152, 204, 115, 315, 352, 320, 120, 215, 332},
-		'\u788e': []uint16{252, 125, 400, 452, 200, 315, 152, 215, 225, 325, 232, 327, 115, 362, 262, 132, 256, 203, 332, 162},
-		'\u7891': []uint16{225, 425, 125, 227, 304, 232, 215, 327, 230, 315, 330, 130, 252},
-		'\u7893': []uint16{413, 225, 327},
-		'\u7897': []uint16{435, 222, 225, 125, 325, 422, 330, 425, 327, 320, 227, 315, 332, 220, 132, 252},
-		'\u7898': []uint16{425, 125, 132, 325, 232, 332},
-		'\u789a': []uint16{413, 227, 330, 327},
-		'\u789b': []uint16{413, 225, 325},
-		'\u789c': []uint16{252, 459, 200},
-		'\u789f': []uint16{426, 435, 225, 125, 327, 325, 227},
-		'\u78a1': []uint16{225, 459},
-		'\u78a3': []uint16{125, 227, 225, 426, 130, 330},
-		'\u78a5': []uint16{413},
-		'\u78a7': []uint16{426, 327, 125, 127, 162, 315, 227, 225, 402, 130, 200, 120, 320, 132, 230, 262},
-		'\u78b0': []uint16{152, 452, 156, 252, 107, 352, 120, 320, 125, 227, 325, 327},
-		'\u78b1': []uint16{225, 425, 125, 325, 220, 120, 152, 332, 356, 132, 232, 331, 452, 215, 256},
-		'\u78b2': []uint16{413, 125},
-		'\u78b3': []uint16{425, 125, 225, 325, 232, 152, 132, 426, 220, 332},
-		'\u78b4': []uint16{225, 220, 325, 125, 252, 352},
-		'\u78b9': []uint16{413},
-		'\u78be': []uint16{452, 125, 152, 156, 130, 325, 227, 332},
-		'\u78c1': []uint16{125, 325, 426, 225, 152, 320, 130, 132, 232, 156, 327, 252, 304, 104, 330, 217},
-		'\u78c5': []uint16{422, 435, 225, 222, 315, 425, 252, 152, 452, 129, 125, 156, 227, 130},
-		'\u78ca': []uint16{227, 427, 315, 100, 228, 327, 115},
-		'\u78cb': []uint16{156, 252, 215, 315},
-		'\u78d0': []uint16{426, 125, 227, 315, 215, 327, 129, 130},
-		'\u78d4': []uint16{413, 225, 227},
-		'\u78d5': []uint16{452, 152, 362, 162, 352, 125, 320},
-		'\u78d9': []uint16{225, 125},
-		'\u78e8': []uint16{252, 452, 152, 125, 325, 200, 225, 256, 156, 315, 132, 162, 362, 320, 215, 332, 115, 327, 220, 104, 120, 330, 232},
-		'\u78ec': []uint16{426, 227, 225, 327},
-		'\u78f2': []uint16{225, 459},
-		'\u78f4': []uint16{435, 130},
-		'\u78f7': []uint16{125, 425, 132, 225, 325, 156, 232, 227, 332, 327, 117, 104},
-		'\u78fa': []uint16{225, 125, 132, 325, 332, 330},
-		'\u78fb': []uint16{130},
-		'\u7901': []uint16{425, 225, 125, 227, 230, 252, 327, 130},
-		'\u7905': []uint16{413},
-		'\u7913': []uint16{225, 459},
-		'\u791e': []uint16{459},
-		'\u7924': []uint16{459},
-		'\u7934': []uint16{215, 252, 256, 459},
-		'\u793a': []uint16{252, 225, 152, 256, 325, 454, 156, 125, 227, 320, 315, 104, 332, 327, 215, 120, 220, 330, 129},
-		'\u793c': []uint16{125, 425, 225, 252, 227, 327, 232, 103, 152, 200, 325, 315, 215, 115, 320, 143, 256, 204, 130, 220, 352, 229, 343, 230, 132, 201},
-		'\u793e': []uint16{125, 225, 425, 231, 325, 156, 120, 331, 320, 117, 220, 227, 131, 217, 230, 252, 238, 327, 229, 356, 317, 330, 232, 130, 256, 352, 104, 332, 228, 132},
-		'\u7940': []uint16{252, 413, 227, 325, 327, 125},
-		'\u7941': []uint16{130, 127, 427, 227, 125, 327, 128},
-		'\u7946': []uint16{459},
-		'\u7948': []uint16{152, 454, 426, 327, 227, 132, 104},
-		'\u7949': []uint16{225, 426, 227},
-		'\u7953': []uint16{413},
-		'\u7956': []uint16{125, 225, 227, 127, 325, 427, 327, 426, 229, 156, 252, 128, 120, 315, 230, 215, 320, 115, 330, 129, 130, 256, 328, 228, 332},
-		'\u7957': []uint16{413, 225, 227},
-		'\u795a': []uint16{227, 327, 413, 220, 252, 329},
-		'\u795b': []uint16{152, 454, 125, 315, 132},
-		'\u795c': []uint16{413, 332, 227, 229, 330, 327},
-		'\u795d': []uint16{452, 152, 252, 127, 156, 227, 125, 327, 229, 225, 128, 330, 230, 130, 325},
-		'\u795e': []uint16{125, 225, 425, 100, 325, 120, 315, 252, 227, 320, 132, 115, 127, 130, 215, 327, 200, 152, 232, 220, 332, 162, 330, 131, 362, 156, 230, 104, 229, 352, 329, 331, 201, 129},
-		'\u795f': []uint16{215, 315, 252, 413, 225, 200},
-		'\u7960': []uint16{426, 125, 227, 230, 225, 327},
-		'\u7962': []uint16{413, 125},
-		'\u7965': []uint16{227, 327, 427, 225, 262, 325, 100, 426, 130, 200, 230, 315, 228, 127, 402, 132, 232, 332, 330, 128, 320, 120, 215, 252, 229, 125},
-		'\u7967': []uint16{225, 413},
-		'\u7968': []uint16{225, 125, 425, 252, 325, 222, 256, 422, 220, 215, 320, 227, 152, 330, 331},
-		'\u796d': []uint16{452, 152, 125, 252, 327, 456, 156, 227, 220, 225, 256},
-		'\u796f': []uint16{229, 227, 413, 327, 228},
-		'\u7977': []uint16{252, 454, 152, 125, 156, 325, 327},
-		'\u7978': []uint16{425, 225, 125, 215, 315, 252, 115, 220, 152, 320, 120, 325},
-		'\u797a': []uint16{327, 227, 413},
-		'\u7980': []uint16{152, 252, 125},
-		'\u7981': []uint16{252, 152, 207, 452, 125, 325, 256, 215, 225, 156, 320, 315, 227, 327, 204, 130, 332, 352, 120, 317, 117, 132, 217, 343},
-		'\u7984': []uint16{227, 225, 327, 127, 427, 426, 130, 125, 220, 328, 215, 230, 232, 325},
-		'\u7985': []uint16{426, 125, 225, 227, 252, 152, 327, 325, 330, 132, 130},
-		'\u798a': []uint16{413},
-		'\u798f': []uint16{130, 327, 425, 125, 200, 127, 227, 132, 232, 330, 225, 230, 252, 331, 131, 229, 256, 129, 215, 325, 220, 315, 329, 115, 117, 120, 328, 152, 128, 228, 320, 332, 100},
-		'\u79a7': []uint16{229, 327, 227, 413, 232, 204, 220, 225},
-		'\u79b3': []uint16{400, 327, 227},
-		'\u79b9': []uint16{127, 227, 427, 232, 130, 327, 320, 228, 230},
-		'\u79ba': []uint16{230, 330, 227, 413, 325, 327, 332},
-		'\u79bb': []uint16{152, 452, 252, 225, 125, 325, 256, 315, 215, 156, 100, 130, 227, 115, 332, 200, 207, 327, 104, 220, 320, 120, 304, 132, 117, 317},
-		'\u79bd': []uint16{225, 426, 125, 315, 227, 325, 256, 332},
-		'\u79be': []uint16{426, 125, 225, 227, 132, 327, 232, 320, 230, 104},
-		'\u79c0': []uint16{200, 227, 402, 327, 125, 100, 215, 225, 232, 325, 130, 252, 162, 330, 315, 127, 262, 115, 328, 230, 129, 228, 120},
-		'\u79c1': []uint16{125, 100, 402, 104, 107, 225, 152, 256, 156, 315, 325, 200, 252, 215, 204, 220, 320, 117, 207, 227, 217, 120, 115},
-		'\u79c3': []uint16{400, 125, 262, 362, 152, 225, 325, 162},
-		'\u79c6': []uint16{426, 225, 125, 204, 325, 227},
-		'\u79c9': []uint16{327, 152, 454, 125, 227, 107, 115, 328, 315},
-		'\u79cb': []uint16{225, 327, 444, 143, 227, 125, 127, 243, 343, 152, 328, 315, 115, 132, 120, 215, 320, 325, 317, 252, 352, 129, 220, 262, 130, 104, 332, 330},
-		'\u79cd': []uint16{238, 222, 225, 125, 435, 422, 252, 152, 135, 235, 204, 320, 325, 127, 256, 220, 200, 215, 120, 217, 322, 115, 243, 227, 315, 332, 130, 352, 330, 117},
-		'\u79d1': []uint16{125, 225, 425, 331, 227, 325, 127, 320, 232, 230, 130, 327, 332, 129, 229, 238, 217, 317, 104, 330, 117, 329, 132, 152, 204, 220, 315, 131, 252, 120, 215, 231, 200, 304, 115, 352, 228},
-		'\u79d2': []uint16{425, 435, 422, 222, 125, 238, 235, 215, 225, 227, 315, 325, 252, 204, 327},
-		'\u79d5': []uint16{225, 125},
-		'\u79d8': []uint16{125, 200, 130, 426, 225, 252, 227, 417, 325, 327, 320, 362, 352, 152, 115, 262, 131, 120, 331},
-		'\u79df': []uint16{452, 152, 225, 125, 325, 252, 220, 320, 256, 332},
-		'\u79e3': []uint16{225, 413, 315, 227, 115, 327},
-		'\u79e4': []uint16{425, 225, 125, 325, 252, 232},
-		'\u79e6': []uint16{127, 427, 130, 243, 143, 125, 227, 417, 332, 327, 117, 132, 230, 129, 128, 131, 330, 315, 120, 325, 115, 232, 228, 331},
-		'\u79e7': []uint16{125, 425, 252, 325, 225, 452, 256, 352, 327, 227},
-		'\u79e9': []uint16{125, 426, 227, 115, 327, 437, 332, 152},
-		'\u79eb': []uint16{125},
-		'\u79ed': []uint16{130, 413},
-		'\u79ef': []uint16{225, 101, 252, 125, 152, 325, 452, 207, 256, 315, 227, 327, 220, 320, 156, 115, 120, 130, 229, 330},
-		'\u79f0': []uint16{452, 152, 252, 225, 125, 200, 325, 327, 100, 315, 201, 115, 320, 227, 120, 256, 217, 129, 156, 130},
-		'\u79f8': []uint16{225, 125},
-		'\u79fb': []uint16{252, 156, 452, 152, 125, 256, 225, 215, 315, 327, 227, 115, 104, 331, 325, 320, 332, 132, 220},
-		'\u79fd': []uint16{200, 225, 125, 215, 100, 315},
-		'\u7a00': []uint16{100, 400, 125, 152, 200, 162, 207, 120, 320, 362, 215, 104, 130, 227, 115, 132, 225, 156, 327, 352, 315, 220, 252, 232, 426, 325, 332},
-		'\u7a02': []uint16{413, 315},
-		'\u7a03': []uint16{413, 225},
-		'\u7a0b': []uint16{225, 125, 325, 425, 127, 220, 204, 227, 252, 435, 222, 207, 152, 232, 331, 327, 320, 104, 304, 315, 332, 215, 256, 128, 217, 352, 228, 230, 115, 422},
-		'\u7a0d': []uint16{407, 107, 207, 152, 120, 115, 112},
-		'\u7a0e': []uint16{225, 125, 425, 252, 325, 217, 104, 317, 256, 331, 143, 117, 220, 215, 332, 131, 327, 204, 304, 232, 152, 132, 330},
-		'\u7a14': []uint16{454, 200, 227},
-		'\u7a17': []uint16{426, 125, 115, 225, 327},
-		'\u7a1a': []uint16{200, 100, 125, 325, 228, 225, 328, 120},
-		'\u7a1e': []uint16{225, 325, 227, 125},
-		'\u7a20': []uint16{100, 400, 200, 227, 325, 315, 332, 125, 115},
-		'\u7a23': []uint16{227, 413, 325},
-		'\u7a33': []uint16{100, 200, 400, 125, 452, 107, 152, 252, 101, 120, 325, 362, 115, 104, 227, 162, 215, 262, 315, 132, 300, 327, 220, 304, 320},
-		'\u7a37': []uint16{225, 413, 227, 130, 327},
-		'\u7a39': []uint16{227},
-		'\u7a3b': []uint16{225, 125, 426, 320, 327, 331, 132, 252, 325, 227, 332, 352, 130, 217},
-		'\u7a3c': []uint16{225, 325, 127, 328, 125, 320},
-		'\u7a3d': []uint16{427, 152, 200, 125, 315, 330, 227, 252, 325, 220, 327, 156, 332, 215, 256, 217},
-		'\u7a3f': []uint16{225, 426, 125, 252, 325, 232, 217, 256, 220},
-		'\u7a46': []uint16{132, 127, 129, 327, 227, 330, 200, 427, 417, 131, 230, 329, 332, 228, 201, 128, 229, 331, 130},
-		'\u7a51': []uint16{459, 225, 227},
-		'\u7a57': []uint16{425, 225, 125, 227, 120, 132, 327, 252, 325, 232, 229, 417, 343, 330, 152},
-		'\u7a70': []uint16{413, 227, 327},
-		'\u7a74': []uint16{225, 426, 435, 125, 152, 252, 315, 327, 215, 230, 304, 325, 330, 220, 115},
-		'\u7a76': []uint16{256, 325, 107, 252, 331, 207, 454, 407, 225, 220, 315, 342, 200, 320, 152, 317, 227, 304},
-		'\u7a77': []uint16{400, 125, 207, 215, 115, 100, 200, 262, 315, 203, 152, 325, 362, 220, 252, 120, 227, 217, 132, 320, 327},
-		'\u7a78': []uint16{459, 225},
-		'\u7a79': []uint16{125, 225},
-		'\u7a7a': []uint16{125, 225, 400, 325, 142, 242, 152, 256, 252, 100, 227, 331, 127, 104, 162, 315, 320, 107, 452, 215, 115, 120, 352, 262, 207, 220, 217, 317, 200, 156, 231, 117, 232, 132, 204, 131, 103, 130, 327, 332, 362, 330, 229, 329, 328},
-		'\u7a7f': []uint16{452, 152, 252, 125, 104, 215, 315, 115, 156, 325, 200, 120, 320, 220, 132, 256, 232},
-		'\u7a80': []uint16{459, 125},
-		'\u7a81': []uint16{101, 156, 100, 152, 125, 256, 407, 115, 130, 225, 315, 132, 252, 325, 107, 227, 232, 327, 215, 217},
-		'\u7a83': []uint16{454, 252, 152, 325, 315, 115, 125, 120, 320, 256, 156, 225},
-		'\u7a84': []uint16{400, 200, 107, 100, 225, 252, 162, 362, 215, 125},
-		'\u7a86': []uint16{413},
-		'\u7a88': []uint16{100, 115, 327},
-		'\u7a8d': []uint16{426, 125, 225, 252, 315, 215, 220},
-		'\u7a91': []uint16{425, 125, 225, 227, 232, 230, 252, 330, 332, 325, 130, 256, 426},
-		'\u7a92': []uint16{152, 100},
-		'\u7a95': []uint16{459, 200, 315, 227},
-		'\u7a96': []uint16{225, 425, 152},
-		'\u7a97': []uint16{142, 125, 225, 426, 252, 232, 315, 227, 115, 435, 327, 215, 104},
-		'\u7a98': []uint16{452, 100, 125, 252, 200, 227, 327},
-		'\u7a9c': []uint16{452, 252, 215, 152, 320},
-		'\u7a9d': []uint16{425, 225, 125, 100, 452, 325, 307, 152, 327, 230, 332, 320, 252, 120, 329, 220, 330, 315},
-		'\u7a9f': []uint16{225, 426, 125, 230, 325, 215},
-		'\u7aa0': []uint16{425, 125, 315, 225},
-		'\u7aa5': []uint16{152, 454, 325, 252, 315, 125, 227, 115, 215},
-		'\u7aa6': []uint16{127, 427, 225, 227, 325, 125, 330, 327, 315},
-		'\u7aa8': []uint16{452, 325, 125},
-		'\u7aac': []uint16{413, 252},
-		'\u7aad': []uint16{413},
-		'\u7ab3': []uint16{413},
-		'\u7abf': []uint16{225, 325},
-		'\u7acb': []uint16{252, 107, 452, 152, 125, 322, 327, 156, 227, 325, 135, 204, 315, 104, 127, 117, 130, 115, 143, 215, 122, 330, 232, 256, 132, 129, 120, 331, 356, 320, 304, 220, 229, 328, 225, 228, 332, 200, 128, 231, 352},
-		'\u7ad6': []uint16{452, 152, 125, 207, 315, 425, 320, 327, 215, 130},
-		'\u7ad9': []uint16{452, 225, 152, 125, 142, 222, 230, 231, 232, 238, 252, 325, 227, 304, 120, 220, 217, 422, 130, 117, 256, 327, 330},
-		'\u7ade': []uint16{156, 125, 152, 454, 107, 227, 120, 327, 320, 252, 315, 325, 100, 207},
-		'\u7adf': []uint16{207, 407, 107, 152, 130, 227, 252, 327, 315},
-		'\u7ae0': []uint16{225, 222, 227, 435, 127, 125, 238, 422, 215, 327, 252, 320, 256, 230, 130, 315, 325, 128, 330, 228, 232, 217, 104, 229, 220, 129},
-		'\u7ae3': []uint16{152, 227, 252, 454, 125, 317},
-		'\u7ae5': []uint16{225, 127, 125, 427, 143, 425, 227, 325, 327, 220, 320, 331, 232, 315, 330, 215, 332, 115, 120, 132, 229, 217, 343},
-		'\u7ae6': []uint16{413, 227},
-		'\u7aed': []uint16{107, 252, 215, 225, 403, 115, 402, 152, 315, 200, 120, 227, 325},
-		'\u7aef': []uint16{225, 452, 212, 125, 152, 222, 227, 143, 100, 362, 327, 207, 262, 204, 215, 130, 217, 320, 220, 252, 162, 132, 242, 325, 200, 127, 315, 352, 129, 229, 112, 422},
-		'\u7af9': []uint16{125, 426, 225, 227, 327, 230, 215, 132, 325, 315, 330, 130, 129, 104, 120, 127, 352, 332, 425, 229},
-		'\u7afa': []uint16{230, 227, 427, 127, 327, 325, 128},
-		'\u7afd': []uint16{413, 315, 225},
-		'\u7aff': []uint16{426, 225, 315, 125, 325, 215, 327, 320, 252, 332, 227},
-		'\u7b03': []uint16{402, 152, 162, 227, 262, 327, 100, 325, 200},
-		'\u7b04': []uint16{413, 252},
-		'\u7b06': []uint16{225, 125, 325},
-		'\u7b08': []uint16{413, 225, 227, 252},
-		'\u7b0a': []uint16{125},
-		'\u7b0b': []uint16{225, 425, 125, 215, 227, 232, 230, 130, 426},
-		'\u7b0f': []uint16{413, 227, 327, 315},
-		'\u7b11': []uint16{452, 252, 125, 256, 227, 220, 162, 152, 200, 315, 225, 215, 320, 327, 115, 120, 325, 127, 352},
-		'\u7b14': []uint16{125, 435, 225, 222, 238, 252, 162, 422, 207, 325, 315, 152, 332, 320, 130, 227, 327, 215, 120, 232, 115, 156, 220, 132},
-		'\u7b15': []uint16{413, 327},
-		'\u7b19': []uint16{425, 227, 125, 225, 327},
-		'\u7b1b': []uint16{425, 125, 225, 127, 227, 426, 327, 325, 252},
-		'\u7b1e': []uint16{454, 252, 227, 327},
-		'\u7b20': []uint16{225, 227, 215},
-		'\u7b24': []uint16{125},
-		'\u7b25': []uint16{413, 327},
-		'\u7b26': []uint16{152, 125, 225, 452, 252, 325, 127, 227, 232, 327, 315, 130, 156, 454, 220, 320, 427},
-		'\u7b28': []uint16{400, 100, 200, 125, 120, 320, 115, 300, 227},
-		'\u7b2a': []uint16{427, 227},
-		'\u7b2b': []uint16{413},
-		'\u7b2c': []uint16{122, 422, 132, 143, 125, 227, 325, 120, 330, 225, 104, 331, 320, 322, 131, 130, 252, 232, 332, 229, 327, 222, 215, 129, 127, 230, 220, 207, 315, 124, 329},
-		'\u7b2e': []uint16{413, 227},
-		'\u7b33': []uint16{413, 227, 320, 327},
-		'\u7b38': []uint16{125},
-		'\u7b3a': []uint16{452, 225, 125, 227, 325},
-		'\u7b3c': []uint16{225, 152, 252, 452, 125, 425, 256, 100, 325, 115, 227, 426, 101, 327, 232, 120},
-		'\u7b3e': []uint16{413},
-		'\u7b45': []uint16{459},
-		'\u7b47': []uint16{413, 125, 227},
-		'\u7b49': []uint16{445, 152, 145, 245, 204, 125, 252, 200, 222, 238, 325, 320, 422, 207, 104, 225, 224, 155, 156, 115, 162, 220, 304, 130, 120, 327, 215, 356, 331, 256, 332, 315, 229, 352, 132},
-		'\u7b4b': []uint16{225, 425, 125, 252, 115, 320, 315, 232, 332, 227, 100, 325, 132},
-		'\u7b4c': []uint16{227, 413},
-		'\u7b4f': []uint16{225, 426, 125, 325, 130, 327, 227},
-		'\u7b50': []uint16{425, 225, 222, 125, 327, 435, 227},
-		'\u7b51': []uint16{225, 325, 452, 252, 152, 220, 304, 156, 227, 215, 327, 331, 132, 131, 328},
-		'\u7b52': []uint16{225, 435, 426, 125, 325, 104, 304, 320, 332, 227, 232, 330, 152, 130},
-		'\u7b54': []uint16{252, 152, 452, 125, 156, 330, 230, 227, 256, 120, 220, 362, 262, 333, 233, 315, 325, 327, 352, 329, 232},
-		'\u7b56': []uint16{225, 125, 227, 426, 152, 325, 252, 215, 156, 327, 220, 331, 232, 130, 315, 256, 332, 129, 329, 229},
-		'\u7b58': []uint16{413},
-		'\u7b5a': []uint16{125, 115, 413},
-		'\u7b5b': []uint16{452, 152, 125, 156, 225, 327, 232, 252, 315, 352},
-		'\u7b5d': []uint16{225, 425, 325, 227, 215, 327},
-		'\u7b60': []uint16{227, 327, 413, 130, 228},
-		'\u7b6e': []uint16{413, 225},
-		'\u7b71': []uint16{327, 413, 227, 127},
-		'\u7b72': []uint16{413, 125},
-		'\u7b75': []uint16{125, 225},
-		'\u7b77': []uint16{125, 225, 426, 325},
-		'\u7b79': []uint16{152, 217, 325, 452, 125, 252, 327, 227, 315, 225, 215, 320, 156, 220, 331, 117},
-		'\u7b7b': []uint16{413},
-		'\u7b7e': []uint16{152, 452, 325, 252, 125, 225, 156, 220, 227, 256, 327, 332},
-		'\u7b80': []uint16{100, 152, 107, 125, 425, 127, 225, 120, 227, 252, 315, 327, 162, 362, 427, 215, 104, 132, 128, 156, 200, 320, 130},
-		'\u7b8d': []uint16{452, 325, 225, 152, 232, 125},
-		'\u7b90': []uint16{413, 227},
-		'\u7b93': []uint16{225, 228},
-		'\u7b94': []uint16{225, 125, 217, 325},
-		'\u7b95': []uint16{413, 225, 125, 315},
-		'\u7b97': []uint16{252, 452, 152, 325, 225, 256, 207, 125, 220, 200, 120, 215, 331, 315, 304, 352, 227, 217, 204, 320, 156, 327, 115, 332},
-		'\u7b9c': []uint16{125},
-		'\u7b9d': []uint16{125},
-		'\u7ba1': []uint16{156, 206, 452, 225, 125, 256, 252, 152, 325, 331, 127, 320, 217, 317, 130, 227, 327, 117, 112, 232, 132, 220, 120, 332, 315, 215, 129, 115, 300, 128, 204, 207},
-		'\u7ba2': []uint16{459},
-		'\u7ba6': []uint16{413},
-		'\u7ba7': []uint16{413, 215},
-		'\u7ba8': []uint16{413},
-		'\u7ba9': []uint16{426, 125, 225, 227, 327},
-		'\u7baa': []uint16{115},
-		'\u7bab': []uint16{426, 252, 225, 227, 125, 327},
-		'\u7bac': []uint16{125},
-		'\u7bad': []uint16{425, 225, 325, 125, 256, 220, 227, 252, 315, 130, 331, 115, 215, 327, 320, 332, 330, 230, 120},
-		'\u7bb1': []uint16{225, 125, 435, 222, 325, 232, 252, 112, 315, 142, 104, 327, 422, 130, 132, 204, 117},
-		'\u7bb4': []uint16{125, 228},
-		'\u7bc1': []uint16{413, 230, 227, 225},
-		'\u7bc6': []uint16{426, 125, 417, 225},
-		'\u7bc7': []uint16{222, 435, 320, 422, 325, 225, 204, 125, 315, 238, 252, 227, 215, 220, 322},
-		'\u7bcc': []uint16{225, 459},
-		'\u7bd1': []uint16{215, 413},
-		'\u7bd3': []uint16{225, 125, 435, 426, 222, 325},
-		'\u7bd9': []uint16{426, 225, 330},
-		'\u7bda': []uint16{413},
-		'\u7bdd': []uint16{125, 115},
-		'\u7be1': []uint16{152},
-		'\u7be5': []uint16{225, 459},
-		'\u7be6': []uint16{225, 125},
-		'\u7bea': []uint16{227, 413},
-		'\u7bee': []uint16{125, 225, 417, 435, 252, 232, 325, 256, 142, 227, 231, 320, 117, 330, 332, 426, 331, 132, 317},
-		'\u7bf1': []uint16{125, 225, 315, 230},
-		'\u7bf7': []uint16{225, 425, 325, 204, 125, 227},
-		'\u7bfc': []uint16{413},
-		'\u7bfe': []uint16{225, 125},
-		'\u7c07': []uint16{152, 426, 435, 235, 135, 162, 215, 227, 322, 222, 252, 225, 132, 125, 262},
-		'\u7c0b': []uint16{426},
-		'\u7c0c': []uint16{262, 162, 362},
-		'\u7c0f': []uint16{413},
-		'\u7c16': []uint16{413},
-		'\u7c1f': []uint16{413},
-		'\u7c26': []uint16{413},
-		'\u7c27': []uint16{225, 425, 325, 125, 215, 232, 227},
-		'\u7c2a': []uint16{125, 225, 152, 332},
-		'\u7c30': []uint16{130},
-		'\u7c38': []uint16{252, 125, 256, 262, 362},
-		'\u7c3f': []uint16{426, 225, 125, 315, 327},
-		'\u7c40': []uint16{413},
-		'\u7c41': []uint16{225, 426, 315, 327, 320, 227},
-		'\u7c4d': []uint16{225, 426, 227, 125, 204, 127, 252, 331, 152, 325, 217, 200, 332, 215, 256},
-		'\u7c73': []uint16{222, 435, 422, 235, 225, 127, 125, 330, 325, 327, 229, 227, 129, 130, 332, 329, 315, 132, 256, 252, 320, 230, 335, 232, 220, 331, 131, 115, 104, 215},
-		'\u7c74': []uint16{452, 227},
-		'\u7c7b': []uint16{225, 435, 125, 238, 222, 152, 256, 204, 325, 422, 320, 215, 252, 227, 232, 315, 220, 104, 327, 120, 332, 130, 331},
-		'\u7c7c': []uint16{125, 325, 225},
-		'\u7c7d': []uint16{225, 426, 325, 125, 327, 252, 204, 227, 232},
-		'\u7c89': []uint16{225, 125, 425, 152, 104, 325, 115, 252, 232, 227, 327, 215, 220, 332, 162, 315, 452, 132, 156, 256, 320},
-		'\u7c91': []uint16{413, 225, 227},
-		'\u7c92': []uint16{125, 225, 435, 222, 325, 422, 332, 320, 252, 227, 327, 120, 132, 230, 352, 232},
-		'\u7c95': []uint16{225, 413, 232},
-		'\u7c97': []uint16{400, 100, 125, 101, 152, 115, 207, 107, 215, 262, 225, 220, 104, 120, 200, 227, 320, 315, 162},
-		'\u7c98': []uint16{125, 452, 152, 325, 100, 156, 132, 162, 204, 332, 362, 232},
-		'\u7c9c': []uint16{452},
-		'\u7c9d': []uint16{413, 225, 227},
-		'\u7c9e': []uint16{413},
-		'\u7c9f': []uint16{427, 225, 127, 227, 125, 325, 426, 215, 327, 129},
-		'\u7ca2': []uint16{413},
-		'\u7ca4': []uint16{417, 130, 125, 227, 327, 132, 332, 230, 117, 131, 317, 232},
-		'\u7ca5': []uint16{425, 225, 104, 315, 232, 220, 320, 252, 115},
-		'\u7caa': []uint16{425, 125, 225, 325, 215, 252, 327},
-		'\u7cae': []uint16{125, 425, 225, 325, 120, 217, 252, 117, 331, 227, 315, 215, 230, 330, 131, 327, 317, 332, 115, 256, 132, 320},
-		'\u7cb1': []uint16{225, 427, 325, 227, 327, 315, 215, 320, 332},
-		'\u7cb2': []uint16{100},
-		'\u7cb3': []uint16{125, 225},
-		'\u7cb9': []uint16{200, 207, 225, 325, 204, 327, 426, 227, 203},
-		'\u7cbc': []uint16{162, 262, 320, 220},
-		'\u7cbd': []uint16{125},
-		'\u7cbe': []uint16{125, 100, 400, 152, 225, 101, 115, 315, 252, 327, 325, 130, 227, 132, 232, 104, 120, 220, 215, 331, 332, 162, 256, 129, 156, 304, 317, 362, 131, 103},
-		'\u7cc1': []uint16{413},
-		'\u7cc5': []uint16{152, 252, 454},
-		'\u7cc7': []uint16{413},
-		'\u7cc8': []uint16{413},
-		'\u7cca': []uint16{200, 100, 362, 452, 252, 262, 125, 225, 152, 315, 320, 162, 325, 220, 352, 115, 256, 156},
-		'\u7ccc': []uint16{125},
-		'\u7ccd': []uint16{125},
-		'\u7cd5': []uint16{225, 200, 426, 125, 232, 132},
-		'\u7cd6': []uint16{225, 125, 425, 252, 325, 227, 331, 332, 327, 132, 152, 120, 315},
-		'\u7cd7': []uint16{413},
-		'\u7cd9': []uint16{200, 400, 125, 325, 362, 262, 227},
-		'\u7cdc': []uint16{127, 152, 427, 225, 100, 125, 227, 156, 232},
-		'\u7cdf': []uint16{452, 100, 152, 215, 400, 362, 262, 125, 225, 115, 325, 132, 156},
-		'\u7ce0': []uint16{425, 452, 252, 125, 225, 332, 315, 327, 227, 232},
-		'\u7ce8': []uint16{125, 400},
-		'\u7cef': []uint16{426, 125, 204, 402},
-		'\u7cfb': []uint16{225, 125, 452, 252, 322, 135, 320, 204, 220, 325, 122, 304, 227, 152, 120, 232, 231, 327, 132, 238, 142, 332, 331, 315, 104, 356, 156},
-		'\u7d0a': []uint16{100, 215, 402, 227},
-		'\u7d20': []uint16{225, 425, 125, 227, 152, 220, 327, 200, 107, 232, 426, 325, 215, 207, 100, 230, 115, 252, 409, 400, 130, 330, 315, 329, 331, 320, 129, 332, 229, 143, 401},
-		'\u7d22': []uint16{252, 127, 225, 227, 152, 107, 327, 427, 125, 256, 230, 130, 330, 417, 454, 325, 215, 200, 132, 229, 129, 426, 262, 315, 320, 220, 362, 329, 332, 162, 222, 115, 156, 131, 217, 232, 331, 343, 120},
-		'\u7d27': []uint16{100, 207, 400, 252, 107, 152, 407, 101, 200, 156, 106, 125, 320, 220, 120, 115, 104, 225, 232, 162, 332, 227, 325, 327, 362, 315, 132, 331},
-		'\u7d2b': []uint16{125, 400, 127, 130, 327, 104, 227, 325, 132, 131, 200, 315, 225, 215, 120, 129, 204, 162, 331, 230},
-		'\u7d2f': []uint16{152, 252, 400, 452, 200, 220, 330, 162, 156, 262, 320, 103, 315, 227, 125, 325, 107, 256, 225, 215, 362, 100, 120, 201, 130, 331},
-		'\u7d6e': []uint16{327, 452, 225, 125, 152, 362, 162, 227, 252},
-		'\u7d77': []uint16{413},
-		'\u7da6': []uint16{130, 127, 427},
-		'\u7dae': []uint16{459},
-		'\u7e3b': []uint16{252, 413, 227},
-		'\u7e41': []uint16{152, 100, 200, 125, 425, 315, 115, 227, 327, 454, 130, 220, 120, 156, 325, 129, 132, 320},
-		'\u7e47': []uint16{227, 434, 327},
-		'\u7e82': []uint16{252, 413, 152, 227, 325},
-		'\u7e9b': []uint16{413},
-		'\u7ea0': []uint16{152, 125, 454, 220, 362, 262, 117, 156, 227},
-		'\u7ea1': []uint16{413, 327, 252, 227, 115},
-		'\u7ea2': []uint16{125, 400, 127, 225, 325, 227, 252, 327, 262, 132, 204, 130, 331, 220, 100, 320, 152, 162, 131, 117, 315, 232, 120, 362, 332, 104, 115, 215, 228, 317, 330, 304, 300, 156, 229, 230},
-		'\u7ea3': []uint16{129, 315, 225, 329},
-		'\u7ea4': []uint16{125, 225, 320, 426, 325, 100, 152, 120, 252, 232, 227, 115, 332, 231, 327, 331, 132},
-		'\u7ea5': []uint16{232, 459, 227, 327},
-		'\u7ea6': []uint16{407, 225, 252, 207, 156, 230, 152, 325, 129, 217, 125, 331, 262, 130, 330, 107, 315, 362, 200, 132, 227, 327, 256, 115, 356, 304, 320, 229, 220, 215, 127, 232, 332, 352, 329, 131},
-		'\u7ea7': []uint16{225, 204, 435, 200, 256, 252, 238, 125, 222, 325, 422, 220, 320, 331, 207, 217, 227, 315, 352, 332, 224, 356, 304},
-		'\u7ea8': []uint16{125, 115, 120},
-		'\u7ea9': []uint16{413},
-		'\u7eaa': []uint16{225, 125, 243, 152, 343, 127, 325, 227, 327, 427, 117, 232, 426, 352, 320, 332, 256, 224, 317, 330, 331, 215, 120, 252, 104, 220, 217, 129},
-		'\u7eab': []uint16{325, 413, 225, 252, 327, 332},
-		'\u7eac': []uint16{225, 426, 327, 125, 325, 204, 227, 130, 315},
-		'\u7ead': []uint16{459, 215, 262, 320},
-		'\u7eaf': []uint16{400, 200, 100, 125, 227, 152, 107, 104, 252, 327, 325, 315, 132, 232, 256, 115, 328},
-		'\u7eb0': []uint16{125, 452},
-		'\u7eb1': []uint16{225, 425, 125, 252, 325, 227, 327, 256, 232, 132},
-		'\u7eb2': []uint16{425, 125, 227, 225, 325, 252, 327, 315, 228, 115, 320, 204},
-		'\u7eb3': []uint16{252, 152, 227, 452, 230, 127, 125, 330, 327, 132, 229, 225, 325, 232, 130, 417, 129, 117, 122, 329, 256, 315, 100, 332, 331, 231, 320, 322, 215, 131},
-		'\u7eb5': []uint16{152, 452, 252, 125, 107, 106, 115, 320, 325, 315, 229, 232, 200, 327, 215, 100, 227, 331, 406, 217, 256, 332, 120, 400, 132},
-		'\u7eb6': []uint16{225, 227, 459, 232, 215, 327, 115, 125, 325, 315, 220, 332, 200},
-		'\u7eb7': []uint16{207, 107, 225, 315, 100, 402, 215, 125, 152, 262, 115, 362, 162, 320, 325, 120, 101},
-		'\u7eb8': []uint16{225, 425, 125, 252, 325, 222, 232, 256, 315, 332, 115, 352, 132, 120, 422, 320, 220},
-		'\u7eb9': []uint16{225, 426, 325, 125, 227, 327, 115, 204, 232, 330, 332, 315},
-		'\u7eba': []uint16{125, 217, 325, 152, 452, 204, 332, 225, 252, 232, 156, 227, 132, 130, 331},
-		'\u7ebd': []uint16{130, 225, 417, 125, 131, 220, 327, 129, 332, 331, 227, 329, 229},
-		'\u7ebe': []uint16{413, 227, 152, 315, 327},
-		'\u7ebf': []uint16{225, 425, 125, 325, 242, 222, 232, 217, 212, 304, 256, 220, 252, 204, 227, 320, 120, 142, 327, 422, 215, 104, 331, 231, 132, 332, 117, 315, 130, 330},
-		'\u7ec0': []uint16{225, 413, 327, 325},
-		'\u7ec1': []uint16{413, 225},
-		'\u7ec2': []uint16{413, 227},
-		'\u7ec3': []uint16{256, 452, 152, 200, 252, 225, 325, 127, 125, 315, 227, 100, 327, 156, 215, 130, 228, 320, 207, 330, 132},
-		'\u7ec4': []uint16{152, 256, 125, 225, 435, 120, 331, 222, 325, 320, 252, 422, 317, 425, 238, 104, 327, 135, 235, 217, 328, 227, 117, 231, 156, 322, 332, 122, 204},
-		'\u7ec5': []uint16{225, 125, 220, 132, 232},
-		'\u7ec6': []uint16{125, 400, 201, 200, 100, 325, 152, 162, 225, 207, 107, 330, 362, 315, 320, 304, 352, 120, 327, 156, 115, 215, 262, 252, 227, 132, 204, 332, 129, 127, 232, 130, 220},
-		'\u7ec7': []uint16{252, 225, 325, 231, 125, 452, 152, 204, 220, 232, 331, 332, 217, 227, 320, 156, 315, 130, 352, 327, 104, 304, 330, 356, 256, 129},
-		'\u7ec8': []uint16{207, 107, 125, 407, 152, 252, 122, 243, 127, 215, 315, 130, 227, 220, 325, 320, 322, 115, 256, 132},
-		'\u7ec9': []uint16{262, 362, 125, 225},
-		'\u7eca': []uint16{452, 225, 152, 125, 362, 262, 252},
-		'\u7ecb': []uint16{413},
-		'\u7ecc': []uint16{215, 413},
-		'\u7ecd': []uint16{252, 130, 227, 327, 427, 325, 230, 330, 132, 125, 129, 127, 315, 225, 328, 131},
-		'\u7ece': []uint16{252, 315, 227, 413, 325, 327},
-		'\u7ecf': []uint16{125, 207, 434, 134, 225, 156, 325, 152, 107, 252, 320, 204, 120, 315, 220, 331, 117, 327, 332, 127, 200, 217, 227, 232, 215, 115, 300, 131, 256, 330, 317, 130, 304, 100, 201, 343, 301, 328, 230, 132, 262, 128, 352},
-		'\u7ed0': []uint16{413},
-		'\u7ed1': []uint16{452, 152, 252, 125, 220, 304, 156, 132},
-		'\u7ed2': []uint16{125, 225, 425, 325, 227, 232, 327, 252, 362, 162},
-		'\u7ed3': []uint16{125, 152, 252, 452, 156, 200, 225, 325, 100, 315, 320, 120, 362, 162, 352, 227, 127, 327, 256, 332, 115, 317, 220, 217, 117, 215, 331, 330, 356, 229, 130, 132, 262, 232},
-		'\u7ed4': []uint16{459, 315, 225, 327},
-		'\u7ed5': []uint16{252, 452, 152, 125, 227, 225, 215, 327, 130, 325, 156, 315, 332, 220, 320, 120, 100},
-		'\u7ed7': []uint16{452},
-		'\u7ed8': []uint16{125, 252, 452, 152, 225, 256, 115, 315, 227, 325, 327, 156, 332, 331},
-		'\u7ed9': []uint16{434, 252, 152, 256, 125, 325, 315, 330, 320, 117, 156, 225, 327, 132, 215, 331, 227},
-		'\u7eda': []uint16{100, 115, 120},
-		'\u7edb': []uint16{404, 125, 227, 327, 230, 130, 330},
-		'\u7edc': []uint16{225, 252, 452, 256, 325, 115, 125, 120, 352, 200, 152, 315, 327, 331, 227, 220, 320, 232, 304, 332, 104},
-		'\u7edd': []uint16{107, 252, 407, 122, 152, 125, 100, 215, 230, 315, 220, 320, 120, 104, 115, 225, 156, 162, 200, 262, 143, 227, 322, 325, 352},
-		'\u7ede': []uint16{452, 125, 325, 152, 115, 220, 435},
-		'\u7edf': []uint16{225, 152, 156, 125, 452, 325, 220, 120, 331, 227, 200, 107, 207, 327, 252, 320, 332, 217, 243, 352, 115, 315, 117, 232, 131, 204, 215, 229, 304, 201, 132, 317, 130},
-		'\u7ee0': []uint16{413},
-		'\u7ee1': []uint16{413, 225, 227, 327, 232},
-		'\u7ee2': []uint16{425, 225, 125, 227, 229, 327, 325, 132},
-		'\u7ee3': []uint16{452, 225, 125, 152, 327, 227, 315, 132, 232, 115, 330, 320, 252, 325, 332, 156},
-		'\u7ee5': []uint16{130, 417, 152, 217, 327, 227, 129, 330, 229, 230, 125, 132},
-		'\u7ee6': []uint16{125, 325},
-		'\u7ee7': []uint16{152, 454, 207, 125, 327, 106, 156, 227, 256, 215, 252, 325, 315, 332, 115, 232, 143, 328, 220},
-		'\u7ee8': []uint16{413},
-		'\u7ee9': []uint16{225, 125, 227, 104, 426, 325, 220, 327, 130, 252},
-		'\u7eea': []uint16{225, 325, 252, 426, 227, 327, 215, 352, 315, 125, 220, 243, 320},
-		'\u7eeb': []uint16{152, 125},
-		'\u7eed': []uint16{252, 253, 200, 207, 325, 225, 256, 452, 127, 152, 125, 215, 315, 320, 156, 227, 307, 204, 332, 327, 220},
-		'\u7eee': []uint16{100, 228, 127, 328},
-		'\u7eef': []uint16{125, 162},
-		'\u7ef0': []uint16{125, 452, 315, 200, 227, 215, 115, 320, 100, 130, 327, 362, 262},
-		'\u7ef1': []uint16{452},
-		'\u7ef2': []uint16{452, 227, 125},
-		'\u7ef3': []uint16{125, 225, 426, 427, 327, 230, 252, 227, 115, 330, 232, 220, 325, 228, 132, 320, 454, 204, 215},
-		'\u7ef4': []uint16{152, 225, 125, 330, 327, 227, 222, 127, 130, 156, 132, 325, 220, 427, 417, 129, 117, 329, 435, 232, 320, 332, 331, 422, 229, 252, 230, 352, 315, 131, 162, 115, 322, 454, 256, 217, 228, 426, 356},
-		'\u7ef5': []uint16{400, 125, 262, 152, 130, 225, 162, 200, 320, 100, 325, 227, 327, 362, 315, 220, 252, 115, 120, 101, 328, 228, 332, 131},
-		'\u7ef6': []uint16{327, 426, 225, 227, 125, 325, 215},
-		'\u7ef7': []uint16{452, 125, 152, 362, 262, 225},
-		'\u7ef8': []uint16{225, 125, 426, 320, 315, 325, 152, 327, 227},
-		'\u7efa': []uint16{435},
-		'\u7efb': []uint16{459, 200},
-		'\u7efc': []uint16{156, 125, 117, 426, 325, 120, 315, 152, 106, 227, 262, 131, 454, 327, 132, 331, 217, 332},
-		'\u7efd': []uint16{454, 152, 225, 215, 227, 315},
-		'\u7efe': []uint16{452, 227},
-		'\u7eff': []uint16{125, 400, 325, 327, 252, 227, 156, 262, 330, 127, 225, 204, 362, 129, 132, 162, 120, 315, 200, 115, 215, 152, 320, 328, 130, 332, 131, 220, 352, 104, 232},
-		'\u7f00': []uint16{452, 252, 225, 227, 125},
-		'\u7f01': []uint16{413},
-		'\u7f02': []uint16{459, 125},
-		'\u7f03': []uint16{413, 227, 327},
-		'\u7f04': []uint16{454, 152, 315, 227, 156},
-		'\u7f05': []uint16{130, 452, 152, 131, 417, 125, 132, 229, 329},
-		'\u7f06': []uint16{225, 125, 425, 325, 452, 220, 232, 227, 331},
-		'\u7f07': []uint16{413, 227, 327},
-		'\u7f08': []uint16{200, 215, 459, 262, 362},
-		'\u7f09': []uint16{252, 152, 325, 125, 156, 256, 225},
-		'\u7f0b': []uint16{413},
-		'\u7f0c': []uint16{413, 227},
-		'\u7f0e': []uint16{426, 225, 125, 227, 132},
-		'\u7f0f': []uint16{413},
-		'\u7f11': []uint16{413, 127},
-		'\u7f12': []uint16{452},
-		'\u7f13': []uint16{152, 100, 252, 452, 107, 207, 200, 125, 215, 115, 327, 156, 315, 201, 227, 162, 362, 217, 262, 120, 132, 325},
-		'\u7f14': []uint16{152, 125, 252, 320, 156},
-		'\u7f15': []uint16{222, 435, 262, 215, 162, 315, 362, 227, 325, 200, 422, 320, 152, 229},
-		'\u7f16': []uint16{125, 152, 452, 252, 225, 256, 325, 217, 117, 320, 120, 104, 156, 204, 332, 132, 331, 215, 315},
-		'\u7f17': []uint16{435, 227},
-		'\u7f18': []uint16{225, 425, 125, 252, 227, 325, 212, 134, 315, 138, 256, 215, 352, 327, 204, 320, 220, 232, 434, 200, 152, 115, 132, 332, 242},
-		'\u7f19': []uint16{125, 413, 130, 227, 327, 228},
-		'\u7f1a': []uint16{454, 252, 256, 125, 215, 325, 315},
-		'\u7f1b': []uint16{413, 200, 315},
-		'\u7f1c': []uint16{100},
-		'\u7f1d': []uint16{225, 452, 125, 325, 152, 252, 256, 425, 215, 132, 204, 220, 315, 352, 332, 320, 156},
-		'\u7f1f': []uint16{413, 125, 227},
-		'\u7f20': []uint16{452, 152, 252, 225, 100, 200, 215, 315, 327, 125, 115, 320, 362, 132, 162},
-		'\u7f21': []uint16{413, 227},
-		'\u7f22': []uint16{252, 413, 125},
-		'\u7f23': []uint16{413},
-		'\u7f24': []uint16{315, 162, 120},
-		'\u7f25': []uint16{100, 315, 162, 362},
-		'\u7f27': []uint16{459, 125},
-		'\u7f28': []uint16{426, 227, 225, 325, 125, 252, 327, 232},
-		'\u7f29': []uint16{252, 152, 452, 256, 125, 325, 217, 220, 156, 315, 320, 115, 352, 227, 304, 232, 332, 225},
-		'\u7f2a': []uint16{127, 215, 327, 427, 227, 435, 252, 229, 129, 225},
-		'\u7f2b': []uint16{152, 125, 156},
-		'\u7f2c': []uint16{413, 227, 327, 125},
-		'\u7f2d': []uint16{152, 452, 315, 227, 100},
-		'\u7f2e': []uint16{252, 152},
-		'\u7f2f': []uint16{413, 227},
-		'\u7f30': []uint16{125, 426, 252, 215, 325, 327, 315},
-		'\u7f31': []uint16{459, 100},
-		'\u7f32': []uint16{452},
-		'\u7f33': []uint16{413},
-		'\u7f34': []uint16{152, 252, 452, 225, 325, 156, 256, 125, 327, 220},
-		'\u7f35': []uint16{413, 227},
-		'\u7f38': []uint16{425, 225, 125, 232, 204, 325, 252, 227, 132, 130},
-		'\u7f3a': []uint16{152, 125, 452, 252, 200, 156, 220, 325, 225, 315, 115, 215, 100, 120, 227, 320, 332, 256},
-		'\u7f42': []uint16{125, 327},
-		'\u7f44': []uint16{454, 252, 115, 227},
-		'\u7f45': []uint16{125},
-		'\u7f50': []uint16{125, 225, 435, 325, 222, 142, 104, 320, 220, 232, 204, 252, 327, 422},
-		'\u7f51': []uint16{125, 225, 425, 142, 252, 232, 325, 152, 315, 215, 227, 331, 320, 132, 231, 115, 131, 327, 117, 352, 256, 120, 130, 104, 332, 217, 220},
-		'\u7f54': []uint16{413, 315, 115, 152, 227},
-		'\u7f55': []uint16{100, 401, 329, 252, 227, 229, 402, 230, 315, 232, 327, 330, 200, 325},
-		'\u7f57': []uint16{127, 330, 130, 417, 327, 227, 229, 125, 230, 325, 252, 129, 225, 232, 329, 332, 315, 152, 343, 331, 132, 100, 104, 204, 128, 131, 120, 320, 304, 156, 220, 328},
-		'\u7f58': []uint16{413, 230, 330},
-		'\u7f5a': []uint16{256, 452, 252, 152, 225, 125, 325, 315, 320, 117, 215, 156},
-		'\u7f5f': []uint16{413, 225},
-		'\u7f61': []uint16{459, 227, 225, 327, 125, 315},
-		'\u7f62': []uint16{452, 152, 160, 260, 252, 315, 227, 352, 115, 332, 156, 125},
-		'\u7f68': []uint16{413},
-		'\u7f69': []uint16{452, 225, 252, 125, 220, 327, 227, 232, 132},
-		'\u7f6a': []uint16{425, 125, 252, 256, 225, 325, 115, 215, 232, 220, 300, 320, 315, 120, 327},
-		'\u7f6e': []uint16{252, 225, 452, 256, 152, 125, 325, 315, 327, 115, 132, 320, 156, 215, 120, 332, 304, 232, 229},
-		'\u7f71': []uint16{452, 425},
-		'\u7f72': []uint16{252, 225, 426, 152, 231, 125, 117, 327, 220, 227, 325, 238},
-		'\u7f74': []uint16{413},
-		'\u7f79': []uint16{152, 125},
-		'\u7f7e': []uint16{413},
-		'\u7f81': []uint16{152, 125, 402, 252, 215, 156, 227, 220, 327},
-		'\u7f8a': []uint16{125, 425, 225, 227, 127, 327, 325, 230, 252, 130, 220, 330, 315, 132, 115, 331, 215, 232, 332, 320, 256, 228, 352, 243, 128, 143, 317},
-		'\u7f8c': []uint16{427, 417, 130, 132, 330, 227, 229, 225, 125, 217},
-		'\u7f8e': []uint16{130, 417, 135, 322, 125, 122, 131, 200, 100, 117, 330, 132, 230, 327, 127, 227, 232, 256, 225, 252, 152, 217, 331, 320, 325, 104, 156, 317, 332, 215, 315, 115, 120, 129, 229, 207, 107, 162, 220, 231, 228, 328, 262, 362, 103},
-		'\u7f94': []uint16{125, 225, 426, 252, 325, 227},
-		'\u7f9a': []uint16{125, 225, 325, 132, 152},
-		'\u7f9d': []uint16{413},
-		'\u7f9e': []uint16{452, 100, 152, 200, 125, 315, 252, 162, 325, 227, 362, 120, 156, 225, 115, 400},
-		'\u7f9f': []uint16{125, 132, 325, 332},
-		'\u7fa1': []uint16{152, 252, 427, 327, 320, 227, 454, 256, 225, 200, 315},
-		'\u7fa4': []uint16{125, 435, 225, 222, 227, 330, 252, 315, 152, 327, 120, 320, 217, 115, 230, 220, 325, 107, 215, 200, 317, 156, 422, 352, 130, 117, 304, 104, 232, 132, 256, 131, 228},
-		'\u7fa7': []uint16{125, 459, 325},
-		'\u7faf': []uint16{125, 332, 232},
-		'\u7fb0': []uint16{125, 459},
-		'\u7fb2': []uint16{227, 327, 413, 328, 225, 228},
-		'\u7fb8': []uint16{162},
-		'\u7fb9': []uint16{426, 225, 327, 125, 320, 315, 215, 227, 232},
-		'\u7fbc': []uint16{413},
-		'\u7fbd': []uint16{227, 125, 427, 435, 225, 127, 327, 152, 417, 130, 204, 315, 426, 222, 331, 115, 252, 229, 129, 228, 325, 422, 120},
-		'\u7fbf': []uint16{427, 229, 227, 127, 327},
-		'\u7fc1': []uint16{426, 225, 127, 427, 227, 315, 327, 230, 130, 330, 125, 320, 229},
-		'\u7fc5': []uint16{426, 125, 325, 327, 252, 225, 315, 227, 229, 332},
-		'\u7fca': []uint16{327, 413, 227},
-		'\u7fcc': []uint16{143},
-		'\u7fce': []uint16{426, 125, 227, 327, 225, 325, 232},
-		'\u7fd4': []uint16{227, 252, 230, 427, 100, 325, 232, 327, 256, 228, 132, 330, 332, 229},
-		'\u7fd5': []uint16{152},
-		'\u7fd8': []uint16{452, 152, 227, 225, 125, 115, 327, 325, 320, 120},
-		'\u7fdf': []uint16{127, 427, 227},
-		'\u7fe0': []uint16{426, 227, 127, 225, 327, 125, 162, 262, 130, 200, 325, 252, 315, 402, 330, 320, 220, 230, 129, 215},
-		'\u7fe1': []uint16{125, 130, 327},
-		'\u7fe5': []uint16{227, 413, 327},
-		'\u7fe6': []uint16{427, 227, 128},
-		'\u7fe9': []uint16{227, 127, 320, 115, 315, 220, 215, 162, 402, 120, 252, 152, 325, 125, 327},
-		'\u7fee': []uint16{227, 413},
-		'\u7ff0': []uint16{229, 132, 125, 227, 426, 332, 327, 230, 330, 329, 331, 232, 129},
-		'\u7ff1': []uint16{152, 156, 327},
-		'\u7ff3': []uint16{413, 227, 225, 252},
-		'\u7ffb': []uint16{152, 452, 252, 115, 125, 120, 315, 225, 320, 232, 227, 156, 215, 327, 256, 107, 325},
-		'\u7ffc': []uint16{225, 426, 215, 315, 227, 125, 327, 130, 325, 242, 330, 217, 232, 104, 204, 332, 252, 207, 230, 212, 320, 228},
-		'\u8000': []uint16{252, 327, 227, 100, 452, 200, 331, 115, 125, 130, 132, 225, 315, 454, 328, 325, 228},
-		'\u8001': []uint16{125, 400, 127, 200, 225, 327, 325, 100, 130, 256, 227, 143, 252, 320, 315, 104, 238, 152, 115, 107, 120, 138, 352, 215, 162, 362, 117, 128, 217, 129, 317, 132, 330, 307, 220, 156, 230, 331, 131, 332},
-		'\u8003': []uint16{152, 156, 252, 452, 125, 325, 220, 256, 227, 327, 225, 230, 129, 330, 332, 217, 127, 143, 130, 315, 320, 100, 117, 331, 317, 329, 229},
-		'\u8004': []uint16{115, 125},
-		'\u8005': []uint16{225, 419, 206, 238, 306, 227, 325, 320, 315, 232, 217, 327, 331, 215, 220, 132, 330, 130},
-		'\u8006': []uint16{230, 426, 125, 227, 330},
-		'\u800b': []uint16{315, 225, 413},
-		'\u800c': []uint16{406, 106, 206, 315, 145, 207, 320, 160, 306, 325, 107, 143, 152, 327, 227, 129, 352, 304, 307, 115, 362, 328, 120},
-		'\u800d': []uint16{452, 252, 152, 120, 225, 200, 320},
-		'\u8010': []uint16{100, 452, 125, 300, 252, 132, 152, 225, 104, 115, 120, 200, 103, 327, 101, 215, 227, 127, 325, 315, 320, 156, 129, 220, 228},
-		'\u8012': []uint16{130, 125},
-		'\u8015': []uint16{125, 152, 452, 156, 227, 252, 325, 315, 320, 327, 256, 225, 132, 304},
-		'\u8016': []uint16{425},
-		'\u8017': []uint16{252, 152, 225, 452, 125, 325, 256, 204, 220, 227, 327, 320, 315},
-		'\u8018': []uint16{252, 454, 227, 325, 327, 125, 228, 225},
-		'\u8019': []uint16{425, 452, 125, 225, 215, 132, 152},
-		'\u801c': []uint16{227, 413, 225},
-		'\u8020': []uint16{452},
-		'\u8022': []uint16{425, 452},
-		'\u8025': []uint16{459},
-		'\u8026': []uint16{156},
-		'\u8027': []uint16{413},
-		'\u8028': []uint16{413},
-		'\u8029': []uint16{413},
-		'\u802a': []uint16{452, 152},
-		'\u8031': []uint16{252, 413},
-		'\u8033': []uint16{125, 425, 330, 225, 227, 325, 142, 152, 315, 200, 207, 115, 327, 215, 235, 156, 220, 332, 252, 100, 320, 331, 120, 132, 229},
-		'\u8035': []uint16{125, 459},
-		'\u8036': []uint16{127, 417, 227, 327, 130, 131, 460, 129, 329, 229, 331, 330, 125, 325, 132, 225, 332},
-		'\u8037': []uint16{152, 227, 320, 262, 362},
-		'\u8038': []uint16{454, 152, 252, 315, 115, 325, 125, 320, 227, 327},
-		'\u803b': []uint16{200, 125, 225, 452, 215, 152, 315, 325, 220, 252, 320, 156, 227, 115, 203},
-		'\u803d': []uint16{152, 427, 227, 100},
-		'\u803f': []uint16{127, 315, 215, 427, 115, 227, 100, 327, 130, 125, 120, 320, 128},
-		'\u8042': []uint16{127, 330, 427, 128, 329, 130, 327, 227},
-		'\u8043': []uint16{413, 227, 327},
-		'\u8046': []uint16{152, 454, 227, 327},
-		'\u804a': []uint16{452, 152, 200, 252, 130, 315, 132, 125, 115, 107, 352, 215, 325, 327},
-		'\u804b': []uint16{452, 125, 256, 215, 315, 252, 317, 227},
-		'\u804c': []uint16{125, 426, 252, 225, 256, 325, 152, 204, 320, 315, 215, 200, 217, 232, 117, 220, 227, 156, 327, 317, 304, 120, 132},
-		'\u804d': []uint16{225, 459},
-		'\u8052': []uint16{100, 252},
-		'\u8054': []uint16{152, 125, 230, 454, 317, 325, 131, 331, 256, 225, 252, 217, 117, 330, 120, 156, 232, 132, 130, 227, 327, 231, 320, 332, 107, 315, 220, 104, 352, 100, 304},
-		'\u8058': []uint16{252, 152, 452, 325, 125, 227, 256, 327},
-		'\u805a': []uint16{152, 452, 252, 125, 325, 332, 120, 156, 327, 227, 115, 132, 130, 315, 232, 215, 256, 320, 330},
-		'\u8069': []uint16{200, 215, 413},
-		'\u806a': []uint16{100, 227, 125, 402, 115, 315, 327, 232, 252, 120, 320, 325, 228},
-		'\u8071': []uint16{315, 100, 459},
-		'\u807f': []uint16{328, 327},
-		'\u8083': []uint16{230, 200, 427, 100, 330, 152, 227, 201, 327, 252, 156, 115, 325, 454, 125, 130, 331, 101, 129, 329, 162},
-		'\u8084': []uint16{152, 125},
-		'\u8086': []uint16{207, 422, 152, 200, 115, 107, 225, 426, 252, 215, 320, 156, 327, 227, 315},
-		'\u8087': []uint16{130, 156, 427, 327, 125, 227, 129, 152, 332, 328, 132, 128},
-		'\u8089': []uint16{225, 425, 125, 315, 204, 320, 325, 252, 215, 100, 104, 332, 220, 152, 120, 227, 304, 327, 132, 232, 143, 217, 117},
-		'\u808b': []uint16{425, 125, 225, 320},
-		'\u808c': []uint16{125, 426, 225, 325, 152, 315, 129, 320, 132, 220},
-		'\u8093': []uint16{459, 215, 225},
-		'\u8096': []uint16{127, 125, 427, 204, 327, 227, 215, 225, 220, 315, 454, 330, 130, 129, 128, 230},
-		'\u8098': []uint16{426, 252, 125, 225, 215, 115, 256, 315},
-		'\u809a': []uint16{125, 426, 112, 322, 325, 225, 252, 320, 215, 352, 230, 152, 315, 122, 220},
-		'\u809b': []uint16{125, 225},
-		'\u809c': []uint16{413, 227},
-		'\u809d': []uint16{425, 125, 225, 325, 115, 252, 320, 315, 220, 215, 332, 232, 117, 132},
-		'\u809f': []uint16{413, 232, 325},
-		'\u80a0': []uint16{225, 125, 425, 325, 320, 315, 252, 215, 220, 327, 152, 332, 227, 256},
-		'\u80a1': []uint16{125, 435, 225, 222, 252, 422, 238, 332, 325, 256, 227, 120, 331, 135, 117, 232, 320, 152, 327, 220, 115, 217, 132, 307, 215},
-		'\u80a2': []uint16{225, 426, 125, 325, 252, 152, 204, 315},
-		'\u80a4': []uint16{225, 325, 426, 125, 252, 332, 100, 215, 315, 227, 232},
-		'\u80a5': []uint16{100, 225, 125, 425, 252, 230, 325, 452, 320, 256, 330, 130, 315, 227, 400, 331, 156, 132, 332, 327, 215, 129, 162, 362, 120, 104, 220, 115},
-		'\u80a9': []uint16{125, 425, 142, 225, 152, 207, 252, 320, 120, 220, 315, 327, 215, 325, 227, 232, 115, 204, 452},
-		'\u80aa': []uint16{225, 325, 459, 304},
-		'\u80ab': []uint16{413, 225},
-		'\u80ad': []uint16{459},
-		'\u80ae': []uint16{100, 101},
-		'\u80af': []uint16{152, 252, 452, 130, 227, 207, 127, 327, 125, 330, 129, 100, 200, 229, 132, 131, 232, 225, 329, 256, 206, 230, 331, 332},
-		'\u80b1': []uint16{225, 125, 325, 220},
-		'\u80b2': []uint16{256, 252, 325, 225, 220, 152, 454, 327, 156, 331, 320, 227, 343, 125, 330, 104, 229, 315, 129, 130, 332, 217, 132, 328, 329},
-		'\u80b4': []uint16{225, 413, 125, 215, 227},
-		'\u80b7': []uint16{413},
-		'\u80ba': []uint16{425, 125, 225, 220, 252, 115, 215, 315, 325, 320},
-		'\u80bc': []uint16{413, 225, 232},
-		'\u80bd': []uint16{426, 225, 232, 125, 325},
-		'\u80be': []uint16{125, 425, 252, 120, 225, 152, 132, 325, 104},
-		'\u80bf': []uint16{125, 225, 452, 252, 152, 320, 200, 220, 325, 315, 156, 215, 331},
-		'\u80c0': []uint16{217, 220, 252, 400, 325, 317, 320, 225, 152, 215, 332, 162, 256, 362, 200},
-		'\u80c1': []uint16{256, 426, 152, 252, 454, 225, 325, 125, 315, 327, 115},
-		'\u80c2': []uint16{413},
-		'\u80c3': []uint16{425, 125, 225, 252, 200, 120, 227, 132, 325, 320, 352},
-		'\u80c4': []uint16{413, 225, 227, 228, 327},
-		'\u80c6': []uint16{125, 201, 425, 100, 225, 215, 115, 315, 152, 252, 227, 320, 207, 325, 327, 120, 132, 162, 317},
-		'\u80cc': []uint16{452, 125, 152, 112, 225, 252, 115, 107, 315, 227, 325, 215, 220, 156, 327, 100, 142, 230, 204, 200, 132, 320, 332, 120, 352},
-		'\u80cd': []uint16{413},
-		'\u80ce': []uint16{225, 125, 435, 252, 325, 104, 222, 256, 220, 315, 231, 227, 232, 422, 115, 132, 356, 215},
-		'\u80d6': []uint16{125, 400, 200, 162, 325, 362, 262, 327, 252, 227, 315, 100, 225, 215, 304},
-		'\u80d7': []uint16{413, 227},
-		'\u80d9': []uint16{413},
-		'\u80da': []uint16{125, 325, 225, 320},
-		'\u80db': []uint16{225, 325},
-		'\u80dc': []uint16{156, 252, 452, 325, 125, 227, 225, 152, 315, 327, 215, 320, 230, 232, 330, 120, 220, 204, 131, 115, 132, 332, 130, 328, 217, 228, 200, 329, 127, 229, 256, 143},
-		'\u80dd': []uint16{225, 459, 315},
-		'\u80de': []uint16{225, 325, 204, 220, 125, 331, 330},
-		'\u80e1': []uint16{127, 125, 427, 152, 227, 115, 107, 225, 128, 327, 325, 407, 320, 132, 330, 120, 130, 352, 315, 100, 362, 156, 129, 162, 329, 229, 426, 142},
-		'\u80e4': []uint16{413, 227, 327},
-		'\u80e5': []uint16{127, 227, 427, 327, 130, 125},
-		'\u80e7': []uint16{200, 459, 362, 262, 227, 325},
-		'\u80e8': []uint16{413},
-		'\u80e9': []uint16{413},
-		'\u80ea': []uint16{413},
-		'\u80eb': []uint16{426, 315, 125},
-		'\u80ec': []uint16{459, 125},
-		'\u80ed': []uint16{125},
-		'\u80ef': []uint16{426, 125, 327},
-		'\u80f0': []uint16{125, 426, 120, 325, 217},
-		'\u80f1': []uint16{225, 459, 325, 327, 125, 132},
-		'\u80f3': []uint16{125, 120, 152},
-		'\u80f4': []uint16{125},
-		'\u80f6': []uint16{225, 425, 125, 130, 152, 325, 104, 132, 256, 232, 315, 120, 156, 452, 220, 332, 115, 204, 320},
-		'\u80f8': []uint16{125, 426, 142, 225, 115, 325, 252, 315, 215, 220, 156, 207, 425, 117, 327, 120, 320},
-		'\u80fa': []uint16{225, 425, 232, 325, 132, 332, 227, 327},
-		'\u80fc': []uint16{125, 115},
-		'\u80fd': []uint16{252, 452, 125, 225, 152, 325, 217, 327, 204, 207, 307, 315, 320, 200, 127, 227, 256, 232, 352, 332, 215, 115, 331, 100, 220, 304, 120, 356, 230, 231, 132, 129, 229, 130},
-		'\u8102': []uint16{125, 225, 325, 230, 220, 315, 330, 232, 252, 130, 104},
-		'\u8106': []uint16{207, 100, 400, 200, 125, 225, 315, 320, 162, 232, 262, 362, 132},
-		'\u8109': []uint16{225, 426, 230, 125, 435, 320, 325, 227, 215, 220, 252, 315, 327, 232, 262, 162, 120, 332},
-		'\u810a': []uint16{125, 426, 120, 225, 325, 227, 327, 330, 117, 315},
-		'\u810d': []uint16{115, 426, 454, 120},
-		'\u810e': []uint16{413},
-		'\u810f': []uint16{225, 400, 125, 325, 200, 315, 162, 100, 332, 320, 201, 120, 227, 331},
-		'\u8110': []uint16{426, 125, 225, 325, 315},
-		'\u8111': []uint16{125, 225, 425, 320, 215, 325, 220, 315, 352, 120, 231, 232, 252, 152, 262, 115, 207, 332, 200, 331, 132},
-		'\u8112': []uint16{413, 225, 232},
-		'\u8113': []uint16{125, 425, 325, 252},
-		'\u8114': []uint16{413, 225, 215},
-		'\u8116': []uint16{125, 426, 225, 352, 325, 320, 227},
-		'\u8118': []uint16{413, 225},
-		'\u811a': []uint16{425, 125, 225, 112, 252, 224, 215, 325, 315, 230, 152, 220, 312, 115, 262, 342, 200, 227, 320, 207, 327, 104, 204, 307},
-		'\u811e': []uint16{413},
-		'\u8129': []uint16{225},
-		'\u812c': []uint16{459},
-		'\u812f': []uint16{225, 413, 325, 125, 227},
-		'\u8131': []uint16{152, 252, 452, 115, 125, 156, 200, 330, 227, 315, 229, 127, 327, 362, 262, 120, 235, 220, 325, 332, 132, 256, 352, 331, 320, 232},
-		'\u8132': []uint16{413, 225, 132, 325, 232},
-		'\u8138': []uint16{425, 142, 125, 225, 207, 222, 252, 152, 215, 220, 200, 201, 227, 120, 320, 325, 327, 315, 300, 256, 115, 100},
-		'\u813e': []uint16{125, 425, 352, 225, 325, 215, 227, 320},
-		'\u8146': []uint16{200, 452},
-		'\u8148': []uint16{225, 125, 325},
-		'\u814a': []uint16{230, 325, 426, 143, 332, 125, 227, 327, 330, 225, 331, 320, 315, 124, 232, 229, 252, 329},
-		'\u814b': []uint16{426, 142, 125, 225, 315, 215},
-		'\u814c': []uint16{452, 152, 125, 132},
-		'\u8150': []uint16{103, 225, 152, 400, 125, 100, 325, 252, 200, 332, 320, 232, 104, 352, 315, 256, 220, 454, 132, 330, 227, 215},
-		'\u8151': []uint16{225, 413, 215, 315, 220},
-		'\u8153': []uint16{129, 132, 130, 125, 230},
-		'\u8154': []uint16{225, 435, 252, 222, 125, 204, 325, 315, 120, 320, 327, 426, 220},
-		'\u8155': []uint16{225, 426, 125, 142, 325, 252, 132, 220, 320, 227, 352},
-		'\u8159': []uint16{413},
-		'\u8160': []uint16{125, 426},
-		'\u8165': []uint16{400, 200, 125, 100, 325, 225, 315, 115, 332},
-		'\u8167': []uint16{125, 413},
-		'\u8169': []uint16{459, 225, 227},
-		'\u816d': []uint16{225, 125, 317},
-		'\u816e': []uint16{425, 125, 320, 215, 327, 225, 325, 204},
-		'\u8170': []uint16{425, 125, 252, 225, 207, 152, 325, 220, 352, 120, 115, 204, 320, 130, 215, 329, 132, 232},
-		'\u8171': []uint16{225, 125},
-		'\u8174': []uint16{200, 225, 402, 413},
-		'\u8179': []uint16{125, 426, 225, 152, 142, 315, 252, 325, 215, 115, 227, 352, 316, 320, 156, 332},
-		'\u817a': []uint16{225, 125, 325, 320, 220},
-		'\u817b': []uint16{200, 452, 225, 152, 362, 262, 100, 320, 162, 125},
-		'\u817c': []uint16{100},
-		'\u817e': []uint16{252, 452, 152, 227, 215, 225, 315, 327, 362, 262, 130, 330, 115, 120, 127, 256, 132, 325, 220, 320, 125, 232},
-		'\u817f': []uint16{425, 225, 125, 252, 325, 207, 232, 220, 327, 320, 227, 332, 352, 100, 217},
-		'\u8180': []uint16{225, 125, 452, 320, 120, 232, 220},
-		'\u8182': []uint16{125},
-		'\u8188': []uint16{225, 325},
-		'\u818a': []uint16{225, 413, 325, 252, 320, 315, 220},
-		'\u818f': []uint16{225, 452, 125, 325, 232, 315, 215, 220, 115, 320, 332},
-		'\u8191': []uint16{227, 413},
-		'\u8198': []uint16{426, 115, 252, 125},
-		'\u819b': []uint16{225, 426, 327, 252, 125, 325},
-		'\u819c': []uint16{225, 425, 325, 232, 125, 152, 315, 252, 132},
-		'\u819d': []uint16{426, 125, 252, 225, 215, 227, 315, 320, 220},
-		'\u81a3': []uint16{413},
-		'\u81a6': []uint16{225, 413},
-		'\u81a8': []uint16{320, 152, 325, 125, 120, 132, 100},
-		'\u81b3': []uint16{426, 125, 252, 229, 225, 232},
-		'\u81ba': []uint16{454, 252, 227, 215, 327},
-		'\u81bb': []uint16{400, 200, 125, 215},
-		'\u81c0': []uint16{125, 426, 227},
-		'\u81c1': []uint16{413, 327},
-		'\u81c2': []uint16{225, 425, 125, 315, 325, 215, 426, 252, 327, 227, 220, 204, 320, 332},
-		'\u81c3': []uint16{100, 402},
-		'\u81c6': []uint16{152, 225, 215, 426, 320, 156, 125, 227},
-		'\u81ca': []uint16{400, 200, 125, 225, 252},
-		'\u81cc': []uint16{125, 413},
-		'\u81e3': []uint16{225, 425, 227, 125, 327, 215, 152, 252, 230, 315, 330, 229, 220, 426, 232},
-		'\u81e7': []uint16{127, 427, 152, 128, 227, 125, 315},
-		'\u81ea': []uint16{138, 434, 107, 152, 125, 100, 252, 207, 328, 120, 238, 156, 330, 315, 134, 115, 327, 325, 320, 332, 129, 227, 331, 104, 304, 130, 132, 307, 103, 131, 352, 162, 117, 230, 217, 232, 234, 300, 153},
-		'\u81ec': []uint16{427, 225, 215},
-		'\u81ed': []uint16{400, 125, 225, 152, 200, 252, 115, 315, 162, 227, 325, 100, 215, 262, 232, 327, 320, 120},
-		'\u81f3': []uint16{434, 207, 252, 107, 134, 206, 307, 152, 120, 306, 327, 230, 227, 315, 320, 132, 243, 215, 234, 115, 125, 212, 220, 325, 262, 225, 330, 228, 332},
-		'\u81f4': []uint16{252, 200, 152, 452, 207, 206, 225, 125, 315, 100, 215, 156, 325, 320, 327, 132, 227, 131, 352, 220, 203, 232, 331, 362, 328, 329, 262},
-		'\u81fb': []uint16{427, 228, 454, 227, 315, 252, 320, 327},
-		'\u81fc': []uint16{426, 125, 230, 225, 325, 252, 227, 215, 330},
-		'\u81fe': []uint16{243, 320, 459, 230, 315, 227},
-		'\u8200': []uint16{452, 227, 125},
-		'\u8202': []uint16{452, 327, 227},
-		'\u8204': []uint16{413},
-		'\u8205': []uint16{225, 125, 425, 325, 227, 426, 215},
-		'\u8206': []uint16{125, 225, 115, 330, 230, 320},
-		'\u820c': []uint16{425, 125, 225, 215, 252, 325, 330, 220, 320, 352, 200, 152, 315, 227, 115, 120, 204, 156},
-		'\u820d': []uint16{225, 452, 152, 125, 215, 252, 327, 107, 325, 227, 229, 115, 129, 142, 256, 230, 330, 132, 329, 315, 220, 320, 127, 352, 130},
-		'\u8210': []uint16{454, 115},
-		'\u8212': []uint16{100, 127, 227, 125, 427, 152, 156, 327, 454, 162, 362, 129, 120, 200, 130, 132, 332, 225, 325, 402, 229, 128},
-		'\u8214': []uint16{452, 152},
-		'\u821b': []uint16{220, 125},
-		'\u821c': []uint16{413, 227, 327, 225, 331, 125, 232},
-		'\u821e': []uint16{125, 452, 252, 225, 325, 152, 315, 215, 227, 156, 220, 331, 130, 327, 320, 117, 115, 256, 131, 143, 120},
-		'\u821f': []uint16{425, 225, 130, 215, 227, 125, 232, 252, 315, 327, 120, 129, 330, 325, 228},
-		'\u8221': []uint16{413},
-		'\u8222': []uint16{125},
-		'\u8223': []uint16{413},
-		'\u8228': []uint16{459},
-		'\u822a': []uint16{125, 217, 252, 152, 426, 325, 225, 331, 227, 427, 156, 256, 117, 332, 131, 231, 232, 327, 132, 317, 330, 454, 130, 135, 228},
-		'\u822b': []uint16{225, 413, 227, 327},
-		'\u822c': []uint16{200, 145, 445, 238, 320, 207, 220, 125, 325, 315, 245, 215, 100, 352, 162, 262, 327, 225, 132, 227, 130, 204},
-		'\u822d': []uint16{459},
-		'\u8230': []uint16{225, 125, 426, 104, 331, 227, 332, 327, 231},
-		'\u8231': []uint16{225, 425, 125, 142, 252, 227, 330},
-		'\u8233': []uint16{459, 125},
-		'\u8234': []uint16{459, 125},
-		'\u8235': []uint16{425, 252, 125, 225, 215, 325, 327, 227},
-		'\u8236': []uint16{225, 426, 325, 227, 331, 125},
-		'\u8237': []uint16{426, 225, 125, 142, 132, 227},
-		'\u8238': []uint16{413, 227, 315, 327},
-		'\u8239': []uint16{425, 125, 225, 142, 252, 256, 325, 217, 315, 232, 156, 220, 104, 227, 331, 130, 117, 129, 332, 327, 215, 320, 224, 120},
-		'\u823b': []uint16{413, 225},
-		'\u823e': []uint16{125},
-		'\u8244': []uint16{125},
-		'\u8247': []uint16{225, 425, 125, 227, 426, 331, 325},
-		'\u8249': []uint16{413},
-		'\u824b': []uint16{459, 225},
-		'\u824f': []uint16{413},
-		'\u8258': []uint16{422, 222, 435, 135},
-		'\u825a': []uint16{413},
-		'\u825f': []uint16{225, 459},
-		'\u8268': []uint16{459, 125},
-		'\u826e': []uint16{413, 332, 225, 227, 327},
-		'\u826f': []uint16{100, 200, 125, 227, 327, 262, 252, 400, 104, 407, 122, 225, 325, 427, 320, 130, 115, 315, 230, 120, 232, 162, 215, 256, 330, 129, 229, 332, 220, 328, 329, 331, 426, 322, 228, 132, 143, 152, 127},
-		'\u8270': []uint16{100, 115, 220, 402, 120, 225, 125, 315, 215, 262, 320, 227},
-		'\u8272': []uint16{225, 125, 426, 325, 204, 252, 215, 200, 330, 220, 315, 227, 320, 238, 224, 327, 130, 230, 256, 115, 332, 331, 129, 352, 104, 207, 132, 232, 152, 262},
-		'\u8273': []uint16{200, 400, 227, 100, 327, 125, 262, 152, 362, 315, 220, 320, 120, 215, 252, 228, 156, 325},
-		'\u8274': []uint16{413},
-		'\u827a': []uint16{125, 225, 325, 320, 426, 227, 252, 327, 331, 215, 220, 232, 132, 332, 330, 217, 120, 152, 231, 117, 315, 328, 115, 304, 317, 229, 228},
-		'\u827d': []uint16{427, 227, 327, 225, 459},
-		'\u827e': []uint16{127, 125, 427, 227, 327, 129, 330, 215, 130, 225, 315, 128, 132},
-		'\u827f': []uint16{459, 227, 225},
-		'\u8282': []uint16{225, 125, 152, 425, 256, 243, 143, 325, 252, 222, 422, 156, 227, 320, 100, 215, 230, 220, 120, 115, 217, 304, 315, 330, 327, 104, 204, 232, 332, 117, 130, 231, 228, 132, 362, 262},
-		'\u8288': []uint16{433},
-		'\u828a': []uint16{459, 227, 327},
-		'\u828b': []uint16{225, 125},
-		'\u828d': []uint16{125, 225, 132, 130},
-		'\u828e': []uint16{459, 225, 227},
-		'\u828f': []uint16{459, 327, 227},
-		'\u8291': []uint16{413, 227},
-		'\u8292': []uint16{225, 426, 125, 227, 315, 327, 130, 143, 215, 332, 129, 115, 330, 325, 220, 230, 252, 127, 229},
-		'\u8297': []uint16{125, 130},
-		'\u8298': []uint16{225, 459},
-		'\u8299': []uint16{125, 129, 130, 327, 325, 229, 315, 232, 332, 329, 115},
-		'\u829c': []uint16{130, 200, 230, 228, 100, 330, 125, 225, 332, 331, 325},
-		'\u829d': []uint16{125, 130, 131, 229, 225, 331, 325, 232, 330, 332, 227, 315, 228, 132},
-		'\u829f': []uint16{152},
-		'\u82a1': []uint16{125, 252},
-		'\u82a4': []uint16{125},
-		'\u82a5': []uint16{125, 435, 225, 129, 227, 320, 327},
-		'\u82a6': []uint16{125, 225, 127, 427, 330, 325, 130, 220, 227, 327, 332, 215, 315, 132, 320},
-		'\u82a8': []uint16{125, 325, 225},
-		'\u82a9': []uint16{427, 225, 227, 327},
-		'\u82aa': []uint16{225, 459, 227},
-		'\u82ab': []uint16{125},
-		'\u82ac': []uint16{227, 130, 417, 125, 327, 129, 325, 330, 132, 229, 131, 230, 200, 232, 225},
-		'\u82ad': []uint16{125, 331, 129},
-		'\u82ae': []uint16{127, 427, 227, 130, 327},
-		'\u82af': []uint16{426, 125, 225, 435, 227, 325, 327, 222, 232, 332},
-		'\u82b0': []uint16{413},
-		'\u82b1': []uint16{225, 125, 452, 325, 127, 152, 315, 330, 252, 320, 227, 130, 215, 115, 162, 327, 131, 362, 232, 262, 230, 204, 132, 143, 120, 332, 331, 128, 256, 200, 104, 100, 129, 117, 220, 329, 228},
-		'\u82b3': []uint16{227, 125, 327, 427, 225, 426, 252, 315, 402, 132, 228, 130, 232, 215, 332, 220, 320, 129, 331, 162},
-		'\u82b4': []uint16{413, 125},
-		'\u82b7': []uint16{459, 225, 130, 227, 327, 127},
-		'\u82b8': []uint16{125, 115, 315, 327, 227, 262, 162, 228, 328},
-		'\u82b9': []uint16{227, 125, 427, 327, 426, 225},
-		'\u82bd': []uint16{252, 425, 225, 125, 227, 325, 327, 256, 320, 332, 129},
-		'\u82be': []uint16{459, 227},
-		'\u82c1': []uint16{459, 325, 125, 227},
-		'\u82c4': []uint16{459, 232, 227, 332, 132, 325},
-		'\u82c7': []uint16{225, 125, 426, 325, 227, 327, 130, 128},
-		'\u82c8': []uint16{459, 327, 325, 227},
-		'\u82ca': []uint16{413},
-		'\u82cb': []uint16{225, 125},
-		'\u82cc': []uint16{427, 227, 327},
-		'\u82cd': []uint16{100, 125, 127, 162, 130, 427, 262, 315, 225, 362, 129, 215, 227, 325, 426, 320, 327, 120, 230, 229, 115, 103, 200},
-		'\u82ce': []uint16{125},
-		'\u82cf': []uint16{130, 252, 127, 230, 417, 330, 125, 117, 131, 227, 327, 132, 152, 331, 325, 225, 129, 229, 128, 332, 329, 200, 362, 262},
-		'\u82d1': []uint16{426, 427, 227, 225, 230, 127, 327, 125, 330, 232, 229, 325},
-		'\u82d2': []uint16{215, 459, 252, 220, 227},
-		'\u82d3': []uint16{225, 227, 459, 325, 125, 327},
-		'\u82d4': []uint16{426, 125, 225, 227, 120, 327, 325, 130},
-		'\u82d5': []uint16{225, 125},
-		'\u82d7': []uint16{225, 425, 125, 127, 132, 252, 330, 227, 100, 327, 130, 329, 325, 315, 256, 128, 115, 232, 328},
-		'\u82d8': []uint16{125, 413},
-		'\u82db': []uint16{100, 152, 125, 200, 115, 120, 156},
-		'\u82dc': []uint16{125, 320, 130},
-		'\u82de': []uint16{426, 125, 227, 225, 315, 320, 252, 325},
-		'\u82df': []uint16{152, 407, 115, 215, 315, 127, 320, 227, 406, 427, 125, 327},
-		'\u82e0': []uint16{227},
-		'\u82e1': []uint16{413, 225, 227},
-		'\u82e3': []uint16{459, 225, 327, 125},
-		'\u82e4': []uint16{125},
-		'\u82e5': []uint16{406, 122, 206, 327, 315, 127, 227, 115, 106, 138, 225, 129, 252, 215, 152, 125, 130, 320, 262, 352, 330, 232, 207, 322, 228, 120, 452, 220},
-		'\u82e6': []uint16{400, 203, 125, 252, 200, 152, 100, 207, 315, 107, 225, 215, 115, 201, 262, 362, 320, 220, 325, 327, 120, 256, 130, 352, 330, 101, 232},
-		'\u82eb': []uint16{452, 125, 225},
-		'\u82ef': []uint16{425, 125, 225, 325, 332, 132, 232, 131},
-		'\u82f1': []uint16{130, 417, 125, 227, 132, 327, 131, 225, 100, 117, 127, 135, 122, 322, 325, 232, 229, 129, 330, 115, 317, 120, 335, 315, 104, 228, 332, 320, 328, 230, 101, 204, 329, 128, 331},
-		'\u82f7': []uint16{459, 325, 225, 232, 332},
-		'\u82f9': []uint16{125, 130, 131, 320, 132, 332, 331, 327},
-		'\u82fb': []uint16{427, 327},
-		'\u8301': []uint16{100, 120, 101},
-		'\u8302': []uint16{227, 100, 400, 327, 200, 130, 215, 129, 325, 220, 232, 329, 252, 127, 332, 330, 320, 125, 262, 132},
-		'\u8303': []uint16{125, 252, 127, 225, 325, 256, 427, 227, 331, 356, 128, 327, 130, 220, 426, 304, 231, 330, 320, 132, 129},
-		'\u8304': []uint16{225, 125, 325, 332, 132},
-		'\u8305': []uint16{125, 127, 427, 225, 215, 130, 227, 132, 325, 315, 330, 230, 327, 115, 332, 329, 128},
-		'\u8306': []uint16{427, 230},
-		'\u8307': []uint16{413},
-		'\u8309': []uint16{125, 325, 225},
-		'\u830c': []uint16{427, 130},
-		'\u830e': []uint16{425, 225, 227, 125, 327, 320},
-		'\u830f': []uint16{459, 262, 327},
-		'\u8311': []uint16{413},
-		'\u8314': []uint16{225, 426},
-		'\u8315': []uint16{413},
-		'\u8317': []uint16{426, 252, 327, 227, 225},
-		'\u831a': []uint16{413, 132},
-		'\u831b': []uint16{225, 459},
-		'\u831c': []uint16{427, 125, 227, 327, 229},
-		'\u8327': []uint16{426, 225, 125, 227, 315, 325, 220, 327},
-		'\u8328': []uint16{229, 330, 230, 332, 227, 327, 329, 130, 129, 132, 232, 325, 331},
-		'\u832b': []uint16{262, 100, 162, 402, 200, 362, 120, 101, 220, 152, 215, 315, 320, 227, 115, 203},
-		'\u832c': []uint16{435, 222, 225, 252, 125, 325, 426, 352},
-		'\u832d': []uint16{125},
-		'\u832f': []uint16{125, 325},
-		'\u8331': []uint16{325, 125},
-		'\u8333': []uint16{327},
-		'\u8334': []uint16{125, 325},
-		'\u8335': []uint16{230, 330, 227, 426, 327, 125, 262, 215, 162, 362, 225, 325, 229},
-		'\u8336': []uint16{425, 125, 225, 252, 227, 327, 130, 325, 132, 232, 115, 315, 117, 120, 256, 230, 220, 330, 332, 331, 320},
-		'\u8338': []uint16{225, 262, 362, 125, 162, 327, 227},
-		'\u8339': []uint16{127, 227, 427, 315, 327, 115, 129, 225, 330, 230},
-		'\u833a': []uint16{459},
-		'\u833c': []uint16{125},
-		'\u8340': []uint16{127, 427, 227, 327, 128},
-		'\u8343': []uint16{227, 413, 327, 130},
-		'\u8346': []uint16{130, 127, 417, 330, 125, 427, 225, 327, 227, 315, 252, 325, 129, 115},
-		'\u8347': []uint16{459, 327},
-		'\u8349': []uint16{125, 225, 425, 252, 325, 104, 227, 315, 120, 152, 327, 330, 232, 207, 107, 100, 156, 320, 115, 220, 129, 215, 229, 200, 332, 132, 204, 331, 130, 256, 101, 230, 117, 317, 127},
-		'\u834f': []uint16{315, 215, 152, 100, 320, 413, 227},
-		'\u8350': []uint16{252, 454, 256, 325, 152, 227, 215, 225, 327, 125, 332},
-		'\u8351': []uint16{413, 227, 327},
-		'\u8352': []uint16{125, 100, 452, 252, 225, 426, 315, 152, 115, 230, 215, 325, 120, 327, 130, 242, 320, 156, 220, 129, 217, 227, 330},
-		'\u8354': []uint16{125, 229, 130, 325, 129, 225, 330, 228, 232, 132},
-		'\u835a': []uint16{427, 125, 225, 252, 327},
-		'\u835b': []uint16{225, 413},
-		'\u835c': []uint16{459, 225, 315},
-		'\u835e': []uint16{125, 225, 132},
-		'\u835f': []uint16{152, 225, 315, 332, 327, 130},
-		'\u8360': []uint16{225, 125, 132, 332},
-		'\u8361': []uint16{252, 256, 452, 203, 315, 152, 200, 362, 262, 215, 352, 115, 225, 325, 330, 320, 227, 220, 230, 327, 125, 120, 162, 332},
-		'\u8363': []uint16{252, 227, 127, 125, 402, 327, 200, 152, 100, 225, 325, 115, 427, 215, 315, 132, 328, 130, 220, 320, 230, 330, 228, 229, 232, 128, 120, 331, 129},
-		'\u8364': []uint16{426, 125, 252, 225, 132},
-		'\u8365': []uint16{130, 413, 327, 227},
-		'\u8366': []uint16{227, 413, 327},
-		'\u8367': []uint16{125, 262, 162, 100, 327, 227},
-		'\u8368': []uint16{125},
-		'\u8369': []uint16{413},
-		'\u836a': []uint16{413, 227, 232},
-		'\u836b': []uint16{400, 225, 327, 227, 252, 426, 152, 100, 125, 454, 315, 325, 215, 220, 229, 330, 320, 328},
-		'\u836c': []uint16{459, 325},
-		'\u836f': []uint16{225, 125, 425, 252, 325, 104, 327, 232, 217, 332, 231, 331, 215, 131, 227, 220, 117, 238, 256, 320, 132, 115, 130, 315, 120, 330, 152},
-		'\u8377': []uint16{225, 130, 426, 125, 227, 129, 417, 327, 207, 131, 330, 332, 325, 132, 115, 204, 217, 127, 320, 220},
-		'\u8378': []uint16{125},
-		'\u837b': []uint16{425, 327, 427, 227, 127},
-		'\u837c': []uint16{215, 152, 325},
-		'\u837d': []uint16{225, 459},
-		'\u8385': []uint16{454, 152},
-		'\u8386': []uint16{130, 125},
-		'\u8389': []uint16{227, 459, 325, 225, 327, 129, 229, 329},
-		'\u838e': []uint16{127, 327, 130, 229, 125, 230, 329, 232, 225, 227, 330, 331, 332, 129},
-		'\u8392': []uint16{413, 130, 129, 327},
-		'\u8393': []uint16{225, 413, 327, 227, 332},
-		'\u8398': []uint16{427, 127, 227, 125, 325, 130, 327},
-		'\u839b': []uint16{413},
-		'\u839c': []uint16{125},
-		'\u839e': []uint16{230, 330, 417, 120, 327, 227, 152, 331},
-		'\u83a0': []uint16{315, 262, 402, 215, 413, 125, 227},
-		'\u83a8': []uint16{125, 459, 325, 327, 225, 315},
-		'\u83a9': []uint16{459, 327},
-		'\u83aa': []uint16{459, 230, 125, 330, 227},
-		'\u83ab': []uint16{407, 127, 130, 107, 152, 115, 315, 327, 129, 207, 120, 227, 104, 131, 320, 329, 125, 229, 106, 132, 330, 332, 325, 128, 230, 232, 100, 331},
-		'\u83b0': []uint16{413},
-		'\u83b1': []uint16{327, 130, 435, 227, 129, 330, 230, 229, 332, 329, 127, 125, 132, 331, 131, 225, 232, 320},
-		'\u83b2': []uint16{327, 125, 426, 227, 225, 325, 130, 129, 230, 131, 132, 330, 332, 232, 328, 228},
-		'\u83b3': []uint16{459, 327, 125, 115, 227},
-		'\u83b4': []uint16{125},
-		'\u83b6': []uint16{459, 227, 225},
-		'\u83b7': []uint16{152, 452, 252, 125, 156, 215, 315, 325, 227, 130, 120, 225, 332, 129, 327},
-		'\u83b8': []uint16{413},
-		'\u83b9': []uint16{200, 227, 427, 327, 315, 362, 262, 100, 127, 132},
-		'\u83ba': []uint16{426, 225, 227, 327, 115, 325, 232, 315},
-		'\u83bc': []uint16{125},
-		'\u83bd': []uint16{227, 232, 427, 200, 228, 100, 225, 327, 162, 325, 262, 125, 362, 402, 130},
-		'\u83c0': []uint16{459, 225, 227, 327},
-		'\u83c1': []uint16{427, 227, 225, 232, 327, 125, 132},
-		'\u83c5': []uint16{315, 427, 127, 426},
-		'\u83c7': []uint16{225, 426, 325, 332, 125, 227, 252},
-		'\u83ca': []uint16{426, 125, 227, 327, 225, 325, 332, 130, 228, 129, 215, 232},
-		'\u83cc': []uint16{225, 425, 252, 125, 325, 220, 227, 320, 332, 304, 232},
-		'\u83cf': []uint16{417, 130, 227},
-		'\u83d4': []uint16{459, 332, 225, 227},
-		'\u83d6': []uint16{125, 325},
-		'\u83d8': []uint16{413, 327},
-		'\u83dc': []uint16{225, 425, 125, 325, 252, 327, 130, 227, 332, 220, 232, 215, 330, 132, 320, 352, 200},
-		'\u83dd': []uint16{459, 125},
-		'\u83df': []uint16{125, 225},
-		'\u83e0': []uint16{125, 325},
-		'\u83e1': []uint16{227, 459, 125, 327},
-		'\u83e5': []uint16{459, 327, 227},
-		'\u83e9': []uint16{125, 120, 325, 130, 320},
-		'\u83ea': []uint16{459, 225, 325, 315},
-		'\u83f0': []uint16{413},
-		'\u83f1': []uint16{227, 426, 232, 125, 332, 327, 132, 331, 130, 231, 230},
-		'\u83f2': []uint16{327, 130, 417, 227, 129, 132, 329, 229, 200, 330, 125, 225, 152, 131, 332, 127, 230, 232, 315, 331, 325, 228},
-		'\u83f9': []uint16{413, 227},
-		'\u83fd': []uint16{426, 227, 130, 327, 125},
-		'\u8401': []uint16{413, 227, 225},
-		'\u8403': []uint16{152, 252, 215, 426, 454, 227, 225, 327},
-		'\u8404': []uint16{325, 225, 330, 332, 459, 331, 227, 252, 125},
-		'\u8406': []uint16{459},
-		'\u840b': []uint16{459},
-		'\u840c': []uint16{152, 227, 452, 427, 327, 130, 215, 132, 156},
-		'\u840d': []uint16{227, 427, 130, 327, 225, 125, 115},
-		'\u840e': []uint16{152, 100, 252, 125, 115, 452, 325},
-		'\u840f': []uint16{459, 225},
-		'\u8411': []uint16{413, 327},
-		'\u8418': []uint16{413, 125, 227, 332, 132, 232},
-		'\u841c': []uint16{413, 125, 225, 325},
-		'\u841d': []uint16{325, 125, 225, 227, 320, 120, 129, 327, 329},
-		'\u8424': []uint16{125, 225},
-		'\u8425': []uint16{256, 125, 425, 156, 225, 325, 204, 252, 152, 227, 230, 130, 327, 220, 215, 142, 315, 304, 120, 352, 330, 104, 229, 320, 132, 115, 143, 452, 331, 129},
-		'\u8426': []uint16{152, 327, 454, 220, 227},
-		'\u8427': []uint16{127, 427, 100, 162, 130, 327, 262, 227, 128, 125, 315, 115},
-		'\u8428': []uint16{127, 225, 330, 227, 230, 327, 427, 130, 325, 417, 129, 125, 332, 229, 329, 132, 320, 331, 117, 128, 232},
-		'\u8431': []uint16{125, 225},
-		'\u8438': []uint16{225, 459, 227, 327},
-		'\u843c': []uint16{125, 225, 325},
-		'\u843d': []uint16{252, 152, 452, 225, 100, 125, 215, 315, 325, 200, 220, 256, 320, 115, 262, 362, 120, 156, 130, 327, 227, 352, 342, 104, 230, 132, 330},
-		'\u8446': []uint16{327, 454, 330, 227, 252, 320},
-		'\u8451': []uint16{413, 327, 227},
-		'\u8456': []uint16{225},
-		'\u8457': []uint16{100, 452, 125, 200, 225, 152, 252, 227, 115, 325, 215, 327, 232},
-		'\u8459': []uint16{459},
-		'\u845a': []uint16{225, 227},
-		'\u845b': []uint16{327, 127, 130, 427, 225, 227, 125, 230, 131, 330, 332, 320, 128, 132},
-		'\u845c': []uint16{459, 225},
-		'\u8461': []uint16{125, 130, 417, 132, 325, 131, 117, 352, 327, 225},
-		'\u8463': []uint16{125, 127, 427, 225, 327, 227, 128, 325, 132, 130, 330, 332, 317},
-		'\u8469': []uint16{225, 426, 227},
-		'\u846b': []uint16{125, 325, 130, 320, 315, 132, 427, 332},
-		'\u846c': []uint16{252, 454, 225, 325, 125, 152, 315, 115, 256, 330},
-		'\u846d': []uint16{413, 327},
-		'\u8471': []uint16{425, 204, 225, 125, 162, 215, 315, 227, 327, 220, 262, 325, 320, 362, 232},
-		'\u8473': []uint16{100, 225, 325, 459, 227},
-		'\u8475': []uint16{225, 125, 129, 327, 130, 332},
-		'\u8476': []uint16{459, 125, 227},
-		'\u8478': []uint16{252, 413},
-		'\u847a': []uint16{252, 320, 413, 227},
-		'\u8482': []uint16{327, 227, 413, 330, 229, 315, 129, 225, 329, 130, 300, 332, 252, 215, 230, 325, 220, 125, 331},
-		'\u8487': []uint16{413},
-		'\u8488': []uint16{413},
-		'\u8489': []uint16{427},
-		'\u848b': []uint16{127, 417, 227, 427, 327, 128, 125, 120, 130},
-		'\u848c': []uint16{459},
-		'\u848e': []uint16{413},
-		'\u8497': []uint16{229, 413, 227, 330},
-		'\u8499': []uint16{130, 330, 452, 127, 227, 132, 125, 152, 327, 120, 252, 230, 262, 320, 229, 256, 115, 129, 362, 162, 225, 100, 200, 128, 131, 156, 331, 352, 329, 325, 232, 220, 332, 228, 215},
-		'\u849c': []uint16{225, 425, 125, 252, 315},
-		'\u84a1': []uint16{225, 332, 459},
-		'\u84af': []uint16{127, 427, 327},
-		'\u84b2': []uint16{127, 427, 125, 225, 130, 229, 227, 135, 327, 129, 332},
-		'\u84b4': []uint16{125, 413},
-		'\u84b8': []uint16{125, 152, 452, 325, 256, 315, 115, 156, 227, 332, 232, 132, 225, 327, 252, 204, 130},
-		'\u84b9': []uint16{413},
-		'\u84ba': []uint16{125, 325},
-		'\u84bd': []uint16{413, 125, 227},
-		'\u84bf': []uint16{427, 227, 125, 225, 327},
-		'\u84c1': []uint16{227, 459, 327},
-		'\u84c4': []uint16{225, 152, 125, 452, 325, 200, 107, 252, 120, 215, 315, 156, 227, 327, 331},
-		'\u84c7': []uint16{125},
-		'\u84c9': []uint16{225, 227, 330, 417, 327, 215, 130, 232, 217, 315},
-		'\u84ca': []uint16{162, 362},
-		'\u84cd': []uint16{413, 125},
-		'\u84d0': []uint16{413},
-		'\u84d1': []uint16{125, 315},
-		'\u84d3': []uint16{125, 129, 327},
-		'\u84d6': []uint16{125},
-		'\u84dd': []uint16{125, 127, 400, 117, 325, 130, 204, 227, 225, 327, 262, 132, 200, 104, 162, 232, 362, 315, 304, 215, 131, 317, 100, 220, 128, 129},
-		'\u84df': []uint16{127, 427, 130, 227, 327, 225, 325},
-		'\u84e0': []uint16{225, 459},
-		'\u84e3': []uint16{232, 459},
-		'\u84e5': []uint16{330, 230},
-		'\u84e6': []uint16{107},
-		'\u84ec': []uint16{100, 452, 127, 130, 230, 227, 101, 115, 362, 262, 315, 225, 327, 120, 435, 162, 325, 125, 330, 232, 427, 129, 320},
-		'\u84fc': []uint16{427, 327, 125},
-		'\u84ff': []uint16{225, 459, 220, 325, 330},
-		'\u850a': []uint16{125},
-		'\u8511': []uint16{252, 152, 125, 256, 156},
-		'\u8513': []uint16{152, 225, 125, 325, 252, 120},
-		'\u8517': []uint16{225, 125, 426, 325, 130, 332, 330, 227},
-		'\u851a': []uint16{227, 427, 327, 130, 127, 120, 100, 402, 115, 125, 215, 104},
-		'\u851f': []uint16{413},
-		'\u8521': []uint16{127, 427, 330, 230, 327, 227, 128, 228, 132, 130},
-		'\u852b': []uint16{452, 162, 262, 120, 252, 325, 362},
-		'\u852c': []uint16{125, 225, 217, 117, 426, 332, 227, 132, 130, 315},
-		'\u8537': []uint16{125, 325, 327},
-		'\u8538': []uint16{435},
-		'\u8539': []uint16{459, 327},
-		'\u853a': []uint16{125, 427, 127, 227, 327, 230, 330},
-		'\u853b': []uint16{225, 315, 459, 327, 227},
-		'\u853c': []uint16{227, 413, 200, 327, 315},
-		'\u853d': []uint16{200, 252, 225, 452, 325, 315, 152, 256, 320, 220, 327, 352},
-		'\u8543': []uint16{230, 228, 325, 152, 125},
-		'\u8548': []uint16{413, 225},
-		'\u8549': []uint16{225, 426, 325, 327, 125, 227, 129, 320},
-		'\u854a': []uint16{225, 426, 227, 327, 320, 325},
-		'\u8556': []uint16{459, 227},
-		'\u8559': []uint16{227, 426, 327, 125, 132},
-		'\u855e': []uint16{459},
-		'\u8564': []uint16{459, 200, 125},
-		'\u8568': []uint16{125, 120, 225},
-		'\u8572': []uint16{413, 130, 125, 327, 227},
-		'\u8574': []uint16{152, 125, 225, 454, 227, 327, 230, 330, 130, 100, 252},
-		'\u8579': []uint16{125},
-		'\u857a': []uint16{459, 125},
-		'\u857b': []uint16{225, 327},
-		'\u857e': []uint16{225, 227, 325, 427, 327, 331, 125, 426},
-		'\u8584': []uint16{400, 125, 200, 100, 127, 162, 362, 315, 225, 227, 252, 132, 320, 327, 220, 128, 215, 204, 104, 120, 325, 352, 332, 129, 304},
-		'\u8585': []uint16{452},
-		'\u8587': []uint16{413, 227, 225, 327, 325, 228},
-		'\u858f': []uint16{125, 132},
-		'\u859b': []uint16{127, 427, 327, 225, 227, 130},
-		'\u859c': []uint16{427, 125, 327, 227},
-		'\u85a4': []uint16{413, 125},
-		'\u85a8': []uint16{413, 227},
-		'\u85aa': []uint16{125, 425, 227, 225, 252, 215, 325, 315, 426, 327, 320, 220},
-		'\u85ae': []uint16{413, 225},
-		'\u85af': []uint16{225, 125, 132, 325},
-		'\u85b0': []uint16{452, 227, 327, 152, 232},
-		'\u85b7': []uint16{459, 225, 227},
-		'\u85b9': []uint16{425, 225},
-		'\u85c1': []uint16{413, 130},
-		'\u85c9': []uint16{454, 252, 215, 200, 220, 256, 227, 232, 327},
-		'\u85cf': []uint16{417, 252, 225, 230, 330, 125, 452, 132, 325, 152, 227, 127, 217, 332, 142, 104, 256, 327, 331, 115, 315, 220, 129, 130, 215, 320, 231, 352, 232, 329, 120, 156, 117, 304, 204, 200},
-		'\u85d0': []uint16{152, 100},
-		'\u85d3': []uint16{225, 320, 125},
-		'\u85d5': []uint16{425, 327, 225, 125, 227, 115},
-		'\u85dc': []uint16{413, 225, 325, 327, 227},
-		'\u85e0': []uint16{125},
-		'\u85e4': []uint16{425, 125, 327, 127, 227, 225, 104, 120, 129, 315, 232, 325, 130, 229, 132, 320},
-		'\u85e9': []uint16{426, 227, 130, 125, 327, 225},
-		'\u85fb': []uint16{426, 225, 125, 227, 325, 120, 327, 152, 330, 332, 230, 132},
-		'\u85ff': []uint16{125},
-		'\u8605': []uint16{459, 227, 327, 127},
-		'\u8611': []uint16{125, 225, 132, 232, 352},
-		'\u8616': []uint16{225, 426, 252, 220},
-		'\u8618': []uint16{125},
-		'\u8627': []uint16{459},
-		'\u8629': []uint16{413, 227},
-		'\u8638': []uint16{452, 252, 327, 227},
-		'\u863c': []uint16{459, 125},
-		'\u864e': []uint16{425, 225, 227, 125, 327, 315, 130, 232, 215, 115, 325, 200, 143, 132, 228, 330, 152, 320, 220, 230, 120, 129, 138, 352, 229, 328, 332, 331, 162, 100, 127, 128, 329},
-		'\u864f': []uint16{426, 225, 332, 252, 227, 152, 325},
-		'\u8650': []uint16{252, 152, 402, 215, 200, 125, 256, 132, 320, 262, 325},
-		'\u8651': []uint16{252, 225, 454, 200, 256, 215, 315, 320, 227, 325, 327, 220, 330},
-		'\u8654': []uint16{100, 125, 228},
-		'\u865a': []uint16{407, 100, 125, 152, 200, 252, 315, 225, 227, 115, 104, 327, 215, 320, 101, 229, 156, 162, 325, 230, 362, 132, 330, 220, 232, 120},
-		'\u865e': []uint16{127, 227, 327, 427, 252, 443, 230, 426, 330, 130, 315, 125, 128},
-		'\u8662': []uint16{427, 227, 327, 215},
-		'\u866b': []uint16{225, 425, 125, 325, 252, 227, 232, 327, 315, 215, 332, 331, 256, 220, 320, 317},
-		'\u866c': []uint16{125},
-		'\u866e': []uint16{413},
-		'\u8671': []uint16{125, 225},
-		'\u8679': []uint16{225, 227, 425, 231, 232, 130, 125, 325, 215, 315, 327, 104, 330, 331, 120, 332, 127},
-		'\u867a': []uint16{459},
-		'\u867b': []uint16{413, 225, 227},
-		'\u867c': []uint16{459, 125, 227},
-		'\u867d': []uint16{106, 406, 115, 315, 120, 320},
-		'\u867e': []uint16{425, 225, 125, 227, 327, 115, 232, 325},
-		'\u867f': []uint16{413, 227, 225},
-		'\u8680': []uint16{252, 454, 256, 225, 325, 232, 152, 200, 215, 320, 125},
-		'\u8681': []uint16{225, 426, 125, 325, 220, 315, 330, 320},
-		'\u8682': []uint16{125, 320, 130, 115},
-		'\u868a': []uint16{125, 426, 225, 325, 227, 327},
-		'\u868b': []uint16{413, 227},
-		'\u868c': []uint16{130, 125, 225, 330, 315},
-		'\u868d': []uint16{115, 125, 120},
-		'\u8693': []uint16{225, 459},
-		'\u8695': []uint16{125, 225, 425, 152, 325, 227, 327, 232, 115, 320},
-		'\u869c': []uint16{125, 225, 325},
-		'\u869d': []uint16{425, 125},
-		'\u86a3': []uint16{225, 459, 227, 325},
-		'\u86a4': []uint16{413, 225, 325},
-		'\u86a7': []uint16{459, 225},
-		'\u86a8': []uint16{459, 332},
-		'\u86a9': []uint16{417, 125},
-		'\u86aa': []uint16{225, 459},
-		'\u86ac': []uint16{413, 132},
-		'\u86af': []uint16{125},
-		'\u86b0': []uint16{125, 225},
-		'\u86b1': []uint16{225, 125},
-		'\u86b4': []uint16{413, 225},
-		'\u86b6': []uint16{225, 125},
-		'\u86ba': []uint16{459},
-		'\u86c0': []uint16{452, 125, 252, 152, 325},
-		'\u86c4': []uint16{459, 225},
-		'\u86c6': []uint16{425, 125, 325},
-		'\u86c7': []uint16{425, 225, 125, 315, 215, 227, 327, 325, 130, 320, 152, 220, 252, 243, 232, 330, 352},
-		'\u86c9': []uint16{459, 225, 227},
-		'\u86ca': []uint16{115, 152, 156},
-		'\u86cb': []uint16{125, 425, 225, 325, 320, 252, 227, 315, 327, 232, 104, 332, 132},
-		'\u86ce': []uint16{225, 413, 227, 325, 327},
-		'\u86cf': []uint16{225, 125},
-		'\u86d0': []uint16{125, 225, 325},
-		'\u86d1': []uint16{459, 225},
-		'\u86d4': []uint16{125, 325},
-		'\u86d8': []uint16{413},
-		'\u86d9': []uint16{426, 225, 125, 156, 327, 215, 320},
-		'\u86db': []uint16{225, 125, 115, 325, 332},
-		'\u86de': []uint16{459, 125},
-		'\u86df': []uint16{227, 426, 125, 130, 327},
-		'\u86e4': []uint16{125, 426, 325, 327, 225, 320, 227},
-		'\u86e9': []uint16{413},
-		'\u86ed': []uint16{413, 125, 225, 227},
-		'\u86ee': []uint16{407, 200, 125, 225, 227, 100, 400, 325, 315, 120, 152, 115, 320, 203},
-		'\u86f0': []uint16{152, 243},
-		'\u86f1': []uint16{459, 125},
-		'\u86f2': []uint16{125},
-		'\u86f3': []uint16{459, 225},
-		'\u86f4': []uint16{459, 125},
-		'\u86f8': []uint16{459, 225},
-		'\u86f9': []uint16{413, 225, 125},
-		'\u86fe': []uint16{225, 125, 320, 315},
-		'\u8700': []uint16{417, 130, 225, 125, 327, 132, 227, 443, 325, 320, 215, 120, 115},
-		'\u8702': []uint16{125, 225, 425, 152, 207, 227, 115, 325, 327, 252, 220, 426, 320, 104, 315, 130},
-		'\u8703': []uint16{315, 125},
-		'\u8707': []uint16{225, 452, 325, 243},
-		'\u8708': []uint16{125, 327},
-		'\u8709': []uint16{125, 315, 320, 225},
-		'\u870a': []uint16{225, 459},
-		'\u870d': []uint16{225, 459},
-		'\u8712': []uint16{252, 459, 225, 262, 125},
-		'\u8713': []uint16{225, 459, 315, 227},
-		'\u8715': []uint16{454, 152, 225, 115, 125},
-		'\u8717': []uint16{125, 225, 152},
-		'\u8718': []uint16{125, 132, 325},
-		'\u871a': []uint16{152, 315, 125, 120},
-		'\u871c': []uint16{125, 225, 425, 200, 227, 325, 315, 362, 262, 327, 320, 332, 252, 132, 330, 115},
-		'\u871e': []uint16{459},
-		'\u8721': []uint16{125, 425, 225, 325, 162, 252, 156, 232, 215, 362, 132, 262, 220, 115},
-		'\u8722': []uint16{459, 225, 227},
-		'\u8723': []uint16{125},
-		'\u8725': []uint16{125, 225},
-		'\u8729': []uint16{413},
-		'\u872e': []uint16{413, 225, 315},
-		'\u8731': []uint16{413},
-		'\u8734': []uint16{225, 459},
-		'\u8737': []uint16{152, 452},
-		'\u873b': []uint16{125, 115, 325},
-		'\u873e': []uint16{125},
-		'\u873f': []uint16{152, 162},
-		'\u8747': []uint16{225, 426, 227, 215, 120, 152, 125, 115, 332, 104},
-		'\u8748': []uint16{125, 225, 325},
-		'\u8749': []uint16{425, 152, 125, 227, 215, 327, 315, 225, 220},
-		'\u874c': []uint16{125},
-		'\u874e': []uint16{426, 125, 225, 325, 227, 327, 252},
-		'\u8753': []uint16{225},
-		'\u8757': []uint16{125, 225, 252},
-		'\u8759': []uint16{125, 327},
-		'\u8760': []uint16{225, 459, 325, 227},
-		'\u8763': []uint16{225, 459},
-		'\u8764': []uint16{125, 459},
-		'\u8765': []uint16{225, 459},
-		'\u876e': []uint16{125},
-		'\u8770': []uint16{125},
-		'\u8772': []uint16{125},
-		'\u8774': []uint16{125, 130, 327},
-		'\u8776': []uint16{225, 426, 125, 325, 227, 132, 327, 330, 215},
-		'\u877b': []uint16{225},
-		'\u877c': []uint16{125},
-		'\u877d': []uint16{413, 227},
-		'\u877e': []uint16{125},
-		'\u8782': []uint16{225, 459, 332, 320},
-		'\u8783': []uint16{125},
-		'\u8785': []uint16{459, 225},
-		'\u8788': []uint16{459, 225, 227},
-		'\u878b': []uint16{459, 225},
-		'\u878d': []uint16{225, 156, 152, 325, 452, 125, 252, 127, 332, 130, 227, 317, 331, 120, 204, 327, 215, 262, 232, 200, 320, 162, 115, 315, 362, 228, 330, 256, 117, 129},
-		'\u8793': []uint16{115},
-		'\u8797': []uint16{413},
-		'\u879f': []uint16{225, 125, 325},
-		'\u87a8': []uint16{413, 225, 325, 125},
-		'\u87ab': []uint16{452, 125},
-		'\u87ac': []uint16{225},
-		'\u87ad': []uint16{413, 225},
-		'\u87af': []uint16{425, 132, 125, 227},
-		'\u87b3': []uint16{125, 132, 120, 115},
-		'\u87b5': []uint16{325, 459},
-		'\u87ba': []uint16{125, 426, 225, 325, 227, 104, 327, 330, 130, 230, 132},
-		'\u87bd': []uint16{125, 459},
-		'\u87c0': []uint16{225, 459},
-		'\u87c6': []uint16{459, 225, 227, 325, 320},
-		'\u87ca': []uint16{413, 125},
-		'\u87cb': []uint16{125},
-		'\u87d1': []uint16{125},
-		'\u87d2': []uint16{125, 225, 130, 330},
-		'\u87db': []uint16{459},
-		'\u87e0': []uint16{227, 454, 125, 327, 130, 132, 230, 315, 215},
-		'\u87e5': []uint16{225, 459},
-		'\u87ea': []uint16{459, 125},
-		'\u87ee': []uint16{459},
-		'\u87f9': []uint16{426, 225, 125, 132, 332, 315, 252, 232},
-		'\u87fe': []uint16{125, 228, 229, 115, 332},
-		'\u8803': []uint16{225, 459},
-		'\u880a': []uint16{459, 225},
-		'\u8813': []uint16{425, 125},
-		'\u8815': []uint16{152, 125, 162, 262, 120},
-		'\u8816': []uint16{459, 225, 325},
-		'\u881b': []uint16{459},
-		'\u8821': []uint16{413, 227, 327, 130, 315, 152},
-		'\u8822': []uint16{200, 400, 125, 115, 315, 100, 152, 227, 327},
-		'\u8832': []uint16{413, 152},
-		'\u8839': []uint16{125, 225, 215},
-		'\u883c': []uint16{459, 125},
-		'\u8840': []uint16{125, 425, 252, 325, 225, 120, 256, 315, 162, 100, 115, 320, 215, 332, 152, 204, 220, 117, 227, 232, 132, 327, 104, 331, 217},
-		'\u8844': []uint16{413},
-		'\u8845': []uint16{252, 413, 320, 256, 325, 327},
-		'\u884c': []uint16{252, 125, 225, 452, 217, 325, 231, 152, 256, 156, 200, 207, 222, 238, 204, 227, 117, 315, 215, 327, 330, 320, 331, 230, 220, 127, 304, 115, 422, 352, 332, 107, 120, 143, 132, 232, 112, 343, 224, 324, 100, 130, 228, 229, 128, 356, 328, 317},
-		'\u884d': []uint16{152, 156, 252, 125, 454, 227, 327, 332, 315, 215, 325, 228, 132, 256},
-		'\u8854': []uint16{452, 225, 152, 252, 327, 227, 315, 125, 330, 325, 256},
-		'\u8857': []uint16{425, 125, 142, 225, 230, 252, 315, 242, 227, 332, 212, 320, 132, 325, 330, 115, 327, 117, 156, 130, 256},
-		'\u8859': []uint16{125, 225, 130, 329, 327, 325},
-		'\u8861': []uint16{200, 252, 152, 227, 130, 225, 325, 427, 127, 215, 327, 232, 125, 315, 256, 201, 332, 204, 220, 129, 320, 228, 104, 352, 131},
-		'\u8862': []uint16{130, 225},
-		'\u8863': []uint16{125, 225, 426, 325, 252, 127, 315, 115, 327, 227, 120, 215, 256, 232, 320, 317, 332, 204, 330, 131, 130},
-		'\u8865': []uint16{152, 452, 125, 156, 252, 320, 204, 315, 132, 325, 256, 327, 352, 227, 215, 225, 217, 115, 117, 332},
-		'\u8868': []uint16{152, 225, 125, 252, 425, 325, 156, 332, 227, 331, 220, 256, 327, 232, 315, 204, 320, 215, 115, 238, 120, 132, 330},
-		'\u8869': []uint16{425, 225, 459},
-		'\u886b': []uint16{225, 426, 327, 227, 320, 232, 132, 325, 315},
-		'\u886c': []uint16{125, 452, 152, 252, 225, 227, 232, 327},
-		'\u886e': []uint16{229, 227, 413, 115, 315, 327, 129},
-		'\u8870': []uint16{152, 402, 100, 225, 325, 156, 252, 320, 220, 125, 215, 227, 332, 315, 132},
-		'\u8872': []uint16{238, 413, 325, 227},
-		'\u8877': []uint16{225, 252, 215, 352, 207, 315, 107, 125, 104, 256, 325},
-		'\u887d': []uint16{413, 252, 225, 215},
-		'\u887e': []uint16{413, 227},
-		'\u887f': []uint16{413, 227, 327},
-		'\u8881': []uint16{127, 427, 327, 227, 128, 130, 125},
-		'\u8882': []uint16{207, 426, 252, 315},
-		'\u8884': []uint16{413, 225, 227},
-		'\u8885': []uint16{162, 262, 115, 315, 362, 220, 320, 152},
-		'\u8888': []uint16{125},
-		'\u888b': []uint16{225, 435, 222, 125, 325, 232, 142, 224, 104, 227, 422, 215, 220, 230, 329},
-		'\u888d': []uint16{225, 426, 125, 315, 325, 115},
-		'\u8892': []uint16{152, 252, 454, 120},
-		'\u8896': []uint16{225, 125, 452, 115, 104, 315, 204, 227, 252, 232, 325, 320, 120, 426, 327, 215},
-		'\u889c': []uint16{125, 225, 426, 325, 232},
-		'\u88a2': []uint16{452, 225},
-		'\u88a4': []uint16{200, 413, 227},
-		'\u88ab': []uint16{434, 125, 225, 107, 152, 100, 156, 104, 120, 117, 327, 232, 315, 252, 317, 230},
-		'\u88ad': []uint16{452, 252, 152, 256, 315, 227, 125, 332, 325, 327},
-		'\u88b1': []uint16{225, 413, 325},
-		'\u88b4': []uint16{320},
-		'\u88b7': []uint16{459, 125},
-		'\u88bc': []uint16{459},
-		'\u88c1': []uint16{225, 152, 125, 252, 452, 256, 200, 325, 156, 201, 215, 227, 132, 317, 327, 315, 331, 117},
-		'\u88c2': []uint16{252, 125, 452, 152, 325, 215, 225, 256, 156, 320, 331, 104, 327, 115, 217, 315},
-		'\u88c5': []uint16{125, 252, 152, 452, 225, 104, 325, 320, 256, 204, 156, 115, 315, 220, 331, 217, 232, 120, 227, 332, 132, 327, 304, 352, 207, 228, 117, 343},
-		'\u88c6': []uint16{225, 325, 204, 125},
-		'\u88c9': []uint16{413},
-		'\u88ce': []uint16{413},
-		'\u88d2': []uint16{413},
-		'\u88d4': []uint16{225, 427, 227, 327},
-		'\u88d5': []uint16{200, 227, 427, 327, 232, 132, 332, 330, 320, 130, 315, 328, 325, 215, 454, 304},
-		'\u88d8': []uint16{127, 427, 125, 227, 225, 215, 327, 315, 129},
-		'\u88d9': []uint16{426, 225, 125, 227, 104, 232, 327, 120, 215},
-		'\u88df': []uint16{225, 459},
-		'\u88e2': []uint16{225, 459},
-		'\u88e3': []uint16{459, 152},
-		'\u88e4': []uint16{125, 225, 426, 325, 217, 232, 227},
-		'\u88e5': []uint16{225},
-		'\u88e8': []uint16{315, 125, 127},
-		'\u88f0': []uint16{225, 413},
-		'\u88f1': []uint16{452, 252, 152},
-		'\u88f3': []uint16{225, 426, 227, 327},
-		'\u88f4': []uint16{127, 427, 327, 227, 128},
-		'\u88f8': []uint16{402, 152, 120, 104, 362, 262, 200, 125, 315, 156},
-		'\u88f9': []uint16{452, 225, 252, 152, 125, 115, 315, 220, 327, 325},
-		'\u88fc': []uint16{413, 327, 227},
-		'\u88fe': []uint16{426, 225},
-		'\u8902': []uint16{426, 225, 125},
-		'\u890a': []uint16{402, 100},
-		'\u8910': []uint16{125, 325, 404, 426, 225, 132},
-		'\u8912': []uint16{152, 452, 227, 115, 125, 156, 129, 130, 320, 456, 252},
-		'\u8913': []uint16{225, 315},
-		'\u8919': []uint16{413, 252},
-		'\u891a': []uint16{127, 427, 327, 227},
-		'\u891b': []uint16{262, 220, 459, 315},
-		'\u8921': []uint16{125, 325},
-		'\u8925': []uint16{225, 125, 343, 325, 232},
-		'\u892a': []uint16{452, 152},
-		'\u892b': []uint16{152},
-		'\u8930': []uint16{413},
-		'\u8934': []uint16{162, 320, 115},
-		'\u8936': []uint16{125, 426, 225, 227, 325, 204, 327},
-		'\u8941': []uint16{125, 115},
-		'\u8944': []uint16{417, 130, 227, 327, 230, 129, 132, 329, 426, 454, 152, 229, 325, 125, 330},
-		'\u895e': []uint16{225, 413},
-		'\u895f': []uint16{225, 425, 315, 125, 204, 115, 227, 220, 327},
-		'\u8966': []uint16{413, 227},
-		'\u897b': []uint16{452, 225},
-		'\u897f': []uint16{230, 130, 225, 142, 412, 112, 125, 330, 127, 143, 227, 325, 327, 212, 229, 132, 312, 129, 131, 332, 317, 217, 331, 315, 152, 117, 320, 329, 104, 232, 120, 215, 242, 342, 304, 252, 220, 352, 115, 218},
-		'\u8981': []uint16{452, 204, 200, 252, 152, 207, 206, 125, 208, 106, 325, 225, 100, 320, 220, 315, 300, 120, 327, 201, 230, 115, 330, 156, 317, 215, 227},
-		'\u8983': []uint16{127, 427, 227, 327},
-		'\u8986': []uint16{152, 454, 125, 315, 252, 215, 227, 225, 320, 115, 156, 232, 132, 256, 325},
-		'\u89c1': []uint16{452, 252, 152, 225, 200, 125, 315, 227, 327, 325, 215, 115, 206, 220, 352, 207, 320, 120, 307, 100, 122, 107, 238, 245, 229, 332, 322, 143, 228, 156, 232},
-		'\u89c2': []uint16{225, 125, 152, 200, 454, 156, 252, 325, 227, 220, 232, 315, 256, 327, 330, 127, 215, 130, 320, 120, 230, 132, 115, 217, 332, 201, 331, 129},
-		'\u89c4': []uint16{125, 152, 225, 304, 256, 426, 320, 252, 200, 300, 325, 156, 204, 227, 120, 356, 100, 307, 315, 162, 362, 215, 327, 331, 132, 220, 454, 117, 301, 229, 332, 217, 352, 115},
-		'\u89c5': []uint16{152, 452, 252, 315, 220, 320, 227, 127, 454, 327},
-		'\u89c6': []uint16{252, 125, 225, 454, 325, 152, 204, 217, 220, 331, 315, 115, 200, 256, 227, 215, 231, 356, 320, 332, 317, 232, 120, 156, 330, 117},
-		'\u89c7': []uint16{413},
-		'\u89c8': []uint16{252, 325, 256, 452, 225, 227, 320, 315, 454, 330, 327, 152, 332},
-		'\u89c9': []uint16{152, 225, 252, 452, 207, 200, 215, 125, 227, 327, 325, 220, 320, 256, 329, 156, 129, 131, 127, 330, 230},
-		'\u89ca': []uint16{152},
-		'\u89cb': []uint16{413},
-		'\u89cc': []uint16{413, 227},
-		'\u89ce': []uint16{252, 459},
-		'\u89cf': []uint16{454},
-		'\u89d0': []uint16{252, 152, 413, 325, 256, 327, 227},
-		'\u89d1': []uint16{452, 215, 252, 227},
-		'\u89d2': []uint16{125, 225, 325, 435, 425, 330, 222, 212, 152, 215, 230, 232, 217, 320, 130, 227, 242, 422, 315, 327, 204, 342, 220, 229, 332, 252, 132, 156, 352, 142, 228, 135, 331},
-		'\u89d6': []uint16{413},
-		'\u89da': []uint16{426},
-		'\u89dc': []uint16{413},
-		'\u89de': []uint16{452, 225, 252},
-		'\u89e3': []uint16{152, 252, 452, 125, 325, 225, 127, 331, 256, 132, 220, 156, 143, 215, 320, 200, 315, 227, 115, 327, 131, 130, 317, 120, 332, 100, 103, 217, 128, 352, 328, 117},
-		'\u89e5': []uint16{115},
-		'\u89e6': []uint16{252, 152, 452, 125, 315, 225, 115, 256, 325, 220, 304, 332, 132, 120, 204, 156, 227, 104, 320, 327},
-		'\u89eb': []uint16{252},
-		'\u89ef': []uint16{413},
-		'\u89f1': []uint16{125},
-		'\u89f3': []uint16{459, 152},
-		'\u8a00': []uint16{225, 454, 245, 252, 315, 125, 325, 127, 320, 227, 152, 215, 115, 220, 206, 306, 238, 327, 204, 120, 207, 232, 228, 200, 331, 132, 330, 256, 332, 262},
-		'\u8a07': []uint16{225, 459, 327, 227},
-		'\u8a3e': []uint16{427, 127},
-		'\u8a48': []uint16{413},
-		'\u8a79': []uint16{127, 427, 128, 129, 227, 327, 130, 329},
-		'\u8a89': []uint16{225, 152, 426, 252, 325, 227, 256, 220, 215, 454, 315, 232, 327, 125, 200, 120},
-		'\u8a8a': []uint16{452, 152, 227, 125},
-		'\u8a93': []uint16{454, 252, 125, 225, 152, 107, 315, 215, 227, 115, 327, 325, 320},
-		'\u8b07': []uint16{413, 227},
-		'\u8b26': []uint16{459},
-		'\u8b66': []uint16{125, 152, 225, 217, 156, 252, 426, 256, 325, 200, 331, 352, 320, 117, 104, 327, 132, 120, 227},
-		'\u8b6c': []uint16{152, 120},
-		'\u8ba1': []uint16{252, 125, 256, 152, 452, 225, 325, 315, 331, 215, 120, 156, 127, 220, 117, 207, 332, 227, 320, 232, 307, 352, 115, 104, 327, 304, 229, 132, 328, 129},
-		'\u8ba2': []uint16{252, 125, 152, 452, 156, 325, 104, 256},
-		'\u8ba3': []uint16{125},
-		'\u8ba4': []uint16{152, 252, 101, 452, 156, 125, 320, 352, 132, 215, 362, 162, 325, 225, 220, 227, 100, 115, 315, 331, 117},
-		'\u8ba5': []uint16{152, 454, 215, 156, 227},
-		'\u8ba6': []uint16{256, 413, 227},
-		'\u8ba7': []uint16{252, 413, 256},
-		'\u8ba8': []uint16{152, 252, 452, 325, 115, 256, 125, 156, 315, 120, 100, 215, 320, 104},
-		'\u8ba9': []uint16{452, 252, 152, 325, 227, 215, 156, 220, 327, 230, 229, 320, 332, 256, 125, 330, 225, 130},
-		'\u8baa': []uint16{252, 152},
-		'\u8bab': []uint16{413, 252, 225, 220, 327},
-		'\u8bad': []uint16{156, 256, 225, 452, 125, 152, 252, 227, 325, 115, 217, 327, 332, 331, 117, 215, 330, 317},
-		'\u8bae': []uint16{225, 125, 252, 452, 152, 325, 256, 215, 232, 331, 220, 227, 115, 156, 327, 231, 217, 330, 320, 120},
-		'\u8baf': []uint16{232, 225, 426, 325, 252, 231, 125, 227, 315, 331, 152, 256, 320, 215, 217, 332, 156, 132, 327},
-		'\u8bb0': []uint16{125, 225, 152, 452, 252, 325, 332, 222, 232, 331, 227, 120, 320, 104, 115, 156, 327, 307, 132, 215, 200, 352, 217, 117, 317, 422, 304},
-		'\u8bb2': []uint16{452, 152, 125, 252, 325, 156, 220, 206, 320, 130, 256, 217, 120, 115, 227, 317, 315},
-		'\u8bb3': []uint16{252, 454, 215, 152, 115, 315, 256, 220, 227, 327},
-		'\u8bb4': []uint16{152},
-		'\u8bb5': []uint16{407},
-		'\u8bb6': []uint16{200, 252, 152},
-		'\u8bb7': []uint16{130, 262, 162, 200, 330},
-		'\u8bb8': []uint16{122, 207, 252, 422, 127, 152, 130, 125, 222, 322, 227, 225, 132, 325, 327, 128, 315, 452, 229, 256, 338, 332, 330},
-		'\u8bb9': []uint16{452, 125, 152, 215, 315, 225, 325, 156},
-		'\u8bba': []uint16{225, 452, 125, 252, 206, 152, 256, 156, 320, 325, 306, 215, 132, 220, 245, 315, 227, 352, 332, 115, 232, 331, 120, 327, 304},
-		'\u8bbc': []uint16{256, 454, 325, 220, 252, 125, 225, 215, 204, 117},
-		'\u8bbd': []uint16{152, 252, 156, 454, 125, 256, 215, 315, 227},
-		'\u8bbe': []uint16{256, 125, 152, 156, 452, 252, 331, 225, 325, 315, 220, 327, 227, 330, 106, 115, 204, 217, 130, 215, 320},
-		'\u8bbf': []uint16{252, 152, 452, 325, 225, 156, 215, 217, 125, 320, 227, 256, 327, 331, 115, 127, 117, 332},
-		'\u8bc0': []uint16{426, 225, 125, 152, 252, 156},
-		'\u8bc1': []uint16{125, 252, 152, 217, 425, 225, 331, 325, 117, 256, 227, 200, 332, 317, 320, 327, 232, 352, 120, 315, 132, 215, 330, 304},
-		'\u8bc2': []uint16{225, 413},
-		'\u8bc3': []uint16{413, 327, 227, 129},
-		'\u8bc4': []uint16{152, 156, 125, 252, 452, 225, 325, 256, 227, 217, 132, 220, 315, 331, 115, 327, 120, 117, 317},
-		'\u8bc5': []uint16{152, 252},
-		'\u8bc6': []uint16{225, 252, 325, 452, 152, 320, 315, 215, 220, 125, 207, 227, 100, 331, 120, 156, 115, 327, 256, 352, 204, 304, 132, 200},
-		'\u8bc8': []uint16{452, 152, 256, 156, 200, 252, 315, 125, 215, 325, 332, 227, 225, 456},
-		'\u8bc9': []uint16{252, 156, 152, 256, 425, 325, 125, 320, 215, 454, 120, 332, 304, 315, 227, 225},
-		'\u8bca': []uint16{152, 252, 454, 125, 325, 225, 156, 217, 320, 256},
-		'\u8bcb': []uint16{152},
-		'\u8bcc': []uint16{454, 252},
-		'\u8bcd': []uint16{425, 225, 125, 215, 252, 227, 315, 325, 327, 207, 220, 320, 115, 352},
-		'\u8bce': []uint16{402, 413},
-		'\u8bcf': []uint16{426, 125, 225, 227, 327, 130, 325},
-		'\u8bd1': []uint16{252, 452, 125, 225, 152, 325, 227, 320, 327, 204, 256, 117, 220, 331, 132, 217},
-		'\u8bd2': []uint16{413, 227, 327},
-		'\u8bd3': []uint16{452, 152},
-		'\u8bd4': []uint16{413},
-		'\u8bd5': []uint16{152, 156, 256, 452, 252, 125, 225, 325, 215, 352, 217, 315, 320, 332, 227, 132, 331},
-		'\u8bd6': []uint16{413, 152},
-		'\u8bd7': []uint16{125, 425, 225, 227, 252, 327, 127, 220, 115, 325, 120, 329, 320, 304, 152, 215, 132, 232, 315, 228},
-		'\u8bd8': []uint16{152, 252, 156},
-		'\u8bd9': []uint16{454, 100},
-		'\u8bda': []uint16{227, 200, 100, 400, 125, 315, 152, 252, 327, 115, 107, 120, 207, 225, 325, 132, 232, 317, 320, 331, 201, 228, 256, 220, 300, 103},
-		'\u8bdb': []uint16{152, 315, 225, 215, 252, 115, 220},
-		'\u8bdc': []uint16{459},
-		'\u8bdd': []uint16{425, 225, 252, 245, 125, 238, 256, 220, 325, 320, 315, 306, 200, 215, 115, 206, 217, 120, 331, 152, 227, 327, 332, 232},
-		'\u8bde': []uint16{152, 243, 343, 200, 325, 125, 225, 315, 320, 143},
-		'\u8bdf': []uint16{125, 152},
-		'\u8be0': []uint16{152, 125},
-		'\u8be1': []uint16{100, 402, 125, 315, 152, 115, 327, 156},
-		'\u8be2': []uint16{152, 256, 252, 454, 325, 227, 327, 331, 217, 332, 343, 125, 229},
-		'\u8be3': []uint16{225, 452, 215, 227, 327},
-		'\u8be4': []uint16{454, 252, 125, 227, 152},
-		'\u8be5': []uint16{438, 252, 138, 152, 207, 107, 132, 315, 125, 227, 327},
-		'\u8be6': []uint16{100, 101, 402, 252, 125, 152, 200, 215, 227, 362, 162, 327},
-		'\u8be7': []uint16{100, 200, 320},
-		'\u8be8': []uint16{125, 215, 252},
-		'\u8be9': []uint16{413, 227, 252, 327},
-		'\u8beb': []uint16{252, 256, 215, 152},
-		'\u8bec': []uint16{152, 454, 156, 125, 252, 315},
-		'\u8bed': []uint16{125, 225, 232, 426, 252, 215, 227, 452, 325, 220, 115, 327, 320, 127, 315, 152, 331, 352, 332, 131, 120, 130, 132},
-		'\u8bee': []uint16{413, 252, 256, 227},
-		'\u8bef': []uint16{225, 252, 125, 452, 152, 256, 156, 115, 227, 320, 120, 215, 220, 325, 315, 352},
-		'\u8bf0': []uint16{125, 156, 252},
-		'\u8bf1': []uint16{152, 156, 454, 125, 252, 100, 215, 120, 132, 256, 332, 327},
-		'\u8bf2': []uint16{252, 454, 115, 227, 315, 220, 327},
-		'\u8bf3': []uint16{452, 125},
-		'\u8bf4': []uint16{452, 152, 252, 225, 245, 220, 206, 125, 120, 315, 228, 320, 215, 325, 352, 115, 207, 200, 256, 306, 156, 227, 104, 331, 327},
-		'\u8bf5': []uint16{252, 454, 152, 227, 325, 256, 215, 327, 125, 225},
-		'\u8bf6': []uint16{410},
-		'\u8bf7': []uint16{452, 252, 152, 125, 325, 315, 227, 156, 327, 115, 320, 332, 215},
-		'\u8bf8': []uint16{438, 127, 125, 122, 138, 152, 227, 252, 327, 130, 330, 320, 120, 115, 315, 128, 331},
-		'\u8bf9': []uint16{413},
-		'\u8bfa': []uint16{252, 227, 132, 427, 129, 327, 330, 127, 130, 125, 230, 229, 426, 232, 329, 325, 332, 225, 331, 220, 320, 454, 131, 162, 362, 215, 315},
-		'\u8bfb': []uint16{252, 452, 152, 125, 256, 325, 204, 225, 217, 327, 220, 132, 227, 115, 332, 215, 331, 315, 200},
-		'\u8bfc': []uint16{413, 225},
-		'\u8bfd': []uint16{152, 125, 252, 156},
-		'\u8bfe': []uint16{125, 425, 252, 225, 143, 152, 104, 325, 256, 227, 217},
-		'\u8bff': []uint16{454, 252, 220},
-		'\u8c00': []uint16{413, 252, 315},
-		'\u8c01': []uint16{438, 152, 138, 315, 115, 320, 215, 120, 227, 327},
-		'\u8c02': []uint16{413, 227, 327},
-		'\u8c03': []uint16{156, 252, 152, 452, 225, 125, 325, 100, 327, 200, 320, 132, 256, 220, 232, 315, 115, 227, 352, 215, 130, 217, 331, 332, 317, 117, 120},
-		'\u8c04': []uint16{152, 315, 115},
-		'\u8c05': []uint16{252, 152, 452, 227, 130, 256, 327, 320},
-		'\u8c06': []uint16{207, 107, 120, 320, 115, 315},
-		'\u8c07': []uint16{413},
-		'\u8c08': []uint16{452, 156, 152, 252, 256, 325, 215, 225, 115, 127, 125, 315, 232, 320, 120, 220, 332, 200, 227, 352, 327, 132, 317},
-		'\u8c0a': []uint16{227, 225, 232, 327, 426, 330, 325, 252, 332, 331, 215, 256},
-		'\u8c0b': []uint16{225, 152, 452, 325, 252, 125, 315, 227, 215, 327, 320, 256, 220, 330, 331, 115, 230, 156, 228},
-		'\u8c0c': []uint16{427, 127, 227, 327},
-		'\u8c0d': []uint16{225, 125, 325, 332},
-		'\u8c0e': []uint16{426, 252, 125, 152, 325, 215, 227},
-		'\u8c0f': []uint16{454, 227, 252, 152, 256, 327, 220, 315, 215, 125, 130},
-		'\u8c10': []uint16{200, 402, 125, 100, 252, 227, 162, 320, 215, 332},
-		'\u8c11': []uint16{413, 252, 262, 227},
-		'\u8c12': []uint16{454, 252, 227, 327, 152},
-		'\u8c13': []uint16{252, 452, 220, 225, 125, 204, 227, 327, 454, 256, 115},
-		'\u8c14': []uint16{459, 227},
-		'\u8c15': []uint16{426, 225, 125, 252, 454, 227, 327},
-		'\u8c16': []uint16{413, 227},
-		'\u8c17': []uint16{454, 125, 315},
-		'\u8c18': []uint16{413, 227, 129},
-		'\u8c19': []uint16{252, 152, 100, 320},
-		'\u8c1a': []uint16{125, 225},
-		'\u8c1b': []uint16{225, 152},
-		'\u8c1c': []uint16{425, 125, 225, 252, 227, 352},
-		'\u8c1d': []uint16{413},
-		'\u8c1f': []uint16{413, 227, 327, 230, 330},
-		'\u8c20': []uint16{413, 227, 327},
-		'\u8c21': []uint16{413, 227, 225, 327},
-		'\u8c22': []uint16{252, 127, 452, 152, 215, 227, 256, 325, 128, 229, 315, 327, 115, 125, 129, 320, 329, 130, 220, 132, 330},
-		'\u8c23': []uint16{125, 225, 252, 426, 152, 227, 315, 156, 120, 327},
-		'\u8c24': []uint16{252, 413, 325, 152, 256},
-		'\u8c25': []uint16{454, 125, 227},
-		'\u8c26': []uint16{327, 227, 100, 427, 152, 454, 115, 228, 125, 315, 120, 229, 330},
-		'\u8c27': []uint16{200, 413, 227, 327, 228},
-		'\u8c28': []uint16{100, 407, 200, 315, 152, 227, 115, 327, 325},
-		'\u8c29': []uint16{152, 156, 125},
-		'\u8c2a': []uint16{454, 152, 256, 227},
-		'\u8c2b': []uint16{413},
-		'\u8c2c': []uint16{200, 125, 427, 426, 225, 315, 325, 152, 227, 120},
-		'\u8c2d': []uint16{127, 456, 427, 227, 215, 327, 128},
-		'\u8c2e': []uint16{413},
-		'\u8c2f': []uint16{427, 125, 327, 130, 330},
-		'\u8c30': []uint16{125},
-		'\u8c31': []uint16{225, 452, 325, 125, 426, 227, 252, 220, 200, 152, 320, 204, 327, 232, 332, 300, 256, 352},
-		'\u8c32': []uint16{200, 413},
-		'\u8c33': []uint16{413, 227},
-		'\u8c34': []uint16{152, 454},
-		'\u8c35': []uint16{125, 152},
-		'\u8c36': []uint16{125},
-		'\u8c37': []uint16{225, 125, 426, 127, 227, 230, 427, 325, 130, 327, 231, 330, 229, 332, 215, 143, 315, 252, 132, 120, 232, 328, 115, 320, 129},
-		'\u8c41': []uint16{152, 452, 125, 100, 115, 107, 200, 227},
-		'\u8c46': []uint16{225, 125, 425, 325, 227, 332, 327, 127, 320, 315, 232, 215, 352, 230, 115, 130, 252, 104, 132, 331, 220},
-		'\u8c47': []uint16{125},
-		'\u8c49': []uint16{225, 459, 125},
-		'\u8c4c': []uint16{125, 325, 332},
-		'\u8c55': []uint16{413, 315, 215},
-		'\u8c5a': []uint16{225, 125},
-		'\u8c61': []uint16{225, 425, 252, 152, 325, 125, 200, 327, 220, 426, 227, 130, 120, 320, 331, 352, 215, 232, 315, 100, 129, 330, 230, 332, 115},
-		'\u8c62': []uint16{454, 152},
-		'\u8c68': []uint16{125},
-		'\u8c6a': []uint16{125, 100, 225, 402, 327, 227, 200, 129, 152, 325, 115, 320, 315, 220, 232, 132, 330, 229, 130},
-		'\u8c6b': []uint16{200, 417, 130, 220, 227, 217, 125, 327, 315, 317, 225, 362, 262, 320, 330, 117, 132, 252, 331},
-		'\u8c73': []uint16{413},
-		'\u8c78': []uint16{225, 413, 330},
-		'\u8c79': []uint16{225, 425, 125, 227, 232, 215, 426, 220, 315, 325, 327, 229},
-		'\u8c7a': []uint16{125, 120, 115},
-		'\u8c82': []uint16{425, 125, 225, 327, 325, 227, 252},
-		'\u8c85': []uint16{225, 459},
-		'\u8c89': []uint16{426, 215, 125},
-		'\u8c8a': []uint16{413},
-		'\u8c8c': []uint16{225, 426, 203, 232, 152, 315, 325, 215, 227, 320, 115, 220, 327, 120},
-		'\u8c94': []uint16{125},
-		'\u8c98': []uint16{413},
-		'\u8d1d': []uint16{127, 225, 125, 332, 327, 417, 130, 227, 129, 330, 329, 132, 235, 325, 331, 229, 232, 252, 131, 117, 315},
-		'\u8d1e': []uint16{327, 227, 427, 132, 225, 128, 125, 127, 315, 200, 120, 232, 325, 228, 100, 320, 331, 130, 104},
-		'\u8d1f': []uint16{125, 152, 452, 252, 104, 156, 225, 325, 320, 315, 215, 307, 115, 217, 220, 103, 120, 304, 256, 327, 332, 227},
-		'\u8d21': []uint16{125, 427, 127, 227, 252, 230, 330, 130, 426, 327, 454, 225, 229, 325, 129},
-		'\u8d22': []uint16{125, 252, 425, 131, 117, 225, 331, 315, 217, 215, 220, 320, 227, 327, 115, 200, 120, 317, 325, 232, 332},
-		'\u8d23': []uint16{252, 125, 325, 152, 225, 320, 426, 220, 256, 115, 156, 331, 215, 227, 117, 317, 327, 315, 332, 120, 217},
-		'\u8d24': []uint16{227, 426, 125, 225, 327, 315, 252, 229, 100, 320, 220, 330, 115, 120, 232, 215, 352, 402},
-		'\u8d25': []uint16{252, 452, 203, 152, 125, 225, 315, 325, 320, 256, 227, 200, 115, 100, 352, 327, 220, 215, 120, 156, 104, 101, 232},
-		'\u8d26': []uint16{125, 425, 252, 225, 304, 220, 256, 325, 217, 215},
-		'\u8d27': []uint16{125, 225, 425, 320, 325, 252, 120, 331, 256, 232, 156, 115, 317, 217, 315, 215, 227, 132, 231, 131, 117, 332, 330, 230, 343},
-		'\u8d28': []uint16{225, 125, 426, 325, 152, 204, 252, 220, 227, 100, 232, 117, 104, 356, 256, 320, 327, 331, 156, 215, 315, 131, 132, 330, 200, 352, 120, 115, 332},
-		'\u8d29': []uint16{452, 152, 225, 325, 125, 156, 115, 320, 256, 227},
-		'\u8d2a': []uint16{152, 452, 100, 125, 115, 317, 315, 256, 120, 225},
-		'\u8d2b': []uint16{100, 125, 402, 156, 152, 200, 103, 256, 117, 252, 320, 315, 120, 204, 220, 215, 115, 331, 227, 317, 325, 225},
-		'\u8d2c': []uint16{152, 452, 252, 156, 125, 315, 220},
-		'\u8d2d': []uint16{252, 152, 256, 125, 454, 156, 325, 332, 225, 320, 132, 120, 330, 217},
-		'\u8d2e': []uint16{152, 452, 125, 256, 156, 325, 252, 217, 132, 227, 104},
-		'\u8d2f': []uint16{152, 227, 252, 427, 207, 315, 204, 327, 225, 200, 222, 325, 454, 215, 125, 322, 104, 328, 320, 115, 120},
-		'\u8d30': []uint16{422, 122, 125, 227, 322},
-		'\u8d31': []uint16{400, 125, 200, 225, 252, 152, 220, 115, 327, 315, 215, 320, 227},
-		'\u8d32': []uint16{427, 227, 225, 125, 327, 127},
-		'\u8d33': []uint16{413},
-		'\u8d34': []uint16{452, 225, 152, 252, 125, 156, 100, 200, 325, 256, 227, 315, 232, 132, 327, 320, 362, 262, 101},
-		'\u8d35': []uint16{125, 200, 400, 130, 327, 227, 225, 100, 215, 330, 315, 152, 131, 132, 220, 129, 320, 115, 325, 228, 156, 120, 138, 331, 328, 329},
-		'\u8d36': []uint16{413, 227},
-		'\u8d37': []uint16{125, 225, 452, 217, 252, 325, 256, 227, 317, 215, 117, 352},
-		'\u8d38': []uint16{156, 225, 217, 320, 125, 331, 107, 325, 425, 332, 304, 454, 117, 330, 132, 204, 317, 231, 227, 315, 426, 417},
-		'\u8d39': []uint16{256, 225, 325, 125, 252, 452, 127, 100, 130, 152, 320, 129, 327, 227, 315, 120, 232, 207, 115, 329, 331, 215, 200, 132, 229, 203, 217, 332, 220, 128, 352, 101},
-		'\u8d3a': []uint16{127, 454, 252, 130, 227, 152, 327, 156, 125, 128, 200, 230, 330, 320, 229, 325},
-		'\u8d3b': []uint16{152, 454, 125, 327, 115, 227},
-		'\u8d3c': []uint16{425, 225, 125, 315, 400, 115, 162, 320, 215, 120, 252, 325},
-		'\u8d3d': []uint16{227, 413},
-		'\u8d3e': []uint16{127, 427, 327, 227, 225, 330, 128, 220, 215, 129, 130, 120, 229, 230},
-		'\u8d3f': []uint16{252, 152, 426, 325, 125, 117, 115, 454, 215, 256, 156, 352},
-		'\u8d40': []uint16{413, 327, 215},
-		'\u8d41': []uint16{252, 325, 452, 227},
-		'\u8d42': []uint16{252, 426, 325, 315, 256},
-		'\u8d43': []uint16{252, 125, 426, 315, 256, 225, 215},
-		'\u8d44': []uint16{125, 256, 325, 225, 320, 252, 426, 104, 152, 220, 331, 217, 156, 130, 332, 232, 204, 120, 227, 327, 129, 132, 317, 215, 207, 117, 128},
-		'\u8d45': []uint16{215, 454},
-		'\u8d46': []uint16{413},
-		'\u8d48': []uint16{152, 156, 220, 125},
-		'\u8d49': []uint16{413, 327, 227, 229, 330},
-		'\u8d4a': []uint16{452, 152, 125},
-		'\u8d4b': []uint16{452, 152, 125, 225, 227, 425, 320, 220, 327, 252, 315, 232},
-		'\u8d4c': []uint16{452, 152, 125, 252, 220, 115, 256, 156, 317},
-		'\u8d4d': []uint16{413},
-		'\u8d4e': []uint16{152, 156, 452, 125, 315},
-		'\u8d4f': []uint16{252, 452, 152, 125, 100, 225, 320, 115, 325, 227, 215, 327, 256, 220, 156, 315, 332},
-		'\u8d50': []uint16{452, 225, 252, 327, 152, 227, 256, 156, 315, 320, 325},
-		'\u8d53': []uint16{227, 427, 327},
-		'\u8d54': []uint16{152, 452, 252, 125, 120, 256, 332, 325, 115, 227, 156},
-		'\u8d55': []uint16{413},
-		'\u8d56': []uint16{252, 452, 225, 127, 325, 152, 352, 327, 120, 125, 227, 129, 215, 200, 331, 329, 315, 220, 230, 330, 229, 320},
-		'\u8d58': []uint16{203, 402, 252, 125, 152, 320, 227},
-		'\u8d59': []uint16{454, 152},
-		'\u8d5a': []uint16{452, 152, 252, 125, 227, 320, 220},
-		'\u8d5b': []uint16{256, 225, 452, 125, 132, 327, 227, 127, 252, 230, 152, 456, 325, 332, 143, 232, 330, 329, 129, 217, 115, 130, 156, 315, 320, 229, 120},
-		'\u8d5c': []uint16{413, 227, 327},
-		'\u8d5d': []uint16{405, 125},
-		'\u8d5e': []uint16{152, 252, 452, 227, 125, 327, 130, 225, 417, 156, 129, 115, 454, 228, 220, 120, 229, 215, 332, 132, 131, 329},
-		'\u8d60': []uint16{252, 152, 452, 125, 256, 325, 227, 327, 156},
-		'\u8d61': []uint16{454, 152, 125, 156, 225},
-		'\u8d62': []uint16{452, 152, 125, 252, 225, 227, 327, 132, 325},
-		'\u8d63': []uint16{417, 130, 217, 332, 132, 327, 227, 125, 112, 427},
-		'\u8d64': []uint16{125, 454, 130, 227, 162, 402, 115, 100, 225, 327, 152, 215, 132, 127, 104, 129, 332, 229, 315, 120, 331, 325, 330, 362, 320, 262},
-		'\u8d66': []uint16{152, 252, 215, 315, 125, 325, 256, 220, 156},
-		'\u8d67': []uint16{413, 327, 200, 152, 227, 329},
-		'\u8d6b': []uint16{127, 227, 327, 162, 427, 200, 435, 129, 235, 130, 135, 229, 315, 262, 330, 115, 325, 332, 132, 232, 329, 220, 131, 120, 362, 230, 331},
-		'\u8d6d': []uint16{125, 104},
-		'\u8d70': []uint16{452, 152, 125, 252, 315, 120, 325, 156, 115, 320, 220, 256, 215, 352, 100, 225, 227, 330, 200, 304, 217, 243, 104, 327},
-		'\u8d73': []uint16{459, 262, 362},
-		'\u8d74': []uint16{452, 252, 152, 215, 315, 115, 327, 320, 227},
-		'\u8d75': []uint16{127, 427, 227, 327, 128, 243, 130, 215, 230, 125, 129},
-		'\u8d76': []uint16{107, 152, 452, 252, 315, 115, 220, 325, 230, 143},
-		'\u8d77': []uint16{152, 452, 252, 242, 125, 222, 320, 107, 156, 315, 352, 215, 422, 327, 325, 200, 332, 256, 220, 227, 230, 100, 115, 132, 104, 300, 225, 228, 207, 120, 145, 331, 306, 129, 143, 204, 328},
-		'\u8d81': []uint16{452, 107, 134, 434, 115, 227, 120, 327},
-		'\u8d84': []uint16{252, 452, 352},
-		'\u8d85': []uint16{152, 452, 125, 227, 104, 327, 100, 200, 252, 114, 132, 142, 120, 117, 107, 156, 115, 217, 228, 130, 330, 162, 153, 131, 101, 256, 127, 315},
-		'\u8d8a': []uint16{407, 107, 207, 252, 130, 200, 227, 152, 127, 125, 325, 327, 156, 230, 225, 132, 232, 304, 315, 117, 215, 131, 120, 115, 320, 262, 217, 228, 129, 330, 343},
-		'\u8d8b': []uint16{125, 152, 452, 207, 215, 115, 320, 227, 156, 325},
-		'\u8d91': []uint16{152},
-		'\u8d94': []uint16{152, 352},
-		'\u8d9f': []uint16{222, 435, 422, 252, 125, 120, 352},
-		'\u8da3': []uint16{225, 200, 125, 426, 252, 315, 220, 215, 352, 320, 325, 300, 227, 327, 402},
-		'\u8db1': []uint16{413, 227},
-		'\u8db3': []uint16{200, 252, 400, 152, 325, 125, 315, 107, 215, 262, 225, 352, 207, 138, 320, 117, 112, 331, 115, 227, 327, 330, 230, 132, 220, 256, 317, 217, 204, 231, 153, 129},
-		'\u8db4': []uint16{152, 452},
-		'\u8db5': []uint16{125, 413},
-		'\u8db8': []uint16{454, 125, 107},
-		'\u8dba': []uint16{413, 152, 252},
-		'\u8dbc': []uint16{413, 327},
-		'\u8dbe': []uint16{225, 325, 115, 125},
-		'\u8dbf': []uint16{452, 152},
-		'\u8dc3': []uint16{252, 152, 454, 256, 201, 327, 227, 320, 115, 315, 332, 325, 304, 220, 125, 130, 120, 215, 225, 156, 228, 200},
-		'\u8dc4': []uint16{252, 262, 362, 459},
-		'\u8dc6': []uint16{125},
-		'\u8dcb': []uint16{227, 426, 152, 100, 315, 225, 115, 125, 156, 252},
-		'\u8dcc': []uint16{252, 452, 125, 152, 317, 362, 162, 320, 120, 325, 156, 100},
-		'\u8dce': []uint16{459, 252, 315},
-		'\u8dcf': []uint16{459, 152},
-		'\u8dd1': []uint16{452, 252, 152, 125, 325, 225, 120, 230, 320, 256, 130, 215, 220, 132, 352, 315, 156, 330, 332, 331},
-		'\u8dd6': []uint16{413, 325, 125, 227},
-		'\u8dd7': []uint16{125},
-		'\u8dda': []uint16{252, 459, 220, 227, 256},
-		'\u8ddb': []uint16{125, 152, 400},
-		'\u8ddd': []uint16{125, 434, 225, 252, 325, 332, 307, 232, 320, 256},
-		'\u8dde': []uint16{459, 227},
-		'\u8ddf': []uint16{434, 152, 452, 112, 225, 125, 252, 307, 325, 352, 320, 327, 132, 156, 227, 107},
-		'\u8de3': []uint16{413, 315},
-		'\u8de4': []uint16{426, 252, 256},
-		'\u8de8': []uint16{452, 152, 125, 156, 104, 252, 225, 120, 217},
-		'\u8dea': []uint16{452, 152, 252, 125, 156},
-		'\u8deb': []uint16{459, 227},
-		'\u8dec': []uint16{413, 320, 125},
-		'\u8def': []uint16{225, 425, 125, 222, 142, 252, 230, 325, 232, 152, 320, 127, 227, 130, 238, 220, 330, 422, 215, 129, 327, 229, 132, 315, 212, 331, 322, 256, 332, 231, 335, 317, 204, 115, 329, 342, 120, 200, 228, 224, 112, 217},
-		'\u8df3': []uint16{452, 152, 252, 156, 125, 215, 220, 315, 227, 325, 330, 225, 256, 104, 352, 331, 320, 162, 362, 327, 115, 132, 332},
-		'\u8df5': []uint16{252, 152, 454, 229, 325, 327, 227, 220, 156},
-		'\u8df7': []uint16{200, 452, 125, 325, 100, 225, 252, 227},
-		'\u8df8': []uint16{252, 413},
-		'\u8df9': []uint16{459, 252},
-		'\u8dfa': []uint16{452, 152, 352},
-		'\u8dfb': []uint16{152},
-		'\u8dfd': []uint16{413},
-		'\u8e05': []uint16{452, 227},
-		'\u8e09': []uint16{152, 162, 362},
-		'\u8e0a': []uint16{101},
-		'\u8e0c': []uint16{152, 115, 120},
-		'\u8e0f': []uint16{452, 152, 252, 100, 125, 307, 315, 325, 232, 362, 162, 156, 115, 327, 120, 256, 304, 130},
-		'\u8e14': []uint16{413, 115},
-		'\u8e1d': []uint16{225, 125, 325},
-		'\u8e1e': []uint16{252, 454, 215, 315},
-		'\u8e1f': []uint16{152},
-		'\u8e22': []uint16{452, 152, 215, 125, 120, 325},
-		'\u8e23': []uint16{413},
-		'\u8e29': []uint16{452, 152, 125},
-		'\u8e2a': []uint16{252, 125, 426, 225, 215, 315, 204, 325, 332, 256, 320, 232},
-		'\u8e2c': []uint16{454},
-		'\u8e2e': []uint16{452, 152},
-		'\u8e2f': []uint16{152, 325},
-		'\u8e31': []uint16{452, 152},
-		'\u8e35': []uint16{315, 413, 252, 215, 115, 225},
-		'\u8e39': []uint16{452},
-		'\u8e3a': []uint16{125},
-		'\u8e3d': []uint16{162, 262, 115, 315},
-		'\u8e40': []uint16{152, 413},
-		'\u8e41': []uint16{152, 413},
-		'\u8e42': []uint16{152, 156},
-		'\u8e44': []uint16{325, 225, 125, 426, 215, 204, 332, 220, 227, 330, 132},
-		'\u8e47': []uint16{427, 215, 227, 402, 252},
-		'\u8e48': []uint16{225, 325, 252, 315, 454, 215, 331, 152, 343, 227},
-		'\u8e49': []uint16{152, 115},
-		'\u8e4a': []uint16{100, 320, 200, 315, 125},
-		'\u8e4b': []uint16{252, 452, 256},
-		'\u8e51': []uint16{162, 362, 452, 152, 115},
-		'\u8e52': []uint16{152, 320, 156},
-		'\u8e59': []uint16{454, 152, 315},
-		'\u8e60': []uint16{225},
-		'\u8e66': []uint16{452, 152, 320, 352, 315, 362, 262, 125, 325, 227},
-		'\u8e69': []uint16{100},
-		'\u8e6c': []uint16{452, 225, 152, 200, 125, 327, 252, 325},
-		'\u8e6d': []uint16{452, 252, 262, 362, 100},
-		'\u8e6f': []uint16{413},
-		'\u8e70': []uint16{252, 459},
-		'\u8e72': []uint16{452, 152, 125, 120},
-		'\u8e74': []uint16{315, 413, 125},
-		'\u8e76': []uint16{315, 413, 352, 215},
-		'\u8e7c': []uint16{425, 152, 225, 125},
-		'\u8e7f': []uint16{452, 152, 320},
-		'\u8e81': []uint16{200, 452, 152, 100, 215, 125, 220, 156, 362, 262, 327},
-		'\u8e85': []uint16{252, 459, 225},
-		'\u8e87': []uint16{252, 315, 320, 459},
-		'\u8e8f': []uint16{252, 256, 459},
-		'\u8e90': []uint16{413},
-		'\u8e94': []uint16{413},
-		'\u8e9c': []uint16{413},
-		'\u8e9e': []uint16{252},
-		'\u8eab': []uint16{125, 252, 142, 225, 238, 426, 152, 112, 315, 222, 207, 115, 256, 325, 320, 215, 200, 204, 120, 227, 220, 327, 232, 307, 422, 332, 228, 352, 201},
-		'\u8eac': []uint16{152, 426, 454, 252, 315, 227, 120, 325, 115},
-		'\u8eaf': []uint16{125, 225, 426, 220, 252, 215},
-		'\u8eb2': []uint16{452, 152, 320, 120, 252, 315, 352, 227, 215, 220},
-		'\u8eba': []uint16{452, 152, 252, 125, 227, 320, 325},
-		'\u8f66': []uint16{225, 125, 425, 252, 325, 142, 127, 238, 331, 222, 117, 230, 130, 220, 152, 315, 204, 231, 256, 217, 330, 104, 232, 132, 422, 227, 115, 215, 327, 332, 120, 320, 229},
-		'\u8f67': []uint16{252, 452, 152, 125, 325, 256, 332, 327, 217, 132, 227},
-		'\u8f68': []uint16{125, 426, 225, 252, 204, 256, 325, 227, 132, 320, 215, 200},
-		'\u8f69': []uint16{227, 427, 127, 115, 125, 100, 327, 426, 315, 130, 232, 132},
-		'\u8f6b': []uint16{413},
-		'\u8f6c': []uint16{152, 452, 252, 125, 156, 256, 325, 106, 315, 107, 227, 115, 143, 215, 200, 120, 320, 327, 220, 307, 225, 117, 304, 352, 217, 356, 332, 132, 317},
-		'\u8f6d': []uint16{413, 325},
-		'\u8f6e': []uint16{435, 125, 225, 222, 152, 325, 238, 107, 104, 422, 232, 156, 217, 331, 320, 130, 332, 231, 227, 315, 204, 304, 327, 228, 132},
-		'\u8f6f': []uint16{125, 400, 200, 100, 152, 120, 162, 117, 252, 225, 262, 115, 215, 132, 331, 104, 227, 217, 320, 327, 315, 325, 352, 201, 203},
-		'\u8f70': []uint16{152, 452, 125, 115, 315, 133, 107, 252, 156, 333, 227, 362, 162, 120, 320},
-		'\u8f71': []uint16{125, 152, 325, 320},
-		'\u8f72': []uint16{459, 227, 327},
-		'\u8f73': []uint16{225, 459},
-		'\u8f74': []uint16{425, 225, 125, 325, 320, 232, 332, 132, 227, 435, 252, 352, 207, 224, 330, 215},
-		'\u8f75': []uint16{413, 130},
-		'\u8f76': []uint16{125},
-		'\u8f78': []uint16{227, 413, 327},
-		'\u8f79': []uint16{413, 227},
-		'\u8f7a': []uint16{413},
-		'\u8f7b': []uint16{400, 107, 200, 100, 125, 207, 252, 325, 162, 362, 152, 104, 315, 115, 117, 215, 156, 320, 352, 227, 327, 120, 130, 332, 217, 330, 232, 132, 220, 101, 331, 304},
-		'\u8f7c': []uint16{225, 227, 413, 228},
-		'\u8f7d': []uint16{252, 452, 125, 152, 204, 222, 256, 104, 225, 320, 315, 156, 325, 227, 215, 327, 422, 228, 115, 304, 132, 332},
-		'\u8f7e': []uint16{227, 459},
-		'\u8f7f': []uint16{125, 426, 417, 225, 325, 320, 220},
-		'\u8f82': []uint16{413, 227, 327},
-		'\u8f83': []uint16{407, 207, 100, 107, 252, 152, 134, 325, 215, 104, 156, 125, 130, 320, 332},
-		'\u8f84': []uint16{207, 409, 315, 252, 413},
-		'\u8f85': []uint16{225, 156, 152, 454, 125, 426, 227, 252, 327, 132, 315, 332, 320, 325, 230, 217},
-		'\u8f86': []uint16{222, 225, 422, 435, 224, 325, 331, 122, 232, 322},
-		'\u8f87': []uint16{413, 225, 332, 227},
-		'\u8f88': []uint16{322, 225, 425, 125, 238, 222, 152, 320, 135, 220, 215, 343, 325, 315, 122, 422},
-		'\u8f89': []uint16{227, 100, 427, 225, 125, 315, 327, 252, 426, 130, 152, 325, 215, 230, 332, 132, 232, 131, 330, 129, 220},
-		'\u8f8a': []uint16{225, 232, 125},
-		'\u8f8b': []uint16{225, 413},
-		'\u8f8d': []uint16{454, 152, 252, 220},
-		'\u8f8e': []uint16{125},
-		'\u8f8f': []uint16{426, 252},
-		'\u8f90': []uint16{152, 125, 325, 426, 332, 104, 225, 142, 132, 232, 227, 320},
-		'\u8f91': []uint16{225, 435, 325, 152, 220, 256, 227, 422, 320, 454, 222, 125, 327, 252, 156},
-		'\u8f93': []uint16{256, 152, 452, 325, 125, 156, 252, 120, 130, 331, 217, 215, 327, 132, 220, 227, 332, 117},
-		'\u8f94': []uint16{125, 252, 315},
-		'\u8f95': []uint16{225, 125, 327, 315, 330, 252, 227},
-		'\u8f96': []uint16{452, 252, 325, 125, 225, 152, 142, 256},
-		'\u8f97': []uint16{152, 115, 120},
-		'\u8f98': []uint16{225, 125, 320, 220, 252},
-		'\u8f99': []uint16{225, 215, 425, 227, 252, 200, 332, 220, 228, 327},
-		'\u8f9a': []uint16{459, 327, 227},
-		'\u8f9b': []uint16{152, 127, 100, 132, 427, 227, 200, 122, 330, 315, 162, 362, 327, 130, 229, 125, 225, 331, 129, 215, 325, 329, 230, 320, 120, 203, 131, 128, 332},
-		'\u8f9c': []uint16{152, 225, 127, 427, 220, 215, 227, 325},
-		'\u8f9e': []uint16{152, 452, 252, 225, 125, 215, 315, 227, 325, 320, 256, 232, 253, 220, 120, 327, 331, 115},
-		'\u8f9f': []uint16{252, 452, 127, 152, 200, 315, 320, 327, 256, 215, 125, 227},
-		'\u8fa3': []uint16{125, 452, 200, 262, 362, 400, 215, 327, 325, 220, 332, 204, 320, 227, 100, 162, 225},
-		'\u8fa8': []uint16{152, 454, 252, 100, 325, 256, 227, 156, 220, 315, 125},
-		'\u8fa9': []uint16{152, 125, 252, 454, 156, 325, 227, 120, 256, 253, 225, 220, 200, 215, 320, 115, 100},
-		'\u8fab': []uint16{125, 426, 225, 325, 352, 320, 204},
-		'\u8fb0': []uint16{225, 426, 227, 327, 232, 125, 222, 315, 132, 129, 215, 423, 230, 130, 322, 143, 343, 320, 331},
-		'\u8fb1': []uint16{252, 225, 426, 152, 315, 454, 325, 215, 256, 227, 220, 156, 332},
-		'\u8fb9': []uint16{407, 212, 242, 207, 125, 225, 238, 142, 127, 230, 262, 112, 204, 325, 330, 227, 252, 104, 315, 352, 320, 152, 327, 229, 220, 228, 117, 215, 134, 332, 217, 317, 128, 331, 312, 232, 300, 256, 132},
-		'\u8fbd': []uint16{130, 417, 100, 230, 327, 227, 143, 117, 132, 225, 131, 129, 142, 330, 315, 125, 229, 217},
-		'\u8fbe': []uint16{452, 152, 252, 200, 227, 225, 320, 232, 330, 230, 129, 127, 327, 332, 229, 130, 125, 325, 215, 228, 329, 315, 231, 132, 220, 115, 331, 256, 328, 100, 120, 131, 101},
-		'\u8fc1': []uint16{452, 152, 252, 256, 227, 125, 325, 156, 327, 215, 230, 130, 228, 330, 320, 315},
-		'\u8fc2': []uint16{152, 100, 400, 115, 156, 125, 327, 227},
-		'\u8fc4': []uint16{107, 120, 452, 454, 227},
-		'\u8fc5': []uint16{101, 227, 100, 402, 327, 107, 115, 162, 125, 132, 331, 330, 332},
-		'\u8fc7': []uint16{447, 234, 252, 125, 152, 206, 143, 107, 207, 101, 320, 100, 257, 156, 200, 120, 220, 325, 315, 225, 127, 307, 132, 115, 230, 352, 327, 130, 306, 215, 212, 135, 300, 227, 104, 332, 229, 342},
-		'\u8fc8': []uint16{152, 452, 129, 200, 327, 227, 262, 329, 130, 230, 330, 132, 228, 225, 232, 320},
-		'\u8fce': []uint16{152, 452, 252, 107, 327, 156, 115, 127, 227, 120, 125, 220, 215, 320, 256, 325, 130, 315, 117},
-		'\u8fd0': []uint16{125, 156, 152, 225, 252, 452, 332, 325, 320, 256, 317, 200, 217, 330, 120, 227, 327, 315, 130, 352, 132, 232, 115, 331, 117, 220, 231, 215, 131},
-		'\u8fd1': []uint16{400, 143, 252, 212, 243, 120, 125, 207, 152, 142, 107, 100, 200, 225, 112, 104, 315, 117, 115, 327, 320, 325, 129, 132, 331, 328, 156, 228, 304, 256, 227, 220, 130},
-		'\u8fd3': []uint16{413},
-		'\u8fd4': []uint16{152, 452, 252, 220, 125, 215, 320, 115, 225, 315, 227, 156, 104, 325},
-		'\u8fd5': []uint16{413},
-		'\u8fd8': []uint16{407, 152, 106, 252, 315, 125, 320, 156, 132, 120, 327, 227, 215, 325, 127, 130, 220},
-		'\u8fd9': []uint16{138, 438, 124, 141, 120, 107, 106, 320, 125, 132, 115, 143},
-		'\u8fdb': []uint16{152, 252, 452, 156, 107, 125, 200, 106, 325, 227, 327, 320, 228, 220, 215, 256, 331, 232, 217, 352, 115, 225, 315, 230, 332, 330, 117, 317, 131, 132, 127, 204, 328, 130, 229, 120},
-		'\u8fdc': []uint16{400, 207, 200, 152, 107, 125, 142, 227, 104, 325, 130, 162, 362, 327, 342, 315, 230, 143, 232, 252, 228, 229, 204, 262, 115, 220, 215, 330, 127, 120, 225, 331, 320, 100, 131, 304, 132, 203, 332, 212, 217, 129, 256, 156, 117},
-		'\u8fdd': []uint16{156, 152, 454, 252, 125, 120, 215, 115, 104, 227, 117, 217, 315, 327},
-		'\u8fde': []uint16{445, 100, 107, 152, 125, 252, 207, 127, 230, 330, 156, 134, 120, 327, 325, 225, 227, 130, 315, 104, 162, 128, 320, 256, 331, 115, 215, 129, 332, 132, 217, 329, 317, 220, 362, 231, 232, 229},
-		'\u8fdf': []uint16{400, 100, 107, 252, 207, 152, 227, 220, 327, 362, 162, 127, 320, 215, 125, 115, 143, 256, 325, 315, 128},
-		'\u8fe2': []uint16{215, 315, 262, 162, 320, 220},
-		'\u8fe4': []uint16{459, 162, 262, 327},
-		'\u8fe5': []uint16{100, 115, 401, 227, 402, 107, 120, 327},
-		'\u8fe6': []uint16{413, 130, 227, 327, 325, 230, 330, 125, 129, 232, 229},
-		'\u8fe8': []uint16{413, 227},
-		'\u8fe9': []uint16{413, 215, 220, 225, 227, 315},
-		'\u8fea': []uint16{127, 130, 227, 327, 232, 427, 332, 330, 132, 329, 129, 230, 229, 256, 325, 331, 131, 125, 315, 252, 228},
-		'\u8feb': []uint16{152, 207, 252, 454, 200, 100, 115, 125, 256, 325, 215, 156, 104, 201},
-		'\u8fed': []uint16{407, 207, 152, 256, 252, 227, 120, 156, 215, 330, 320, 327, 125, 129, 220, 332, 225},
-		'\u8fee': []uint16{427},
-		'\u8ff0': []uint16{204, 252, 256, 454, 225, 206, 152, 227, 325, 327, 220, 125, 115, 328, 104},
-		'\u8ff7': []uint16{252, 152, 452, 100, 125, 225, 320, 104, 315, 362, 162, 120, 227, 200, 232, 215, 115, 256, 156, 329, 132, 327, 262, 129, 103},
-		'\u8ff8': []uint16{452, 152},
-		'\u8ff9': []uint16{225, 125, 426, 252, 215, 315, 327, 227, 320, 220, 325, 229},
-		'\u8ffd': []uint16{152, 452, 125, 252, 320, 315, 115, 156, 215, 120, 227, 327, 325, 132, 220},
-		'\u9000': []uint16{152, 452, 252, 125, 156, 315, 356, 120, 220, 215, 325, 115, 256, 117, 327, 217, 320, 352, 132, 227},
-		'\u9001': []uint16{452, 152, 252, 325, 125, 315, 256, 227, 156, 320, 120, 225, 115, 132, 327, 232, 332},
-		'\u9002': []uint16{152, 100, 200, 454, 101, 125, 325, 107, 227, 115, 402, 104, 315, 203, 120, 327, 252, 228, 156, 220, 328},
-		'\u9003': []uint16{152, 452, 252, 215, 125, 315, 115, 317, 325, 256, 220, 332, 117, 156, 132},
-		'\u9004': []uint16{127, 427, 431},
-		'\u9005': []uint16{252, 459, 315},
-		'\u9006': []uint16{125, 454, 152, 153, 426, 252, 315, 225, 325, 107, 115, 104, 100, 156, 227, 320, 256, 217, 327, 117, 200, 120, 132},
-		'\u9009': []uint16{152, 252, 452, 125, 325, 225, 256, 320, 156, 227, 204, 217, 327, 132, 120, 232, 115, 215, 332, 228, 331},
-		'\u900a': []uint16{227, 454, 200, 232, 229, 100, 125, 327, 152, 304, 215, 230, 320, 132, 330, 329, 225, 130, 331},
-		'\u900b': []uint16{413, 327, 229, 227},
-		'\u900d': []uint16{100, 115, 125, 101, 130, 327},
-		'\u900f': []uint16{152, 452, 100, 252, 125, 325, 232, 200, 215, 162, 120, 217, 320, 104, 156, 256, 332, 132, 220, 327, 225, 315, 362, 129, 262},
-		'\u9010': []uint16{107, 252, 452, 325, 152, 315, 454, 320, 125, 115, 256, 120, 332},
-		'\u9011': []uint16{413, 215, 227},
-		'\u9012': []uint16{252, 152, 452, 256, 156, 325, 227, 231, 125, 220, 120, 130, 230},
-		'\u9014': []uint16{225, 125, 142, 207, 204, 426, 315, 152, 320, 325, 242, 227, 215, 217, 252, 342},
-		'\u9016': []uint16{227, 413, 327},
-		'\u9017': []uint16{152, 452, 252, 125, 100, 227, 327, 256, 325},
-		'\u901a': []uint16{134, 125, 225, 152, 452, 256, 107, 252, 117, 200, 156, 325, 232, 227, 120, 331, 127, 130, 100, 162, 230, 320, 222, 315, 233, 231, 215, 220, 115, 131, 327, 330, 132, 362, 262, 129, 217, 207, 422, 332, 229, 317, 104, 304, 228},
-		'\u901b': []uint16{452, 252, 152, 215},
-		'\u901d': []uint16{152, 252, 454, 215, 256, 125, 220, 120},
-		'\u901e': []uint16{454, 152, 252, 120, 315, 327, 227, 115, 215},
-		'\u901f': []uint16{207, 125, 225, 201, 252, 325, 426, 262, 232, 152, 200, 315, 332, 256, 204, 227, 327, 104, 156, 320, 115, 215, 330, 231, 132, 352, 331},
-		'\u9020': []uint16{252, 152, 325, 125, 452, 256, 225, 156, 204, 320, 120, 227, 315, 220, 331, 230, 327, 115, 215, 332, 304, 100},
-		'\u9021': []uint16{413, 152, 327},
-		'\u9022': []uint16{452, 252, 315, 215, 115, 327, 120, 227, 152, 256, 320},
-		'\u9026': []uint16{262, 459, 227},
-		'\u902d': []uint16{454},
-		'\u902e': []uint16{152, 452, 125},
-		'\u902f': []uint16{427, 127},
-		'\u9035': []uint16{227, 413},
-		'\u9036': []uint16{162},
-		'\u9038': []uint16{454, 227, 252, 315, 327, 200, 402, 125, 215, 262, 204, 152, 115, 232, 127, 217},
-		'\u903b': []uint16{125, 252, 325, 320, 332, 256, 120},
-		'\u903c': []uint16{452, 152, 252, 315, 100, 115, 162, 156, 320, 327, 120, 256},
-		'\u903e': []uint16{454, 152, 315, 320, 227, 143},
-		'\u9041': []uint16{454, 252, 152, 115, 227, 125},
-		'\u9042': []uint16{407, 227, 452, 152, 130, 327, 252, 125, 220, 200, 315, 325, 129, 225, 115, 215, 320, 328},
-		'\u9044': []uint16{413, 315},
-		'\u9047': []uint16{152, 225, 452, 252, 227, 215, 220, 327, 125, 320, 325, 315, 115, 330, 256, 127, 156},
-		'\u904d': []uint16{201, 222, 152, 435, 252, 200, 325, 315, 125, 115, 356, 320, 422, 212, 220, 352},
-		'\u904f': []uint16{152, 454, 215, 252, 327, 315, 115},
-		'\u9050': []uint16{152, 315, 320, 156, 402, 125, 115, 227, 327},
-		'\u9051': []uint16{402, 125},
-		'\u9052': []uint16{100, 220},
-		'\u9053': []uint16{435, 252, 225, 125, 207, 222, 325, 327, 227, 215, 132, 220, 117, 230, 200, 152, 127, 320, 315, 142, 330, 130, 232, 331, 422, 224, 324, 115, 143, 129, 328, 229, 300, 307, 242, 317, 120, 131, 332, 362, 228, 262, 352, 203, 217, 304, 342},
-		'\u9057': []uint16{125, 156, 152, 100, 454, 426, 252, 325, 225, 315, 215, 330, 115, 227, 327, 320, 331, 304, 120},
-		'\u9058': []uint16{413, 227},
-		'\u905b': []uint16{452, 152, 252},
-		'\u9062': []uint16{200, 262, 459},
-		'\u9063': []uint16{454, 252, 152, 204, 325, 315, 125, 256, 120, 115},
-		'\u9065': []uint16{100, 152, 125, 402, 426, 156, 200, 120, 115, 315, 320, 162, 262, 227, 230, 325, 220, 201, 330, 327, 307, 107},
-		'\u9068': []uint16{152, 454, 327},
-		'\u906d': []uint16{152, 452, 125, 222, 252, 225, 215, 422, 115},
-		'\u906e': []uint16{152, 452, 330, 115, 125, 352, 220, 120, 132, 315, 320, 156, 130, 327},
-		'\u9074': []uint16{152},
-		'\u9075': []uint16{152, 427, 130, 454, 327, 227, 132, 120, 330, 156},
-		'\u907d': []uint16{200, 107},
-		'\u907f': []uint16{152, 252, 452, 320, 125, 156, 315, 115, 130, 330, 227, 327, 352, 120, 325},
-		'\u9080': []uint16{152, 452, 252, 125, 227, 115, 256, 327},
-		'\u9082': []uint16{152, 115},
-		'\u9083': []uint16{200, 402, 227},
-		'\u9088': []uint16{227, 402, 100, 327},
-		'\u908b': []uint16{100, 162, 362},
-		'\u9091': []uint16{426, 227, 225, 230, 327, 330, 215, 228, 238, 229},
-		'\u9093': []uint16{127, 427, 130, 227, 125, 327, 128, 129},
-		'\u9095': []uint16{227, 417, 130, 327},
-		'\u9097': []uint16{130, 413, 227, 327},
-		'\u9099': []uint16{130, 413, 227},
-		'\u909b': []uint16{413, 130, 227},
-		'\u909d': []uint16{127, 427},
-		'\u90a1': []uint16{413, 230, 330},
-		'\u90a2': []uint16{127, 427, 130, 227, 327, 132},
-		'\u90a3': []uint16{138, 438, 127, 124, 106, 230, 130, 143, 343, 330, 243, 227, 120, 225, 229, 327, 325, 132, 232, 329, 320, 125, 129, 332, 331, 315},
-		'\u90a6': []uint16{225, 227, 426, 327, 231, 230, 330, 331, 325, 232, 125, 362, 262, 129, 329, 215, 343, 315, 130, 252, 132, 229, 332, 320, 228, 127},
-		'\u90aa': []uint16{400, 125, 100, 252, 225, 200, 227, 315, 215, 120, 115, 103},
-		'\u90ac': []uint16{427, 127},
-		'\u90ae': []uint16{125, 452, 230, 117, 152, 325, 256, 331, 332, 156, 320, 225, 317, 330, 131, 252, 217, 227, 132},
-		'\u90af': []uint16{130, 115, 125, 131},
-		'\u90b0': []uint16{427, 127, 327, 227},
-		'\u90b1': []uint16{127, 427, 327, 227, 330, 230, 130},
-		'\u90b3': []uint16{413, 130, 330},
-		'\u90b4': []uint16{127, 427},
-		'\u90b5': []uint16{127, 427, 130, 125, 227, 327, 128, 230, 330},
-		'\u90b6': []uint16{413},
-		'\u90b8': []uint16{427, 225, 227, 127, 125, 230},
-		'\u90b9': []uint16{127, 427, 130, 128, 227, 327, 431},
-		'\u90ba': []uint16{413, 227, 327},
-		'\u90bb': []uint16{125, 452, 252, 152, 225, 227, 315, 132, 320, 327, 325, 220, 215, 256, 156, 130},
-		'\u90be': []uint16{413},
-		'\u90c1': []uint16{200, 127, 100, 125, 262, 427, 152, 315, 115, 325, 227, 162, 327, 225, 252, 362, 128, 320, 332, 130, 132},
-		'\u90c4': []uint16{427, 127},
-		'\u90c5': []uint16{413, 227, 327},
-		'\u90c7': []uint16{427, 127},
-		'\u90ca': []uint16{242, 142, 225, 426, 230, 227, 125, 217, 152, 330, 325, 342, 320, 115, 304},
-		'\u90ce': []uint16{225, 426, 227, 127, 125, 325, 327, 222, 217, 330, 315, 320, 229, 220, 230, 329, 235, 120, 131, 232, 352, 233},
-		'\u90cf': []uint16{427, 130, 127},
-		'\u90d0': []uint16{413},
-		'\u90d1': []uint16{130, 127, 427, 128, 131, 230, 100, 227, 330, 115, 117, 327, 229, 417, 132},
-		'\u90d3': []uint16{130, 413},
-		'\u90d7': []uint16{127, 227, 427},
-		'\u90db': []uint16{413},
-		'\u90dc': []uint16{427, 127, 227},
-		'\u90dd': []uint16{127, 427, 227, 327, 128},
-		'\u90e1': []uint16{425, 227, 125, 130, 327, 225, 230, 329},
-		'\u90e2': []uint16{413, 227, 130, 327},
-		'\u90e6': []uint16{127, 227, 427, 128, 327},
-		'\u90e7': []uint16{130, 413, 125, 227},
-		'\u90e8': []uint16{125, 212, 225, 222, 322, 435, 325, 231, 152, 320, 238, 422, 324, 217, 117, 331, 227, 104, 252, 327, 232, 230, 315, 204, 304, 229, 352, 242, 330, 132, 156, 317},
-		'\u90eb': []uint16{130},
-		'\u90ed': []uint16{127, 427, 225, 227, 330, 327, 130, 128, 315, 325, 230},
-		'\u90ef': []uint16{413, 130, 327},
-		'\u90f4': []uint16{130, 417, 227, 327},
-		'\u90f8': []uint16{230, 330, 130, 315, 325, 331},
-		'\u90fd': []uint16{407, 230, 125, 225, 207, 252, 330, 127, 130, 325, 327, 227, 232, 331, 152, 229, 332, 132, 156, 129, 256, 315, 104, 128, 329},
-		'\u90fe': []uint16{129, 413, 130, 227},
-		'\u9102': []uint16{130, 127, 417, 227, 217, 117, 132, 327, 332, 317, 125, 131, 232, 330, 331},
-		'\u9104': []uint16{413, 130, 227},
-		'\u9119': []uint16{152, 402, 200, 138, 100, 320, 125, 156, 227, 315, 115},
-		'\u911e': []uint16{413, 130},
-		'\u9122': []uint16{427, 127, 125, 227, 130},
-		'\u9123': []uint16{413, 130},
-		'\u912f': []uint16{130, 413},
-		'\u9131': []uint16{130, 330},
-		'\u9139': []uint16{413},
-		'\u9143': []uint16{413, 227},
-		'\u9146': []uint16{427, 127, 227},
-		'\u9149': []uint16{222, 130, 322, 230, 220, 227, 143},
-		'\u914a': []uint16{315, 426, 125, 252, 225},
-		'\u914b': []uint16{125, 230, 426, 330, 225, 331, 227},
-		'\u914c': []uint16{252, 454, 107, 215, 152, 227, 120},
-		'\u914d': []uint16{152, 256, 252, 452, 156, 125, 100, 325, 200, 132, 220, 217, 227, 225, 232, 327, 201, 315, 317, 115, 320, 330},
-		'\u914e': []uint16{413},
-		'\u9150': []uint16{225, 232, 413, 325},
-		'\u9152': []uint16{425, 125, 225, 252, 256, 325, 130, 143, 315, 227, 115, 331, 152, 231, 232, 132, 215, 327, 220, 120, 320, 352, 104, 330, 131, 332, 129},
-		'\u9157': []uint16{152},
-		'\u915a': []uint16{225, 425, 232, 125, 132, 325, 120, 332},
-		'\u915d': []uint16{152, 143},
-		'\u915e': []uint16{132, 413, 225},
-		'\u9161': []uint16{454},
-		'\u9162': []uint16{413, 252},
-		'\u9163': []uint16{402, 152, 100, 262, 115, 107, 315, 125, 227, 327},
-		'\u9164': []uint16{413},
-		'\u9165': []uint16{400, 125, 100, 225, 152, 362, 204, 325, 262, 227, 162, 215, 327},
-		'\u9169': []uint16{115, 152},
-		'\u916a': []uint16{225, 132, 125, 325},
-		'\u916c': []uint16{225, 426, 252, 152, 125, 454, 220, 325, 215, 332, 156, 320, 352},
-		'\u916e': []uint16{413, 225, 232, 325, 125, 332},
-		'\u916f': []uint16{426, 225, 232, 125, 325, 220, 332, 132},
-		'\u9170': []uint16{125, 325, 459, 332, 225, 320, 232},
-		'\u9171': []uint16{425, 225, 125, 452, 325, 315, 320, 215, 232, 104, 132},
-		'\u9172': []uint16{413},
-		'\u9174': []uint16{413},
-		'\u9175': []uint16{252, 125, 325, 256, 152, 331, 132},
-		'\u9176': []uint16{425, 225, 220, 325, 125, 232, 227},
-		'\u9177': []uint16{200, 152, 407, 125, 100, 325, 320, 227, 120, 143},
-		'\u9178': []uint16{225, 325, 425, 125, 332, 232, 200, 100, 162, 252, 132, 203, 356, 152, 362, 115, 400, 220, 320, 315, 103, 256, 104},
-		'\u9179': []uint16{454},
-		'\u917d': []uint16{400},
-		'\u917e': []uint16{454},
-		'\u917f': []uint16{156, 252, 152, 452, 125, 225, 343, 227},
-		'\u9185': []uint16{413},
-		'\u9187': []uint16{225, 402, 232, 100, 227, 332, 200, 125, 132, 325, 229, 115, 327, 162, 315},
-		'\u9189': []uint16{452, 227, 252, 327, 125, 152, 127, 325, 256, 315, 215, 115, 228, 162, 220, 130, 320, 232, 132},
-		'\u918b': []uint16{425, 125, 225, 215, 252, 232, 325, 132, 320, 152, 332},
-		'\u918c': []uint16{225, 413, 232},
-		'\u918d': []uint16{125, 115, 459},
-		'\u9190': []uint16{225, 315},
-		'\u9191': []uint16{413},
-		'\u9192': []uint16{252, 452, 200, 152, 100, 220, 227, 215, 256, 327, 125, 320, 325, 120},
-		'\u919a': []uint16{225, 425, 232, 227},
-		'\u919b': []uint16{225, 425, 232, 325, 125, 320, 332},
-		'\u91a2': []uint16{413},
-		'\u91aa': []uint16{125, 262},
-		'\u91ad': []uint16{413},
-		'\u91ae': []uint16{413, 252, 327},
-		'\u91af': []uint16{413},
-		'\u91b4': []uint16{130, 125},
-		'\u91b5': []uint16{413},
-		'\u91ba': []uint16{413, 262, 362, 252},
-		'\u91c7': []uint16{152, 156, 452, 252, 125, 225, 127, 315, 215, 327, 325, 227, 132, 229, 130, 217, 200, 129, 332, 256, 220, 320, 117},
-		'\u91c9': []uint16{425, 125, 225, 227, 325, 132},
-		'\u91ca': []uint16{252, 152, 426, 225, 127, 125, 325, 315, 454, 227, 156, 256, 320, 162, 327, 132, 317, 215},
-		'\u91cc': []uint16{412, 238, 242, 222, 235, 225, 112, 330, 230, 327, 229, 125, 329, 243, 325, 227, 130, 129, 207, 127, 315, 212, 215, 362, 320, 220, 422, 332, 115, 331, 132, 232, 228, 333, 135, 104, 120, 322, 122, 131, 107, 335},
-		'\u91cd': []uint16{100, 125, 200, 152, 400, 252, 107, 156, 225, 130, 325, 222, 204, 104, 315, 327, 320, 162, 215, 143, 362, 220, 262, 115, 217, 120, 227, 132, 332, 207, 117, 131, 331, 317, 256, 201, 422, 129, 304, 135, 235, 328, 228, 330},
-		'\u91ce': []uint16{125, 225, 104, 404, 100, 400, 252, 142, 227, 230, 325, 256, 215, 132, 331, 327, 200, 315, 115, 229, 156, 129, 232, 220, 320, 330, 217, 152, 127, 332, 120, 103, 130, 228},
-		'\u91cf': []uint16{225, 425, 222, 252, 256, 207, 125, 325, 152, 220, 200, 204, 227, 120, 322, 304, 201, 320, 352, 217, 115, 215, 232, 315, 332, 331, 327, 330, 156, 218, 253},
-		'\u91d1': []uint16{225, 125, 404, 127, 325, 426, 327, 132, 130, 227, 331, 252, 128, 332, 232, 330, 115, 220, 230, 129, 320, 315, 162, 217, 120, 143, 229, 243, 104, 304, 343, 204, 215, 329, 222, 117, 131, 100, 231, 228, 356, 256, 235, 328},
-		'\u91dc': []uint16{315, 130, 115, 225},
-		'\u9274': []uint16{134, 156, 252, 152, 225, 427, 227, 125, 215, 426, 315, 327, 129, 130, 325, 352, 454, 256, 132, 115, 232, 331, 320},
-		'\u928e': []uint16{413, 227, 327},
-		'\u92ae': []uint16{413, 325, 125, 330, 227, 327, 252, 204},
-		'\u92c8': []uint16{413, 227},
-		'\u933e': []uint16{452, 227, 125},
-		'\u936a': []uint16{459, 225},
-		'\u938f': []uint16{413, 104, 227, 327},
-		'\u93ca': []uint16{125},
-		'\u93d6': []uint16{152},
-		'\u943e': []uint16{413, 327, 227},
-		'\u946b': []uint16{227, 427, 327, 332, 132, 231, 232},
-		'\u9486': []uint16{413},
-		'\u9487': []uint16{413, 332, 227},
-		'\u9488': []uint16{134, 225, 125, 425, 152, 315, 115, 104, 252, 217, 325, 227, 215, 120, 204, 229, 232, 352, 327, 207, 132, 320, 220, 156},
-		'\u9489': []uint16{452, 125, 225, 315, 120, 227, 215, 220, 320, 232, 325, 352, 327, 152},
-		'\u948a': []uint16{227, 413, 327},
-		'\u948b': []uint16{426},
-		'\u948d': []uint16{426, 227},
-		'\u948e': []uint16{225, 426, 125, 227},
-		'\u948f': []uint16{327, 427, 227},
-		'\u9490': []uint16{413},
-		'\u9492': []uint16{425, 225, 332, 227, 125},
-		'\u9493': []uint16{152, 452, 130, 125, 252, 315, 320, 227, 120, 330, 225, 115},
-		'\u9494': []uint16{413},
-		'\u9495': []uint16{413, 125, 227, 327},
-		'\u9497': []uint16{227, 229, 327, 426, 325, 225, 215, 125, 315},
-		'\u9499': []uint16{425, 225, 125, 325, 152, 232, 132, 156, 227},
-		'\u949a': []uint16{425},
-		'\u949b': []uint16{425, 132, 225, 125, 325, 232, 327, 227},
-		'\u949c': []uint16{413, 327, 227},
-		'\u949d': []uint16{400, 200, 152, 215, 125, 100},
-		'\u949e': []uint16{426, 125, 225, 227, 325, 327, 256, 331},
-		'\u949f': []uint16{222, 425, 125, 225, 127, 235, 327, 227, 152, 130, 224, 220, 315, 215, 330, 325, 252, 115, 232, 328, 320, 128},
-		'\u94a0': []uint16{425, 225, 232, 132, 125, 227, 332, 327},
-		'\u94a1': []uint16{425, 125, 225, 227, 232, 327, 325},
-		'\u94a2': []uint16{125, 225, 425, 325, 331, 227, 332, 252, 217, 327, 232, 132, 104, 231, 215, 320, 256, 120, 129, 228, 152, 330, 452, 117, 131},
-		'\u94a3': []uint16{413, 125},
-		'\u94a4': []uint16{454, 227, 125, 327},
-		'\u94a5': []uint16{125, 426, 225, 227, 325, 215, 327},
-		'\u94a6': []uint16{227, 427, 327, 417, 152, 125, 115, 130, 426, 454, 230, 129, 320, 330, 229},
-		'\u94a7': []uint16{227, 435, 327, 125, 315, 215, 115, 320},
-		'\u94a8': []uint16{425, 125, 325, 225, 232, 132, 332},
-		'\u94a9': []uint16{425, 252, 225, 125, 452, 232, 152, 227, 325, 115, 215, 220, 320},
-		'\u94aa': []uint16{413, 327},
-		'\u94ab': []uint16{413, 227, 327},
-		'\u94ac': []uint16{426},
-		'\u94ad': []uint16{427, 127, 327, 227},
-		'\u94ae': []uint16{225, 127, 427, 125, 227, 327, 304},
-		'\u94af': []uint16{413, 225, 227, 327, 232},
-		'\u94b0': []uint16{227, 413, 327},
-		'\u94b1': []uint16{425, 127, 252, 225, 125, 200, 130, 325, 235, 315, 129, 227, 128, 327, 132, 220, 232, 330, 320, 256, 229, 332, 352, 215},
-		'\u94b2': []uint16{413, 227},
-		'\u94b3': []uint16{452, 125, 152, 225, 227, 325, 115, 232},
-		'\u94b4': []uint16{426, 225, 325, 125, 132, 232},
-		'\u94b5': []uint16{426, 125, 225, 327, 227, 230, 315},
-		'\u94b7': []uint16{413},
-		'\u94b9': []uint16{425, 225, 227},
-		'\u94ba': []uint16{426, 227, 225, 327, 215},
-		'\u94bb': []uint16{452, 152, 125, 200, 225, 227, 117, 332, 315, 156, 327, 115, 220, 252, 325, 232, 132},
-		'\u94bc': []uint16{426, 125, 232, 325, 227, 132, 327},
-		'\u94bd': []uint16{426, 125, 332},
-		'\u94be': []uint16{425, 125, 225, 232, 132, 332, 227, 325},
-		'\u94bf': []uint16{413, 227, 225, 327},
-		'\u94c0': []uint16{425, 125, 225, 325, 327, 227},
-		'\u94c1': []uint16{125, 225, 425, 332, 325, 127, 327, 331, 227, 252, 215, 130, 217, 162, 131, 115, 315, 320, 232, 330, 152, 132, 229, 452, 107, 104, 120, 220, 129, 256, 352, 230, 317, 328, 117, 128},
-		'\u94c2': []uint16{425, 125, 227, 327},
-		'\u94c3': []uint16{325, 225, 426, 125, 127, 227, 232, 327, 215, 320, 315, 132, 331, 304, 252, 220},
-		'\u94c4': []uint16{200, 315},
-		'\u94c5': []uint16{425, 125, 152, 130, 232, 325, 225, 327, 227, 204, 132, 320, 332, 352},
-		'\u94c6': []uint16{125, 152, 426, 454},
-		'\u94c8': []uint16{425, 325, 232},
-		'\u94c9': []uint16{227, 413, 327},
-		'\u94ca': []uint16{413},
-		'\u94cb': []uint16{413, 227, 225},
-		'\u94cc': []uint16{426},
-		'\u94cd': []uint16{413, 225, 227},
-		'\u94ce': []uint16{227, 427, 327, 225},
-		'\u94d0': []uint16{225, 452},
-		'\u94d1': []uint16{413},
-		'\u94d2': []uint16{413},
-		'\u94d5': []uint16{425},
-		'\u94d6': []uint16{227, 413},
-		'\u94d7': []uint16{413},
-		'\u94d9': []uint16{427, 125},
-		'\u94db': []uint16{225, 433, 315, 227, 327},
-		'\u94dc': []uint16{425, 125, 225, 325, 130, 227, 129, 332, 327, 115, 132, 232, 217, 343, 330, 331, 315},
-		'\u94dd': []uint16{425, 125, 225, 232, 217, 327, 104, 332, 132, 325, 227, 331},
-		'\u94de': []uint16{459},
-		'\u94df': []uint16{425, 232, 225, 227},
-		'\u94e0': []uint16{125},
-		'\u94e1': []uint16{452, 125},
-		'\u94e2': []uint16{325, 222, 225, 315, 435, 422, 227},
-		'\u94e3': []uint16{454, 125, 227, 152, 232, 132, 327},
-		'\u94e4': []uint16{115},
-		'\u94e5': []uint16{413},
-		'\u94e7': []uint16{459, 225, 125, 227, 327},
-		'\u94e8': []uint16{227, 413, 152, 327},
-		'\u94e9': []uint16{413, 115, 152},
-		'\u94ea': []uint16{413},
-		'\u94eb': []uint16{413, 227, 327},
-		'\u94ec': []uint16{425, 225, 125, 325, 227, 132, 232, 426, 332},
-		'\u94ed': []uint16{227, 125, 426, 327, 225, 152, 315, 454, 120, 115},
-		'\u94ee': []uint16{100, 200, 320, 220, 362, 162, 227, 327, 228, 120, 152},
-		'\u94ef': []uint16{413, 225},
-		'\u94f0': []uint16{452, 125, 152, 132, 332, 227},
-		'\u94f1': []uint16{425, 426, 125, 227, 132},
-		'\u94f2': []uint16{452, 152, 125, 225, 132, 227},
-		'\u94f3': []uint16{426, 225, 227},
-		'\u94f5': []uint16{225, 125, 232},
-		'\u94f6': []uint16{125, 331, 426, 127, 225, 227, 232, 130, 325, 327, 217, 317, 132, 315, 104, 304, 117, 204, 330, 332, 256, 100, 120, 129, 252, 131, 220, 320},
-		'\u94f7': []uint16{413, 327},
-		'\u94f8': []uint16{452, 152, 125, 252, 227, 225, 156, 320, 115, 327, 325, 132, 328},
-		'\u94f9': []uint16{413},
-		'\u94fa': []uint16{452, 225, 152, 125, 115, 130, 230, 156, 100, 252, 232, 320, 325, 330, 132, 315, 332},
-		'\u94fc': []uint16{413, 227},
-		'\u94fd': []uint16{413},
-		'\u94fe': []uint16{225, 426, 125, 435, 120, 227, 204, 232, 320, 327, 332, 107, 132},
-		'\u94ff': []uint16{100, 433, 227, 115, 333, 133, 327},
-		'\u9500': []uint16{156, 252, 125, 256, 325, 452, 225, 152, 317, 115, 100, 220, 215, 320, 331, 120, 217, 332, 227, 315, 318},
-		'\u9501': []uint16{452, 252, 256, 152, 225, 125, 325, 327, 320, 227, 315, 132, 100, 232, 228, 352, 129, 215, 304, 229, 130},
-		'\u9502': []uint16{425, 132, 232, 227, 225},
-		'\u9503': []uint16{162, 120},
-		'\u9504': []uint16{452, 125, 152, 227, 327, 120, 225, 252},
-		'\u9505': []uint16{425, 225, 125, 325, 252, 320, 315, 220, 120, 352, 215, 132, 228, 327, 227},
-		'\u9506': []uint16{125, 129, 225, 232, 325},
-		'\u9507': []uint16{413},
-		'\u9508': []uint16{325, 125, 252, 425, 204, 225, 452, 152, 120, 332, 256, 227, 327},
-		'\u9509': []uint16{425, 452, 125, 225},
-		'\u950b': []uint16{225, 325, 252, 227, 427, 100, 125, 315, 426, 320, 327, 256, 115, 331, 220, 330, 228, 352},
-		'\u950c': []uint16{425, 225, 227, 325, 125, 232, 132, 327},
-		'\u950d': []uint16{413},
-		'\u950e': []uint16{413},
-		'\u950f': []uint16{413, 225, 327},
-		'\u9510': []uint16{200, 400, 125, 152, 227, 100, 427, 327, 107, 215, 352, 225, 120, 325, 115, 232, 320},
-		'\u9511': []uint16{426, 325, 125, 232, 227},
-		'\u9512': []uint16{115, 125},
-		'\u9513': []uint16{413, 227},
-		'\u9514': []uint16{413, 125},
-		'\u9515': []uint16{413},
-		'\u9516': []uint16{459, 327, 227},
-		'\u9517': []uint16{425, 225, 227, 327, 232},
-		'\u9518': []uint16{413, 227},
-		'\u9519': []uint16{125, 200, 425, 452, 252, 152, 225, 215, 115, 227, 230, 315, 162, 100, 156, 325, 256, 320, 317, 352, 129, 130, 220, 204, 329, 330},
-		'\u951a': []uint16{425, 125, 252, 117, 225, 152, 104},
-		'\u951b': []uint16{452, 227, 327, 125},
-		'\u951d': []uint16{413},
-		'\u951e': []uint16{413, 327, 227},
-		'\u951f': []uint16{227, 413, 327},
-		'\u9521': []uint16{425, 327, 230, 130, 125, 227, 132, 330, 325, 332, 225, 129, 232, 117, 229, 331},
-		'\u9522': []uint16{413, 252, 225, 125, 256},
-		'\u9523': []uint16{425, 125, 315, 225, 320, 115, 252, 227, 330, 215, 232, 327},
-		'\u9524': []uint16{426, 225, 227, 325, 125, 222, 152, 315, 435, 320, 422, 332, 329, 232},
-		'\u9525': []uint16{452, 125, 325, 225, 320, 232, 315, 227, 215, 115},
-		'\u9526': []uint16{125, 426, 327, 130, 417, 227, 225, 115, 132, 315, 230, 330, 317, 325, 215, 129, 328, 220, 252, 402, 232, 152},
-		'\u9528': []uint16{425, 225, 426},
-		'\u9529': []uint16{452},
-		'\u952a': []uint16{452},
-		'\u952b': []uint16{413},
-		'\u952c': []uint16{413, 227},
-		'\u952d': []uint16{225, 435, 222, 125, 232, 422, 227, 327, 252},
-		'\u952e': []uint16{225, 425, 325, 125, 227, 327, 120, 427, 152, 104, 304, 332},
-		'\u952f': []uint16{125, 452, 252, 225, 325, 132, 425, 315},
-		'\u9530': []uint16{425, 125, 325, 225, 232, 227, 327},
-		'\u9531': []uint16{115, 413, 125, 327},
-		'\u9532': []uint16{115},
-		'\u9534': []uint16{227, 413, 327},
-		'\u9535': []uint16{200, 433, 315, 333, 233, 227, 327},
-		'\u9536': []uint16{426, 225, 232, 132, 227},
-		'\u9537': []uint16{413, 227, 327},
-		'\u9538': []uint16{425},
-		'\u9539': []uint16{425, 225, 327, 227},
-		'\u953a': []uint16{427, 227, 327},
-		'\u953b': []uint16{152, 125, 454, 225, 320, 332, 232, 227, 252, 327, 156},
-		'\u953e': []uint16{435, 227},
-		'\u953f': []uint16{413},
-		'\u9540': []uint16{125, 152, 452, 252, 325, 225, 256, 132},
-		'\u9541': []uint16{425, 225, 325, 125, 227, 132, 232, 327},
-		'\u9542': []uint16{152, 156, 252, 125, 115, 215, 315},
-		'\u9544': []uint16{413},
-		'\u9545': []uint16{413},
-		'\u9546': []uint16{459},
-		'\u9547': []uint16{425, 225, 230, 152, 227, 125, 130, 352, 100, 327, 238, 252, 229, 156, 325, 217, 129, 132, 115, 330, 332, 117, 131, 304, 228, 104},
-		'\u9549': []uint16{425, 225, 426, 232},
-		'\u954a': []uint16{452, 125},
-		'\u954c': []uint16{454, 152, 327, 227},
-		'\u954d': []uint16{425, 125, 225, 227},
-		'\u954e': []uint16{413},
-		'\u954f': []uint16{125},
-		'\u9550': []uint16{425, 227, 426, 225, 125, 327},
-		'\u9551': []uint16{422, 222, 235, 435, 227},
-		'\u9552': []uint16{435, 227},
-		'\u9553': []uint16{413, 225, 232, 227, 327},
-		'\u9554': []uint16{125},
-		'\u9555': []uint16{327},
-		'\u9556': []uint16{225, 425, 227, 125},
-		'\u9557': []uint16{433, 125, 227},
-		'\u9558': []uint16{413, 327},
-		'\u955a': []uint16{325},
-		'\u955b': []uint16{227, 413},
-		'\u955c': []uint16{225, 426, 125, 232, 327, 227, 325, 130, 220, 252, 352, 117, 315, 115, 320, 330, 215, 129, 331},
-		'\u955d': []uint16{225, 413, 227},
-		'\u955e': []uint16{426, 225, 327},
-		'\u9561': []uint16{427},
-		'\u9562': []uint16{125},
-		'\u9563': []uint16{225, 125},
-		'\u9564': []uint16{413},
-		'\u9565': []uint16{413},
-		'\u9566': []uint16{413, 227, 232},
-		'\u9567': []uint16{125},
-		'\u9568': []uint16{413},
-		'\u9569': []uint16{225, 413},
-		'\u956b': []uint16{413, 225, 215, 227},
-		'\u956c': []uint16{413},
-		'\u956d': []uint16{425, 227, 104, 327},
-		'\u956f': []uint16{225, 125, 332},
-		'\u9570': []uint16{426, 125, 225, 227, 327, 252},
-		'\u9571': []uint16{413},
-		'\u9572': []uint16{425, 232},
-		'\u9573': []uint16{227, 413, 215, 327},
-		'\u9576': []uint16{452, 152, 125, 130, 227, 327, 252, 132, 330},
-		'\u957f': []uint16{225, 252, 400, 125, 130, 107, 325, 152, 200, 100, 327, 120, 317, 262, 362, 132, 162, 104, 129, 315, 227, 215, 115, 238, 117, 331, 131, 127, 330, 220, 204, 304, 332, 328, 320, 232, 217, 230, 228, 128, 352, 207, 156, 142},
-		'\u95e8': []uint16{225, 425, 125, 142, 252, 230, 207, 325, 222, 330, 227, 320, 422, 127, 200, 315, 327, 331, 152, 215, 229, 332, 238, 115, 220, 232, 342, 132, 129, 356, 130, 338, 117, 242, 352, 217, 329, 204, 256, 307, 300, 231, 120},
-		'\u95e9': []uint16{225, 425, 452, 227},
-		'\u95ea': []uint16{152, 452, 125, 262, 162, 252, 362, 325, 320, 227, 220, 215, 315, 225, 115, 327, 104, 132, 130, 332},
-		'\u95eb': []uint16{127, 427, 327},
-		'\u95ed': []uint16{252, 452, 152, 325, 125, 153, 115, 204, 120, 256, 225, 100, 156, 320, 315, 104, 200, 317, 332, 352},
-		'\u95ee': []uint16{125, 452, 152, 252, 225, 220, 256, 156, 227, 315, 320, 325, 232, 215, 115, 120, 127, 331, 327, 352},
-		'\u95ef': []uint16{129, 452, 152, 327, 227, 125, 315, 120, 252, 228, 215},
-		'\u95f0': []uint16{427, 125, 227, 404, 143, 327},
-		'\u95f1': []uint16{413, 225, 227, 252, 327},
-		'\u95f2': []uint16{452, 125, 152, 200, 252, 256, 320, 225, 262, 100, 315, 103, 215, 115, 104, 120, 325, 227, 143, 352, 327, 253, 300, 156, 201, 362},
-		'\u95f3': []uint16{413, 227, 327},
-		'\u95f4': []uint16{225, 212, 412, 243, 222, 125, 104, 325, 242, 207, 238, 252, 152, 220, 320, 215, 230, 422, 107, 332, 227, 120, 217, 327, 330, 315, 229, 132, 352, 331, 312, 232, 322, 256, 156},
-		'\u95f5': []uint16{127, 427, 130, 227, 327},
-		'\u95f6': []uint16{459},
-		'\u95f7': []uint16{452, 200, 252, 125, 100, 103, 115, 315, 225, 120, 152, 362, 162, 352, 300, 107, 104, 201},
-		'\u95f8': []uint16{425, 225, 125, 252, 325, 256, 130, 230, 452},
-		'\u95f9': []uint16{452, 200, 152, 252, 125, 162, 362, 262, 320, 220, 352, 325, 120, 256, 227, 215, 156, 100, 130, 132},
-		'\u95fa': []uint16{125, 315, 225, 325},
-		'\u95fb': []uint16{225, 452, 152, 127, 325, 227, 215, 252, 315, 120, 327, 115, 331, 232, 200, 125, 220, 128, 320, 130, 317, 332, 228, 330, 132, 328, 230},
-		'\u95fc': []uint16{413, 227},
-		'\u95fd': []uint16{417, 130, 117, 125, 131, 132, 227, 327, 217, 129},
-		'\u95fe': []uint16{427, 225, 127, 125, 330, 227, 327, 315},
-		'\u9600': []uint16{225, 425, 220, 125, 232},
-		'\u9601': []uint16{225, 227, 426, 125, 252, 325, 230, 327, 220, 232, 215, 330, 315},
-		'\u9602': []uint16{225, 454, 327},
-		'\u9603': []uint16{413},
-		'\u9604': []uint16{252, 426, 352},
-		'\u9605': []uint16{152, 252, 454, 156, 125, 256, 227, 327, 325, 225},
-		'\u9606': []uint16{459, 130, 125, 327, 227},
-		'\u9607': []uint16{125},
-		'\u9608': []uint16{125, 225},
-		'\u9609': []uint16{452, 125, 152},
-		'\u960a': []uint16{459, 327},
-		'\u960b': []uint16{413, 315, 227, 152, 320},
-		'\u960c': []uint16{413},
-		'\u960d': []uint16{426},
-		'\u960e': []uint16{127, 125, 427, 327, 325, 128, 225, 227},
-		'\u960f': []uint16{459, 125},
-		'\u9610': []uint16{152, 454, 227, 327, 156},
-		'\u9611': []uint16{426, 125, 152, 227, 252, 327, 315},
-		'\u9612': []uint16{413, 115},
-		'\u9614': []uint16{200, 125, 400, 252, 315, 100, 227, 215, 152, 325, 203, 327, 320, 107, 130},
-		'\u9615': []uint16{435, 222, 225, 422, 227},
-		'\u9616': []uint16{215, 125, 115, 120},
-		'\u9617': []uint16{230, 413, 227, 327},
-		'\u9619': []uint16{427, 435, 227, 127, 426, 327, 225, 215, 152},
-		'\u961a': []uint16{127, 427},
-		'\u961c': []uint16{130, 230, 330, 320, 225, 229, 131, 331},
-		'\u961f': []uint16{225, 125, 425, 231, 222, 252, 325, 238, 320, 215, 217, 232, 422, 227, 220, 327, 142, 207, 256},
-		'\u9621': []uint16{125, 330, 228},
-		'\u9622': []uint16{459},
-		'\u962a': []uint16{230, 227, 130, 330, 331},
-		'\u962e': []uint16{127, 427, 227, 327, 128},
-		'\u9631': []uint16{225, 413},
-		'\u9632': []uint16{152, 252, 156, 125, 225, 452, 325, 117, 331, 204, 256, 120, 215, 132, 207, 320, 130, 115, 227, 104, 131, 232, 315, 327, 217, 317, 220, 229},
-		'\u9633': []uint16{230, 225, 125, 227, 330, 325, 127, 327, 404, 426, 130, 332, 252, 427, 243, 152, 232, 229, 320, 343, 331, 315, 328, 129, 115, 204, 132, 131, 220, 100, 228, 215, 120, 417, 231, 256, 402, 217},
-		'\u9634': []uint16{125, 400, 127, 100, 225, 230, 152, 130, 115, 330, 103, 227, 327, 162, 142, 325, 120, 229, 252, 315, 220, 104, 320, 342, 362, 143, 352, 232},
-		'\u9635': []uint16{222, 125, 426, 225, 322, 252, 227, 135, 152, 235, 142, 320, 215, 315, 343, 327, 325, 220, 156, 129, 217, 332, 331, 232, 256, 338, 304, 422, 317},
-		'\u9636': []uint16{125, 325, 426, 225, 320, 343, 120, 227, 132, 104, 327, 304, 215, 352, 328},
-		'\u963b': []uint16{152, 125, 252, 452, 225, 325, 156, 215, 227, 256, 220, 315, 132, 332},
-		'\u963c': []uint16{413},
-		'\u963d': []uint16{413},
-		'\u963f': []uint16{127, 130, 125, 417, 129, 327, 132, 330, 227, 230, 131, 329, 215, 152, 229, 115, 331, 117, 315, 225, 232, 325, 104, 133},
-		'\u9640': []uint16{325, 125, 225, 230, 330, 129, 229, 232, 200, 227},
-		'\u9642': []uint16{459, 230, 225, 125, 330, 130},
-		'\u9644': []uint16{112, 452, 125, 152, 252, 104, 156, 315, 325, 107, 117, 256, 317, 332, 115, 331, 330, 327, 215, 229, 227},
-		'\u9645': []uint16{225, 307, 212, 325, 331, 426, 220, 352, 204, 332, 215, 231, 320, 232, 125, 356, 252, 330, 454, 327, 262, 315, 304, 227, 256, 317, 343},
-		'\u9646': []uint16{225, 125, 127, 107, 252, 325, 242, 422, 230, 331, 130, 142, 227, 427, 327, 426, 232, 117, 131, 120, 307, 315, 317, 220, 152, 217, 132, 332, 330, 124, 417, 320, 224, 100, 128},
-		'\u9647': []uint16{417, 130, 132, 125, 227, 327, 129, 330, 225, 315},
-		'\u9648': []uint16{127, 427, 152, 128, 125, 315, 227, 100, 327, 252, 225, 130, 220, 325, 115, 230, 129, 132, 332},
-		'\u9649': []uint16{230, 413, 330},
-		'\u964b': []uint16{200, 125, 402, 315},
-		'\u964c': []uint16{100, 125, 402, 225, 142, 227, 152, 327},
-		'\u964d': []uint16{252, 152, 452, 125, 256, 325, 225, 156, 215, 227, 327, 243, 320, 115, 220, 317, 132, 332, 315, 352},
-		'\u9650': []uint16{325, 152, 225, 452, 200, 252, 125, 320, 331, 156, 243, 227, 315, 327, 120, 132, 352, 104, 332, 220, 217},
-		'\u9654': []uint16{413},
-		'\u9655': []uint16{130, 417, 117, 427, 131, 225, 227, 125, 327, 132, 230, 331, 330},
-		'\u965b': []uint16{125},
-		'\u965f': []uint16{413, 330, 327, 227, 115, 230},
-		'\u9661': []uint16{400, 100, 125, 107, 152, 130, 230},
-		'\u9662': []uint16{225, 125, 231, 425, 217, 220, 252, 325, 232, 331, 230, 227, 238, 142, 317, 242, 330, 327, 215, 229, 130, 320, 304, 256, 132, 117},
-		'\u9664': []uint16{434, 252, 134, 152, 106, 125, 120, 143, 132, 325, 315, 320, 156, 215, 332, 115, 227, 225, 256},
-		'\u9667': []uint16{459},
-		'\u9668': []uint16{125, 454, 152, 327},
-		'\u9669': []uint16{225, 203, 325, 252, 400, 100, 200, 125, 217, 107, 220, 215, 315, 256, 327, 331, 332, 231, 120, 152, 320, 227, 204},
-		'\u966a': []uint16{452, 152, 125, 252, 227, 156, 217, 225, 327, 325, 220, 120},
-		'\u966c': []uint16{413},
-		'\u9672': []uint16{225, 242, 413},
-		'\u9674': []uint16{413},
-		'\u9675': []uint16{225, 230, 426, 227, 125, 330, 325, 327, 130, 229, 332, 217, 231, 232, 329, 331, 152, 252, 328, 342},
-		'\u9676': []uint16{125, 127, 427, 152, 327, 225, 330, 227, 252, 256, 229, 320, 130, 332, 128, 230, 132, 325, 315, 402, 232, 156, 120, 454, 431, 129},
-		'\u9677': []uint16{152, 252, 452, 225, 125, 315, 325, 215, 120, 256},
-		'\u9685': []uint16{426, 225, 212, 315, 229, 330, 215, 220, 227},
-		'\u9686': []uint16{227, 230, 143, 127, 427, 152, 100, 233, 243, 327, 330, 133, 130, 125, 333, 200, 252, 232, 325, 362, 229, 101, 225, 132, 156, 215, 204, 332, 331, 262, 220, 329, 402, 328},
-		'\u9688': []uint16{413},
-		'\u968b': []uint16{143, 427, 127, 227, 444, 129},
-		'\u968d': []uint16{230, 330, 413, 130},
-		'\u968f': []uint16{134, 107, 452, 252, 101, 152, 125, 120, 115, 100, 325, 315, 104, 320, 130, 162, 362, 227, 230, 327, 225, 156, 215, 220},
-		'\u9690': []uint16{152, 125, 452, 162, 227, 100, 104, 262, 362, 315, 252, 330, 120, 115, 327, 320, 215, 325, 156, 230},
-		'\u9694': []uint16{452, 252, 152, 225, 156, 125, 142, 104, 320, 120, 132, 107, 227, 325, 115, 215, 315, 220, 130, 131},
-		'\u9697': []uint16{227, 127, 427, 327},
-		'\u9698': []uint16{225, 125, 200, 426, 325, 327},
-		'\u9699': []uint16{225, 426, 315, 207, 125, 215, 220},
-		'\u969c': []uint16{252, 125, 225, 256, 325, 426, 304, 331, 152, 204, 315, 132, 332, 217, 317},
-		'\u96a7': []uint16{125, 426, 325, 225, 331},
-		'\u96b0': []uint16{413, 130, 227},
-		'\u96b3': []uint16{413},
-		'\u96b6': []uint16{225, 152, 325, 230, 417, 320, 125, 327, 227, 426, 331, 252},
-		'\u96b9': []uint16{413, 227, 327},
-		'\u96bc': []uint16{413, 225, 227, 327},
-		'\u96bd': []uint16{459, 227, 100, 327, 315},
-		'\u96be': []uint16{400, 107, 203, 125, 152, 100, 225, 200, 252, 207, 315, 320, 120, 115, 325, 215, 220, 262, 256, 227, 204, 327, 132, 331, 330, 317, 217},
-		'\u96c0': []uint16{225, 426, 315, 125, 325, 327, 330, 320, 132, 215, 130, 152, 227, 131, 304, 220, 230},
-		'\u96c1': []uint16{327, 227, 425, 127, 130, 225, 125, 129, 330, 215, 132, 120, 232, 115, 315},
-		'\u96c4': []uint16{225, 227, 125, 100, 404, 426, 104, 320, 230, 427, 327, 325, 162, 330, 115, 252, 152, 215, 120, 262, 315, 130, 332, 220, 200, 132, 229, 129, 328, 228},
-		'\u96c5': []uint16{130, 200, 402, 227, 230, 327, 127, 125, 132, 330, 325, 129, 100, 229, 225, 215, 332, 262, 232, 329, 120, 152, 320, 203, 220, 331, 131},
-		'\u96c6': []uint16{125, 152, 252, 435, 225, 331, 200, 325, 454, 156, 256, 227, 222, 230, 120, 132, 327, 332, 130, 215, 422, 104, 129, 217, 330, 320, 117, 232, 115, 304, 315, 343},
-		'\u96c7': []uint16{152, 125, 452, 120, 252, 156, 325},
-		'\u96c9': []uint16{426, 225, 125, 227, 327},
-		'\u96cc': []uint16{404, 125, 120, 104, 320, 315, 132, 352, 325, 152, 332, 227},
-		'\u96cd': []uint16{125, 227, 427, 127, 327, 417, 330, 130, 162, 115, 132, 120, 143, 325},
-		'\u96ce': []uint16{227, 413, 225, 125},
-		'\u96cf': []uint16{125, 426, 252, 225, 227, 327, 132, 215},
-		'\u96d2': []uint16{427, 127, 230},
-		'\u96d5': []uint16{125, 225, 452, 152, 227, 104, 315, 115, 215, 229, 120, 232, 325, 156},
-		'\u96e0': []uint16{413},
-		'\u96e8': []uint16{425, 225, 125, 227, 325, 252, 315, 215, 127, 327, 143, 220, 256, 228, 115, 320, 130, 120, 243, 232, 332, 204, 229},
-		'\u96e9': []uint16{413, 230},
-		'\u96ea': []uint16{225, 425, 125, 227, 127, 327, 252, 162, 132, 315, 325, 115, 256, 330, 220, 215, 130, 228, 152, 143, 320, 352, 129, 229, 142, 104, 232, 120, 328, 128},
-		'\u96ef': []uint16{232, 227, 427, 327, 228},
-		'\u96f3': []uint16{225, 215, 325, 227, 332},
-		'\u96f6': []uint16{125, 156, 422, 322, 100, 122, 362, 124, 107, 252, 262, 142, 215, 315, 162, 222, 233, 152, 320, 227, 256, 115, 352, 143, 103, 327, 225, 333, 120, 131, 325},
-		'\u96f7': []uint16{125, 127, 225, 227, 425, 327, 252, 325, 152, 130, 330, 329, 229, 315, 129, 115, 230, 215, 320, 256, 332, 120, 220, 331, 132, 232},
-		'\u96f9': []uint16{225, 426, 125},
-		'\u96fe': []uint16{225, 425, 125, 130, 252, 215, 162, 227, 330, 320, 327, 152, 325, 115, 220, 315, 120, 232, 332, 129},
-		'\u9700': []uint16{152, 125, 452, 225, 252, 325, 220, 156, 215, 320, 352, 227, 256, 327},
-		'\u9701': []uint16{454, 227, 327, 115, 125, 315},
-		'\u9704': []uint16{227, 225, 427, 315, 327, 125, 215, 330, 252, 115, 232},
-		'\u9706': []uint16{225, 227, 215, 413, 327, 315},
-		'\u9707': []uint16{152, 225, 156, 452, 227, 125, 327, 325, 115, 143, 252, 256, 132, 320, 220, 315, 228, 328, 352, 331, 130, 317},
-		'\u9708': []uint16{227, 413},
-		'\u9709': []uint16{325, 200, 125, 425, 452, 225, 252, 152, 332, 220, 100, 352, 156},
-		'\u970d': []uint16{127, 227, 427, 417, 130, 252, 107, 327, 125, 129, 131, 315, 330, 229, 215, 329, 230, 128, 325, 132},
-		'\u970e': []uint16{107, 343, 243, 143},
-		'\u970f': []uint16{162, 262, 327, 227},
-		'\u9713': []uint16{125, 225},
-		'\u9716': []uint16{227, 427, 327, 225, 125},
-		'\u971c': []uint16{425, 343, 225, 125, 227, 215, 232, 327, 143, 315, 332, 132, 152, 325, 204},
-		'\u971e': []uint16{227, 225, 425, 327, 125, 330, 426, 228, 230, 130, 315, 332, 120, 320, 220, 215, 427, 132},
-		'\u972a': []uint16{125, 459},
-		'\u972d': []uint16{426, 225, 227, 262, 162, 327},
-		'\u9730': []uint16{125, 325},
-		'\u9732': []uint16{252, 152, 452, 125, 225, 315, 227, 327, 232, 100, 215, 243, 320, 230, 332, 132, 129, 330, 256, 229, 325, 220, 200, 352, 130},
-		'\u9738': []uint16{125, 425, 227, 252, 152, 325, 225, 327, 130, 232, 454, 332, 215, 315, 120, 256, 230, 320, 220, 330, 229, 100},
-		'\u9739': []uint16{125, 315, 327, 132},
-		'\u973e': []uint16{225, 413},
-		'\u9752': []uint16{125, 130, 400, 227, 152, 327, 127, 225, 117, 325, 104, 331, 131, 262, 143, 115, 132, 100, 162, 317, 320, 252, 228, 120, 315, 200, 330, 204, 215, 256, 129, 232, 230, 220, 229, 362, 332, 217, 328},
-		'\u9753': []uint16{100, 413, 125, 227, 327, 315},
-		'\u9756': []uint16{243, 227, 127, 130, 327, 427, 252, 230, 417, 232, 330, 132, 229, 325, 315, 228},
-		'\u9759': []uint16{200, 125, 452, 152, 225, 227, 162, 362, 127, 400, 252, 327, 100, 325, 120, 130, 215, 156, 262, 220, 315, 132, 232, 329, 356, 129, 330, 228, 332, 115, 320, 328},
-		'\u975b': []uint16{104, 225, 125},
-		'\u975e': []uint16{107, 414, 104, 407, 252, 130, 230, 207, 125, 206, 225, 215, 162, 315, 227, 320, 115, 120, 327, 106, 330, 117, 129, 152, 331, 156, 325, 220, 132, 131, 317, 229, 332, 217, 127, 328, 228},
-		'\u9760': []uint16{452, 252, 200, 152, 325, 134, 125, 100, 215, 115, 120, 227, 315, 352, 232},
-		'\u9761': []uint16{454, 200, 252, 315, 215, 227, 103, 125, 325, 100, 115},
-		'\u9762': []uint16{225, 125, 212, 425, 152, 206, 112, 201, 207, 252, 325, 222, 204, 224, 142, 315, 115, 307, 220, 200, 320, 120, 215, 332, 100, 331, 227, 352, 422, 327, 232, 256, 330, 132, 329, 300, 322, 217, 362, 324, 262, 435, 130},
-		'\u9765': []uint16{413, 225, 227},
-		'\u9769': []uint16{256, 156, 332, 325, 225, 125, 454, 331, 320, 152, 220, 217, 252, 132, 227, 117, 330, 230, 352, 232, 327, 315, 129, 115, 130, 317, 229},
-		'\u9773': []uint16{127, 427, 327, 227},
-		'\u9774': []uint16{426, 125, 225, 232, 132, 315, 332},
-		'\u9776': []uint16{434, 125, 435, 225, 252, 426, 256, 325, 227, 132},
-		'\u977c': []uint16{225, 459, 330},
-		'\u9785': []uint16{227, 459},
-		'\u978b': []uint16{225, 425, 125, 252, 325, 227, 215, 232, 220, 132, 332, 117, 130},
-		'\u978d': []uint16{426, 125, 225, 130, 330, 115, 327, 325, 227, 328, 331, 132, 131},
-		'\u9791': []uint16{125, 130},
-		'\u9792': []uint16{413, 227},
-		'\u9794': []uint16{452},
-		'\u9798': []uint16{225, 125, 252, 330, 325, 220},
-		'\u97a0': []uint16{152, 427, 454, 127, 115, 125, 225, 327, 227, 128},
-		'\u97a3': []uint16{452, 125, 152, 325},
-		'\u97ab': []uint16{413},
-		'\u97ad': []uint16{125, 425, 152, 225, 452, 252, 227, 325, 115, 215, 315, 156, 426, 220, 232},
-		'\u97af': []uint16{459, 225},
-		'\u97b2': []uint16{459},
-		'\u97b4': []uint16{459},
-		'\u97e6': []uint16{127, 427, 227, 327, 230, 129, 225, 329, 229, 330, 130, 331, 132, 115, 128},
-		'\u97e7': []uint16{125, 200, 402, 227, 120, 325, 315, 232, 332, 327, 201},
-		'\u97e9': []uint16{127, 417, 130, 227, 128, 131, 132, 327, 125, 230, 129, 322, 330, 225, 331, 122, 427},
-		'\u97ea': []uint16{215, 459, 327, 227},
-		'\u97eb': []uint16{227, 413, 327},
-		'\u97ec': []uint16{125, 115, 328, 152, 228, 127, 327, 315, 132},
-		'\u97ed': []uint16{125, 132, 327},
-		'\u97f3': []uint16{225, 125, 425, 325, 252, 227, 331, 131, 232, 320, 330, 327, 256, 215, 204, 315, 120, 115, 117, 132, 230, 220, 332},
-		'\u97f5': []uint16{425, 225, 125, 227, 325, 200, 327, 332, 320, 315},
-		'\u97f6': []uint16{130, 320, 227, 127, 327, 427, 417, 132, 131, 125},
-		'\u9875': []uint16{422, 222, 225, 125, 435, 325, 329, 320, 227, 204, 327},
-		'\u9876': []uint16{452, 225, 125, 112, 152, 222, 330, 107, 252, 325, 227, 115, 230, 315, 262, 100, 204, 327, 256, 215, 104, 422, 120, 242, 132, 320, 220, 162, 130, 331, 207, 200},
-		'\u9877': []uint16{222, 235, 143, 107, 207, 435, 115, 422, 327, 220, 129, 227},
-		'\u9878': []uint16{200},
-		'\u9879': []uint16{125, 222, 238, 225, 435, 422, 204, 127, 252, 232, 325, 130, 207, 320, 332, 115, 327, 230, 227, 128},
-		'\u987a': []uint16{227, 101, 125, 107, 452, 327, 400, 152, 134, 232, 143, 252, 100, 230, 200, 215, 130, 129, 115, 228, 330, 132, 229, 315, 243, 120, 162, 362, 117, 320, 104, 156, 331, 231, 256, 332, 328, 225, 325},
-		'\u987b': []uint16{207, 407, 225, 125, 127, 143, 252, 152, 327, 315, 325, 330, 120, 227, 130, 320, 229, 332, 215, 129},
-		'\u987c': []uint16{225, 227, 413, 327},
-		'\u987d': []uint16{100, 402, 125, 152, 200, 315, 327, 132, 120, 227, 325, 115},
-		'\u987e': []uint16{252, 127, 125, 452, 152, 315, 207, 215, 115, 225, 320, 331, 104, 120, 220, 327, 307, 227, 128, 100, 325, 256, 317, 130},
-		'\u987f': []uint16{107, 222, 252, 435, 230, 227, 229, 152, 235, 331, 315, 130, 200, 330, 220, 325, 327, 332, 125, 232, 452, 215, 115, 320, 422, 256, 129, 156, 343},
-		'\u9880': []uint16{162},
-		'\u9881': []uint16{152, 454, 156, 227, 327, 125, 256},
-		'\u9882': []uint16{252, 452, 152, 125, 454, 227, 315, 327, 228, 225, 220, 115},
-		'\u9883': []uint16{252, 459},
-		'\u9884': []uint16{152, 156, 125, 407, 252, 107, 256, 320, 227, 104, 327, 325, 304, 132, 315, 117, 120, 331, 143, 317},
-		'\u9885': []uint16{225, 426, 125, 142, 215, 252, 325},
-		'\u9886': []uint16{125, 252, 225, 452, 152, 156, 227, 325, 320, 120, 327, 315, 215, 204, 220, 104, 331, 232, 330},
-		'\u9887': []uint16{407, 152, 107, 232, 120, 203, 332, 330, 229, 262, 227, 315, 220},
-		'\u9888': []uint16{426, 225, 125, 325, 142, 252, 332},
-		'\u9889': []uint16{427, 327, 227, 232, 152},
-		'\u988a': []uint16{225, 426, 125},
-		'\u988c': []uint16{225, 325, 125},
-		'\u988d': []uint16{413, 130, 132, 327, 229, 227, 330},
-		'\u988f': []uint16{426, 225, 325},
-		'\u9890': []uint16{130, 115, 228, 152, 220, 132, 120, 252},
-		'\u9891': []uint16{125, 100, 225, 207, 107, 426, 204, 152, 402, 252, 256, 325, 227, 327, 232, 315, 320, 332, 217, 132},
-		'\u9893': []uint16{100, 125, 454, 162, 252, 227, 320, 315, 120},
-		'\u9894': []uint16{152, 454},
-		'\u9896': []uint16{427, 200, 227, 315, 327, 125, 100, 325, 228},
-		'\u9897': []uint16{222, 435, 125, 422, 132, 327, 325, 152},
-		'\u9898': []uint16{225, 125, 425, 152, 252, 325, 220, 315, 227, 320, 452, 200, 215, 332, 130},
-		'\u989a': []uint16{225, 125},
-		'\u989b': []uint16{125, 413, 130, 327},
-		'\u989c': []uint16{125, 127, 227, 315, 225, 327, 427, 426, 229, 330, 320, 417, 252, 215, 230, 115, 220, 120, 232},
-		'\u989d': []uint16{225, 125, 425, 204, 252, 104, 325, 130, 107, 127, 128, 232, 215, 327, 227, 129, 253, 207, 115, 320},
-		'\u989e': []uint16{125},
-		'\u989f': []uint16{100},
-		'\u98a0': []uint16{152, 452, 230, 115, 156, 315, 125, 362, 262, 100, 400, 215, 220, 162, 242, 252, 227, 120, 352},
-		'\u98a1': []uint16{413},
-		'\u98a2': []uint16{227, 413, 327},
-		'\u98a4': []uint16{152, 452, 252, 162, 225, 125, 362, 256, 215, 315, 156, 325, 220},
-		'\u98a5': []uint16{225},
-		'\u98a6': []uint16{413, 320, 215, 252},
-		'\u98a7': []uint16{125},
-		'\u98ce': []uint16{125, 225, 425, 252, 315, 227, 325, 115, 320, 152, 100, 220, 327, 215, 120, 256, 230, 330, 332, 232, 352, 200, 229, 104, 238, 156, 132, 228, 130, 331, 131, 317, 342, 217, 117, 129, 328, 304},
-		'\u98d1': []uint16{413},
-		'\u98d2': []uint16{162, 262, 315, 120, 107, 327, 227, 200},
-		'\u98d3': []uint16{125},
-		'\u98d5': []uint16{433, 262, 362, 233, 133},
-		'\u98d8': []uint16{152, 452, 362, 252, 107, 315, 262, 100, 162, 125, 156, 327, 115, 200, 227, 120, 320, 256, 127, 352},
-		'\u98d9': []uint16{152, 225, 320, 228, 125},
-		'\u98da': []uint16{252, 228},
-		'\u98de': []uint16{125, 452, 152, 227, 252, 156, 327, 132, 315, 325, 162, 107, 115, 215, 127, 217, 320, 225, 130, 331, 232, 220, 228, 120, 256, 131, 330, 104, 129, 100, 204, 229},
-		'\u98df': []uint16{125, 225, 452, 252, 152, 320, 325, 215, 120, 104, 315, 327, 115, 331, 220, 204, 332, 256, 132, 330, 232, 217, 156},
-		'\u98e7': []uint16{413},
-		'\u98e8': []uint16{454, 210, 125, 152},
-		'\u990d': []uint16{413},
-		'\u9910': []uint16{125, 225, 426, 252, 222, 325, 256, 220, 315, 215, 238, 422, 217, 115},
-		'\u992e': []uint16{459, 225, 315, 227, 327},
-		'\u9954': []uint16{413, 227},
-		'\u9955': []uint16{125, 115},
-		'\u9964': []uint16{225},
-		'\u9965': []uint16{125, 426, 252, 115, 315, 120, 200, 352, 152, 402, 215, 320, 220},
-		'\u9966': []uint16{225},
-		'\u9967': []uint16{452, 125},
-		'\u9968': []uint16{459, 225},
-		'\u9969': []uint16{413},
-		'\u996a': []uint16{252, 225, 413, 331},
-		'\u996b': []uint16{413},
-		'\u996c': []uint16{413, 252},
-		'\u996d': []uint16{425, 252, 225, 125, 143, 220, 325, 330, 215, 315, 320, 227, 204, 115, 256, 132, 120, 352, 229},
-		'\u996e': []uint16{125, 452, 152, 225, 252, 325, 120, 320, 115, 315, 227, 156, 215, 117, 232, 327, 130},
-		'\u996f': []uint16{152, 225},
-		'\u9970': []uint16{252, 225, 452, 325, 125, 152, 227, 315, 204, 256, 320, 331, 132, 232, 332},
-		'\u9971': []uint16{152, 400, 452, 100, 332, 156, 115, 225, 125, 315, 320, 120, 215, 252, 220, 227, 304, 325, 356},
-		'\u9972': []uint16{125, 152, 454, 252, 325, 132, 104},
-		'\u9973': []uint16{225},
-		'\u9974': []uint16{125, 215, 225, 315},
-		'\u9975': []uint16{125, 225, 426, 227, 325},
-		'\u9976': []uint16{452, 152, 127, 200, 252, 230, 120, 227, 215, 330, 320, 327, 130, 325, 427, 115},
-		'\u9977': []uint16{225, 125, 252},
-		'\u997a': []uint16{125, 426, 225},
-		'\u997c': []uint16{225, 425, 125, 252, 325, 227, 315, 332, 132, 232},
-		'\u997d': []uint16{125, 225},
-		'\u997e': []uint16{125},
-		'\u997f': []uint16{452, 152, 225, 125, 252, 215, 115, 325, 320, 120},
-		'\u9980': []uint16{413, 227, 327},
-		'\u9981': []uint16{200, 454, 252, 215},
-		'\u9984': []uint16{125},
-		'\u9985': []uint16{426, 125, 225, 252, 232, 204, 352},
-		'\u9986': []uint16{225, 426, 125, 230, 231, 325, 130, 238, 227, 256, 252, 232, 352, 331, 215, 152, 320, 217},
-		'\u9987': []uint16{459},
-		'\u9988': []uint16{252, 152, 225, 156, 125, 325},
-		'\u9989': []uint16{125},
-		'\u998a': []uint16{400, 125},
-		'\u998b': []uint16{400, 252, 200, 125, 115},
-		'\u998d': []uint16{225, 125, 252, 232},
-		'\u998e': []uint16{125},
-		'\u998f': []uint16{252, 125, 325, 225, 452},
-		'\u9990': []uint16{413, 225},
-		'\u9991': []uint16{459, 225},
-		'\u9992': []uint16{125, 130, 327},
-		'\u9993': []uint16{426, 125, 225},
-		'\u9994': []uint16{413, 225, 315},
-		'\u9995': []uint16{413, 227},
-		'\u9996': []uint16{125, 122, 107, 422, 143, 252, 152, 225, 104, 222, 115, 215, 315, 156, 212, 322, 327, 132, 130, 230, 325, 131, 330, 320, 243, 117, 227, 120, 220, 331, 207, 217, 332, 328},
-		'\u9997': []uint16{225, 413, 227, 327},
-		'\u9998': []uint16{413},
-		'\u9999': []uint16{130, 125, 400, 225, 227, 327, 127, 252, 131, 325, 330, 100, 132, 162, 332, 200, 315, 129, 215, 232, 204, 229, 362, 320, 120, 104, 262, 331, 115, 230, 220, 128, 228},
-		'\u99a5': []uint16{227, 162, 402, 327, 229, 328},
-		'\u99a8': []uint16{227, 200, 426, 225, 125, 327, 325, 203, 220},
-		'\u9a6c': []uint16{425, 127, 225, 125, 230, 130, 252, 330, 327, 107, 325, 227, 232, 315, 129, 329, 207, 215, 135, 229, 332, 132, 128, 115, 220, 100, 320, 117, 331, 322, 120, 304, 352, 328, 122, 131, 256, 143, 231, 307, 104, 243},
-		'\u9a6d': []uint16{252, 315, 325, 125},
-		'\u9a6e': []uint16{452, 152, 125, 332},
-		'\u9a6f': []uint16{125, 152, 452, 227, 215, 100, 200, 156},
-		'\u9a70': []uint16{454, 252, 152, 115, 227, 315, 215, 200, 232, 327, 331, 256, 132, 120, 332},
-		'\u9a71': []uint16{152, 454, 125, 156, 225, 252, 325, 315, 227, 215, 320, 332, 327},
-		'\u9a73': []uint16{252, 452, 152, 125, 262, 220, 256, 325, 156, 227, 100, 225, 362, 315, 200},
-		'\u9a74': []uint16{425, 225, 125, 227, 325, 315, 327, 215, 152, 220, 115, 130, 120, 252},
-		'\u9a75': []uint16{413},
-		'\u9a76': []uint16{252, 325, 152, 454, 332, 452, 227},
-		'\u9a77': []uint16{115, 125, 315},
-		'\u9a78': []uint16{125},
-		'\u9a79': []uint16{225, 426, 227, 327, 125, 330, 315, 325},
-		'\u9a7a': []uint16{413},
-		'\u9a7b': []uint16{452, 125, 152, 252, 156, 130, 331, 227, 115, 131},
-		'\u9a7c': []uint16{225, 452, 125, 325, 332, 228},
-		'\u9a7d': []uint16{100, 125},
-		'\u9a7e': []uint16{152, 452, 125, 252, 222, 225, 227, 132, 117, 315, 327, 115, 435, 422, 120, 256},
-		'\u9a7f': []uint16{125, 230, 152, 228, 130, 330},
-		'\u9a80': []uint16{459},
-		'\u9a81': []uint16{100, 125, 115, 327},
-		'\u9a82': []uint16{452, 252, 152, 215, 125, 315, 362, 162, 256, 120, 325, 220, 225},
-		'\u9a84': []uint16{100, 402, 125, 115, 227, 225, 315, 120, 215, 327},
-		'\u9a85': []uint16{230, 330, 427, 227, 125},
-		'\u9a86': []uint16{125, 127, 427, 130, 132, 227, 325, 327},
-		'\u9a87': []uint16{200, 115, 315, 162, 100, 125, 225, 330},
-		'\u9a88': []uint16{125},
-		'\u9a8a': []uint16{413, 130, 227, 327, 315},
-		'\u9a8b': []uint16{252, 454, 215, 327, 227, 320},
-		'\u9a8c': []uint16{256, 225, 252, 325, 152, 452, 156, 200, 125, 331, 227, 315, 220, 332, 204, 120, 327, 132},
-		'\u9a8f': []uint16{227, 426, 125, 327, 332, 402, 129},
-		'\u9a90': []uint16{413, 125, 227, 327},
-		'\u9a91': []uint16{452, 125, 152, 225, 227, 325, 327, 130, 252, 115, 104, 331, 315, 256, 215, 352},
-		'\u9a92': []uint16{125},
-		'\u9a93': []uint16{413},
-		'\u9a96': []uint16{452},
-		'\u9a97': []uint16{452, 252, 125, 152, 256, 325, 215, 220},
-		'\u9a98': []uint16{225, 413, 227},
-		'\u9a9a': []uint16{152, 225, 400, 252, 215, 125, 156, 230, 327, 227, 115, 320, 220, 232},
-		'\u9a9b': []uint16{413, 315, 220, 225, 215},
-		'\u9a9c': []uint16{227, 315, 200, 327, 413},
-		'\u9a9d': []uint16{413, 227, 225},
-		'\u9a9e': []uint16{227, 413, 327, 228},
-		'\u9a9f': []uint16{452},
-		'\u9aa0': []uint16{125, 459, 325, 227},
-		'\u9aa1': []uint16{125, 426, 225},
-		'\u9aa2': []uint16{227, 413, 327},
-		'\u9aa3': []uint16{413},
-		'\u9aa4': []uint16{225, 107, 407, 152, 220, 201, 125, 315, 156, 227, 320, 115},
-		'\u9aa5': []uint16{227, 327, 427, 215, 426, 315, 225},
-		'\u9aa7': []uint16{227, 454, 217, 327, 315, 228},
-		'\u9aa8': []uint16{125, 426, 225, 325, 152, 215, 315, 252, 227, 262, 327, 320, 200, 132, 115, 220, 307, 120, 332, 162, 330, 352, 331, 133},
-		'\u9ab0': []uint16{125},
-		'\u9ab1': []uint16{413},
-		'\u9ab6': []uint16{325, 125},
-		'\u9ab7': []uint16{125},
-		'\u9ab8': []uint16{225, 125, 215, 325},
-		'\u9aba': []uint16{459},
-		'\u9abc': []uint16{225, 325, 459},
-		'\u9ac0': []uint16{413, 115},
-		'\u9ac1': []uint16{413},
-		'\u9ac2': []uint16{125},
-		'\u9ac5': []uint16{225, 459},
-		'\u9acb': []uint16{125},
-		'\u9acc': []uint16{125},
-		'\u9ad1': []uint16{125},
-		'\u9ad3': []uint16{225, 413, 325, 125, 215, 252},
-		'\u9ad8': []uint16{400, 125, 252, 100, 200, 107, 152, 127, 104, 327, 225, 325, 330, 130, 117, 227, 120, 315, 142, 320, 132, 328, 115, 332, 331, 162, 229, 362, 129, 204, 128, 304, 207, 131, 215, 217, 220, 232, 317, 262, 156, 329, 352, 228, 230, 103, 256},
-		'\u9ae1': []uint16{413, 227},
-		'\u9ae6': []uint16{413, 200, 227, 252, 327},
-		'\u9aeb': []uint16{413, 225},
-		'\u9aed': []uint16{413, 125},
-		'\u9aef': []uint16{426, 225, 327, 125, 227},
-		'\u9af9': []uint16{413},
-		'\u9afb': []uint16{413, 225, 330},
-		'\u9b03': []uint16{426, 225, 125, 330},
-		'\u9b08': []uint16{413},
-		'\u9b0f': []uint16{413},
-		'\u9b13': []uint16{426, 125, 225, 315, 227, 320, 215},
-		'\u9b1f': []uint16{225, 413, 227, 327, 315},
-		'\u9b23': []uint16{125, 426},
-		'\u9b2f': []uint16{413, 327},
-		'\u9b32': []uint16{426, 227},
-		'\u9b3b': []uint16{454, 315, 232},
-		'\u9b3c': []uint16{425, 125, 225, 252, 315, 115, 400, 227, 320, 215, 325, 152, 127, 220, 100, 120, 352, 143},
-		'\u9b41': []uint16{225, 100, 130, 125, 315, 252, 228, 327, 328},
-		'\u9b42': []uint16{225, 425, 315, 125, 252, 115, 325, 200, 204, 120, 227, 215, 327, 220, 332},
-		'\u9b43': []uint16{225, 459},
-		'\u9b44': []uint16{225, 215, 125, 426, 200, 315, 220, 115, 227, 327},
-		'\u9b45': []uint16{125, 225, 315, 220, 120},
-		'\u9b47': []uint16{225, 252},
-		'\u9b48': []uint16{459, 225, 327},
-		'\u9b49': []uint16{459, 225, 215},
-		'\u9b4d': []uint16{459, 125, 315},
-		'\u9b4f': []uint16{127, 427, 243, 227, 117, 327, 130, 129, 315, 125, 444, 128, 220, 143},
-		'\u9b51': []uint16{413, 115, 125, 227},
-		'\u9b54': []uint16{125, 426, 225, 315, 227, 327, 252, 104, 352, 220, 115, 120, 132},
-		'\u9c7c': []uint16{425, 125, 225, 252, 127, 227, 330, 115, 325, 232, 315, 215, 220, 327, 130, 320, 120, 152, 107, 230, 132, 332},
-		'\u9c7f': []uint16{125, 352, 132},
-		'\u9c81': []uint16{127, 327, 330, 425, 227, 325, 230, 130, 232, 331, 317, 200, 128, 132, 129, 229, 117, 100, 329, 332, 417, 225, 228, 131, 125, 217, 115, 427, 252},
-		'\u9c82': []uint16{413, 227, 225},
-		'\u9c85': []uint16{125, 130},
-		'\u9c86': []uint16{413, 227, 225},
-		'\u9c87': []uint16{125, 129},
-		'\u9c88': []uint16{413, 125, 227, 225},
-		'\u9c8b': []uint16{413, 227},
-		'\u9c8d': []uint16{127, 427, 125, 327, 129, 227, 115},
-		'\u9c8e': []uint16{413},
-		'\u9c90': []uint16{413},
-		'\u9c91': []uint16{125, 225},
-		'\u9c92': []uint16{413},
-		'\u9c94': []uint16{413},
-		'\u9c95': []uint16{413},
-		'\u9c9a': []uint16{413},
-		'\u9c9b': []uint16{413, 325, 227, 225, 125},
-		'\u9c9c': []uint16{230, 125, 100, 200, 400, 332, 330, 162, 325, 127, 152, 225, 331, 215, 115, 315, 227, 256, 252, 327, 204, 104, 120, 132, 317, 220, 129, 130},
-		'\u9c9e': []uint16{413, 225},
-		'\u9c9f': []uint16{225, 413, 125},
-		'\u9ca0': []uint16{225, 315, 100},
-		'\u9ca1': []uint16{225, 459},
-		'\u9ca2': []uint16{125, 225},
-		'\u9ca3': []uint16{125},
-		'\u9ca4': []uint16{426, 125, 132, 227, 225, 115, 327, 330, 130},
-		'\u9ca5': []uint16{125},
-		'\u9ca6': []uint16{459},
-		'\u9ca7': []uint16{413},
-		'\u9ca8': []uint16{125, 225, 232, 132, 325},
-		'\u9ca9': []uint16{413},
-		'\u9cab': []uint16{125, 215},
-		'\u9cad': []uint16{413},
-		'\u9cae': []uint16{125, 325},
-		'\u9cb0': []uint16{413},
-		'\u9cb1': []uint16{125},
-		'\u9cb2': []uint16{125},
-		'\u9cb3': []uint16{125, 225},
-		'\u9cb4': []uint16{413},
-		'\u9cb5': []uint16{225, 413},
-		'\u9cb6': []uint16{125},
-		'\u9cb7': []uint16{413, 125, 225},
-		'\u9cb8': []uint16{426, 227, 225, 125, 327, 152, 325, 315},
-		'\u9cba': []uint16{413},
-		'\u9cbb': []uint16{413},
-		'\u9cbc': []uint16{413},
-		'\u9cbd': []uint16{413},
-		'\u9cc3': []uint16{425, 152, 325},
-		'\u9cc4': []uint16{426, 225, 125},
-		'\u9cc5': []uint16{459, 225, 227},
-		'\u9cc6': []uint16{459},
-		'\u9cc7': []uint16{125, 426},
-		'\u9cca': []uint16{125},
-		'\u9ccc': []uint16{413, 227, 125, 225, 315, 130},
-		'\u9ccd': []uint16{225, 425, 325},
-		'\u9cce': []uint16{413, 225},
-		'\u9ccf': []uint16{125, 115},
-		'\u9cd0': []uint16{413, 227},
-		'\u9cd3': []uint16{413},
-		'\u9cd4': []uint16{425, 225, 125},
-		'\u9cd5': []uint16{125, 225},
-		'\u9cd6': []uint16{425, 225, 125, 215, 325},
-		'\u9cd7': []uint16{125, 426, 225, 252, 327},
-		'\u9cd8': []uint16{413},
-		'\u9cd9': []uint16{125},
-		'\u9cdc': []uint16{125},
-		'\u9cdd': []uint16{225, 125},
-		'\u9cde': []uint16{425, 125, 225, 315, 227, 327, 325, 115, 152, 262, 162},
-		'\u9cdf': []uint16{225, 125, 325},
-		'\u9ce2': []uint16{413, 225},
-		'\u9e1f': []uint16{425, 125, 225, 152, 227, 325, 315, 215, 115, 327, 132, 220, 320, 252, 232, 130, 127, 129},
-		'\u9e20': []uint16{127, 225, 229, 115, 315},
-		'\u9e21': []uint16{425, 125, 225, 230, 115, 325, 315, 130, 152, 252, 132, 215, 120, 330, 227, 332, 327, 320, 232, 352, 256, 129, 243, 331, 143},
-		'\u9e22': []uint16{125, 426, 225, 227},
-		'\u9e23': []uint16{252, 452, 227, 325, 152, 327, 454, 125, 215, 256, 225, 315, 115, 220, 156, 229, 130, 320, 330, 120, 129, 232, 238, 328, 128, 230, 228},
-		'\u9e25': []uint16{225, 426, 227, 327, 125},
-		'\u9e26': []uint16{125, 132, 225, 115, 332, 252, 320, 330, 130},
-		'\u9e28': []uint16{125, 225},
-		'\u9e29': []uint16{426, 125, 315},
-		'\u9e2a': []uint16{225, 459, 327, 325},
-		'\u9e2b': []uint16{413},
-		'\u9e2c': []uint16{125},
-		'\u9e2d': []uint16{426, 125, 225, 130, 330, 325, 232, 132, 252, 304, 315},
-		'\u9e2f': []uint16{225, 459, 227, 325, 215, 327},
-		'\u9e31': []uint16{413, 125},
-		'\u9e32': []uint16{413, 125},
-		'\u9e33': []uint16{125, 325, 327, 315},
-		'\u9e35': []uint16{125, 120, 225},
-		'\u9e36': []uint16{225, 459},
-		'\u9e37': []uint16{200, 125},
-		'\u9e38': []uint16{125},
-		'\u9e39': []uint16{459, 225},
-		'\u9e3a': []uint16{459},
-		'\u9e3d': []uint16{125, 225, 426, 227, 332, 232, 327, 132},
-		'\u9e3e': []uint16{427, 227, 125, 327, 315, 252, 115},
-		'\u9e3f': []uint16{327, 227, 427, 125, 426, 402, 225, 315, 228, 132, 332, 127, 115, 215, 130, 229, 325, 320, 129, 232},
-		'\u9e41': []uint16{125},
-		'\u9e42': []uint16{225, 427, 459, 327, 227},
-		'\u9e43': []uint16{227, 459, 225, 325, 320, 332, 327},
-		'\u9e44': []uint16{426, 227, 327, 225, 315, 320},
-		'\u9e45': []uint16{225, 425, 125, 232, 130, 325, 332, 330, 104, 132, 115, 327, 315, 320, 227, 120, 331},
-		'\u9e46': []uint16{459, 225},
-		'\u9e47': []uint16{225, 459},
-		'\u9e48': []uint16{125},
-		'\u9e49': []uint16{225, 325, 459, 227, 315},
-		'\u9e4a': []uint16{426, 225, 227, 130, 315, 125, 115, 330},
-		'\u9e4b': []uint16{225, 459},
-		'\u9e4c': []uint16{125},
-		'\u9e4e': []uint16{413},
-		'\u9e4f': []uint16{227, 427, 327, 225, 232, 132, 328, 228, 115, 330, 130},
-		'\u9e51': []uint16{225, 459, 115, 227},
-		'\u9e55': []uint16{459, 225},
-		'\u9e57': []uint16{426, 227},
-		'\u9e58': []uint16{230, 459, 315},
-		'\u9e5a': []uint16{225, 459},
-		'\u9e5b': []uint16{413},
-		'\u9e5c': []uint16{215, 426, 320, 225},
-		'\u9e5e': []uint16{426, 125, 225, 104, 227},
-		'\u9e63': []uint16{413},
-		'\u9e64': []uint16{425, 227, 225, 327, 130, 125, 115, 315, 232, 215, 129, 325, 132, 330, 320, 131, 228, 120, 331, 328},
-		'\u9e66': []uint16{125, 115, 327},
-		'\u9e67': []uint16{125},
-		'\u9e68': []uint16{413, 225},
-		'\u9e69': []uint16{459, 125, 225, 227},
-		'\u9e6a': []uint16{125},
-		'\u9e6b': []uint16{130, 425, 225, 327, 227},
-		'\u9e6c': []uint16{115, 125},
-		'\u9e6d': []uint16{426, 227, 225, 125, 130, 232, 327},
-		'\u9e70': []uint16{425, 227, 225, 125, 232, 327, 132, 325, 332, 130, 104, 129, 115, 330, 120, 229},
-		'\u9e71': []uint16{413},
-		'\u9e73': []uint16{225, 413, 130, 227, 332, 327},
-		'\u9e7e': []uint16{413},
-		'\u9e7f': []uint16{127, 425, 225, 125, 227, 327, 330, 130, 232, 332, 230, 427, 315, 229, 252, 115, 220, 129, 215},
-		'\u9e82': []uint16{125, 225},
-		'\u9e87': []uint16{413, 227},
-		'\u9e88': []uint16{413, 327},
-		'\u9e8b': []uint16{125},
-		'\u9e92': []uint16{125, 327, 130},
-		'\u9e93': []uint16{212, 225, 426, 227, 327, 331, 242, 230},
-		'\u9e9d': []uint16{125},
-		'\u9e9f': []uint16{227, 225, 426, 327, 315, 330, 325, 132, 129, 232, 115},
-		'\u9ea6': []uint16{225, 127, 125, 426, 230, 227, 130, 427, 132, 327, 129, 330, 229, 325, 332, 104, 152, 252, 331, 315, 232, 352, 143},
-		'\u9eb4': []uint16{427},
-		'\u9eb8': []uint16{125, 225},
-		'\u9ebb': []uint16{125, 225, 425, 127, 103, 152, 100, 325, 327, 362, 262, 227, 252, 115, 215, 320, 156, 162, 452, 130, 131, 200, 220, 104, 217, 331, 315, 330, 232, 352, 129, 230, 132, 332},
-		'\u9ebd': []uint16{459},
-		'\u9ebe': []uint16{125},
-		'\u9ec4': []uint16{125, 127, 130, 400, 143, 225, 325, 204, 262, 227, 117, 132, 128, 131, 327, 252, 315, 120, 330, 217, 320, 232, 162, 230, 115, 152, 229, 215, 332, 362, 331, 200, 104, 129, 352, 317},
-		'\u9ec9': []uint16{413},
-		'\u9ecd': []uint16{426, 225, 125},
-		'\u9ece': []uint16{230, 127, 143, 330, 417, 227, 130, 327, 125, 320, 331, 132, 131, 129, 228, 325, 332, 128},
-		'\u9ecf': []uint16{125, 400, 100, 152},
-		'\u9ed1': []uint16{125, 400, 130, 127, 262, 162, 252, 100, 230, 227, 104, 325, 330, 120, 229, 315, 129, 132, 225, 327, 115, 204, 220, 320, 131, 215, 200, 362, 232, 352, 331, 217, 332},
-		'\u9ed4': []uint16{417, 130, 217, 327, 131, 232, 117, 227, 125, 129, 115, 330, 332},
-		'\u9ed8': []uint16{200, 162, 362, 252, 207, 107, 329, 152, 452, 227, 327, 315, 100, 120, 115, 129, 320, 325, 330, 232, 127, 225, 229, 256, 156, 132, 131, 125, 101, 130},
-		'\u9edb': []uint16{127, 327, 426, 227, 225, 125, 129, 328},
-		'\u9edc': []uint16{252, 315, 152},
-		'\u9edd': []uint16{162, 262, 362, 125},
-		'\u9edf': []uint16{130, 413},
-		'\u9ee0': []uint16{200, 125, 413},
-		'\u9ee2': []uint16{262, 362, 402},
-		'\u9ee5': []uint16{413},
-		'\u9ee7': []uint16{162},
-		'\u9ee9': []uint16{315, 152},
-		'\u9eef': []uint16{162, 100, 115, 120, 103},
-		'\u9ef9': []uint16{225, 413},
-		'\u9efb': []uint16{413, 315},
-		'\u9efc': []uint16{413, 115},
-		'\u9efe': []uint16{459, 227, 152},
-		'\u9f0b': []uint16{130, 125},
-		'\u9f0d': []uint16{413},
-		'\u9f0e': []uint16{227, 426, 225, 162, 315, 327, 152, 252, 320, 232, 215, 115, 220, 130, 107, 125, 325, 120, 328, 332, 330, 230, 132},
-		'\u9f10': []uint16{227, 413, 327, 215},
-		'\u9f13': []uint16{152, 452, 125, 225, 252, 215, 315, 325, 362, 162, 220, 227, 330, 320, 327, 120, 262, 130, 425, 132, 115, 232, 156, 129, 100},
-		'\u9f17': []uint16{413, 227},
-		'\u9f19': []uint16{459},
-		'\u9f20': []uint16{225, 425, 125, 325, 315, 252, 227, 215, 426, 143, 115, 152, 220, 243, 120, 232},
-		'\u9f22': []uint16{125},
-		'\u9f2c': []uint16{225, 125},
-		'\u9f2f': []uint16{125},
-		'\u9f39': []uint16{125, 225},
-		'\u9f3b': []uint16{125, 426, 252, 227, 325, 152, 200, 315, 225, 230, 352, 320, 215, 142, 120, 220, 115, 330, 132, 332, 327, 204},
-		'\u9f3e': []uint16{125, 252, 152},
-		'\u9f44': []uint16{413},
-		'\u9f50': []uint16{400, 127, 207, 407, 100, 200, 152, 227, 243, 230, 125, 330, 132, 107, 327, 315, 252, 130, 328, 362, 262, 115, 215, 162, 229, 120, 320, 128, 104, 101, 331, 129, 452, 329, 220, 131, 352, 222},
-		'\u9f51': []uint16{413, 125},
-		'\u9f7f': []uint16{225, 425, 125, 215, 325, 252, 227, 315, 220, 204, 132, 320, 232, 120, 207, 332},
-		'\u9f80': []uint16{413, 227},
-		'\u9f83': []uint16{125},
-		'\u9f84': []uint16{225, 227, 426, 204, 325, 435, 352, 252, 327, 304, 356, 125, 331, 330, 228},
-		'\u9f85': []uint16{125},
-		'\u9f86': []uint16{413, 227},
-		'\u9f87': []uint16{452, 115},
-		'\u9f88': []uint16{225, 413, 325},
-		'\u9f89': []uint16{459, 225},
-		'\u9f8a': []uint16{459, 200, 215},
-		'\u9f8b': []uint16{125, 252},
-		'\u9f8c': []uint16{100, 315},
-		'\u9f99': []uint16{425, 125, 227, 330, 225, 127, 130, 230, 232, 327, 325, 132, 315, 332, 229, 115, 129, 215, 128, 320, 228, 331, 104, 131, 252, 217, 117, 220, 120, 243, 143},
-		'\u9f9a': []uint16{127, 427, 327, 227, 128, 130},
-		'\u9f9b': []uint16{426, 225},
-		'\u9f9f': []uint16{425, 225, 125, 130, 132, 327, 227, 152, 325, 120, 232, 330, 129, 426, 315},
-		'\u9fa0': []uint16{413},
-	}
-
 	positions = [...]string{"B", "E", "M", "S"}
 	poss      = [...]string{"a", "ad", "ag", "an", "b", "bg", "c", "d", "df", "dg", "e", "en", "f", "g", "h", "i", "in", "j", "jn", "k", "l", "ln", "m", "mg", "mq", "n", "ng", "nr", "nrfg", "nrt", "ns", "nt", "nz", "o", "p", "q", "qe", "qg", "r", "rg", "rr", "rz", "s", "t", "tg", "u", "ud", "ug", "uj", "ul", "uv", "uz", "v", "vd", "vg", "vi", "vn", "vq", "w", "x", "y", "yg", "z", "zg"}
 )
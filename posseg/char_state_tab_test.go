@@ -5,11 +5,11 @@ import (
 )
 
 func TestGet(t *testing.T) {
-	result := charStateTab.get('\u8000')
+	result := DefaultModel().charStates('\u8000')
 	if len(result) != 17 {
 		t.FailNow()
 	}
-	result = charStateTab.get('\uaaaa')
+	result = DefaultModel().charStates('\uaaaa')
 	if len(result) == 17 {
 		t.FailNow()
 	}
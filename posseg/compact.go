@@ -0,0 +1,150 @@
+package posseg
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/fumiama/jieba/util"
+)
+
+/*
+The compact format of a model is a binary encoding, which is embedded as
+model.bin for the default model. All integers are little endian, and a
+state is an uvarint:
+
+	magic    4 bytes "JBPM"
+	pos      uvarint count, and count times of uvarint length and bytes
+	start    uvarint count, and count times of state and float64
+	trans    uvarint count, and count times of
+	    from   state
+	    to     uvarint count, and count times of state and float64
+	unknown  uvarint count, and count times of state and float64
+	runes    uvarint count, and count times of
+	    rune   uvarint, the difference from the previous rune
+	    chars  uvarint count, and count times of state
+	    emits  uvarint count, and count times of state and float64
+
+Every state of the model has a trans entry, which may have no transition.
+*/
+const compactMagic = "JBPM"
+
+// writeCompact writes m to w in the compact format.
+func writeCompact(w io.Writer, m *Model) error {
+	cw := util.NewCompactWriter(w)
+	putProbs := func(probs map[uint16]float64) {
+		cw.Uvarint(uint64(len(probs)))
+		for _, state := range sortedStates(probs) {
+			cw.Uvarint(uint64(state))
+			cw.Float(probs[state])
+		}
+	}
+	cw.WriteString(compactMagic)
+	cw.Uvarint(uint64(len(m.poss)))
+	for _, pos := range m.poss {
+		cw.Uvarint(uint64(len(pos)))
+		cw.WriteString(pos)
+	}
+	putProbs(m.start)
+	cw.Uvarint(uint64(len(m.states)))
+	for _, state := range m.states {
+		cw.Uvarint(uint64(state))
+		putProbs(m.trans[state])
+	}
+	putProbs(m.unknown)
+	rs := &m.runes
+	cw.Uvarint(uint64(len(rs.runes)))
+	prev := rune(0)
+	for j, r := range rs.runes {
+		cw.Uvarint(uint64(r - prev))
+		prev = r
+		chars := rs.chars[rs.charOff[j]:rs.charOff[j+1]]
+		cw.Uvarint(uint64(len(chars)))
+		for _, state := range chars {
+			cw.Uvarint(uint64(state))
+		}
+		cw.Uvarint(uint64(rs.emitOff[j+1] - rs.emitOff[j]))
+		for k := rs.emitOff[j]; k < rs.emitOff[j+1]; k++ {
+			cw.Uvarint(uint64(rs.emitStates[k]))
+			cw.Float(rs.emitProbs[k])
+		}
+	}
+	return cw.Flush()
+}
+
+// compactReader decodes the compact format with the states checked.
+type compactReader struct {
+	*util.CompactReader
+	npos int // the number of POS, which a state must be in
+}
+
+func (r *compactReader) state() uint16 {
+	state := r.Uvarint()
+	if state < 100 || state >= 5*100 || int(state%100) >= r.npos {
+		r.Fail()
+		return 0
+	}
+	return uint16(state)
+}
+
+func (r *compactReader) probs() map[uint16]float64 {
+	n := r.Count(9)
+	probs := make(map[uint16]float64, n)
+	for i := 0; i < n; i++ {
+		state := r.state()
+		probs[state] = r.Float()
+	}
+	return probs
+}
+
+// readCompact decodes a model in the compact format from data.
+func readCompact(data []byte) (*Model, error) {
+	if !strings.HasPrefix(string(data), compactMagic) {
+		return nil, fmt.Errorf("%w: not a compact model", ErrInvalidModel)
+	}
+	r := &compactReader{CompactReader: util.NewCompactReader(data[len(compactMagic):],
+		fmt.Errorf("%w: corrupted compact model", ErrInvalidModel))}
+	m := &Model{poss: make([]string, r.Count(1))}
+	for i := range m.poss {
+		m.poss[i] = string(r.Bytes(r.Count(1)))
+	}
+	if len(m.poss) > maxPOS {
+		return nil, fmt.Errorf("%w: more than %d POS", ErrInvalidModel, maxPOS)
+	}
+	r.npos = len(m.poss)
+	m.start = r.probs()
+	m.states = make([]uint16, r.Count(2))
+	m.trans = make(map[uint16]probTransMap, len(m.states))
+	for i := range m.states {
+		m.states[i] = r.state()
+		m.trans[m.states[i]] = r.probs()
+	}
+	m.unknown = r.probs()
+	rs := &m.runes
+	n := r.Count(3)
+	rs.runes = make([]rune, n)
+	rs.charOff = make([]int32, 1, n+1)
+	rs.emitOff = make([]int32, 1, n+1)
+	prev := uint64(0)
+	for j := range rs.runes {
+		prev += r.Uvarint()
+		if prev > math.MaxInt32 {
+			r.Fail()
+		}
+		rs.runes[j] = rune(prev)
+		for k := r.Count(1); k > 0; k-- {
+			rs.chars = append(rs.chars, r.state())
+		}
+		rs.charOff = append(rs.charOff, int32(len(rs.chars)))
+		for k := r.Count(9); k > 0; k-- {
+			rs.emitStates = append(rs.emitStates, r.state())
+			rs.emitProbs = append(rs.emitProbs, r.Float())
+		}
+		rs.emitOff = append(rs.emitOff, int32(len(rs.emitStates)))
+	}
+	if err := r.End(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
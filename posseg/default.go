@@ -0,0 +1,14 @@
+package posseg
+
+import "github.com/fumiama/jieba/dict"
+
+// NewDefault creates a Segmenter with the embedded default dictionary,
+// see package dict.
+func NewDefault() (*Segmenter, error) {
+	file, err := dict.Open(dict.Default)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadDictionary(file)
+}
@@ -0,0 +1,91 @@
+package posseg
+
+import "sort"
+
+/*
+runeStates has the char states and the emission probabilities of the
+runes in dense arrays. The char states of runes[j] are
+chars[charOff[j]:charOff[j+1]], and its emissions are the states
+emitStates[emitOff[j]:emitOff[j+1]] in order with the probabilities at
+the same indexes of emitProbs.
+*/
+type runeStates struct {
+	runes      []rune // the runes in order
+	charOff    []int32
+	chars      []uint16
+	emitOff    []int32
+	emitStates []uint16
+	emitProbs  []float64
+}
+
+// newRuneStates creates a runeStates of the char states keyed by rune and
+// the emission probabilities keyed by state and rune.
+func newRuneStates(charState map[rune][]uint16, emit map[uint16]map[rune]float64) runeStates {
+	type stateProb struct {
+		state uint16
+		prob  float64
+	}
+	emitted := make(map[rune][]stateProb)
+	for state, probs := range emit {
+		for r, p := range probs {
+			emitted[r] = append(emitted[r], stateProb{state, p})
+		}
+	}
+	var rs runeStates
+	for r := range charState {
+		rs.runes = append(rs.runes, r)
+	}
+	for r := range emitted {
+		if _, ok := charState[r]; !ok {
+			rs.runes = append(rs.runes, r)
+		}
+	}
+	sort.Slice(rs.runes, func(i, j int) bool { return rs.runes[i] < rs.runes[j] })
+	rs.charOff = make([]int32, 1, len(rs.runes)+1)
+	rs.emitOff = make([]int32, 1, len(rs.runes)+1)
+	for _, r := range rs.runes {
+		rs.chars = append(rs.chars, charState[r]...)
+		rs.charOff = append(rs.charOff, int32(len(rs.chars)))
+		sps := emitted[r]
+		sort.Slice(sps, func(i, j int) bool { return sps[i].state < sps[j].state })
+		for _, sp := range sps {
+			rs.emitStates = append(rs.emitStates, sp.state)
+			rs.emitProbs = append(rs.emitProbs, sp.prob)
+		}
+		rs.emitOff = append(rs.emitOff, int32(len(rs.emitStates)))
+	}
+	return rs
+}
+
+// index returns the index of r in runes, or -1 if r is missing.
+func (rs *runeStates) index(r rune) int {
+	j := sort.Search(len(rs.runes), func(j int) bool { return rs.runes[j] >= r })
+	if j < len(rs.runes) && rs.runes[j] == r {
+		return j
+	}
+	return -1
+}
+
+// charStates returns the char states of r, or false if r has none.
+func (rs *runeStates) charStates(r rune) ([]uint16, bool) {
+	j := rs.index(r)
+	if j < 0 || rs.charOff[j] == rs.charOff[j+1] {
+		return nil, false
+	}
+	return rs.chars[rs.charOff[j]:rs.charOff[j+1]], true
+}
+
+// emitProb returns the log probability of state emitting r, or false if
+// it is missing.
+func (rs *runeStates) emitProb(state uint16, r rune) (float64, bool) {
+	j := rs.index(r)
+	if j < 0 {
+		return 0, false
+	}
+	states := rs.emitStates[rs.emitOff[j]:rs.emitOff[j+1]]
+	k := sort.Search(len(states), func(k int) bool { return states[k] >= state })
+	if k < len(states) && states[k] == state {
+		return rs.emitProbs[int(rs.emitOff[j])+k], true
+	}
+	return 0, false
+}
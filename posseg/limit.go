@@ -0,0 +1,39 @@
+package posseg
+
+import (
+	"context"
+
+	"github.com/fumiama/jieba/util"
+)
+
+// Limits are the limits of CutContext, a zero field means no limit.
+type Limits = util.Limits
+
+// LimitError is returned by CutContext when the sentence exceeds Limits.
+type LimitError = util.LimitError
+
+// SetLimits sets the limits of CutContext. It should not be called
+// concurrently with cutting.
+func (seg *Segmenter) SetLimits(limits Limits) {
+	seg.limits = limits
+}
+
+// Limits returns the limits of CutContext.
+func (seg *Segmenter) Limits() Limits {
+	return seg.limits
+}
+
+/*
+CutContext is like Cut, but it checks ctx between blocks and returns
+ctx.Err() once ctx is done. It returns a *LimitError if the sentence is
+longer than MaxInputLength bytes, or more than MaxHMMRun consecutive
+runes not in dictionary would be cut by the Hidden Markov Model at once.
+No segments are returned with an error.
+
+Cut and the other functions ignore the limits.
+*/
+func (seg *Segmenter) CutContext(ctx context.Context, sentence string, hmm bool) ([]Segment, error) {
+	return util.Limit(ctx, seg.limits, len(sentence), func(l *util.Limiter) []Segment {
+		return seg.cut(seg.Dictionary(), sentence, hmm, l)
+	})
+}
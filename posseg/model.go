@@ -0,0 +1,419 @@
+package posseg
+
+import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/fumiama/jieba/util"
+)
+
+const minFloat = -3.14e100
+
+// maxPOS is the maximum number of POS of a model, because a state is
+// encoded as position*100 plus the index of its POS.
+const maxPOS = 100
+
+// ErrInvalidModel is returned when loading a model in wrong format.
+var ErrInvalidModel = errors.New("posseg: invalid model")
+
+/*
+Model is a Hidden Markov Model used to cut and tag the consecutive
+Chinese characters not in dictionary. A state is a position in word, one
+of B, M, E and S, with a POS. The model has the log probabilities of the
+start states, the transitions between states and the emissions of runes
+from states, and the states which each rune has been seen with. A
+missing transition means it never happens.
+
+A Model is read-only after loading, so it can be shared by any number of
+cuts concurrently.
+
+The text format of a model has one record per line, the fields are
+separated by whitespaces, and empty lines and lines beginning with '#'
+are ignored:
+
+	pos POS...
+	start STATE LOGP
+	trans FROM TO LOGP
+	emit STATE RUNE LOGP
+	unknown STATE LOGP
+	char RUNE STATE...
+
+where STATE, FROM and TO are a position and a POS joined by '-', such as
+B-n, RUNE is a single character, and LOGP is the natural logarithm of
+the probability. The optional pos lines list the POS in order, the other
+POS are appended in order of appearance, and there are at most 100 POS.
+An unknown line gives the probability to emit a rune missing in the emit
+lines of the state, which is almost impossible if not given. A rune
+without char line may be in any state. For example:
+
+	pos a ad ag an b
+	start B-a -4.762305214596967
+	trans B-a E-a -0.0050648453069648755
+	emit E-a 好 -3.0510987225002293
+	char 好 B-a E-a S-a
+*/
+type Model struct {
+	poss    []string
+	start   map[uint16]float64
+	trans   map[uint16]probTransMap
+	unknown map[uint16]float64
+	runes   runeStates
+	states  []uint16 // all the states, for the runes without char states
+}
+
+// modelData is the model trained by the original Jieba in the compact
+// format, which is decoded by DefaultModel on first use. It is generated
+// from model.txt in the text format.
+//
+//go:generate go run ./internal/gen
+//go:embed model.bin
+var modelData []byte
+
+var (
+	defaultModel     *Model
+	defaultModelOnce sync.Once
+)
+
+// DefaultModel returns the built-in model trained by the original Jieba,
+// which is used by a Segmenter by default. It is decoded on the first
+// call.
+func DefaultModel() *Model {
+	defaultModelOnce.Do(func() {
+		var err error
+		if defaultModel, err = readCompact(modelData); err != nil {
+			panic(err)
+		}
+	})
+	return defaultModel
+}
+
+// SetHMMModel sets the Hidden Markov Model used to cut and tag the
+// consecutive Chinese characters not in dictionary, nil for DefaultModel.
+// It should not be called concurrently with cutting.
+func (seg *Segmenter) SetHMMModel(model *Model) {
+	seg.model = model
+}
+
+// HMMModel returns the Hidden Markov Model used to cut and tag the
+// consecutive Chinese characters not in dictionary.
+func (seg *Segmenter) HMMModel() *Model {
+	if seg.model == nil {
+		return DefaultModel()
+	}
+	return seg.model
+}
+
+// POS returns the POS of the model in order.
+func (m *Model) POS() []string {
+	return append([]string(nil), m.poss...)
+}
+
+// pos returns the POS of t.
+func (m *Model) pos(t tag) string {
+	return m.poss[t%100]
+}
+
+// charStates returns the states which r may be in.
+func (m *Model) charStates(r rune) []uint16 {
+	if states, ok := m.runes.charStates(r); ok {
+		return states
+	}
+	return m.states
+}
+
+// emitProb returns the log probability to emit r from state.
+func (m *Model) emitProb(state uint16, r rune) float64 {
+	if p, ok := m.runes.emitProb(state, r); ok {
+		return p
+	}
+	if p, ok := m.unknown[state]; ok {
+		return p
+	}
+	return minFloat
+}
+
+// stateName returns the name of state in the text format, such as B-n.
+func (m *Model) stateName(state uint16) string {
+	return tag(state).position() + "-" + m.pos(tag(state))
+}
+
+// modelBuilder collects the records of a model, and assigns the index of
+// every POS in order of appearance.
+type modelBuilder struct {
+	m         *Model
+	emit      map[uint16]map[rune]float64
+	charState map[rune][]uint16
+	index     map[string]uint16
+	seen      map[uint16]struct{}
+}
+
+func newModelBuilder() *modelBuilder {
+	return &modelBuilder{
+		m: &Model{
+			start:   make(map[uint16]float64),
+			trans:   make(map[uint16]probTransMap),
+			unknown: make(map[uint16]float64),
+		},
+		emit:      make(map[uint16]map[rune]float64),
+		charState: make(map[rune][]uint16),
+		index:     make(map[string]uint16),
+		seen:      make(map[uint16]struct{}),
+	}
+}
+
+// addPOS returns the index of pos, which is added if it is new.
+func (b *modelBuilder) addPOS(pos string) (uint16, bool) {
+	if i, ok := b.index[pos]; ok {
+		return i, true
+	}
+	if len(b.m.poss) >= maxPOS {
+		return 0, false
+	}
+	i := uint16(len(b.m.poss))
+	b.m.poss = append(b.m.poss, pos)
+	b.index[pos] = i
+	return i, true
+}
+
+// state returns the state of a position and a POS.
+func (b *modelBuilder) state(position byte, pos string) (uint16, bool) {
+	p := strings.IndexByte("BEMS", position)
+	if p < 0 || len(pos) == 0 {
+		return 0, false
+	}
+	i, ok := b.addPOS(pos)
+	if !ok {
+		return 0, false
+	}
+	state := uint16(p+1)*100 + i
+	b.seen[state] = struct{}{}
+	return state, true
+}
+
+// parseState returns the state named s, such as B-n.
+func (b *modelBuilder) parseState(s string) (uint16, bool) {
+	if len(s) < 3 || s[1] != '-' {
+		return 0, false
+	}
+	return b.state(s[0], s[2:])
+}
+
+// build completes the model, in which every state has a start
+// probability and transitions.
+func (b *modelBuilder) build() *Model {
+	m := b.m
+	m.runes = newRuneStates(b.charState, b.emit)
+	m.states = make([]uint16, 0, len(b.seen))
+	for state := range b.seen {
+		m.states = append(m.states, state)
+	}
+	sort.Slice(m.states, func(i, j int) bool { return m.states[i] < m.states[j] })
+	for _, state := range m.states {
+		if _, ok := m.start[state]; !ok {
+			m.start[state] = minFloat
+		}
+		if _, ok := m.trans[state]; !ok {
+			m.trans[state] = make(probTransMap)
+		}
+	}
+	return m
+}
+
+// parseRune returns the single rune of s.
+func parseRune(s string) (rune, bool) {
+	r, size := utf8.DecodeRuneInString(s)
+	return r, r != utf8.RuneError && size == len(s)
+}
+
+// LoadModel reads a model in the text format from r.
+func LoadModel(r io.Reader) (*Model, error) {
+	b := newModelBuilder()
+	m := b.m
+	hasStart := false
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		fields := strings.Fields(line)
+		switch fields[0] {
+		case "pos":
+			for _, pos := range fields[1:] {
+				if _, ok := b.addPOS(pos); !ok {
+					return nil, util.LineError(ErrInvalidModel, n, line)
+				}
+			}
+			continue
+		case "char":
+			if len(fields) < 3 {
+				return nil, util.LineError(ErrInvalidModel, n, line)
+			}
+			r, ok := parseRune(fields[1])
+			if !ok {
+				return nil, util.LineError(ErrInvalidModel, n, line)
+			}
+			states := make([]uint16, 0, len(fields)-2)
+			for _, field := range fields[2:] {
+				state, ok := b.parseState(field)
+				if !ok {
+					return nil, util.LineError(ErrInvalidModel, n, line)
+				}
+				states = append(states, state)
+			}
+			b.charState[r] = states
+			continue
+		}
+		logp, err := strconv.ParseFloat(fields[len(fields)-1], 64)
+		if err != nil || len(fields) < 3 {
+			return nil, util.LineError(ErrInvalidModel, n, line)
+		}
+		state, ok := b.parseState(fields[1])
+		if !ok {
+			return nil, util.LineError(ErrInvalidModel, n, line)
+		}
+		switch {
+		case fields[0] == "start" && len(fields) == 3:
+			m.start[state] = logp
+			hasStart = true
+		case fields[0] == "trans" && len(fields) == 4:
+			to, ok := b.parseState(fields[2])
+			if !ok {
+				return nil, util.LineError(ErrInvalidModel, n, line)
+			}
+			if m.trans[state] == nil {
+				m.trans[state] = make(probTransMap)
+			}
+			m.trans[state][to] = logp
+		case fields[0] == "emit" && len(fields) == 4:
+			r, ok := parseRune(fields[2])
+			if !ok {
+				return nil, util.LineError(ErrInvalidModel, n, line)
+			}
+			if b.emit[state] == nil {
+				b.emit[state] = make(map[rune]float64)
+			}
+			b.emit[state][r] = logp
+		case fields[0] == "unknown" && len(fields) == 3:
+			m.unknown[state] = logp
+		default:
+			return nil, util.LineError(ErrInvalidModel, n, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if !hasStart || len(b.emit) == 0 {
+		return nil, fmt.Errorf("%w: no start or emit probability", ErrInvalidModel)
+	}
+	return b.build(), nil
+}
+
+// LoadModelAt reads a model in the text format from the given file name.
+func LoadModelAt(file string) (*Model, error) {
+	return util.ReadFile(file, LoadModel)
+}
+
+// sortedStates returns the keys of a map keyed by state in order.
+func sortedStates[V any](m map[uint16]V) []uint16 {
+	states := make([]uint16, 0, len(m))
+	for state := range m {
+		states = append(states, state)
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i] < states[j] })
+	return states
+}
+
+// Save writes m to w in the text format read by LoadModel.
+func (m *Model) Save(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	formatFloat := func(f float64) string {
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	fmt.Fprintf(bw, "pos %s\n", strings.Join(m.poss, " "))
+	for _, state := range sortedStates(m.start) {
+		fmt.Fprintf(bw, "start %s %s\n", m.stateName(state), formatFloat(m.start[state]))
+	}
+	for _, from := range sortedStates(m.trans) {
+		trans := m.trans[from]
+		for _, to := range sortedStates(trans) {
+			fmt.Fprintf(bw, "trans %s %s %s\n", m.stateName(from), m.stateName(to), formatFloat(trans[to]))
+		}
+	}
+	rs := &m.runes
+	for _, r := range rs.runes {
+		if unicode.IsSpace(r) {
+			return fmt.Errorf("%w: cannot save rune %q", ErrInvalidModel, r)
+		}
+	}
+	emit := make(map[uint16][]int32) // the indexes in emitStates of every state
+	for k, state := range rs.emitStates {
+		emit[state] = append(emit[state], int32(k))
+	}
+	for _, state := range sortedStates(emit) {
+		j := 0
+		for _, k := range emit[state] {
+			for rs.emitOff[j+1] <= k {
+				j++
+			}
+			fmt.Fprintf(bw, "emit %s %c %s\n", m.stateName(state), rs.runes[j], formatFloat(rs.emitProbs[k]))
+		}
+	}
+	for _, state := range sortedStates(m.unknown) {
+		fmt.Fprintf(bw, "unknown %s %s\n", m.stateName(state), formatFloat(m.unknown[state]))
+	}
+	for j, r := range rs.runes {
+		if rs.charOff[j] == rs.charOff[j+1] {
+			continue
+		}
+		fmt.Fprintf(bw, "char %c", r)
+		for _, state := range rs.chars[rs.charOff[j]:rs.charOff[j+1]] {
+			fmt.Fprintf(bw, " %s", m.stateName(state))
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// SaveAt writes m to the given file in the text format read by LoadModelAt.
+func (m *Model) SaveAt(file string) error {
+	return util.WriteFile(file, m.Save)
+}
+
+// LoadCompactModel reads a model in the compact format written by
+// SaveCompact from r.
+func LoadCompactModel(r io.Reader) (*Model, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return readCompact(data)
+}
+
+// LoadCompactModelAt reads a model in the compact format from the given
+// file name.
+func LoadCompactModelAt(file string) (*Model, error) {
+	return util.ReadFile(file, LoadCompactModel)
+}
+
+// SaveCompact writes m to w in a compact binary format, which is smaller
+// and faster to load than the text format, and read by LoadCompactModel.
+func (m *Model) SaveCompact(w io.Writer) error {
+	return writeCompact(w, m)
+}
+
+// SaveCompactAt writes m to the given file in the compact format read by
+// LoadCompactModelAt.
+func (m *Model) SaveCompactAt(file string) error {
+	return util.WriteFile(file, m.SaveCompact)
+}
@@ -0,0 +1,111 @@
+package posseg
+
+import (
+	"math"
+	"strings"
+
+	"github.com/fumiama/jieba/dictionary"
+	"github.com/fumiama/jieba/internal/nbest"
+	"github.com/fumiama/jieba/util"
+)
+
+// Candidate is a candidate segmentation with its score.
+type Candidate struct {
+	segments []Segment
+	score    float64
+}
+
+// Segments returns the segments of the candidate.
+func (c Candidate) Segments() []Segment {
+	return c.segments
+}
+
+// Score returns the log-probability of the candidate, which is the sum of
+// log(frequency/total) of all words chosen from the DAG.
+func (c Candidate) Score() float64 {
+	return c.score
+}
+
+// cutNBest returns at most k candidates of a block of Chinese characters,
+// candidates with the same segments are merged into the best one.
+func (seg *Segmenter) cutNBest(d *Dictionary, blk string, k int, hmm bool) []Candidate {
+	runes := []rune(blk)
+	logTotal := d.LogTotal()
+	rs := nbest.Calc(d.DAG(runes), k, func(e dictionary.Edge) float64 {
+		return math.Log(e.Frequency) - logTotal
+	})
+	candidates := make([]Candidate, 0, len(rs[0]))
+	seen := make(map[string]struct{}, len(rs[0]))
+	routes := make([]*route, len(runes)+1)
+	var key strings.Builder
+	for rank := range rs[0] {
+		nbest.Walk(rs, rank, func(x int, r nbest.Route) {
+			routes[x] = &route{frequency: r.Score, index: r.Index}
+		})
+		var segments []Segment
+		if hmm {
+			segments = seg.cutRoutes(d, runes, routes, nil)
+		} else {
+			segments = seg.cutRoutesNoHMM(d, runes, routes)
+		}
+		key.Reset()
+		for _, s := range segments {
+			key.WriteString(s.text)
+			key.WriteByte(0)
+			key.WriteString(s.pos)
+			key.WriteByte(0)
+		}
+		if _, ok := seen[key.String()]; ok {
+			continue
+		}
+		seen[key.String()] = struct{}{}
+		candidates = append(candidates, Candidate{segments: segments, score: rs[0][rank].Score})
+	}
+	return candidates
+}
+
+// mergeCandidates joins every candidate of a with every candidate of b,
+// and keeps at most k best ones.
+func mergeCandidates(a, b []Candidate, k int) []Candidate {
+	return nbest.Merge(a, b, k, func(x, y Candidate) Candidate {
+		segments := make([]Segment, 0, len(x.segments)+len(y.segments))
+		segments = append(append(segments, x.segments...), y.segments...)
+		return Candidate{segments: segments, score: x.score + y.score}
+	}, Candidate.Score)
+}
+
+/*
+CutNBest cuts a sentence into at most k candidate segmentations with POS,
+ranked by their scores in descending order, so the first candidate is
+the same as the result of Cut.
+
+The candidates are the k best routes of the DAG, scored by the same
+frequency model as Cut. Parameter hmm controls whether to use the Hidden
+Markov Model, which does not change the scores.
+*/
+func (seg *Segmenter) CutNBest(sentence string, k int, hmm bool) []Candidate {
+	if k <= 0 {
+		return nil
+	}
+	d := seg.Dictionary()
+	candidates := []Candidate{{}}
+	appendSegments := func(segments ...Segment) {
+		for i := range candidates {
+			candidates[i].segments = append(candidates[i].segments, segments...)
+		}
+	}
+	seg.splitProtected(sentence, func(part string, pos string, protected bool) {
+		if protected {
+			appendSegments(Segment{part, pos})
+			return
+		}
+		for _, blk := range util.RegexpSplit(seg.blocks.Han, part, -1) {
+			if seg.blocks.Han.MatchString(blk) {
+				candidates = mergeCandidates(candidates, seg.cutNBest(d, blk, k, hmm), k)
+				continue
+			}
+			appendSegments(seg.appendSkipped(nil, blk)...)
+		}
+	})
+	return candidates
+}
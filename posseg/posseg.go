@@ -5,7 +5,9 @@ import (
 	"io"
 	"math"
 	"regexp"
+	"strings"
 
+	"github.com/fumiama/jieba/dictionary"
 	"github.com/fumiama/jieba/util"
 )
 
@@ -34,48 +36,174 @@ func (s Segment) Pos() string {
 	return s.pos
 }
 
-// Segmenter is a Chinese words segmentation struct.
-type Segmenter Dictionary
+/*
+Segmenter is a Chinese words segmentation struct.
+
+The dictionary of a Segmenter is a snapshot which is replaced atomically
+when loading user dictionaries or reloading, so every cut sees one
+consistent version of dictionary without waiting for the loading.
+*/
+type Segmenter struct {
+	shared    *dictionary.Shared
+	blocks    BlockPatterns
+	limits    Limits
+	model     *Model
+	patterns  []ProtectedPattern
+	protected *regexp.Regexp // patterns joined, nil if no pattern
+	groups    []int          // group index of every pattern in protected
+}
+
+// NewSegmenter creates a Segmenter using the shared dictionary, every
+// change to the dictionary applies to all segmenters sharing it.
+func NewSegmenter(shared *dictionary.Shared) *Segmenter {
+	return &Segmenter{shared: shared, blocks: DefaultBlockPatterns}
+}
+
+// Shared returns the shared dictionary of Segmenter, which can be used
+// to create segmenters of jieba, analyse and tokenizers.
+func (seg *Segmenter) Shared() *dictionary.Shared {
+	return seg.shared
+}
+
+// Dictionary returns the current dictionary snapshot of Segmenter.
+func (seg *Segmenter) Dictionary() *Dictionary {
+	return seg.shared.Dictionary()
+}
+
+// AddWord adds a new word with frequency and POS to dictionary. It
+// copies the whole dictionary, use Shared().Edit to add many words with
+// one copy.
+func (seg *Segmenter) AddWord(word string, frequency float64, pos string) {
+	seg.shared.AddToken(dictionary.NewToken(word, frequency, pos))
+}
+
+// DeleteWord removes a word from dictionary
+func (seg *Segmenter) DeleteWord(word string) {
+	seg.shared.DeleteToken(word)
+}
+
+/*
+SuggestFrequency returns a suggested frequncy of a word or a long word
+cutted into several short words, see jieba.Segmenter.SuggestFrequency.
+*/
+func (seg *Segmenter) SuggestFrequency(words ...string) float64 {
+	return seg.suggestFrequency(seg.Dictionary(), false, words...)
+}
+
+/*
+TuneFrequency is like SuggestFrequency, but also sets the suggested
+frequency to the word in dictionary, so that the following cuts keep the
+word as a single word, or cut it into the given words. The POS of the
+word is kept unchanged.
+*/
+func (seg *Segmenter) TuneFrequency(words ...string) float64 {
+	frequency := seg.suggestFrequency(seg.Dictionary(), true, words...)
+	seg.AddWord(strings.Join(words, ""), frequency, "")
+	return frequency
+}
+
+// suggestFrequency suggests the frequency of words in d. If strict is set,
+// the frequency for cutting a word is strictly lower than the product of
+// the given words, otherwise the route of the whole word may win by a tie.
+func (seg *Segmenter) suggestFrequency(d *Dictionary, strict bool, words ...string) float64 {
+	total := d.Total()
+	frequency := 1.0
+	if len(words) > 1 {
+		for _, word := range words {
+			if freq, ok := d.Frequency(word); ok {
+				frequency *= freq
+			}
+			frequency /= total
+		}
+		if strict {
+			frequency = math.Max(math.Ceil(frequency*total)-1, 0)
+		} else {
+			frequency, _ = math.Modf(frequency * total)
+		}
+		wordFreq := 0.0
+		if freq, ok := d.Frequency(strings.Join(words, "")); ok {
+			wordFreq = freq
+		}
+		if wordFreq < frequency {
+			frequency = wordFreq
+		}
+		return frequency
+	}
+	word := words[0]
+	for _, segment := range seg.cut(d, word, false, nil) {
+		if freq, ok := d.Frequency(segment.text); ok {
+			frequency *= freq
+		}
+		frequency /= total
+	}
+	frequency, _ = math.Modf(frequency * total)
+	frequency += 1.0
+	wordFreq := 1.0
+	if freq, ok := d.Frequency(word); ok {
+		wordFreq = freq
+	}
+	if wordFreq > frequency {
+		frequency = wordFreq
+	}
+	return frequency
+}
 
 // LoadDictionary loads dictionary from given file name.
 // Everytime LoadDictionaryAt is called, previously loaded dictionary will be cleard.
 func LoadDictionary(file io.Reader) (*Segmenter, error) {
-	dict := &Dictionary{freqMap: make(map[string]float64), posMap: make(map[string]string)}
-	err := dict.loadDictionary(file)
+	shared, err := dictionary.LoadShared(file)
 	if err != nil {
 		return nil, err
 	}
-	return (*Segmenter)(dict), nil
+	return NewSegmenter(shared), nil
 }
 
 // LoadDictionaryAt loads dictionary from given file name.
 // Everytime LoadDictionaryAt is called, previously loaded dictionary will be cleard.
 func LoadDictionaryAt(file string) (*Segmenter, error) {
-	dict := &Dictionary{freqMap: make(map[string]float64), posMap: make(map[string]string)}
-	err := dict.loadDictionaryAt(file)
+	shared, err := dictionary.LoadSharedAt(file)
 	if err != nil {
 		return nil, err
 	}
-	return (*Segmenter)(dict), nil
+	return NewSegmenter(shared), nil
 }
 
 // LoadUserDictionary loads a user specified dictionary, it must be called
 // after LoadDictionary, and it will not clear any previous loaded dictionary,
 // instead it will override exist entries.
+//
+// The words are loaded into a copy of current dictionary, which replaces
+// the current one only if the whole file is loaded successfully.
 func (seg *Segmenter) LoadUserDictionary(file io.Reader) error {
-	return (*Dictionary)(seg).loadDictionary(file)
+	return seg.shared.LoadUserDictionary(file)
 }
 
 // LoadUserDictionaryAt loads a user specified dictionary, it must be called
 // after LoadDictionary, and it will not clear any previous loaded dictionary,
 // instead it will override exist entries.
+//
+// The words are loaded into a copy of current dictionary, which replaces
+// the current one only if the whole file is loaded successfully.
 func (seg *Segmenter) LoadUserDictionaryAt(fileName string) error {
-	return (*Dictionary)(seg).loadDictionaryAt(fileName)
+	return seg.shared.LoadUserDictionaryAt(fileName)
+}
+
+// SaveDictionary writes all words in dictionary to w in the text format,
+// which can be loaded again by LoadDictionary.
+func (seg *Segmenter) SaveDictionary(w io.Writer) error {
+	return dictionary.WriteDictionary(w, seg.Dictionary().Tokens())
+}
+
+// SaveDictionaryAt writes all words in dictionary to the given file in the
+// text format, which can be loaded again by LoadDictionaryAt.
+func (seg *Segmenter) SaveDictionaryAt(file string) error {
+	return dictionary.WriteDictionaryAt(file, seg.Dictionary().Tokens())
 }
 
 func (seg *Segmenter) cutDetailInternal(sentence string) (results []Segment) {
 	runes := []rune(sentence)
-	posList := viterbi(runes)
+	m := seg.HMMModel()
+	posList := m.viterbi(runes)
 	begin := 0
 	next := 0
 	for i, char := range runes {
@@ -84,15 +212,15 @@ func (seg *Segmenter) cutDetailInternal(sentence string) (results []Segment) {
 		case "B":
 			begin = i
 		case "E":
-			results = append(results, Segment{string(runes[begin : i+1]), pos.pos()})
+			results = append(results, Segment{string(runes[begin : i+1]), m.pos(pos)})
 			next = i + 1
 		case "S":
-			results = append(results, Segment{string(char), pos.pos()})
+			results = append(results, Segment{string(char), m.pos(pos)})
 			next = i + 1
 		}
 	}
 	if next < len(runes) {
-		results = append(results, Segment{string(runes[next:]), posList[next].pos()})
+		results = append(results, Segment{string(runes[next:]), m.pos(posList[next])})
 	}
 	return
 }
@@ -120,52 +248,20 @@ func (seg *Segmenter) cutDetail(sentence string) (results []Segment) {
 	return
 }
 
-func (seg *Segmenter) dag(runes []rune) [][]int {
-	n := len(runes)
-	dag := make([][]int, n)
-	for k := 0; k < n; k++ {
-		dag[k] = make([]int, 0, 64)
-		i := k
-		frag := runes[k : k+1]
-		for {
-			freq, ok := (*Dictionary)(seg).Frequency(string(frag))
-			if !ok {
-				break
-			}
-			if freq > 0.0 {
-				dag[k] = append(dag[k], i)
-			}
-			i++
-			if i >= n {
-				break
-			}
-			frag = runes[k : i+1]
-		}
-		if len(dag[k]) == 0 {
-			dag[k] = append(dag[k], k)
-		}
-	}
-	return dag
-}
-
 type route struct {
 	frequency float64
 	index     int
 }
 
-func (seg *Segmenter) calc(runes []rune) []*route {
-	dag := seg.dag(runes)
+func calc(d *Dictionary, runes []rune) []*route {
+	dag := d.DAG(runes)
+	logTotal := d.LogTotal()
 	n := len(runes)
 	rs := make([]*route, n+1)
 	rs[n] = &route{frequency: 0.0, index: 0}
 	for idx := n - 1; idx >= 0; idx-- {
-		for _, i := range dag[idx] {
-			var r *route
-			if freq, ok := (*Dictionary)(seg).Frequency(string(runes[idx : i+1])); ok {
-				r = &route{frequency: math.Log(freq) - (*Dictionary)(seg).logTotal + rs[i+1].frequency, index: i}
-			} else {
-				r = &route{frequency: math.Log(1.0) - (*Dictionary)(seg).logTotal + rs[i+1].frequency, index: i}
-			}
+		for _, e := range dag[idx] {
+			r := &route{frequency: math.Log(e.Frequency) - logTotal + rs[e.Index+1].frequency, index: e.Index}
 			if v := rs[idx]; v == nil {
 				rs[idx] = r
 			} else {
@@ -178,9 +274,15 @@ func (seg *Segmenter) calc(runes []rune) []*route {
 	return rs
 }
 
-func (seg *Segmenter) cutDAG(sentence string) (results []Segment) {
+func (seg *Segmenter) cutDAG(d *Dictionary, sentence string, l *util.Limiter) []Segment {
 	runes := []rune(sentence)
-	routes := seg.calc(runes)
+	return seg.cutRoutes(d, runes, calc(d, runes), l)
+}
+
+// cutRoutes cuts runes along routes, and cuts the consecutive single runes
+// not in dictionary again by the Hidden Markov Model, the runes are kept
+// as they are if l stops.
+func (seg *Segmenter) cutRoutes(d *Dictionary, runes []rune, routes []*route, l *util.Limiter) (results []Segment) {
 	buf := make([]rune, 0, 256)
 	for x := 0; x < len(runes); {
 		y := routes[x].index + 1
@@ -193,7 +295,7 @@ func (seg *Segmenter) cutDAG(sentence string) (results []Segment) {
 		if len(buf) > 0 {
 			bufString := string(buf)
 			if len(buf) == 1 {
-				if tag, ok := (*Dictionary)(seg).Pos(bufString); ok {
+				if tag, ok := d.Pos(bufString); ok {
 					results = append(results, Segment{bufString, tag})
 				} else {
 					results = append(results, Segment{bufString, "x"})
@@ -201,12 +303,12 @@ func (seg *Segmenter) cutDAG(sentence string) (results []Segment) {
 				buf = buf[:0]
 				continue
 			}
-			if v, ok := (*Dictionary)(seg).Frequency(bufString); !ok || v == 0.0 {
+			if v, ok := d.Frequency(bufString); (!ok || v == 0.0) && l.Run(len(buf)) {
 				results = append(results, seg.cutDetail(bufString)...)
 			} else {
 				for _, elem := range buf {
 					selem := string(elem)
-					if tag, ok := (*Dictionary)(seg).Pos(selem); ok {
+					if tag, ok := d.Pos(selem); ok {
 						results = append(results, Segment{selem, tag})
 					} else {
 						results = append(results, Segment{selem, "x"})
@@ -216,7 +318,7 @@ func (seg *Segmenter) cutDAG(sentence string) (results []Segment) {
 			buf = buf[:0]
 		}
 		word := string(frag)
-		if tag, ok := (*Dictionary)(seg).Pos(word); ok {
+		if tag, ok := d.Pos(word); ok {
 			results = append(results, Segment{word, tag})
 		} else {
 			results = append(results, Segment{word, "x"})
@@ -227,20 +329,20 @@ func (seg *Segmenter) cutDAG(sentence string) (results []Segment) {
 	if len(buf) > 0 {
 		bufString := string(buf)
 		if len(buf) == 1 {
-			if tag, ok := (*Dictionary)(seg).Pos(bufString); ok {
+			if tag, ok := d.Pos(bufString); ok {
 				results = append(results, Segment{bufString, tag})
 			} else {
 				results = append(results, Segment{bufString, "x"})
 			}
 			return
 		}
-		if v, ok := (*Dictionary)(seg).Frequency(bufString); !ok || v == 0.0 {
+		if v, ok := d.Frequency(bufString); (!ok || v == 0.0) && l.Run(len(buf)) {
 			results = append(results, seg.cutDetail(bufString)...)
 			return
 		}
 		for _, elem := range buf {
 			selem := string(elem)
-			if tag, ok := (*Dictionary)(seg).Pos(selem); ok {
+			if tag, ok := d.Pos(selem); ok {
 				results = append(results, Segment{selem, tag})
 			} else {
 				results = append(results, Segment{selem, "x"})
@@ -250,9 +352,14 @@ func (seg *Segmenter) cutDAG(sentence string) (results []Segment) {
 	return
 }
 
-func (seg *Segmenter) cutDAGNoHMM(sentence string) (results []Segment) {
+func (seg *Segmenter) cutDAGNoHMM(d *Dictionary, sentence string, _ *util.Limiter) []Segment {
 	runes := []rune(sentence)
-	routes := seg.calc(runes)
+	return seg.cutRoutesNoHMM(d, runes, calc(d, runes))
+}
+
+// cutRoutesNoHMM cuts runes along routes, and joins the consecutive
+// single letters into one word.
+func (seg *Segmenter) cutRoutesNoHMM(d *Dictionary, runes []rune, routes []*route) (results []Segment) {
 	buf := make([]rune, 0, 256)
 	for x := 0; x < len(runes); {
 		y := routes[x].index + 1
@@ -267,7 +374,7 @@ func (seg *Segmenter) cutDAGNoHMM(sentence string) (results []Segment) {
 			buf = buf[:0]
 		}
 		word := string(frag)
-		if tag, ok := (*Dictionary)(seg).Pos(word); ok {
+		if tag, ok := d.Pos(word); ok {
 			results = append(results, Segment{word, tag})
 		} else {
 			results = append(results, Segment{word, "x"})
@@ -282,35 +389,64 @@ func (seg *Segmenter) cutDAGNoHMM(sentence string) (results []Segment) {
 
 // Cut cuts a sentence into words.
 // Parameter hmm controls whether to use the Hidden Markov Model.
-func (seg *Segmenter) Cut(sentence string, hmm bool) (results []Segment) {
-	var cut func(sentence string) []Segment
+func (seg *Segmenter) Cut(sentence string, hmm bool) []Segment {
+	return seg.cut(seg.Dictionary(), sentence, hmm, nil)
+}
+
+func (seg *Segmenter) cut(d *Dictionary, sentence string, hmm bool, l *util.Limiter) (results []Segment) {
+	if seg.protected == nil {
+		return seg.cutBlocks(results, d, sentence, hmm, l)
+	}
+	seg.splitProtected(sentence, func(part string, pos string, protected bool) {
+		if protected {
+			results = append(results, Segment{part, pos})
+			return
+		}
+		results = seg.cutBlocks(results, d, part, hmm, l)
+	})
+	return
+}
+
+// cutBlocks appends the segments of sentence without protected patterns
+// to results, and stops before the next block if l stops.
+func (seg *Segmenter) cutBlocks(results []Segment, d *Dictionary, sentence string, hmm bool, l *util.Limiter) []Segment {
+	var cut func(d *Dictionary, sentence string, l *util.Limiter) []Segment
 	if hmm {
 		cut = seg.cutDAG
 	} else {
 		cut = seg.cutDAGNoHMM
 	}
-	for _, blk := range util.RegexpSplit(reHanInternal, sentence, -1) {
-		if reHanInternal.MatchString(blk) {
-			results = append(results, cut(blk)...)
+	for _, blk := range util.RegexpSplit(seg.blocks.Han, sentence, -1) {
+		if l.Done() {
+			break
+		}
+		if seg.blocks.Han.MatchString(blk) {
+			results = append(results, cut(d, blk, l)...)
 			continue
 		}
-		for _, x := range util.RegexpSplit(reSkipInternal, blk, -1) {
-			if reSkipInternal.MatchString(x) {
-				results = append(results, Segment{x, "x"})
-				continue
-			}
-			for _, xx := range x {
-				s := string(xx)
-				switch {
-				case reNum.MatchString(s):
-					results = append(results, Segment{s, "m"})
-				case reEng.MatchString(x):
-					results = append(results, Segment{x, "eng"})
-				default:
-					results = append(results, Segment{s, "x"})
-				}
+		results = seg.appendSkipped(results, blk)
+	}
+	return results
+}
+
+// appendSkipped appends the segments of a block without Chinese characters.
+func (seg *Segmenter) appendSkipped(results []Segment, blk string) []Segment {
+	for _, x := range util.RegexpSplit(seg.blocks.Skip, blk, -1) {
+		if seg.blocks.Skip.MatchString(x) {
+			results = append(results, Segment{x, "x"})
+			continue
+		}
+		for _, xx := range x {
+			s := string(xx)
+			switch {
+			case reNum.MatchString(s):
+				results = append(results, Segment{s, "m"})
+			case reEng.MatchString(x):
+				results = append(results, Segment{x, "eng"})
+			default:
+				results = append(results, Segment{s, "x"})
 			}
 		}
 	}
-	return
+	return results
 }
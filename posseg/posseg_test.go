@@ -1,7 +1,14 @@
 package posseg
 
 import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
 	"testing"
+
+	jieba "github.com/fumiama/jieba"
+	"github.com/fumiama/jieba/dictionary"
 )
 
 var (
@@ -268,6 +275,19 @@ var (
 	}
 )
 
+// testDict is a small dictionary in text format with POS.
+const testDict = "有限 300 a\n公司 600 n\n"
+
+// loadTestSegmenter creates a Segmenter of the dictionary in text format.
+func loadTestSegmenter(t *testing.T, text string) *Segmenter {
+	t.Helper()
+	s, err := LoadDictionary(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
 func TestCut(t *testing.T) {
 	for index, content := range testContents {
 		result := seg.Cut(content, true)
@@ -416,3 +436,160 @@ func BenchmarkCut(b *testing.B) {
 		seg.Cut(sentence, true)
 	}
 }
+
+func TestDictionaryTotal(t *testing.T) {
+	seg := loadTestSegmenter(t, testDict+"有限公司 400 n\n")
+	seg.AddWord("有限公司", 100, "")
+	if seg.Dictionary().Total() != 1000 {
+		t.Fatalf("got total %f after overriding, expected 1000", seg.Dictionary().Total())
+	}
+	if pos, ok := seg.Dictionary().Pos("有限公司"); !ok || pos != "n" {
+		t.Fatalf("got pos %s, expected n", pos)
+	}
+	seg.DeleteWord("有限公司")
+	if seg.Dictionary().Total() != 900 {
+		t.Fatalf("got total %f after deleting, expected 900", seg.Dictionary().Total())
+	}
+	if _, ok := seg.Dictionary().Pos("有限公司"); ok {
+		t.Fatal("POS of deleted word should be removed")
+	}
+	if _, ok := seg.Dictionary().Frequency("有限公"); ok {
+		t.Fatal("orphaned prefix should be removed")
+	}
+}
+
+func TestLoadUserDictionaryFailure(t *testing.T) {
+	seg := loadTestSegmenter(t, testDict)
+	before := seg.Dictionary()
+	if err := seg.LoadUserDictionary(strings.NewReader("有限公司 400 nt\n永和 x ns\n")); err == nil {
+		t.Fatal("expected error for invalid frequency")
+	}
+	if seg.Dictionary() != before {
+		t.Fatal("dictionary should not be replaced by a failed load")
+	}
+	if _, ok := seg.Dictionary().Pos("有限公司"); ok {
+		t.Fatal("words before the error should not be loaded")
+	}
+	if err := seg.Reload(strings.NewReader(testDict), strings.NewReader("有限公司 400 nt\n")); err != nil {
+		t.Fatal(err)
+	}
+	if pos, ok := seg.Dictionary().Pos("有限公司"); !ok || pos != "nt" {
+		t.Fatalf("got %s %v, expected nt true", pos, ok)
+	}
+	if _, ok := before.Pos("有限公司"); ok {
+		t.Fatal("previous snapshot should not be modified")
+	}
+}
+
+func TestSharedDictionary(t *testing.T) {
+	shared, err := dictionary.LoadShared(strings.NewReader(testDict))
+	if err != nil {
+		t.Fatal(err)
+	}
+	jseg, pseg := jieba.NewSegmenter(shared), NewSegmenter(shared)
+	jseg.AddWord("有限公司", 400)
+	if result := pseg.Cut("有限公司", false); len(result) != 1 || result[0].Text() != "有限公司" {
+		t.Fatalf("got %v, expected 有限公司", result)
+	}
+	pseg.AddWord("有限公司", 400, "nt")
+	if result := pseg.Cut("有限公司", false); result[0].Pos() != "nt" {
+		t.Fatalf("got %v, expected nt", result)
+	}
+	if err = jseg.LoadUserDictionary(strings.NewReader("永和 50 ns\n")); err != nil {
+		t.Fatal(err)
+	}
+	if pos, ok := pseg.Dictionary().Pos("永和"); !ok || pos != "ns" {
+		t.Fatalf("got %s %v, expected ns true", pos, ok)
+	}
+	if jseg.Dictionary() != pseg.Dictionary() {
+		t.Fatal("segmenters should share the same dictionary snapshot")
+	}
+}
+
+func TestCutNBest(t *testing.T) {
+	seg := loadTestSegmenter(t, "结婚 500 v\n的 2000 uj\n和 1000 c\n和尚 300 nr\n尚未 400 d\n未 200 d\n尚 50 d\n")
+	sentence := "结婚的和尚未结婚的"
+	candidates := seg.CutNBest(sentence, 3, false)
+	if len(candidates) != 3 {
+		t.Fatalf("got %d candidates, expected 3", len(candidates))
+	}
+	best := candidates[0].Segments()
+	if cut := seg.Cut(sentence, false); !reflect.DeepEqual(best, cut) {
+		t.Fatalf("got best %v, expected %v", best, cut)
+	}
+	if best, cut := seg.CutNBest(sentence, 3, true)[0].Segments(), seg.Cut(sentence, true); !reflect.DeepEqual(best, cut) {
+		t.Fatalf("got best %v with hmm, expected %v", best, cut)
+	}
+	second := candidates[1].Segments()
+	if second[2].Text() != "和尚" || second[2].Pos() != "nr" {
+		t.Fatalf("got second %v, expected 和尚/nr", second)
+	}
+	if candidates[1].Score() > candidates[0].Score() {
+		t.Fatalf("candidates are not ranked: %f > %f", candidates[1].Score(), candidates[0].Score())
+	}
+}
+
+func TestTuneFrequency(t *testing.T) {
+	seg := loadTestSegmenter(t, "今天 200 t\n天气 500 n\n今天天气 300 l\n石墨 100 n\n烯 10 n\n")
+	seg.TuneFrequency("今天", "天气")
+	if result := seg.Cut("今天天气", false); len(result) != 2 || result[1].Pos() != "n" {
+		t.Fatalf("got %v after tuning, expected 2 words", result)
+	}
+	if pos, ok := seg.Dictionary().Pos("今天天气"); !ok || pos != "l" {
+		t.Fatalf("got %s %v, expected l true", pos, ok)
+	}
+	seg.TuneFrequency("石墨烯")
+	if result := seg.Cut("石墨烯", false); len(result) != 1 {
+		t.Fatalf("got %v after tuning, expected 1 word", result)
+	}
+}
+
+func TestProtectedPatterns(t *testing.T) {
+	seg := loadTestSegmenter(t, "访问 100 v\n邮件 100 n\n增长 100 v\n发布 100 v\n")
+	seg.SetProtectedPatterns(DefaultProtectedPatterns...)
+	expected := []Segment{
+		{"访问", "v"}, {"https://a.b/c?d=1", "xu"}, {"，", "x"}, {"邮件", "n"}, {"foo@bar.com", "xe"},
+		{"，", "x"}, {"v1.2.3", "x"}, {"发布", "v"}, {"2024-05-01", "t"}, {"增长", "v"}, {"3.14%", "m"},
+	}
+	for _, hmm := range []bool{false, true} {
+		result := seg.Cut("访问https://a.b/c?d=1，邮件foo@bar.com，v1.2.3发布2024-05-01增长3.14%", hmm)
+		if !reflect.DeepEqual(result, expected) {
+			t.Fatalf("got %v, expected %v", result, expected)
+		}
+	}
+}
+
+func TestBlockPatterns(t *testing.T) {
+	seg := loadTestSegmenter(t, "Wi-Fi 100 eng\n升级 100 v\n连接 100 v\n")
+	seg.AddWord("Windows 11", 100, "nz")
+	seg.SetBlockPatterns(ExtendedBlockPatterns)
+	expected := []Segment{{"升级", "v"}, {"Windows 11", "nz"}, {"，", "x"}, {"连接", "v"}, {"Wi-Fi", "eng"}}
+	for _, hmm := range []bool{false, true} {
+		result := seg.Cut("升级Windows 11，连接Wi-Fi", hmm)
+		if !reflect.DeepEqual(result, expected) {
+			t.Fatalf("got %v, expected %v", result, expected)
+		}
+	}
+}
+
+func TestCutContext(t *testing.T) {
+	seg := loadTestSegmenter(t, "我们 100 r\n程序员 100 n\n")
+	sentence := "我们是程序员，鲸鲨鲟鳄"
+	result, err := seg.CutContext(context.Background(), sentence, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := seg.Cut(sentence, true); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("got %v, expected %v", result, expected)
+	}
+	seg.SetLimits(Limits{MaxHMMRun: 3})
+	var limitErr *LimitError
+	if _, err = seg.CutContext(context.Background(), sentence, true); !errors.As(err, &limitErr) || limitErr.Length != 4 {
+		t.Fatalf("got %v, expected MaxHMMRun exceeded", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = seg.CutContext(ctx, sentence, true); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, expected %v", err, context.Canceled)
+	}
+}
@@ -14,271 +14,3 @@ func (m probTransMap) Get(key uint16) float64 {
 	}
 	return inf
 }
-
-var (
-	probTransKeys = []uint16{}
-	probTrans     = map[uint16]probTransMap{
-		100: {200: -0.0050648453069648755, 300: -5.287963037107507},
-		101: {201: -0.0007479013978476627, 301: -7.198613337130562},
-		102: {},
-		103: {203: 0.0},
-		104: {204: -0.06753917715798491, 304: -2.7286269787493125},
-		105: {},
-		106: {206: -0.04442738163948101, 306: -3.1360307468646766},
-		107: {207: -0.04677309521554972, 307: -3.0857425240950174},
-		108: {208: 0.0},
-		109: {},
-		110: {210: -0.05870760082677792, 310: -2.864396271492904},
-		111: {},
-		112: {212: -0.01914032315305203, 312: -3.965512906408017},
-		113: {},
-		114: {214: 0.0},
-		115: {215: -7.5100009018967535, 315: -0.0005477305718588087},
-		116: {316: 0.0},
-		117: {217: -0.2543207374058728, 317: -1.4936259227444613},
-		118: {318: 0.0},
-		119: {},
-		120: {220: -3.68054609970312, 320: -0.02553239955770466},
-		121: {321: 0.0},
-		122: {222: -0.1861592793620009, 322: -1.7727887176378618},
-		123: {},
-		124: {224: -0.9316228008055788, 324: -0.5007333022296009},
-		125: {225: -0.1386970766026951, 325: -2.04401015913586},
-		126: {},
-		127: {227: -0.9187994754319938, 327: -0.5091566178045187},
-		128: {228: -0.6898863081052478, 328: -0.6964187211005602},
-		129: {229: -0.19889046807373303, 329: -1.7127985687430378},
-		130: {230: -0.30583032091031265, 330: -1.333745856818688},
-		131: {231: -5.85632199504386, 331: -0.0028658525153568088},
-		132: {232: -0.5116528585143264, 332: -0.9150511614331327},
-		133: {233: -0.15615808279732152, 333: -1.9339496252366735},
-		134: {234: -0.0005180550387437946, 334: -7.565688085284925},
-		135: {235: -0.23327551934156823, 335: -1.5699064300606476},
-		136: {236: 0.0},
-		137: {},
-		138: {238: -0.01687625300447763, 338: -4.090274052396829},
-		139: {},
-		140: {240: -2.239526957026909, 340: -0.11261887810814913},
-		141: {241: 0.0},
-		142: {242: -0.015465951118050396, 342: -4.176837382501129},
-		143: {243: -0.06198448272867337, 343: -2.8117033638171693},
-		144: {},
-		145: {245: -2.170492159178564e-05, 345: -10.7379823741081},
-		146: {},
-		147: {},
-		148: {},
-		149: {},
-		150: {},
-		151: {},
-		152: {252: -0.012409266514635869, 352: -4.395510002912934},
-		153: {253: 0.0},
-		154: {},
-		155: {355: 0.0},
-		156: {256: -0.01102649184048478, 356: -4.512962732418981},
-		157: {257: 0.0},
-		158: {},
-		159: {},
-		160: {260: -0.0046236917718409775, 360: -5.378872763286892},
-		161: {},
-		162: {262: -0.5543440297742611, 362: -0.854370382921828},
-		163: {},
-		200: {100: -3.4301509494938407, 101: -6.530359369866237, 103: -6.905890270156357, 104: -5.525983558928292, 106: -4.069370617491082, 107: -4.117261312051237, 108: -9.965267808870705, 110: -11.323391293023898, 112: -5.717589226727901, 115: -6.038496946808002, 117: -6.104838894944724, 120: -5.522952743340017, 122: -4.436632687260457, 124: -8.361560571145588, 125: -1.479406075636878, 127: -4.831656636089442, 128: -8.451711668139886, 129: -7.1944663972050185, 130: -5.321701425373129, 131: -7.642880088580479, 132: -6.519370248290642, 133: -9.78294625207675, 134: -6.0958541085369085, 135: -7.907487763972047, 138: -4.479759806376397, 140: -13.520615870360118, 141: -9.965267808870705, 142: -6.188246664431055, 143: -5.039464450291145, 145: -6.332203133863164, 152: -2.2068977276606287, 153: -8.586141937229426, 155: -11.574705721304804, 156: -3.354802515634624, 157: -12.827468689800172, 160: -8.458020837333152, 162: -6.33069369961431, 400: -4.841303829467445, 401: -10.812565669257907, 402: -7.868126690091467, 403: -10.812565669257907, 404: -7.3657577763437, 406: -3.544575125142281, 407: -3.378426427593927, 409: -9.649414859452227, 410: -9.031979500627978, 412: -5.155641891921391, 413: -7.752294874566346, 414: -9.736426236441856, 417: -6.561217358226142, 419: -6.495966839906481, 422: -4.73967475678773, 423: -11.574705721304804, 425: -4.191071250905458, 426: -4.934829887478268, 427: -6.3589938674209305, 433: -10.630244112463952, 434: -3.686853600315555, 435: -4.984600924703292, 438: -3.947717891287047, 439: -12.827468689800172, 444: -7.777612682550635, 445: -4.923872400185871, 446: -5.816254702449806, 447: -7.628971658534347, 448: -1.5134881880027917, 449: -4.426247674636356, 450: -3.4773228981331137, 451: -7.270640628100635, 452: -3.1548103981230424, 454: -6.3809555343951985, 456: -9.994255345743957, 459: -9.230156429211727, 460: -5.506941727527432},
-		201: {100: -3.669490663077246, 101: -5.577421564067343, 103: -7.408859517113575, 104: -6.442419001553947, 106: -5.341996757640598, 107: -4.558153015609841, 108: -9.172448109374933, 112: -6.6204021567493045, 115: -5.892163656378089, 117: -6.861564213566192, 120: -5.997872543403312, 122: -5.595897840234916, 124: -8.824141415106718, 125: -2.6719094715397667, 127: -5.990928071050501, 128: -9.259459486364563, 129: -7.745331753734787, 130: -6.245708707307894, 131: -8.949304558060723, 132: -7.482967489267296, 133: -9.577913217483097, 134: -5.436764589063194, 135: -9.259459486364563, 138: -5.405450875997045, 142: -7.062234909028343, 143: -6.152023223230571, 145: -6.379240099932416, 152: -0.6987018824779084, 153: -9.018297429547674, 155: -10.964207578602988, 156: -3.0646831065707905, 160: -9.71144461010762, 162: -7.8506922693926136, 400: -5.554796164549365, 401: -9.865595289934879, 402: -9.172448109374933, 404: -8.884766036923152, 406: -4.4880047424923335, 407: -3.535874384412182, 409: -10.271060398043042, 410: -10.964207578602988, 412: -5.483568655260997, 413: -8.712915779996493, 414: -9.865595289934879, 417: -7.725529126438608, 419: -7.275328124489052, 422: -5.537057340211983, 425: -5.825472281879416, 426: -6.8290410218606326, 427: -7.745331753734787, 433: -9.172448109374933, 434: -3.226155280913672, 435: -6.01544768822482, 438: -5.2772322222631685, 444: -9.172448109374933, 445: -7.2147035026726165, 446: -7.380688640146878, 447: -7.968475305048997, 448: -2.7833266366065983, 449: -5.257097313854112, 450: -3.6745970571518205, 451: -8.884766036923152, 452: -2.244971905780595, 454: -6.300768484490921, 459: -9.71144461010762, 460: -7.3006459324733415},
-		202: {},
-		203: {100: -3.6052367516663226, 101: -5.932878424497706, 103: -5.820082930352362, 104: -5.474131949776605, 106: -3.354245843284712, 107: -3.24087671226596, 108: -9.038958755220563, 112: -4.96708704885052, 115: -5.847111602740281, 117: -5.978687960529, 120: -5.428040842576338, 122: -4.790463513171203, 124: -7.940346466552453, 125: -1.6296204338393674, 127: -4.682249928530971, 128: -8.191660894833358, 129: -7.304357699832456, 130: -5.350079301106626, 131: -7.572621686427135, 132: -6.641063482422192, 133: -10.137571043888672, 134: -5.446223161659528, 135: -8.345811574660617, 138: -4.104484822089871, 142: -6.353381409970411, 143: -4.849304013194137, 145: -6.736373662226517, 152: -2.148688790579445, 153: -9.038958755220563, 155: -9.444423863328726, 156: -3.0106802349898643, 160: -8.191660894833358, 162: -6.148586997324398, 400: -5.094145926969426, 401: -10.137571043888672, 402: -8.058129502208836, 404: -7.304357699832456, 406: -2.728434599968544, 407: -2.9349098473654345, 409: -8.751276682768781, 410: -7.834985950894627, 412: -4.565417011710908, 413: -7.652664394100672, 414: -9.444423863328726, 417: -6.526653131244448, 419: -7.498513714273414, 422: -4.699491734965477, 425: -4.64040281859547, 426: -5.100618441475043, 427: -6.959517213540726, 434: -3.524186825509113, 435: -5.8068377036023415, 438: -3.5135058160887787, 444: -6.641063482422192, 445: -4.478088828129051, 446: -6.010436658843581, 447: -7.364982321648891, 448: -2.0789273316730545, 449: -4.363019498344264, 450: -4.271102986955375, 451: -6.641063482422192, 452: -3.071957680290955, 454: -6.026697179715361, 459: -9.444423863328726, 460: -5.22491615815262},
-		204: {100: -4.707673302574278, 101: -7.800150133419737, 103: -6.283289297017788, 104: -4.557557781934221, 106: -6.218462133938539, 107: -5.577520032648095, 112: -5.6289933828534355, 114: -12.354027025020278, 115: -7.343391730924022, 117: -5.482935730409732, 120: -5.224729476090905, 121: -12.354027025020278, 122: -4.711023389459561, 124: -8.50387942331022, 125: -0.7488506003459426, 127: -5.464435716665812, 128: -9.175973194672332, 129: -7.653546659227862, 130: -4.893536719194941, 131: -6.744555229835318, 132: -5.697300500841886, 133: -11.660879844460332, 134: -6.151491507832356, 135: -7.810732242750274, 138: -5.393679295918971, 141: -10.967732663900387, 142: -6.102123141854389, 143: -6.11570239998077, 145: -6.614234112841044, 152: -2.302248786072008, 153: -9.869120375232278, 156: -2.882707963426864, 157: -11.660879844460332, 160: -10.408116875964964, 162: -7.758907174885688, 400: -4.857374586851995, 402: -8.164372282993853, 404: -5.649612670056171, 405: -12.354027025020278, 406: -4.680338895752548, 407: -4.707673302574278, 409: -10.274585483340442, 412: -4.603412292249869, 413: -7.748856839032187, 414: -8.210892298628746, 417: -6.216299970934044, 419: -6.424437881630383, 422: -4.805998055085264, 425: -3.636344972854637, 426: -4.001944353667641, 427: -7.278853209786451, 433: -10.967732663900387, 434: -3.903828702428319, 435: -4.428869512795575, 438: -5.6883433072378695, 444: -8.328675334285128, 445: -5.63139723016483, 446: -8.827666500404117, 447: -8.690465378890632, 448: -2.512468069106317, 449: -7.463677896798524, 450: -6.064311454111281, 451: -8.023293684733947, 452: -2.7256344294304595, 454: -5.935662089084067, 456: -9.463655267124114, 459: -8.857519463553798, 460: -8.422201392295952, 461: -12.354027025020278},
-		205: {},
-		206: {100: -4.316505787725195, 101: -5.657713206360876, 103: -7.810927842700653, 104: -4.974044769755475, 106: -4.096441559872942, 107: -3.4848930231958763, 108: -8.56861354439817, 110: -12.192954477374535, 112: -5.183093975455244, 114: -12.88610165793448, 115: -5.377862883255817, 116: -12.88610165793448, 117: -5.2189434026153325, 120: -5.076560333281069, 122: -3.738914100963566, 124: -6.469369375422154, 125: -1.8458874846215843, 127: -3.8640204354190053, 128: -7.302605349152781, 129: -6.2985516431096835, 130: -3.8921775165230805, 131: -5.97734687861926, 132: -5.659892647833808, 133: -9.66722583306628, 134: -4.471605864756585, 135: -6.472642700767123, 138: -2.8554089311630637, 140: -10.940191508879167, 141: -8.39746528820234, 142: -5.159447993086838, 143: -3.6728654828462717, 145: -8.504075023260599, 152: -1.9334021670253692, 153: -8.491652503262042, 156: -4.158485479613413, 157: -12.88610165793448, 160: -12.88610165793448, 162: -7.00556867153378, 400: -4.554515414303727, 401: -10.806660116254644, 402: -7.937341767556312, 403: -11.787489369266371, 404: -6.746217105708225, 406: -5.593083978161698, 407: -2.590031623121201, 409: -10.052888313878263, 410: -9.94166267876804, 412: -5.548513914395884, 413: -7.7621376785312215, 414: -8.251372669704844, 417: -5.773774213223569, 419: -8.86074996719933, 422: -4.506792173881629, 423: -11.787489369266371, 425: -4.452942462128252, 426: -5.8481956944872975, 427: -5.767275408872402, 431: -12.88610165793448, 433: -9.553897147759276, 434: -2.483628296436571, 435: -5.585628843666681, 438: -2.536487015571298, 439: -12.192954477374535, 444: -7.47000125573006, 445: -6.286231158721643, 446: -7.792351457127718, 447: -7.817197455714249, 448: -6.159868255575733, 449: -8.80856421402876, 450: -8.301134179263908, 451: -9.75060744200533, 452: -2.224569093260564, 454: -6.443561491466282, 456: -10.247044328319221, 459: -9.222540011804833, 460: -8.241710758793108},
-		207: {100: -2.8017387321396003, 101: -4.976725806196074, 103: -6.517554503391935, 104: -6.088576745933047, 106: -5.389947368737205, 107: -3.8123735976947555, 108: -8.525193106593266, 110: -11.610537538836944, 112: -6.389506266248981, 115: -4.684451303170083, 117: -6.955387420543832, 120: -5.46957598058305, 122: -4.507215476310831, 124: -8.646553963597533, 125: -2.893408012444315, 127: -5.092681644858881, 128: -8.679343786420525, 129: -7.033252556338388, 130: -5.892687806969307, 131: -8.172526184052073, 132: -7.138898745473375, 133: -8.734152022915518, 134: -5.72686702153749, 135: -8.230393566372621, 138: -4.512988688222151, 141: -10.163618555900618, 142: -6.546361477812658, 143: -5.6165761115303745, 145: -6.994180163458288, 152: -1.0992734839585843, 153: -7.59190479835054, 155: -12.080541168082679, 156: -4.06514778922512, 157: -9.700995033952506, 160: -10.69424680696279, 162: -6.3286036515394315, 400: -3.961440330445193, 401: -10.471103255648579, 402: -7.9469758927072975, 404: -8.06596157432944, 406: -5.224921643521071, 407: -2.7332776619458645, 409: -9.579105216343468, 410: -9.562844695471687, 412: -5.49990203079773, 413: -7.887860705139717, 414: -9.738735361935353, 417: -7.120497660102484, 419: -9.470471375340674, 422: -4.6755319453646464, 423: -11.29208380771841, 425: -5.0330239467253834, 426: -6.327333810098955, 427: -6.9089214545806, 433: -9.515591810621142, 434: -2.6661829812307816, 435: -5.613774553277754, 438: -4.301074200624354, 439: -13.68997908051678, 444: -7.628522161588763, 445: -6.08657974077611, 446: -7.079283035799021, 447: -7.316659290939767, 448: -3.663654618021589, 449: -7.112117722795733, 450: -4.379883875403605, 451: -8.391661713968743, 452: -1.6807549996611406, 454: -5.703474141962785, 456: -11.49275450318056, 459: -10.052392920790394, 460: -7.483403153791852},
-		208: {100: -4.6866377692261105, 101: -5.142113297908936, 103: -7.3015975472623085, 104: -7.013915474810528, 106: -5.627621113690637, 107: -3.957558579440102, 108: -5.566996491874202, 112: -7.707062655370473, 115: -4.374858145195269, 117: -8.400209835930418, 120: -5.76115250631516, 122: -4.9344739331306915, 124: -7.707062655370473, 125: -3.458567413321114, 127: -4.736648189800772, 128: -7.707062655370473, 129: -8.400209835930418, 130: -7.013915474810528, 134: -6.002314563132048, 135: -8.400209835930418, 138: -3.3376148029034516, 142: -6.097624742936373, 143: -6.454299686875105, 145: -7.013915474810528, 152: -1.8606238803127486, 153: -8.400209835930418, 156: -4.6866377692261105, 162: -7.3015975472623085, 400: -3.9004001656001535, 402: -8.400209835930418, 404: -7.707062655370473, 406: -4.903702274463938, 407: -2.277717026416032, 412: -5.455770856763977, 417: -8.400209835930418, 422: -4.241326752570746, 425: -4.488186830502272, 426: -7.013915474810528, 427: -6.608450366702363, 434: -2.7268865687589257, 435: -6.454299686875105, 438: -2.5537710608726942, 445: -5.068005325755214, 448: -4.873849311314257, 449: -3.6127180931483722, 450: -8.400209835930418, 452: -1.1229621092989344, 454: -5.627621113690637, 460: -5.455770856763977},
-		209: {},
-		210: {100: -6.498282149476434, 106: -5.111987788356543, 107: -3.665068805420218, 110: -3.13098631948996, 117: -6.498282149476434, 120: -5.111987788356543, 122: -2.7140925155581725, 124: -6.498282149476434, 125: -2.293589530085468, 127: -3.453759711753011, 130: -5.805134968916488, 132: -5.805134968916488, 134: -6.498282149476434, 135: -6.498282149476434, 138: -2.8094026953624978, 141: -6.498282149476434, 142: -6.498282149476434, 143: -4.706522680248379, 152: -3.9333327920148973, 156: -6.498282149476434, 400: -2.8347205033467873, 404: -5.805134968916488, 406: -5.805134968916488, 407: -3.202445283472105, 410: -5.3996698608083245, 417: -4.3010575721402144, 422: -3.9333327920148973, 425: -3.7256934272366524, 426: -4.3010575721402144, 427: -4.3010575721402144, 434: -4.3010575721402144, 435: -5.805134968916488, 438: -1.4678442280839983, 445: -5.111987788356543, 447: -5.805134968916488, 448: -5.111987788356543, 449: -4.418840607796598, 450: -4.013375499688434, 452: -2.4378391389300145, 454: -4.552372000421121, 460: -3.5538431703099933},
-		211: {},
-		212: {100: -4.337384778823908, 101: -5.930084750763245, 103: -6.992620982957545, 104: -5.075814171829403, 106: -4.413538177181877, 107: -3.4622158465989212, 108: -9.064219347176355, 112: -4.533974775212322, 115: -5.740983506983912, 117: -5.418443133479925, 120: -5.68727196773766, 122: -3.3639848257358396, 124: -7.885564350834709, 125: -1.7839096235433134, 127: -4.024834639794114, 128: -7.348171699683997, 129: -6.303418580688616, 130: -3.519774592923733, 131: -6.3900706977498265, 132: -5.693695972699015, 133: -8.469512239429662, 134: -5.252707523344598, 135: -6.81292754856986, 138: -3.8025011330766483, 140: -12.529955249976082, 141: -9.234118383971753, 142: -5.20151789708092, 143: -5.099841111414281, 145: -7.262097090912754, 152: -2.0380978692772542, 153: -7.955244271472699, 156: -4.273347905349924, 157: -11.836808069416136, 160: -10.920517337541982, 162: -6.262754701434719, 400: -4.305791737338221, 401: -10.738195780748027, 402: -7.904982436691811, 403: -11.836808069416136, 404: -6.849782640959014, 406: -4.005389304230431, 407: -2.6761307424590544, 409: -8.974607188486669, 410: -10.584045100920768, 412: -4.918112850395665, 413: -7.382460773162629, 414: -9.064219347176355, 417: -5.6434236074455715, 419: -7.602701564818877, 422: -4.199091636751338, 423: -10.584045100920768, 425: -4.3493543023816335, 426: -5.235577950687261, 427: -5.800131179486606, 433: -9.31107942510788, 434: -3.0611814059072415, 435: -5.033302811807799, 438: -3.4923029858256154, 439: -11.836808069416136, 443: -12.529955249976082, 444: -7.602701564818877, 445: -5.482438028618786, 446: -8.55966333642396, 447: -8.111114642179484, 448: -2.077479612579614, 449: -6.655024519124051, 450: -7.168663084266656, 451: -9.821905048873871, 452: -2.342680700957058, 454: -6.129697804667261, 456: -11.836808069416136, 459: -9.585516270809642, 460: -7.140883520159581},
-		213: {},
-		214: {107: -4.442651256490317, 125: -0.2995165300987837, 132: -3.7495040759303713, 152: -3.056356895370426, 404: -4.442651256490317, 425: -2.833213344056216, 448: -2.245426679154097},
-		215: {100: -4.71147680000632, 101: -5.813383984571282, 103: -8.349062959732706, 104: -6.242644830354667, 106: -3.466024322741158, 107: -3.158713672249576, 108: -9.249849505070895, 110: -10.716186573864322, 112: -4.89610364351196, 115: -3.3807699123769632, 117: -6.7975190257175075, 120: -4.583511858204817, 122: -4.3705502130357266, 124: -7.625144120506007, 125: -2.788140972989545, 127: -3.8061006795439027, 128: -7.3604515662789245, 129: -6.496678868688216, 130: -5.140237470718006, 131: -7.625144120506007, 132: -6.363760408966732, 133: -8.318291301065951, 134: -5.523229722974112, 135: -7.7039249983591205, 138: -3.7280809422285266, 141: -9.86888871347712, 142: -5.683572373049291, 143: -4.362976626004502, 145: -5.76979354849642, 152: -2.4600984400494132, 153: -9.735357320852597, 156: -5.560970050956959, 160: -7.105268661220098, 162: -6.208996796236435, 400: -4.760349204399492, 401: -9.042210140292651, 402: -7.7039249983591205, 403: -11.121651681972487, 404: -6.909524084094002, 406: -3.60821524821168, 407: -2.8120978553344935, 409: -8.636745032184486, 410: -8.008136372762113, 412: -4.917093919403796, 413: -7.160838512374909, 414: -8.518961996528104, 417: -6.2853697750210085, 419: -6.293337944670186, 422: -4.695163224514796, 425: -4.648532888398794, 426: -5.294177734973736, 427: -5.6857486524718865, 433: -9.329892212744431, 434: -3.3516395596086803, 435: -5.318023872346569, 438: -3.0909167578739454, 439: -11.814798862532433, 444: -6.94726441207685, 445: -4.2630866471811215, 446: -7.45809003584284, 447: -7.4080796152681785, 448: -1.6368664643265434, 449: -4.025344296445759, 450: -2.5504439022500107, 451: -7.610106243141466, 452: -2.6487239068542645, 454: -5.786520342301734, 456: -10.716186573864322, 459: -9.416903589734062, 460: -4.795502208817387},
-		216: {107: -2.03688192726104, 122: -3.1354942159291497, 125: -3.1354942159291497, 127: -3.1354942159291497, 129: -2.03688192726104, 132: -2.4423470353692043, 406: -3.1354942159291497, 407: -2.03688192726104, 434: -1.749199854809259, 444: -3.1354942159291497, 452: -2.4423470353692043, 460: -3.1354942159291497},
-		217: {100: -4.817381150280411, 101: -5.71856911396881, 103: -7.6453906664616005, 104: -5.092962815798105, 106: -5.278726716783033, 107: -3.9690899945545244, 108: -11.864898371637707, 112: -5.031866638851507, 115: -6.78972455640388, 117: -3.6924515532949287, 120: -6.1347985886641325, 122: -4.105710933129911, 124: -7.804455361091288, 125: -1.1883209358684308, 127: -4.740420109144283, 128: -8.497602541651233, 129: -7.036584634335406, 130: -4.222374237404805, 131: -6.0359527540275, 132: -5.050355474377749, 133: -11.171751191077762, 134: -5.973254159811936, 135: -7.354038865120858, 138: -5.150727841728235, 141: -9.156848170535497, 142: -5.7601051392227225, 143: -4.5358046353911154, 145: -6.571593546913215, 152: -1.7662141698383198, 153: -7.508189544948116, 156: -2.9994455458840847, 157: -11.864898371637707, 160: -11.864898371637707, 162: -8.686844541289762, 400: -4.736402425957671, 401: -10.766286082969598, 402: -8.281379433181597, 404: -5.308120015479665, 406: -3.88077641293478, 407: -3.306947187749302, 409: -9.299949014176171, 410: -10.766286082969598, 412: -4.403257979429132, 413: -8.309550310148294, 414: -10.255460459203606, 417: -5.2870370139166605, 419: -7.192069537175801, 422: -4.070899282133712, 425: -3.580899067389181, 426: -5.042700981017217, 427: -6.740934392234449, 433: -11.864898371637707, 434: -3.5708487315356794, 435: -3.7726589649134965, 438: -5.335479533375482, 444: -5.998430314704411, 445: -4.496558685326327, 446: -8.569061505633378, 447: -8.253980458993484, 448: -3.071893280340176, 449: -6.909071314036447, 450: -7.857565186405236, 451: -8.43091116715256, 452: -2.840767103182672, 454: -5.652292275886189, 456: -10.766286082969598, 459: -10.073138902409653, 460: -8.058235881867388, 461: -11.864898371637707},
-		218: {125: -0.916290731874155, 156: -1.6094379124341003, 422: -1.6094379124341003, 448: -1.6094379124341003},
-		219: {},
-		220: {100: -4.281994308212445, 101: -5.631355414286653, 103: -7.781973234434385, 104: -5.206986151539336, 106: -3.6487758283119094, 107: -3.4303213085337663, 108: -9.494951825809325, 110: -10.283409186173595, 112: -4.843592257351859, 114: -11.892847098607696, 115: -4.812820598685105, 117: -5.7232363661162395, 120: -4.488567980570427, 122: -4.232732779433767, 124: -7.229408004495628, 125: -1.9874613487884873, 127: -4.183090234153531, 128: -7.6301672215663805, 129: -6.780859310251152, 130: -4.471669570012302, 131: -6.67791134099871, 132: -5.982050454567168, 133: -9.184796897505485, 134: -5.11976672295216, 135: -7.338970207007155, 138: -3.5985474897504606, 141: -8.897114825053704, 142: -5.792528146587632, 143: -4.407355490576941, 145: -5.916496189309762, 152: -2.2468357764662827, 153: -9.120258376367914, 155: -11.892847098607696, 156: -3.873234304207428, 160: -8.458859894122549, 162: -6.842991091358159, 400: -4.584304301068506, 401: -10.794234809939587, 402: -8.25526093888131, 404: -6.494684397089943, 406: -3.28563040454387, 407: -2.81649536663483, 409: -9.494951825809325, 410: -8.396339537141216, 412: -4.153487895918597, 413: -7.7497123722161625, 414: -9.184796897505485, 417: -6.0263790416743985, 419: -6.6888404115309, 422: -4.333287602599996, 423: -11.892847098607696, 425: -4.068401167730077, 426: -5.246456583759966, 427: -6.0179163677556655, 433: -9.81340555692786, 434: -3.020079568696759, 435: -4.843592257351859, 438: -3.145018490118953, 444: -7.426938979953112, 445: -4.567039596011923, 446: -7.248456199466323, 447: -7.210715871483476, 448: -1.999409881925069, 449: -4.667365625825401, 450: -4.095555825060223, 451: -7.815309654701976, 452: -2.593397551559737, 454: -6.437525983249994, 456: -10.506552737487805, 459: -10.101087629379641, 460: -5.233553178924058},
-		221: {100: -3.6635616461296463, 107: -2.9704144655697013, 125: -1.466337068793427, 130: -3.6635616461296463, 131: -3.6635616461296463, 143: -3.6635616461296463, 152: -1.5841201044498106, 406: -3.6635616461296463, 407: -2.277267285009756, 412: -3.6635616461296463, 425: -2.5649493574615367, 434: -2.9704144655697013, 448: -2.277267285009756, 452: -3.6635616461296463},
-		222: {100: -3.4312981852350974, 101: -6.006821180675518, 103: -7.110240120503148, 104: -4.5609797564036345, 106: -4.739222979044614, 107: -3.9444158261482496, 108: -8.877339027909251, 110: -11.036823277262624, 112: -4.381211341445001, 114: -13.051726297804889, 115: -5.178509242942146, 117: -4.918726079221275, 120: -5.3433156305475205, 122: -3.9961701396295717, 124: -8.146451519366458, 125: -1.2428418586344567, 127: -4.136428352686779, 128: -8.172719446187068, 129: -6.70258730642509, 130: -4.445423931316875, 131: -6.700840581090149, 132: -5.532576340135065, 133: -8.862071555778464, 134: -6.041865795885597, 135: -6.600466298043695, 138: -4.274941914103396, 140: -13.051726297804889, 141: -10.37757764837836, 142: -5.703460568971786, 143: -4.520531586752837, 145: -8.031140672855464, 152: -2.286794983034085, 153: -9.223084901315794, 156: -4.136831388898321, 157: -11.547648901028614, 160: -8.885061074003161, 162: -5.454580887140518, 400: -3.5027731247083813, 401: -11.179924120903296, 402: -7.368146530466206, 403: -11.346978205566463, 404: -6.055960141500038, 405: -13.744873478364834, 406: -4.432427883451497, 407: -3.08158527471424, 409: -9.139703292376742, 410: -9.180525286896998, 412: -4.028318095180343, 413: -7.057764870498319, 414: -8.342196096492554, 417: -5.936550427973778, 419: -7.6490489159326085, 422: -4.276099634295993, 423: -11.953114009136778, 425: -2.584047725401541, 426: -4.605170185988091, 427: -6.031982516874704, 431: -12.358579117244943, 433: -9.234013971847984, 434: -3.4681685652706618, 435: -4.5302423249714945, 438: -4.087222527690592, 439: -13.744873478364834, 444: -7.0162448652801315, 445: -5.667115914627914, 446: -8.397765947647365, 447: -8.041091003708633, 448: -3.3506609737697177, 449: -5.751591150263242, 450: -6.294212682153294, 451: -8.563089928072749, 452: -2.5891802554522334, 454: -5.722960699379124, 456: -10.107287318638448, 459: -8.824892552536708, 460: -6.280936873895908},
-		223: {},
-		224: {100: -4.102081544724253, 101: -6.251903883165888, 103: -7.4150546929715695, 104: -5.139498272365443, 106: -5.011117105717236, 107: -3.8926234464952976, 112: -4.300058998590761, 115: -5.692288095230466, 117: -4.470615713805129, 120: -5.590505400920524, 122: -4.919098206996984, 124: -8.331345424845724, 125: -1.1575148271658653, 127: -4.919098206996984, 128: -9.429957713513835, 129: -7.4150546929715695, 130: -4.506333796407208, 131: -7.032062440715464, 132: -6.1341208475095055, 133: -9.429957713513835, 134: -6.191279261349454, 135: -8.177194745018467, 138: -4.484750224740034, 142: -5.263292489712107, 143: -6.08005362623923, 145: -6.512186981429555, 152: -2.2702770817920355, 153: -7.4150546929715695, 156: -3.33076046660297, 160: -10.12310489407378, 162: -6.385435275790411, 400: -3.871201010907891, 402: -6.98761067814463, 404: -6.4855187343473935, 406: -4.634167167917093, 407: -3.211357593822105, 409: -9.429957713513835, 412: -3.201446709922651, 413: -8.513666981639679, 414: -8.513666981639679, 417: -5.995970509028688, 419: -9.024492605405669, 422: -4.597651954941996, 425: -2.239281679181627, 426: -5.132672307295043, 427: -6.596744369457618, 433: -10.12310489407378, 434: -3.844583469907935, 435: -4.865609522045998, 438: -4.273780114126921, 444: -8.73681053295389, 445: -5.903597188897673, 446: -7.820519801079734, 447: -8.177194745018467, 448: -2.7700229735583473, 449: -7.725209621275409, 450: -6.3164424043034595, 451: -10.12310489407378, 452: -2.9286680429734453, 454: -6.409532827369472, 459: -10.12310489407378, 460: -8.177194745018467},
-		225: {100: -3.978080739676936, 101: -5.66511002885322, 103: -7.226405423299325, 104: -5.180636619931472, 106: -4.190342829817711, 107: -3.4944759036200104, 108: -9.747411617977368, 110: -11.829165299415585, 112: -4.73057541992539, 114: -14.026389876751804, 115: -5.891042927845096, 116: -15.818149345979858, 117: -5.768485683286129, 120: -5.674897141113285, 121: -13.738707804300022, 122: -4.315425743494227, 124: -8.021269003196336, 125: -1.5681852668440757, 127: -4.176030634548956, 128: -7.857476737591742, 129: -6.497072932409011, 130: -4.627304180183864, 131: -6.569839042752047, 132: -5.824501213735774, 133: -9.081182387978004, 134: -5.564674159804854, 135: -6.871253822091411, 138: -4.237621308874419, 140: -13.420254073181487, 141: -10.201378248313288, 142: -5.680815718996385, 143: -4.820777330015851, 145: -5.920026155942882, 152: -1.9576816748949826, 153: -7.835391643968746, 155: -12.321641784513378, 156: -3.4432626270142315, 157: -11.946948335071967, 160: -8.971206206394479, 162: -6.491449172887481, 400: -4.463563321458343, 401: -10.848336046403858, 402: -8.012674720709002, 403: -11.675014619588326, 404: -6.352779233827144, 406: -3.269153885047312, 407: -2.843798856577224, 409: -8.438517193370306, 410: -9.56048175809722, 412: -3.2992131105507476, 413: -7.393071443471427, 414: -9.078812718622684, 417: -6.05157031071119, 419: -5.577225735733038, 422: -4.139353573657507, 423: -11.461440519290267, 425: -4.0025817700435695, 426: -4.867518253150814, 427: -5.886852092804464, 431: -13.515564252985813, 433: -9.60554325022834, 434: -3.02036718444358, 435: -4.609902920306358, 438: -4.088613625268949, 439: -12.450853515993385, 443: -15.818149345979858, 444: -7.514396930416446, 445: -4.439406914477365, 446: -8.510947031215121, 447: -7.750059719701615, 448: -2.6041753509928776, 449: -6.198484655569791, 450: -7.019845627494879, 451: -8.139822989472973, 452: -2.5678396434451454, 454: -6.1850279881302805, 456: -10.333352412489203, 459: -9.153740325629451, 460: -6.125876773892667},
-		226: {},
-		227: {100: -4.8510677626421845, 101: -5.7730519464060785, 103: -8.937924040051959, 104: -6.217255374990538, 106: -4.94735395033205, 107: -3.4849255221566655, 108: -10.008365451753372, 110: -10.500841936851167, 112: -5.053712792408054, 115: -5.352554962307688, 116: -13.591884390209483, 117: -6.552224040347407, 120: -5.9317700710355545, 122: -4.240478577954697, 124: -8.06245530269806, 125: -2.215924780312412, 127: -3.092945179586863, 128: -6.730173049728752, 129: -5.017932864974637, 130: -4.2940827424633055, 131: -7.532761194627686, 132: -5.81367291569699, 133: -8.293567023661446, 134: -6.002042878026825, 135: -8.031202759193954, 138: -4.444164380214968, 140: -12.898737209649537, 141: -9.274396276673173, 142: -5.003115000304024, 143: -4.5453579034715155, 145: -6.356985969894652, 152: -2.0990187856170266, 153: -10.647445411043043, 156: -5.154383967702493, 157: -11.026935032747947, 160: -9.548833122374933, 162: -6.252346694801808, 400: -4.393717819165009, 401: -10.259679880034279, 402: -6.779539296032004, 403: -11.64597424115417, 404: -6.165930733131942, 406: -3.553254243731061, 407: -2.7497772603161366, 409: -8.438592795711704, 410: -8.492017962385285, 412: -4.177624061886849, 413: -5.9924830567936676, 414: -9.584551204977013, 417: -4.797210988826062, 419: -6.774053818755333, 422: -4.1913369671653555, 423: -10.819295667969701, 425: -3.2610397831490916, 426: -4.049725450293434, 427: -4.33599720327733, 431: -13.591884390209483, 433: -9.070095813160442, 434: -2.6531504246270647, 435: -3.8870307199596144, 438: -4.153771198535428, 439: -8.254346310508165, 443: -10.259679880034279, 444: -5.890684209352036, 445: -3.62584466301639, 446: -7.176787431037887, 447: -7.496059827777258, 448: -2.72112786103457, 449: -5.437671695295254, 450: -7.210068372803384, 451: -6.729126477158082, 452: -2.0218227338684454, 454: -4.549252862659409, 456: -10.647445411043043, 459: -6.955937834522836, 460: -6.3869918800048096, 461: -12.898737209649537, 463: -12.205590029089592},
-		228: {100: -4.722301912631971, 101: -5.214199526512442, 103: -7.986788248752224, 104: -5.651413332935188, 106: -4.421961443308267, 107: -3.0839856594591835, 108: -9.77854771798028, 112: -4.880707918029367, 115: -5.060048846685184, 117: -5.396521083306397, 120: -5.16342720113902, 122: -3.8253043836924947, 124: -7.832637568924966, 125: -2.1427608565846947, 127: -2.720649780568422, 128: -4.880707918029367, 129: -6.114986071850632, 130: -4.403269310296114, 131: -5.846722085255953, 132: -5.886727419869652, 133: -8.392253356860389, 134: -5.771214532747808, 135: -9.77854771798028, 138: -4.241213450961743, 141: -8.169109805546178, 142: -4.754667197134003, 143: -4.3318103463139686, 145: -6.734025280256856, 152: -1.925331329824207, 153: -9.085400537420334, 156: -4.974526673247023, 160: -9.77854771798028, 162: -6.344560513495133, 400: -4.43144018726281, 402: -7.986788248752224, 404: -6.559671893112078, 406: -4.500433058749762, 407: -3.1611447400058017, 409: -9.085400537420334, 410: -9.77854771798028, 412: -4.0387548058010445, 413: -5.324200421726771, 414: -9.085400537420334, 417: -5.75319602724513, 419: -7.699106176300443, 422: -4.165419611592209, 423: -9.77854771798028, 425: -3.7920957126958412, 426: -4.888198589758526, 427: -5.153574904696008, 434: -2.7818662298037404, 435: -4.0848155791775795, 438: -3.9525476105998285, 444: -6.0408780996969105, 445: -4.515857529075394, 446: -7.832637568924966, 447: -6.782815444426288, 448: -2.3579688125694784, 449: -5.75319602724513, 450: -9.085400537420334, 451: -8.392253356860389, 452: -2.238988888679249, 454: -4.991055975198233, 456: -9.085400537420334, 459: -9.085400537420334, 460: -6.48271085197595},
-		229: {100: -4.103149768384549, 101: -6.169770621635323, 103: -7.824819045321476, 104: -5.907896433139415, 106: -4.916098148757115, 107: -3.7238299403807074, 108: -10.532869246423687, 112: -4.703923628813479, 115: -4.889190695837191, 117: -6.102052447580373, 120: -5.874158293507565, 122: -4.047470943219932, 124: -8.517966225881421, 125: -1.7629737014516949, 127: -2.6547139099203623, 128: -7.115142562810321, 129: -3.537103090118836, 130: -3.7433345988289806, 131: -7.218683241751161, 132: -4.78826477724723, 133: -9.280106277928319, 134: -6.038630621142877, 135: -6.516486225671298, 138: -4.615320382265873, 141: -9.434256957755577, 142: -5.178644247937354, 143: -4.744439297707201, 145: -6.935556985835241, 152: -2.1201480766041603, 153: -10.532869246423687, 156: -4.812557469816275, 157: -10.532869246423687, 160: -10.127404138315523, 162: -6.819297179719379, 400: -4.559059634554425, 401: -10.127404138315523, 402: -6.306035501155507, 403: -11.226016426983632, 404: -6.748679612505425, 406: -3.8982358885620005, 407: -3.153861118795382, 409: -8.18149398926021, 410: -8.335644669087467, 412: -4.385469897694945, 413: -6.35081910378248, 414: -9.434256957755577, 417: -4.204932462694492, 419: -6.228804153219516, 422: -3.9875195860892667, 423: -11.226016426983632, 425: -3.0755485153596283, 426: -4.076884828426225, 427: -4.8475902433320455, 433: -10.532869246423687, 434: -3.1021621638777184, 435: -3.884532574620471, 438: -4.272332216113095, 439: -7.615098514339407, 444: -5.312513421345361, 445: -4.404908954727167, 446: -7.670668365494218, 447: -9.280106277928319, 448: -2.972528398637727, 449: -5.947901767753114, 450: -7.615098514339407, 451: -7.562454780853986, 452: -2.433618684626726, 454: -5.309814364376197, 456: -11.226016426983632, 459: -7.69965590236747, 460: -6.84398979230975, 461: -11.226016426983632},
-		230: {100: -4.533345781203185, 101: -6.417350824011239, 103: -8.632653132767416, 104: -4.862640865016976, 106: -5.3415260690694515, 107: -4.463330330418472, 108: -11.921055020284227, 110: -13.530492932718326, 112: -3.5763122658666804, 115: -6.795901272745379, 117: -4.4404011333383195, 120: -5.754377455619585, 121: -12.837345752158381, 122: -4.206734593706587, 124: -8.647691010131956, 125: -1.2788161453831373, 127: -3.6954448334047196, 128: -7.969811301702799, 129: -5.336816266763086, 130: -2.1933742915728085, 131: -5.630968460686129, 132: -4.611307322672897, 133: -10.891435603103067, 134: -6.810272777583032, 135: -7.71636240089326, 138: -4.822514106096005, 141: -11.132597659919956, 142: -4.323959799085098, 143: -4.303590472443165, 145: -6.907756608768487, 152: -2.5084760916737308, 153: -9.61846992729018, 155: -13.530492932718326, 156: -4.318054762659997, 157: -10.822442731616116, 160: -10.640121174822163, 162: -7.502214412487628, 400: -4.648934444079351, 401: -10.96554357525679, 402: -7.713381772755122, 404: -6.523797705881286, 406: -3.698877392419994, 407: -3.757057119542472, 409: -9.946973994262217, 410: -11.132597659919956, 412: -3.947348394873541, 413: -6.93744839857589, 414: -9.680345331008269, 417: -4.971198565283454, 419: -7.504626958893012, 422: -4.05678879594157, 423: -13.530492932718326, 425: -3.1798546505869276, 426: -4.434890238212567, 427: -5.394267377809866, 433: -10.891435603103067, 434: -3.42202579949566, 435: -4.609035353770444, 438: -4.929510215572406, 439: -11.921055020284227, 443: -13.530492932718326, 444: -5.458337624530077, 445: -4.1341710431167025, 446: -9.136043778045888, 447: -8.640143804496573, 448: -2.9164604224458333, 449: -7.497406710919526, 450: -6.88800613135107, 451: -8.46789789969136, 452: -3.0720576133813164, 454: -6.226649707440623, 456: -10.586053953551886, 459: -8.560679633142326, 460: -7.777920293892693},
-		231: {100: -4.972479528443855, 101: -5.2608818025832305, 103: -7.974712366600939, 104: -4.2600804163845885, 106: -5.235275851678058, 107: -3.8810927047367874, 108: -11.11020658253009, 112: -5.440325659549569, 115: -7.281565186040994, 117: -4.866039681866353, 120: -6.060350575280552, 121: -11.11020658253009, 122: -3.779801370685687, 124: -7.349006466836527, 125: -1.267424905111356, 127: -4.8411102988238275, 128: -8.545257225068552, 129: -6.779473242243758, 130: -3.5315497319353266, 131: -3.518344867640156, 132: -4.709949137221268, 134: -4.560555840296279, 135: -9.500768670095988, 138: -5.299065589553389, 141: -11.11020658253009, 142: -5.832091923299572, 143: -4.077582321502083, 145: -6.535495604026706, 152: -1.7587139307980229, 153: -8.40215638142788, 156: -3.8942315798786233, 160: -11.11020658253009, 162: -9.030765040850254, 400: -5.613038357236888, 402: -10.417059401970144, 404: -3.6085721246466758, 406: -3.4648088831014556, 407: -3.2941927433710614, 409: -10.417059401970144, 410: -11.11020658253009, 412: -4.833563093188445, 413: -11.11020658253009, 414: -9.723912221410199, 417: -6.356616391423724, 419: -8.91298200519387, 422: -3.554824638289816, 425: -4.601437445558408, 426: -6.140393282954089, 427: -7.178380949805764, 434: -3.04180362396039, 435: -4.134792655074137, 438: -5.447246102394144, 439: -8.471149252914831, 444: -7.421327128416153, 445: -4.32236160022051, 446: -9.723912221410199, 447: -11.11020658253009, 448: -2.8425009177676634, 449: -8.807621489536043, 450: -9.723912221410199, 451: -9.723912221410199, 452: -3.562704899715122, 454: -7.81436971652576, 459: -10.417059401970144, 460: -8.807621489536043},
-		232: {100: -4.9490278735999, 101: -6.253492216472158, 103: -8.843759381917984, 104: -4.6983596488956, 106: -5.1094969219562305, 107: -4.328201354619581, 108: -11.195134639081463, 112: -4.1273886237903845, 115: -6.600014788946872, 117: -5.381004107256396, 120: -6.350947552622872, 122: -3.640276118040787, 124: -7.8629301289062585, 125: -1.1714669262892294, 127: -3.8902825016616775, 128: -8.017080808733517, 129: -5.801507092729101, 130: -3.911343062681973, 131: -5.906867608386928, 132: -3.331867915071889, 133: -10.096522350413352, 134: -5.964026022226876, 135: -7.544476397787724, 138: -5.02239074475797, 141: -10.789669530973297, 142: -5.848027108363993, 143: -4.577063435221779, 145: -6.625591630736523, 152: -2.334635471465447, 153: -9.115693097401627, 155: -11.888281819641408, 156: -3.8926382153541366, 160: -10.501987458521517, 162: -7.051999912689929, 400: -4.481571089463768, 401: -11.888281819641408, 402: -7.196933937412264, 403: -11.888281819641408, 404: -6.123090716856563, 406: -3.635835729394455, 407: -3.576883541204767, 409: -10.278843907207307, 410: -10.789669530973297, 412: -3.474672944481741, 413: -6.897849232862671, 414: -8.843759381917984, 417: -4.734448018062565, 419: -6.395220376300859, 422: -4.04130083750262, 423: -10.501987458521517, 425: -3.3175478612971405, 426: -4.065436529361635, 427: -5.619185535935146, 433: -10.096522350413352, 434: -3.319825334287629, 435: -4.20257875840686, 438: -4.670104981238, 439: -10.096522350413352, 444: -6.541174288923939, 445: -4.105057803305371, 446: -7.7611474345963165, 447: -8.304762881185297, 448: -3.027640777867532, 449: -6.806877454656944, 450: -7.020747369185825, 451: -8.556077309466204, 452: -2.8506295554909413, 454: -5.937639267053681, 456: -9.180231618539198, 459: -8.15061220135804, 460: -7.044094733182816},
-		233: {100: -5.7903423608503095, 101: -8.562931083090092, 103: -8.562931083090092, 104: -8.562931083090092, 106: -6.365706505753872, 107: -4.671110784979464, 110: -8.562931083090092, 112: -7.464318794421981, 115: -6.260345990096045, 117: -6.260345990096045, 120: -5.997981725628555, 122: -2.245766396342807, 124: -7.464318794421981, 125: -3.4691808822833288, 127: -4.712783481380033, 129: -7.869783902530146, 130: -6.365706505753872, 132: -7.464318794421981, 133: -2.690813293614675, 134: -8.562931083090092, 135: -8.562931083090092, 138: -4.899369436960445, 142: -6.260345990096045, 143: -6.16503581029172, 152: -2.5467739233917377, 156: -7.464318794421981, 162: -6.771171613862037, 400: -5.230726572914887, 402: -7.869783902530146, 406: -5.7903423608503095, 407: -3.249725104048304, 409: -8.562931083090092, 410: -5.923873753474832, 412: -5.5184086453666685, 417: -4.59263916953797, 422: -3.1512850312350515, 425: -4.519879815255541, 426: -6.483489541410255, 427: -5.923873753474832, 433: -3.326489120260142, 434: -4.631105450365766, 435: -3.532493161697656, 438: -3.9882201045867083, 444: -7.869783902530146, 445: -5.0365705584739295, 446: -7.464318794421981, 447: -8.562931083090092, 448: -2.3403548150187223, 449: -5.304834545068609, 450: -1.7773434380821618, 451: -4.849359016385783, 452: -1.9549304577940043, 454: -5.0365705584739295, 459: -4.358238463699125, 460: -5.007583021600677},
-		234: {100: -3.707686938726001, 101: -5.171603498030898, 103: -7.4460177140582475, 104: -4.260468893306113, 106: -6.470410405027673, 107: -3.937378650534441, 112: -4.929217700494293, 115: -5.748874221205041, 117: -4.469921415021115, 120: -5.156011403271061, 122: -3.366833792066828, 124: -5.905572673111098, 125: -1.2672095197672428, 127: -3.707686938726001, 128: -7.059244738962235, 129: -5.970111194248669, 130: -3.4303857260110813, 131: -4.620184477299653, 132: -4.969245914476523, 133: -9.525459255738083, 134: -5.844948051294663, 135: -6.414416135673098, 138: -2.954265106216899, 140: -9.930924363846247, 141: -8.059122186944656, 142: -4.696257024374667, 143: -3.535941045960784, 145: -8.544630002726358, 152: -1.82571749752844, 153: -7.985014214790934, 156: -3.4046735555274314, 162: -7.117513647086211, 400: -4.23103835946917, 402: -8.111765920430077, 404: -6.5693922385765235, 406: -5.69923624011327, 407: -4.625962454579542, 409: -9.77677368401899, 412: -5.16165316717773, 413: -7.200895256025262, 414: -7.851482822166411, 417: -5.368313792276952, 419: -9.014633631972092, 422: -4.000892056256767, 423: -11.722683833074303, 425: -4.115799301854673, 426: -5.310865565364406, 427: -5.6045866350329545, 431: -11.029536652514357, 433: -10.113245920640201, 434: -3.5493903941080744, 435: -5.1816538338844, 438: -3.182164816354568, 444: -7.697332142339153, 445: -6.795430147917098, 446: -8.631641379715987, 447: -9.324788560275932, 448: -4.737967512956037, 449: -4.653660406496043, 450: -8.085097673347917, 451: -9.014633631972092, 452: -3.303324002006828, 454: -6.409477854032515, 456: -10.624071544406192, 459: -9.237777183286303, 460: -9.083626503459044},
-		235: {100: -3.7031528191769754, 101: -6.543262742292252, 103: -6.646446978527482, 104: -4.9105679676938845, 106: -4.973830164471851, 107: -4.134729071288826, 112: -3.5763738406865895, 115: -5.704403750550913, 117: -5.381338174209815, 120: -5.9212114834160365, 122: -3.649628338761271, 124: -8.865650462582478, 125: -1.2517330659629002, 127: -4.468735215414845, 128: -9.376476086348468, 129: -6.068369127752325, 130: -4.2003263537746385, 131: -7.641875030960361, 132: -6.044271576173264, 133: -8.683328905788523, 134: -6.432037107182027, 135: -4.35699117697523, 138: -4.439606942491821, 142: -5.282131524126367, 143: -4.473673497055428, 145: -7.256212550148377, 152: -2.149055689061499, 153: -5.9212114834160365, 156: -4.2003263537746385, 160: -10.475088375016577, 162: -7.216991836995096, 400: -4.083171261623976, 402: -7.530649395850137, 404: -6.316205291656906, 406: -4.1172461085084775, 407: -3.2131612823138265, 409: -9.376476086348468, 412: -4.4911520943293874, 413: -8.172503282022532, 414: -8.865650462582478, 417: -5.132754123051766, 419: -8.395646833336741, 422: -3.7322077392246746, 425: -3.3251715388844687, 426: -5.063442323161538, 427: -6.270395755625612, 433: -10.475088375016577, 434: -3.1284332118400386, 435: -4.207887826475215, 438: -4.926012290121358, 439: -9.376476086348468, 444: -6.919740313527164, 445: -5.526328484638409, 446: -9.376476086348468, 447: -8.277863797680357, 448: -2.728787712785138, 449: -8.395646833336741, 450: -7.179251509012248, 451: -10.475088375016577, 452: -2.5481248301535993, 454: -5.986452005284438, 456: -9.376476086348468, 459: -9.088794013896687, 460: -6.919740313527164},
-		236: {125: -0.6931471805599453, 435: -0.6931471805599453},
-		237: {},
-		238: {100: -3.546611504512179, 101: -5.605998004408728, 103: -6.694425832790958, 104: -5.161194529167501, 106: -4.892411646081026, 107: -3.478237052952054, 108: -8.340153746630259, 110: -12.456477215571136, 112: -5.405198959836823, 114: -13.555089504239245, 115: -4.812196032727107, 117: -6.073533802329728, 120: -5.275646016526579, 122: -3.8052777443071637, 124: -7.864730049915184, 125: -1.497882363047387, 127: -4.52591136133717, 128: -8.062028060898696, 129: -6.9661630267057255, 130: -5.044518189131894, 131: -7.400231410222827, 132: -6.189276667029772, 133: -9.124272705395931, 134: -5.911127555236715, 135: -7.888662816126812, 138: -3.9153071147734493, 140: -11.945651591805143, 141: -9.477552060333526, 142: -5.034103514584311, 143: -4.62023916394948, 145: -6.710274025030982, 152: -2.022822302480277, 153: -9.022490011085988, 156: -4.0450149787871394, 157: -10.721876160183028, 160: -8.726775766936944, 162: -6.184229337702528, 400: -3.9618023355855, 401: -10.559357230685254, 402: -7.805696518330991, 403: -12.8619423236793, 404: -6.573154827082856, 406: -5.016917906437816, 407: -2.510217571191138, 409: -8.83659063294415, 410: -9.224356163952914, 412: -5.6360970160739985, 413: -7.6857925911054705, 414: -8.037636607774537, 417: -6.365921765818922, 419: -9.033300927190204, 422: -4.268529106351653, 423: -12.168795143119354, 425: -3.4480898423381343, 426: -5.313122944007442, 427: -6.330336098471274, 431: -13.555089504239245, 433: -9.683888493331354, 434: -3.278384591145073, 435: -4.629768087295359, 438: -3.897310692677474, 439: -12.8619423236793, 443: -13.555089504239245, 444: -7.571153223552054, 445: -5.4920265929124525, 446: -7.558637415620224, 447: -7.486663915995134, 448: -2.551091465751927, 449: -5.8126874824234624, 450: -7.200719463441894, 451: -8.57835576181867, 452: -2.186253833298424, 454: -6.453413532619801, 456: -10.336213679371044, 459: -9.320982999641986, 460: -5.442561740760607},
-		239: {},
-		240: {100: -5.10594547390058, 104: -5.10594547390058, 106: -4.412798293340635, 107: -3.0265039322207445, 108: -5.10594547390058, 112: -4.412798293340635, 115: -5.10594547390058, 122: -5.10594547390058, 127: -5.10594547390058, 129: -5.10594547390058, 130: -5.10594547390058, 138: -3.1600353248452673, 140: -5.10594547390058, 142: -5.10594547390058, 145: -4.412798293340635, 152: -2.3333567516607996, 156: -4.412798293340635, 162: -5.10594547390058, 400: -3.4965075614664802, 404: -5.10594547390058, 406: -3.4965075614664802, 407: -2.16150649473414, 422: -4.412798293340635, 425: -5.10594547390058, 427: -5.10594547390058, 434: -2.540996116439044, 438: -3.4965075614664802, 448: -1.1741198411762548, 449: -5.10594547390058, 452: -2.70805020110221},
-		241: {100: -2.993855513541131, 101: -6.970417040106848, 103: -6.682734967655067, 104: -5.235815984718742, 106: -5.989587787095122, 107: -4.240387932285863, 112: -5.671134055976587, 115: -3.738295988488627, 117: -5.871804751438739, 120: -5.360979127672747, 122: -4.773192462770629, 125: -0.7672069866370257, 127: -2.8928795962011287, 128: -6.1231191797196445, 129: -6.2772698595469025, 130: -3.6623100815107046, 131: -6.459591416340857, 132: -5.671134055976587, 134: -8.069029328774958, 138: -6.682734967655067, 141: -8.069029328774958, 142: -5.766444235780912, 143: -4.177209030664331, 152: -2.981432993542574, 156: -4.736824818599754, 162: -5.504079971313421, 400: -2.8167559007283276, 402: -6.459591416340857, 404: -4.240387932285863, 406: -6.970417040106848, 407: -4.043677638039808, 412: -5.0245068910515345, 413: -8.069029328774958, 417: -5.235815984718742, 422: -4.850153503906757, 425: -4.177209030664331, 426: -4.431443169048572, 427: -4.405467682645312, 434: -3.6870026941010763, 435: -4.810932790753475, 437: -8.069029328774958, 438: -6.459591416340857, 444: -8.069029328774958, 445: -7.3758821482150125, 446: -7.3758821482150125, 448: -8.069029328774958, 452: -2.993855513541131, 454: -5.766444235780912},
-		242: {100: -4.116698496101698, 101: -5.9592873005373885, 103: -7.419246796694014, 104: -5.049543867229909, 106: -4.762634891067116, 107: -3.4165826462256597, 108: -9.91245224929671, 110: -11.52189016173081, 112: -5.134169445599974, 115: -5.301299991631071, 117: -5.850286585438844, 120: -5.554462192451069, 122: -3.9432333111360474, 124: -8.071902615899223, 125: -1.632146626986941, 127: -4.4945756476910335, 128: -8.207704157058284, 129: -6.978595379460806, 130: -4.685093971689167, 131: -7.630069863620183, 132: -6.359965420088328, 133: -6.863179208814689, 134: -6.251457998672309, 135: -6.48169606539301, 138: -4.22853240373676, 141: -9.817142069492384, 142: -4.763795657303079, 143: -5.371287393284531, 145: -7.079238905240493, 152: -1.8412024695773868, 153: -10.017812764954536, 156: -3.8433324576231236, 157: -9.575980012675497, 160: -9.817142069492384, 162: -5.628865687436081, 400: -4.331214127398602, 401: -11.116425053622645, 402: -7.682437849137499, 403: -11.116425053622645, 404: -6.569590444647518, 406: -4.375117982278173, 407: -2.7868474074614724, 409: -8.120692780068655, 410: -8.996161517422555, 412: -4.65704238375995, 413: -7.339840019089603, 414: -9.079543126361605, 417: -6.389037234910305, 419: -8.154594331744336, 422: -4.153865982599834, 425: -3.9414455440911236, 426: -5.0364918585270555, 427: -6.334504355890055, 431: -12.215037342290755, 433: -8.120692780068655, 434: -3.571211499941152, 435: -5.267100273675786, 438: -3.8373361296931168, 444: -6.8969173484465385, 445: -5.266140119977443, 446: -8.244745428738634, 447: -7.324688214069002, 448: -2.274494881803616, 449: -5.738064979401073, 450: -7.28778365713355, 451: -7.640326363787373, 452: -1.9623023616446913, 454: -6.160597996021385, 456: -9.91245224929671, 459: -8.8138399606286, 460: -6.5246778879666945},
-		243: {100: -4.4115592866919, 101: -5.931133218221465, 103: -7.986690387030758, 104: -5.373950365732873, 106: -5.017890995836355, 107: -3.59535407533881, 108: -9.85849256393235, 110: -12.25638783673072, 112: -3.725094520935701, 114: -11.340097104856564, 115: -5.983510830184553, 116: -11.850922728622557, 117: -4.968827196420996, 120: -5.821841317943267, 121: -12.949535017290666, 122: -3.327946292450591, 124: -5.868667120599883, 125: -1.7392699079529312, 127: -3.7356011098043527, 128: -7.144400048374177, 129: -6.4289138897319695, 130: -3.6552212115383043, 131: -5.780185000620066, 132: -5.3317754406821605, 133: -10.87009347561083, 134: -5.601591194141978, 135: -6.983388278166974, 138: -3.857415276701914, 140: -12.949535017290666, 141: -8.701039775241307, 142: -5.119506934756826, 143: -3.385584392748595, 145: -5.780185000620066, 152: -2.0780565212948656, 153: -7.912582414877036, 156: -4.354270290454273, 157: -10.551639744492295, 160: -10.464628367502666, 162: -7.1843439145058205, 400: -4.4813220080961464, 401: -11.157775548062611, 402: -8.23103614599557, 403: -12.949535017290666, 404: -6.9188497570294025, 406: -4.686460181488068, 407: -2.6197671702657876, 409: -9.078334006382775, 410: -9.653698151286337, 412: -4.0086439495052035, 413: -7.473071465359155, 414: -9.905012579567243, 417: -5.271208660783779, 419: -9.01770938456634, 422: -3.3582271688067187, 423: -9.814040801361516, 425: -4.311186704317961, 426: -3.93180899159387, 427: -5.618474712072033, 431: -12.949535017290666, 433: -10.0591632593945, 434: -3.227389501434992, 435: -4.68285657398477, 438: -3.4420574140919467, 443: -11.003624868235352, 444: -6.461850998806055, 445: -5.429842613174126, 446: -7.626525038152257, 447: -7.68684482838578, 448: -2.6619762493340455, 449: -5.661290616270541, 450: -8.240004815978331, 451: -9.338617104646442, 452: -2.632283553831678, 454: -6.021977111012348, 456: -10.241484816188455, 459: -9.77148118694272, 460: -7.117652540007149},
-		244: {},
-		245: {100: -3.591552560215763, 101: -5.404747310163883, 103: -7.092146764067696, 104: -5.002621103321234, 106: -3.391178512634755, 107: -2.9328414725531347, 108: -9.316770315592029, 110: -10.70306467671192, 112: -5.482708851633595, 115: -4.916167295345212, 117: -5.8588775902533285, 120: -5.097262610415923, 122: -4.126595107663696, 124: -6.3210380420380385, 125: -2.1061365172768287, 127: -4.17656981714113, 128: -7.7586256975454795, 129: -6.559929950320387, 130: -4.455021802203491, 131: -6.6957314914794495, 132: -5.843252272350248, 133: -6.831863665804029, 134: -4.937873573927075, 135: -6.21442830697978, 138: -3.096677286939268, 141: -8.21815802692392, 142: -5.585070864295165, 143: -3.898450156649296, 145: -6.118097198041348, 152: -2.2208770944944978, 153: -9.60445238804381, 156: -4.744639983682138, 160: -8.911305207483865, 162: -5.4097598519874275, 400: -4.0412099361666085, 402: -7.484188851843719, 403: -10.70306467671192, 404: -6.677712985976771, 406: -4.2928897947457525, 407: -2.473553557747463, 409: -8.623623135032084, 410: -8.064007347096661, 412: -5.783083750883795, 413: -7.658542238988497, 414: -10.009917496151974, 417: -6.049104326554397, 419: -8.623623135032084, 422: -4.4207979298159135, 423: -10.70306467671192, 425: -4.375127892982725, 426: -5.414797646017385, 427: -6.237156558057336, 433: -7.707332403157929, 434: -3.0252011760337094, 435: -5.515678870871165, 438: -2.6923731375816184, 444: -7.99501447560971, 445: -6.159769894441916, 446: -7.869851332655704, 447: -8.138115319250383, 448: -2.6296617077255147, 449: -5.002621103321234, 450: -5.273719047757479, 452: -2.2363230287917806, 454: -5.783083750883795, 456: -10.70306467671192, 459: -9.09362676427782, 460: -5.634160474491688},
-		246: {},
-		247: {},
-		248: {},
-		249: {},
-		250: {},
-		251: {},
-		252: {100: -3.8989602169930455, 101: -5.664196681536004, 103: -7.241241872313853, 104: -4.983028657736162, 106: -4.3380116903822, 107: -3.8745522679749045, 108: -10.032168700504307, 110: -11.316993601459338, 112: -4.943164989794456, 114: -12.415605890127447, 115: -5.688363278650623, 116: -14.261432580625778, 117: -5.3950859946696585, 120: -5.490165807931589, 121: -12.962149596495518, 122: -3.5306822505857616, 124: -7.025093237871435, 125: -1.6039998551365695, 127: -4.019082792649434, 128: -7.4248153294769805, 129: -6.382393461475447, 130: -3.744887778540468, 131: -6.079525662696459, 132: -5.510169086206409, 133: -9.353691709692155, 134: -5.648989817302444, 135: -7.203534643213922, 136: -15.360044869293889, 138: -3.5669605605219843, 140: -11.696483223164241, 141: -8.953164883224574, 142: -5.184318885799832, 143: -4.342268548704642, 145: -7.170522758545795, 152: -2.0813343688678403, 153: -8.046158037660426, 155: -13.057459776299842, 156: -3.7598963830655916, 157: -11.083378750277832, 160: -8.855756695757242, 162: -6.985798687197583, 400: -4.397101743290844, 401: -11.249171005120576, 402: -7.838726889094648, 403: -11.958847487631733, 404: -6.800942274800441, 405: -14.666897688733943, 406: -3.8437550700482332, 407: -3.43503624391392, 409: -8.829167241568003, 410: -9.24194767125254, 412: -4.217342859093619, 413: -7.3231480966088185, 414: -8.639824714158593, 417: -5.620724662971672, 419: -6.62403748472467, 422: -4.031138400953819, 423: -11.671165415179951, 425: -3.930272778440694, 426: -4.673089656997769, 427: -5.7769692138324595, 431: -12.962149596495518, 433: -9.626703592396142, 434: -3.045831077644524, 435: -4.80202103532547, 438: -3.3708541699088914, 439: -12.315522431570464, 443: -13.568285400065832, 444: -7.429479015059923, 445: -5.276488295017099, 446: -6.6060419357996265, 447: -6.0622432215477104, 448: -2.7223489109035683, 449: -3.0502430380012373, 450: -6.114916671832042, 451: -5.113712570631263, 452: -2.68146236274217, 454: -6.1368869936070904, 456: -10.39720023903398, 459: -9.163600741499367, 460: -5.864375190449522},
-		253: {100: -3.306416029258377, 101: -6.725402952484031, 103: -6.9877672169515215, 104: -6.032255771924086, 106: -5.358526677221241, 107: -3.6273918298096217, 108: -9.290352309945568, 112: -6.6512949803303085, 115: -7.344442160890254, 117: -6.517763587705786, 120: -6.6512949803303085, 122: -3.421055396811793, 125: -2.4017798503802044, 127: -6.8054456601575675, 129: -8.597205129385623, 130: -6.6512949803303085, 131: -8.597205129385623, 132: -8.597205129385623, 134: -7.344442160890254, 135: -9.290352309945568, 138: -4.7470575276755635, 142: -7.093127732609348, 143: -6.199309856587251, 145: -9.290352309945568, 152: -0.7653893812649694, 153: -8.191740021277457, 156: -2.348195604246098, 160: -9.290352309945568, 162: -7.344442160890254, 400: -4.3345252523443065, 406: -4.400003181723814, 407: -3.8831805384854485, 412: -6.294620036391576, 413: -9.290352309945568, 417: -9.290352309945568, 419: -9.290352309945568, 422: -4.279717015849312, 425: -5.679434397301343, 426: -6.9877672169515215, 427: -7.680914397511467, 434: -4.131297010731038, 435: -5.358526677221241, 438: -6.154858094016418, 445: -6.582302108843358, 446: -7.498592840717513, 447: -7.680914397511467, 448: -2.9273242064051024, 449: -4.160453595022494, 450: -5.378329304517421, 451: -7.344442160890254, 452: -2.8967615559949356, 454: -7.344442160890254, 460: -8.597205129385623},
-		254: {},
-		255: {100: -2.2512917986064953, 104: -2.9444389791664407, 107: -3.1675825304806504, 115: -3.8607297110405954, 122: -2.474435349920705, 125: -1.0881409888008142, 130: -4.553876891600541, 138: -2.762117422372486, 142: -4.553876891600541, 143: -3.8607297110405954, 152: -3.8607297110405954, 156: -3.455264602932431, 400: -3.8607297110405954, 407: -4.553876891600541, 412: -4.553876891600541, 417: -3.455264602932431, 425: -3.455264602932431, 434: -3.1675825304806504, 435: -3.8607297110405954, 438: -4.553876891600541, 452: -3.8607297110405954},
-		256: {100: -4.235664336765162, 101: -5.524392453954045, 103: -7.4911762261521115, 104: -5.168876240552239, 106: -4.043072489013538, 107: -3.7895152698882213, 108: -10.64929584159924, 110: -13.59373482076568, 112: -5.059881188456336, 114: -13.59373482076568, 115: -6.636237449888729, 117: -5.6113184739379465, 118: -13.59373482076568, 120: -5.682044300057342, 122: -4.591895723366838, 124: -8.064305733254256, 125: -1.0298723530045988, 127: -4.8745808573031395, 128: -8.280528841723893, 129: -7.004808343232161, 130: -4.762022902944103, 131: -6.746791681180301, 132: -6.083304264387674, 133: -11.028785463304143, 134: -5.7475360052682545, 135: -8.12567467963055, 138: -4.438907158719756, 140: -13.59373482076568, 141: -10.01021588230957, 142: -6.084399554749089, 143: -4.911705386896508, 145: -6.933159670925995, 152: -2.225881371689506, 153: -8.100673377425132, 156: -3.0687035065292996, 157: -12.900587640205735, 160: -9.27624670722937, 162: -8.275614826921464, 400: -4.913402891972262, 401: -11.801975351537624, 402: -8.96876200748141, 404: -7.022851858426096, 406: -3.1133530444938566, 407: -3.3569944942353125, 409: -8.603302233986945, 410: -10.549212383042258, 412: -3.6644333333365724, 413: -8.280528841723893, 414: -9.51619737685996, 417: -6.083304264387674, 419: -6.652544765697305, 422: -4.49869307315296, 423: -12.20744045964579, 425: -4.1613715251439904, 426: -5.009069914234431, 427: -6.993864321552843, 433: -11.98429690833158, 434: -3.3008776672862745, 435: -5.208474300610268, 438: -4.350249850197195, 439: -12.20744045964579, 443: -12.900587640205735, 444: -8.164389191811239, 445: -4.5877162458631195, 446: -7.332243136444638, 447: -6.922968499919806, 448: -2.521658147877695, 449: -4.946917861555937, 450: -7.536950807537056, 451: -6.591578866362059, 452: -3.2021274819991996, 454: -7.046949410005157, 456: -11.19583954796731, 459: -9.787072330995361, 460: -6.886872484162933},
-		257: {100: -4.941642422609305, 101: -6.327936783729195, 103: -6.327936783729195, 104: -5.634789603169249, 106: -4.248495242049359, 107: -4.718498871295094, 112: -4.53617731450114, 115: -5.2293244950610855, 120: -6.327936783729195, 122: -2.0938302791319354, 125: -2.2503993398234754, 127: -3.032099917724866, 128: -6.327936783729195, 130: -2.0374773425808037, 131: -6.327936783729195, 132: -6.327936783729195, 135: -6.327936783729195, 138: -2.4777891820191362, 142: -6.327936783729195, 143: -4.718498871295094, 152: -3.8430301339411947, 156: -6.327936783729195, 160: -6.327936783729195, 400: -3.1924425678000454, 406: -5.634789603169249, 407: -3.5553480614894135, 412: -5.2293244950610855, 417: -5.634789603169249, 422: -4.02535169073515, 425: -2.744417845273085, 426: -5.634789603169249, 427: -4.718498871295094, 434: -4.941642422609305, 435: -4.382026634673881, 438: -2.744417845273085, 444: -5.2293244950610855, 447: -6.327936783729195, 448: -2.772588722239781, 449: -3.3834978045627544, 452: -3.332204510175204, 460: -4.53617731450114},
-		258: {},
-		259: {},
-		260: {100: -4.603957329562882, 101: -6.714170529909472, 103: -8.100464891029363, 104: -6.714170529909472, 106: -2.6755148735479595, 107: -2.7581306390645515, 112: -5.797879798035317, 115: -4.804628025025033, 117: -7.4073177104694174, 120: -4.436903244899716, 122: -4.075113200294213, 124: -6.491026978595262, 125: -2.4067327522266626, 127: -2.924315158455533, 128: -8.100464891029363, 129: -6.714170529909472, 130: -4.768260380854159, 131: -8.100464891029363, 132: -6.714170529909472, 133: -8.100464891029363, 134: -4.699267509367207, 135: -8.100464891029363, 138: -2.8481914629827325, 141: -8.100464891029363, 142: -5.903240313693143, 143: -3.5571701087593586, 145: -8.100464891029363, 152: -2.6032966657361607, 156: -5.156025911862922, 160: -4.603957329562882, 162: -7.4073177104694174, 400: -4.057413623194813, 402: -5.903240313693143, 404: -5.903240313693143, 406: -3.381966019734268, 407: -2.33214389523559, 409: -8.100464891029363, 410: -6.491026978595262, 412: -5.797879798035317, 413: -7.4073177104694174, 417: -5.535515533567826, 422: -5.156025911862922, 425: -4.0061203288072615, 426: -5.615558241241362, 427: -4.666477686544217, 434: -3.2721511537270613, 435: -5.615558241241362, 438: -2.1241139817314285, 444: -6.021023349349527, 445: -5.535515533567826, 446: -6.154554741974049, 447: -7.4073177104694174, 448: -6.3087054218013074, 449: -7.4073177104694174, 450: -7.4073177104694174, 451: -7.4073177104694174, 452: -2.524515787883046, 454: -5.797879798035317, 459: -7.001852602361253, 460: -4.666477686544217},
-		261: {},
-		262: {100: -3.784718459481977, 101: -6.98058652610505, 103: -7.062264557119317, 104: -6.108391114096119, 106: -5.107277189912887, 107: -4.358478754803564, 110: -10.912412158829376, 112: -5.868987041910129, 115: -4.969612783702675, 117: -7.062264557119317, 120: -5.770748602326716, 122: -3.663908086458765, 124: -8.60982706583533, 125: -1.9243407207567418, 127: -4.774685104743141, 128: -9.302974246395275, 129: -7.105749669059056, 130: -5.584535990039795, 131: -9.12065268960132, 132: -6.923428112265102, 133: -8.427505509041376, 134: -6.663916916780017, 135: -8.273354829214117, 138: -4.974875953746949, 141: -9.813799870161265, 142: -5.71391512756355, 143: -5.487462141347973, 145: -8.139823436589595, 152: -2.0855308146987426, 153: -8.715187581493156, 156: -5.104269668848931, 160: -9.813799870161265, 162: -4.502237276863209, 400: -4.400666829184647, 402: -7.223532704715439, 403: -10.912412158829376, 404: -5.551119993119951, 406: -4.220328416322747, 407: -3.6832982810360737, 409: -8.715187581493156, 410: -7.867889721105953, 412: -5.487462141347973, 413: -7.446676256029649, 414: -10.912412158829376, 417: -6.530385524155494, 419: -7.151212043135813, 422: -4.476261790459948, 425: -3.993716939808904, 426: -4.792114739878425, 427: -6.15023822403162, 431: -10.912412158829376, 433: -9.302974246395275, 434: -3.6681846432260254, 435: -5.018009324564525, 438: -4.241645837983501, 444: -7.446676256029649, 445: -5.104269668848931, 446: -7.580207648654172, 447: -7.867889721105953, 448: -1.5104601831521456, 449: -5.403023822201399, 450: -2.414401786829912, 451: -6.818067596607275, 452: -2.147265737190354, 454: -5.527917096040286, 456: -9.526117797709485, 459: -8.60982706583533, 460: -6.834874714923656},
-		263: {},
-		300: {200: -0.15225895278450224, 300: -1.9573362843447062},
-		301: {201: -0.640037355245997, 301: -0.7492366472109889},
-		302: {},
-		303: {},
-		304: {204: -0.03697100476728258, 304: -3.3160498792268744},
-		305: {},
-		306: {206: -0.3618898524754362, 306: -1.1919094133166193},
-		307: {207: -0.014864590735645855, 307: -4.216196443872451},
-		308: {},
-		309: {},
-		310: {210: 0.0},
-		311: {},
-		312: {212: 0.0},
-		313: {},
-		314: {},
-		315: {215: -0.7026619410944851, 315: -0.6837220980982187},
-		316: {216: -0.6931471805599453, 316: -0.6931471805599453},
-		317: {217: -0.14026125751410626, 317: -2.0335595159078217},
-		318: {218: 0.0},
-		319: {},
-		320: {220: -0.6103493408406276, 320: -0.7834244394528702},
-		321: {221: -0.6931471805599453, 321: -0.6931471805599453},
-		322: {222: -0.24867623061733957, 322: -1.513366287948698},
-		323: {},
-		324: {224: -0.020181594737323828, 324: -3.9130580682712353},
-		325: {225: -0.14378203809805257, 325: -2.0104865313727696},
-		326: {},
-		327: {227: -0.033952407837838455, 327: -3.399723676897525},
-		328: {228: -0.024812491911998164, 328: -3.708788640097414},
-		329: {229: -0.43009105470816617, 329: -1.0511082812555934},
-		330: {230: -0.41538716750484356, 330: -1.079058715594638},
-		331: {231: -1.0619399219246302, 331: -0.42431826412433127},
-		332: {232: -0.6129122790767475, 332: -0.7803857433216695},
-		333: {233: -0.43913545259227216, 333: -1.0344929668659584},
-		334: {234: 0.0},
-		335: {235: -0.5887911556507256, 335: -0.8096760200284628},
-		336: {},
-		337: {},
-		338: {238: -0.001225584220815199, 338: -6.704950363246782},
-		339: {},
-		340: {240: 0.0},
-		341: {},
-		342: {242: -0.038706454714843914, 342: -3.2710397079253735},
-		343: {243: -0.0390980816630263, 343: -3.261167222997749},
-		344: {},
-		345: {245: 0.0},
-		346: {},
-		347: {},
-		348: {},
-		349: {},
-		350: {},
-		351: {},
-		352: {252: -0.02741220765591975, 352: -3.6104416243987187},
-		353: {},
-		354: {},
-		355: {255: 0.0},
-		356: {256: -0.005719329272592161, 356: -5.16676204219196},
-		357: {},
-		358: {},
-		359: {},
-		360: {260: 0.0},
-		361: {},
-		362: {262: -0.2694884162268495, 362: -1.4429499090882365},
-		363: {},
-		400: {100: -4.882268156170318, 101: -7.26639576964248, 103: -8.094236458007124, 104: -5.938156924782082, 106: -4.575014898361772, 107: -4.518890361306889, 108: -10.030887281649642, 110: -12.078580125014897, 112: -5.706968277783041, 115: -6.6042108146865015, 116: -13.464874486134788, 117: -5.855507948180576, 120: -6.165077119376627, 122: -3.640321921686802, 124: -8.395970283914556, 125: -1.5213751269156488, 127: -4.008377408535394, 128: -8.31158289163701, 129: -6.749491099800107, 130: -4.607643991932841, 131: -8.217850413974302, 132: -5.942474254747663, 133: -9.337740101089697, 134: -6.78905126449994, 135: -6.283282541522923, 138: -4.909037671126346, 141: -10.899925128673251, 142: -5.736018662282245, 143: -5.077106842159008, 145: -7.540618688720256, 152: -2.858363682368508, 153: -9.04603387833819, 156: -4.333902049542178, 160: -9.108165659445197, 162: -6.90101895960266, 400: -3.4485941061235375, 401: -10.979967836346788, 402: -6.040112724311578, 403: -9.095426633667767, 404: -6.462718531731166, 406: -4.129223923193158, 407: -3.3661902843354006, 409: -8.636560748832487, 410: -9.02222322964447, 412: -4.871831982435112, 413: -5.620241841670107, 414: -8.132155692869418, 417: -4.824932829459497, 419: -6.466364843884187, 422: -4.0801607272138245, 423: -9.636233089645692, 425: -2.5035098376626337, 426: -3.385544655402463, 427: -4.72198101462265, 431: -12.771727305574842, 433: -9.02222322964447, 434: -3.5267922888341605, 435: -3.5263094069859635, 438: -4.241617888892058, 439: -11.267649908798568, 443: -12.366262197466678, 444: -6.808147961956396, 445: -5.47599223282556, 446: -5.130402931533845, 447: -6.744654330999492, 448: -2.5906269560137267, 449: -3.7186257100251514, 450: -5.67417845496005, 451: -5.990102303736918, 452: -2.018836966706191, 454: -4.905388382528299, 456: -8.352886697778244, 459: -6.413885039066743, 460: -5.131363777151846, 463: -13.464874486134788},
-		401: {100: -5.972111818592167, 101: -6.377576926700331, 104: -7.0707241072602764, 106: -6.377576926700331, 107: -4.50577474979874, 112: -6.377576926700331, 115: -5.972111818592167, 117: -6.377576926700331, 120: -7.0707241072602764, 122: -4.298135385020496, 124: -7.0707241072602764, 125: -4.180352349364112, 127: -4.5858174574722765, 129: -4.23751076320406, 130: -5.124813958204963, 132: -5.972111818592167, 134: -7.0707241072602764, 138: -3.8126275692387948, 142: -5.124813958204963, 143: -5.684429746140386, 145: -6.377576926700331, 152: -2.6640048599960235, 156: -7.0707241072602764, 162: -7.0707241072602764, 400: -3.7748872412559478, 401: -5.124813958204963, 402: -5.461286194826176, 406: -4.5858174574722765, 407: -3.2865344733420154, 409: -7.0707241072602764, 412: -5.684429746140386, 413: -3.264061617489957, 414: -5.972111818592167, 417: -5.684429746140386, 419: -6.377576926700331, 422: -4.126285128093836, 425: -3.8126275692387948, 426: -3.851848282392076, 427: -4.672828834461907, 434: -2.616376811006769, 435: -3.0633909220278057, 438: -4.298135385020496, 444: -5.684429746140386, 445: -4.873499529924057, 446: -7.0707241072602764, 448: -4.180352349364112, 449: -5.972111818592167, 450: -6.377576926700331, 452: -0.9704051552402126, 454: -2.9435897222151852, 459: -4.5858174574722765, 460: -7.0707241072602764},
-		402: {100: -4.577175405927603, 101: -6.896289800872859, 103: -9.60434000197507, 104: -6.063380677937755, 106: -5.2285829803147825, 107: -4.846448728969314, 108: -10.297487182535015, 112: -6.007027741386623, 115: -6.219949738629295, 117: -6.831751279735288, 120: -6.713968244078904, 122: -4.881386780330594, 124: -8.911192821415124, 125: -2.3961098287423988, 127: -3.291698163281512, 128: -7.899591909736643, 129: -4.56738739956144, 130: -4.890315411074895, 132: -5.903038027862576, 133: -8.505727713306959, 134: -7.161992966605864, 135: -7.899591909736643, 138: -5.2223133673011874, 141: -9.60434000197507, 142: -6.107832440508589, 143: -5.348727292156846, 145: -7.658429852919756, 152: -3.147570346402906, 153: -9.198874893866904, 156: -5.597006816742598, 160: -7.899591909736643, 162: -6.3854641771068685, 400: -3.3640641568042993, 401: -9.60434000197507, 402: -3.475289791914524, 403: -10.297487182535015, 404: -5.775698605485974, 405: -9.60434000197507, 406: -4.3160729712805335, 407: -3.27819052881997, 409: -7.732537825073478, 410: -7.899591909736643, 412: -4.587060165160144, 413: -3.477470817860884, 414: -8.911192821415124, 417: -4.157602630308759, 419: -5.293540876589555, 422: -4.717757356548792, 423: -7.658429852919756, 425: -2.2300245155249567, 426: -2.319176212667293, 427: -3.8758649147284965, 433: -6.405666884424388, 434: -4.013353021464212, 435: -3.4973171142328145, 438: -4.092929419966324, 439: -8.351577033479701, 444: -4.587060165160144, 445: -4.655580111596901, 446: -6.896289800872859, 447: -7.732537825073478, 448: -3.698978153920499, 449: -5.254062065615768, 450: -6.048991940485656, 451: -6.659901022808628, 452: -1.8385588992507527, 454: -3.6141262367687395, 459: -4.367898039145119, 460: -4.931511167513163, 461: -9.60434000197507, 463: -10.297487182535015},
-		403: {100: -4.527980547296962, 103: -6.473890696352274, 106: -4.276666119016055, 107: -3.908941338890738, 115: -5.780743515792329, 120: -5.780743515792329, 122: -5.375278407684165, 125: -2.623743094642216, 127: -4.276666119016055, 130: -6.473890696352274, 132: -6.473890696352274, 138: -4.527980547296962, 143: -5.780743515792329, 152: -2.4849066497880004, 156: -5.780743515792329, 160: -6.473890696352274, 162: -6.473890696352274, 400: -3.58351893845611, 402: -5.780743515792329, 403: -5.0875963352323845, 404: -6.473890696352274, 406: -3.58351893845611, 407: -2.396353252446555, 412: -3.765840495250065, 413: -4.276666119016055, 417: -5.375278407684165, 419: -4.527980547296962, 422: -5.375278407684165, 425: -2.396353252446555, 426: -2.7850112422383386, 427: -4.276666119016055, 434: -3.834833366737016, 435: -3.58351893845611, 438: -3.765840495250065, 444: -5.780743515792329, 445: -3.908941338890738, 447: -6.473890696352274, 448: -2.890371757896165, 449: -5.375278407684165, 450: -6.473890696352274, 452: -1.7203005052459102, 454: -3.908941338890738, 460: -4.527980547296962},
-		404: {100: -5.5501579721628405, 101: -8.42560407984233, 103: -9.054212739264702, 104: -6.103216359552103, 106: -6.2064005957873345, 107: -5.491747210006426, 108: -9.524216368510439, 110: -11.133654280944539, 112: -6.103216359552103, 115: -6.726935033680286, 117: -6.388722152581289, 120: -6.199180347813847, 122: -5.201409093496529, 124: -9.341894811716484, 125: -0.8080411794071395, 127: -4.843938710035542, 128: -11.133654280944539, 129: -7.039309718722438, 130: -5.03109568633097, 131: -6.856988161928484, 132: -6.406266462232199, 133: -11.133654280944539, 134: -8.137922007390548, 135: -8.831069187950494, 138: -5.27572112646108, 141: -11.133654280944539, 142: -6.590359498674536, 143: -6.569306089476703, 145: -8.42560407984233, 152: -2.9527733389481496, 153: -10.440507100384593, 156: -3.8922879976222213, 160: -11.133654280944539, 162: -7.914778456076339, 400: -4.348066635936609, 401: -11.133654280944539, 402: -7.326991791174219, 404: -3.777374404393791, 406: -5.033335328924475, 407: -3.9437321101987313, 409: -8.93642970360832, 410: -11.133654280944539, 412: -4.179015416063551, 413: -5.5315354600648385, 414: -7.667918378144813, 417: -5.242010069118768, 419: -6.8029209406582085, 422: -4.703934802905402, 423: -9.341894811716484, 425: -2.21206321738056, 426: -3.5845715701322534, 427: -5.52418248575958, 433: -8.361065558704757, 434: -3.5322519463608057, 435: -4.266720996482658, 438: -5.1623924411540765, 439: -11.133654280944539, 444: -6.764206428477518, 445: -5.509636774757201, 446: -8.300440936888323, 447: -10.03504199227643, 448: -3.7324230165315244, 449: -7.99816006501539, 450: -8.137922007390548, 451: -8.42560407984233, 452: -2.242417851428477, 454: -5.865796121881211, 456: -9.054212739264702, 459: -6.829589187740369, 460: -7.732456899282384, 463: -10.03504199227643},
-		405: {125: -2.4159137783010487, 127: -4.02535169073515, 400: -2.9267394020670396, 402: -2.639057329615259, 406: -4.02535169073515, 409: -3.332204510175204, 412: -4.02535169073515, 413: -2.2335922215070942, 417: -2.9267394020670396, 425: -3.332204510175204, 426: -2.639057329615259, 427: -4.02535169073515, 435: -3.332204510175204, 438: -4.02535169073515, 452: -1.3862943611198906, 454: -2.9267394020670396, 459: -3.332204510175204, 460: -4.02535169073515},
-		406: {100: -3.749888765843523, 101: -5.751212180108722, 103: -6.839186070571776, 104: -4.513975282142273, 106: -4.925731962812107, 107: -3.8634270737943943, 108: -8.945438869702269, 112: -4.951635536702046, 114: -12.080933085631418, 115: -5.971685502867053, 117: -5.125578827850003, 120: -4.973917195798404, 122: -3.98754719657121, 124: -7.391881415662625, 125: -1.3248137093316992, 127: -3.3451265889875033, 128: -8.220203374590824, 129: -6.244661427929139, 130: -3.2528419087133984, 131: -5.618293720387238, 132: -5.084709158661673, 133: -10.694638724511528, 134: -5.304711196611131, 135: -7.164608471006404, 138: -3.721212753039745, 140: -10.98232079696331, 141: -9.308344363391637, 142: -5.163722647878527, 143: -4.300629997723045, 145: -9.38969000284559, 152: -1.8979878131047012, 153: -8.06005567529119, 156: -3.3817934077513097, 157: -12.774080266191364, 160: -13.46722744675131, 162: -7.100756999019872, 400: -4.146240554688063, 401: -10.576855688855144, 402: -7.065310250024123, 403: -10.066030065089153, 404: -6.540650413528584, 405: -12.774080266191364, 406: -5.9700202235479916, 407: -2.981552207713544, 409: -9.66056495698099, 410: -12.774080266191364, 412: -5.020885996307023, 413: -6.609713384205919, 414: -6.678255703759139, 417: -5.473269899177744, 419: -7.289283332700709, 422: -4.234147587805637, 423: -10.422705009027887, 425: -3.771502343491089, 426: -5.150193970258906, 427: -5.5391818458765325, 431: -13.46722744675131, 433: -10.471495173197319, 434: -3.0984661834181555, 435: -5.177687962127168, 438: -3.44942954803777, 439: -11.069332173952938, 443: -13.46722744675131, 444: -7.451070287052956, 445: -6.664832683426998, 446: -7.205735762430267, 447: -7.325190041163953, 448: -6.239564948022655, 449: -9.21873220470195, 450: -7.9065458157357815, 451: -8.483620825042973, 452: -2.535299743524074, 454: -5.499254267088374, 456: -9.38969000284559, 459: -8.592030123550158, 460: -9.555204441323163},
-		407: {100: -3.247460873265525, 101: -5.180368772925723, 103: -7.2331315265056615, 104: -6.141564276760761, 106: -5.854471537397445, 107: -3.3844620971140142, 108: -7.414829252961232, 110: -11.750250834371018, 112: -6.904939520700147, 114: -14.389308163986277, 115: -5.296963350247802, 117: -7.628893472902849, 120: -5.482779246391759, 122: -4.4466960567661, 124: -8.908669240644286, 125: -3.558332801482778, 127: -4.900200336947887, 128: -9.277320375629735, 129: -7.263220890687153, 130: -6.287933492757696, 131: -9.301711828753893, 132: -7.56602204163059, 133: -8.712554361717995, 134: -6.003591335358426, 135: -6.971727761571733, 138: -4.805819285378473, 141: -11.556094819930061, 142: -6.960380969184005, 143: -5.802215845190373, 145: -6.735338983507503, 152: -1.552700715240268, 153: -7.78672027179694, 155: -11.253813948057127, 156: -5.258443993511891, 157: -9.372028327171353, 160: -8.639915178078024, 162: -6.510773967845916, 400: -2.849594722259666, 401: -10.778390251342053, 402: -6.714226306269944, 404: -7.68244582738353, 405: -14.389308163986277, 406: -5.706770039983202, 407: -2.671989848466785, 409: -7.655906272148918, 410: -9.994859009313839, 412: -5.232263089068319, 413: -6.927667771777703, 414: -8.58417319506979, 417: -6.654749319631521, 419: -8.433470794521446, 422: -3.9899360515534585, 423: -12.443398014930963, 425: -4.253320965211914, 426: -5.506083933707282, 427: -6.596546443169752, 433: -9.207524613694192, 434: -2.5374848351799977, 435: -5.086479772574943, 438: -4.810304990426035, 439: -12.086723070992232, 443: -11.344785726262854, 444: -6.879972897969686, 445: -6.023635780211282, 446: -5.282441200486371, 447: -5.801097485334759, 448: -5.992250773810021, 449: -6.478717551729799, 450: -8.18273223726135, 451: -7.007183798248764, 452: -1.1370329366057388, 454: -4.917450039578993, 456: -10.700428709872341, 459: -8.311665920637243, 460: -8.273416038503242, 461: -12.779870251552177},
-		408: {},
-		409: {100: -3.0847761839373105, 101: -5.975147941833475, 103: -6.973676771944603, 104: -8.22643974043997, 106: -6.280529591384657, 107: -4.6569070439586, 112: -7.127827451771861, 115: -5.875064483276493, 117: -7.533292559880025, 120: -6.84014537932008, 122: -6.1469981987601345, 125: -2.987341733551905, 127: -4.1833884726054205, 129: -5.74153309065197, 130: -6.1469981987601345, 132: -7.127827451771861, 133: -8.919586920999915, 134: -8.919586920999915, 138: -5.587382410824712, 142: -7.533292559880025, 143: -5.923854647445925, 152: -3.078945263626517, 156: -5.923854647445925, 162: -6.5216916482015455, 400: -1.6775045617429536, 402: -3.9023070841849914, 403: -8.919586920999915, 404: -7.820974632331806, 406: -5.181917302716547, 407: -3.2601047052402943, 409: -6.61700182800587, 410: -8.919586920999915, 412: -5.623750054995586, 413: -4.700079215823809, 414: -7.533292559880025, 417: -4.237455693875696, 419: -8.22643974043997, 422: -2.8913084007692174, 425: -3.3248755413980766, 426: -3.856991887972949, 427: -4.021747121049004, 433: -8.919586920999915, 434: -3.246263653828423, 435: -3.942853178579341, 438: -4.344875942496532, 444: -7.127827451771861, 445: -3.011503982830985, 446: -5.181917302716547, 447: -8.22643974043997, 448: -4.760703837640244, 449: -5.875064483276493, 450: -5.700711096131715, 451: -7.310149008565815, 452: -1.6117141402362094, 454: -3.819720493175717, 459: -3.819720493175717, 460: -5.336067982543805, 461: -8.919586920999915},
-		410: {100: -5.0759357200325885, 101: -7.180069874302796, 103: -8.789507786736896, 104: -6.997748317508842, 106: -4.458774446450565, 107: -3.19852080622604, 108: -7.180069874302796, 110: -6.843597637681583, 112: -6.081457585634687, 115: -5.422211956750423, 120: -4.541012544687538, 122: -4.370667178940299, 124: -7.403213425617006, 125: -2.649623234510641, 127: -3.2326797250373587, 128: -6.71006624505706, 129: -6.081457585634687, 130: -5.611453956388951, 132: -6.22455842927536, 133: -6.592283209400677, 134: -6.016919064497116, 138: -2.8652519893223647, 141: -7.6908954980687865, 142: -6.22455842927536, 143: -4.135547436579373, 145: -8.096360606176951, 152: -2.889610433154405, 156: -6.150450457121638, 160: -7.6908954980687865, 162: -6.391612513938526, 400: -3.6190237916987447, 401: -8.789507786736896, 402: -5.5706319618686955, 404: -6.843597637681583, 406: -5.95629444268068, 407: -2.966461891253878, 410: -2.770914572240662, 412: -6.22455842927536, 413: -4.02733385193914, 417: -4.87748478130875, 419: -8.096360606176951, 422: -4.819215873184775, 425: -4.035917595630532, 426: -4.31217097225869, 427: -4.02733385193914, 433: -7.180069874302796, 434: -4.300871417004757, 435: -4.458774446450565, 438: -1.6554140655440306, 444: -6.486922693742851, 445: -6.081457585634687, 446: -7.6908954980687865, 447: -8.789507786736896, 448: -5.0759357200325885, 449: -3.2882495761921695, 450: -4.939360185026838, 451: -5.845068807570456, 452: -2.4597868812142, 454: -4.819215873184775, 456: -8.789507786736896, 459: -5.028307671043334, 460: -3.217353754559132},
-		411: {},
-		412: {100: -4.5189380050412975, 101: -5.568568393368359, 103: -8.630886271681646, 104: -5.295175036697232, 106: -4.829520114262749, 107: -3.4704187423213764, 108: -9.391856048580353, 110: -12.336295027746793, 112: -5.595381734710225, 115: -5.543576558753014, 117: -5.531311026800602, 120: -5.76447879422421, 122: -3.292520980620059, 124: -5.557889420792505, 125: -2.2833391691771365, 127: -4.006556545236259, 128: -7.8665628126538065, 129: -6.526152537099683, 130: -4.008166073991903, 131: -6.479314708018606, 132: -5.854717898470362, 133: -8.136589949866867, 134: -5.5213862991310085, 135: -7.394652605137489, 138: -4.091085553064239, 140: -13.434907316414902, 141: -9.543087018304277, 142: -5.524683609317559, 143: -4.941597065461997, 145: -7.075333447742525, 152: -1.8726489774260204, 153: -8.590720229956311, 155: -12.336295027746793, 156: -4.586398015526823, 157: -10.439175042860912, 160: -10.299413100485754, 162: -6.242725257701657, 400: -4.341662716314968, 401: -10.490468337248462, 402: -7.385173861182945, 403: -11.825469403980803, 404: -6.248763011892578, 406: -4.694410586483091, 407: -2.6193579613346563, 409: -8.8916125341449, 410: -9.969171413615177, 412: -4.242934601796906, 413: -6.3373584658001105, 414: -9.245252574388477, 417: -4.714446803689248, 419: -7.594265659041505, 422: -4.017715389901488, 423: -11.355465774735068, 425: -3.206225167167223, 426: -4.366476190621413, 427: -5.358391988862574, 433: -8.479080258813642, 434: -3.130900198163625, 435: -4.120206929114477, 438: -3.7766172247244008, 439: -10.950000666626902, 444: -6.7231669213587235, 445: -5.226415564674522, 446: -7.2993424253331645, 447: -7.562789526939487, 448: -2.442689475065116, 449: -4.533268384114577, 450: -7.594265659041505, 451: -8.172217127510017, 452: -1.9293589957837238, 454: -5.149646182345955, 456: -8.980560020161395, 459: -8.107031147625323, 460: -7.2907216822892575, 461: -13.434907316414902},
-		413: {100: -5.476266964133363, 101: -7.22211673205734, 103: -8.976135873302548, 104: -6.491229223514548, 106: -5.60501594687767, 107: -4.53610241935431, 108: -10.480213270078822, 112: -5.793463097098308, 115: -6.609012259170931, 117: -7.772163068976612, 120: -7.241534817914442, 122: -4.950784182567399, 124: -9.381600981410712, 125: -2.927713549764907, 127: -3.5941924832426912, 128: -7.995306620290822, 129: -5.597411347492451, 130: -5.12362699540681, 131: -9.227450301583454, 132: -6.283011322417013, 133: -9.381600981410712, 134: -7.261337445210621, 135: -7.995306620290822, 138: -5.353277520286405, 141: -11.173360450638768, 142: -5.705300309503636, 143: -5.608840043316074, 145: -7.646999926022606, 152: -3.2602052647106996, 156: -5.8262529199212985, 160: -10.480213270078822, 162: -6.939253946041508, 400: -3.550696499315172, 401: -7.509798804509121, 402: -4.379894318058758, 404: -5.601206418461003, 405: -8.775465177840397, 406: -4.357720460564436, 407: -3.363819125985357, 409: -6.939253946041508, 410: -6.568190264650676, 412: -4.15944497582824, 413: -2.4561695174156326, 414: -8.34014710658255, 417: -3.8331736153186515, 419: -6.049396471235508, 422: -4.53610241935431, 423: -7.562442537994543, 425: -2.1568475756427414, 426: -2.455514745743852, 427: -3.5575693786029343, 431: -9.787066089518877, 433: -6.002876455600616, 434: -3.668418382242596, 435: -3.5151329244774154, 438: -4.273637343353895, 439: -8.608411093177232, 444: -5.267998602584197, 445: -4.4246009031470885, 446: -7.646999926022606, 447: -7.806064620652293, 448: -4.073333284009507, 449: -6.268085672200338, 450: -5.816774175966755, 451: -6.882901009490376, 452: -1.7862975002691117, 454: -3.248926265751207, 456: -8.976135873302548, 459: -3.428790641284272, 460: -5.659931704473785, 463: -8.870775357644721},
-		414: {100: -4.294919559325406, 101: -2.9543936166610956, 103: -7.057036981697891, 104: -3.9509566509750353, 106: -5.2934483894365325, 107: -3.9584473227041928, 112: -5.670742620578001, 115: -4.337936944409097, 117: -5.237878538281722, 120: -4.878504537373825, 122: -4.572130331909891, 124: -8.155649270366002, 125: -0.8545014645099691, 127: -3.633860693316961, 128: -6.450901178127576, 129: -6.140746249823736, 130: -4.1853573568138795, 131: -6.450901178127576, 132: -4.689913367566275, 134: -8.155649270366002, 135: -5.211210291199561, 138: -4.139266249613613, 141: -8.848796450925946, 142: -5.211210291199561, 143: -4.721662065880855, 145: -8.155649270366002, 152: -4.078111826460282, 153: -7.057036981697891, 156: -3.2690666249397244, 162: -6.209739121310688, 400: -5.211210291199561, 401: -8.848796450925946, 402: -6.076207728686165, 404: -7.239358538491846, 406: -4.95697615281532, 407: -4.194836100768423, 409: -7.462502089806056, 410: -8.848796450925946, 412: -5.4475990692637914, 413: -5.4475990692637914, 414: -6.651571873589727, 417: -3.7612001156935624, 419: -7.057036981697891, 422: -4.5583370097775555, 425: -3.1893142351663255, 426: -3.5454915428668707, 427: -4.754451888703846, 434: -4.112598002531451, 435: -3.7989404436764094, 438: -3.492210176253934, 439: -6.76935490924611, 444: -6.76935490924611, 445: -4.878504537373825, 446: -8.155649270366002, 447: -7.239358538491846, 448: -4.382888332271363, 449: -6.651571873589727, 450: -6.651571873589727, 451: -8.155649270366002, 452: -4.2436262649378556, 454: -4.771259007020227, 459: -8.848796450925946, 460: -6.902886301870633},
-		415: {},
-		416: {},
-		417: {100: -5.172473979259473, 101: -6.87283716800434, 103: -8.009601222636825, 104: -5.893345707834273, 106: -5.573068157133041, 107: -4.6084038409746695, 108: -11.41079860429898, 110: -10.717651423739035, 112: -4.908758464680218, 115: -6.85692171269844, 117: -5.335452573210296, 120: -6.578492845727142, 122: -4.73308561832221, 124: -9.464888455243667, 125: -1.945544732919786, 127: -3.3086664146643545, 128: -7.945062701499254, 129: -4.648647299175636, 130: -3.5537047393964882, 131: -6.933461789820774, 132: -4.892389051545124, 133: -8.390373718154619, 134: -6.52799668171261, 135: -8.319756150940664, 138: -5.250646691672848, 141: -10.02450424317909, 142: -5.098156765605424, 143: -5.267686507581859, 145: -7.582157207809885, 152: -2.874979948759578, 153: -9.706050512060555, 156: -4.615092829125467, 160: -11.005333496190817, 162: -7.638037666204342, 400: -3.0959664250144248, 401: -7.9930719206856145, 402: -5.816087224697141, 403: -10.717651423739035, 404: -5.418084837790567, 405: -11.41079860429898, 406: -3.7580155228799095, 407: -3.389378234022441, 409: -7.914291042832501, 410: -9.464888455243667, 412: -3.656102671577482, 413: -4.71006749475117, 414: -6.776069616069345, 417: -2.551080104548453, 419: -6.516697126458676, 422: -4.04025315058941, 423: -9.906721207522706, 425: -2.173475560992911, 426: -3.277504743076347, 427: -4.037738216852662, 432: -12.103945784858926, 433: -7.550068893258385, 434: -3.53510936029085, 435: -3.522464103560327, 438: -4.578845038733125, 439: -8.638209882059199, 444: -5.921860878142295, 445: -4.292782399833648, 446: -6.674600155904485, 447: -8.845849246837444, 448: -3.9122057635814684, 449: -7.308155239262185, 450: -5.61019194500724, 451: -7.9930719206856145, 452: -2.2954834527319083, 454: -4.727437658532706, 456: -10.312186315630871, 459: -5.334303808006423, 460: -6.719450722069837, 461: -9.80136069186488},
-		418: {},
-		419: {100: -4.430072474283456, 101: -5.732752695362214, 103: -8.130647968160584, 104: -4.668595310777154, 106: -4.510236313324109, 107: -3.051072091526119, 108: -7.562663930554645, 112: -5.347090214550229, 114: -10.9638613122168, 115: -4.804765923724867, 117: -6.348740795375541, 120: -5.100230136618703, 122: -4.013046543774216, 124: -8.565966039418429, 125: -1.6616712866107384, 127: -4.8657870300505595, 128: -8.25581111111459, 129: -7.072041014106174, 130: -5.776475506376045, 131: -7.300299666087153, 132: -5.839897332813541, 133: -8.398911954755263, 134: -5.593223284089137, 135: -6.6331279719304685, 138: -4.17827366720887, 142: -4.96244643425565, 143: -5.1232196548434015, 145: -6.184737819105271, 152: -2.0100920176673456, 153: -9.577566951096909, 156: -4.719694411553064, 157: -10.9638613122168, 160: -9.86524902354869, 162: -6.431261819063544, 400: -4.418511651882381, 401: -9.017951163161486, 402: -7.113713710506741, 404: -6.7443536070406935, 406: -3.6223774598536393, 407: -2.3396090485071546, 409: -6.729754807619541, 410: -10.270714131656854, 412: -4.676002752055016, 413: -6.729754807619541, 414: -8.4789546624288, 417: -6.281730085092581, 419: -5.680657583478811, 422: -4.051118491723623, 423: -10.9638613122168, 425: -3.9644388447088392, 426: -4.852393972714122, 427: -6.509514015963292, 433: -8.884419770536963, 434: -2.8920181626076418, 435: -4.907077298988176, 438: -4.34512232869958, 444: -7.032035679492474, 445: -5.158726343300311, 446: -7.38034237376069, 447: -7.596565482230326, 448: -2.5749561411020934, 449: -7.300299666087153, 450: -8.073489554320636, 451: -8.661276219222755, 452: -2.081886127967933, 454: -5.55221526036176, 456: -10.270714131656854, 459: -7.872818858858484, 460: -6.509514015963292},
-		420: {},
-		421: {},
-		422: {100: -4.681467579073693, 101: -6.248786735889066, 103: -8.34941116733404, 104: -5.113227428758092, 106: -5.254608839888542, 107: -4.753386346811339, 108: -10.603469219433425, 110: -12.449295909931756, 112: -4.3012358973762925, 114: -12.85476101803992, 115: -7.054154358748179, 117: -5.180840465761266, 120: -6.371653666582722, 121: -13.547908198599865, 122: -4.314046631582337, 124: -6.713799459786027, 125: -1.6921663778419098, 127: -4.03605681623546, 128: -8.423944219196606, 129: -6.333403784448722, 130: -3.8279228996058547, 131: -5.443506890678253, 132: -5.290263240391638, 133: -10.775319476360085, 134: -6.049592327832884, 135: -5.522718876709031, 136: -13.547908198599865, 138: -4.779956288836445, 141: -10.908850868984608, 142: -5.970786267723187, 143: -4.277131457819852, 145: -7.653505364335015, 152: -2.304235670613051, 153: -9.504856930765316, 156: -4.725733437653786, 160: -10.908850868984608, 162: -7.761010817233158, 400: -3.6441706773085305, 401: -11.150012925801494, 402: -7.296004315433977, 403: -13.547908198599865, 404: -6.346737315318187, 405: -13.547908198599865, 406: -4.536628707421936, 407: -3.693505853754229, 409: -9.88434655247022, 410: -10.412413982670715, 412: -4.305778504134276, 413: -6.6572990784526995, 414: -8.635253312863814, 417: -5.061380421494514, 419: -6.692499399989938, 422: -2.07154298894144, 423: -10.775319476360085, 425: -2.513244366522365, 426: -3.786329892246431, 427: -5.6640849837077125, 431: -13.547908198599865, 433: -10.71469485454365, 434: -3.2018203312933133, 435: -4.091880310874579, 438: -4.736404948441626, 439: -9.763718564681605, 444: -5.929165820929453, 445: -6.098410193217016, 446: -7.7702558753772095, 447: -7.857548744275805, 448: -3.411920999825503, 449: -5.48075915868976, 450: -6.323154792831895, 451: -10.146710816937711, 452: -2.1327389024275285, 454: -5.230630432378631, 456: -10.11392099411472, 459: -7.968178372613644, 460: -6.600932206464448},
-		423: {100: -5.269145989247757, 104: -6.655440350367647, 106: -5.046002437933547, 107: -4.709530201312334, 115: -6.655440350367647, 120: -5.269145989247757, 122: -5.556828061699537, 125: -2.685148436815526, 127: -4.0163830207523885, 129: -5.556828061699537, 130: -4.458215773031428, 131: -6.655440350367647, 132: -4.458215773031428, 133: -6.655440350367647, 134: -5.962293169807702, 138: -5.962293169807702, 142: -5.962293169807702, 143: -5.556828061699537, 152: -3.189704447567921, 400: -4.3528552573736015, 402: -4.709530201312334, 404: -5.962293169807702, 406: -5.556828061699537, 407: -3.765068592471483, 409: -6.655440350367647, 412: -3.765068592471483, 413: -2.917770732084279, 417: -4.1705337005796475, 419: -4.863680881139593, 422: -3.4773865200197016, 423: -5.046002437933547, 425: -1.9640924681385037, 426: -1.9826115159057414, 427: -3.189704447567921, 434: -3.4773865200197016, 435: -3.3232358401924436, 438: -3.1290798257514862, 439: -5.556828061699537, 444: -4.3528552573736015, 445: -3.3596034843633182, 446: -6.655440350367647, 448: -5.556828061699537, 450: -5.269145989247757, 452: -2.577902906461928, 454: -3.9473901492654373, 459: -3.822227006311431},
-		424: {},
-		425: {100: -4.686340403757639, 101: -6.376123176014915, 103: -8.580965984236023, 104: -5.6517715300296745, 106: -4.494207478442838, 107: -3.6985628652441704, 108: -9.426915226751934, 110: -11.14591534169756, 112: -4.765614408579322, 114: -14.090354320864, 115: -5.741106520297211, 116: -14.090354320864, 117: -5.969171078785172, 120: -5.9134004526382205, 122: -4.233172521204384, 124: -8.45556471769475, 125: -2.139541734531748, 127: -3.8383600940569904, 128: -7.92493646663258, 129: -6.198275899615881, 130: -4.4897301627753174, 131: -7.394555403805509, 132: -5.803832947182764, 133: -8.43087210510438, 134: -6.179763708607522, 135: -7.639883898719824, 138: -4.299251898922663, 141: -10.479436408219776, 142: -5.148285627819775, 143: -4.802127410230747, 145: -6.129681712475883, 152: -2.3490351109974346, 153: -9.127509690604093, 155: -13.397207140304054, 156: -4.829225782055713, 157: -11.257140976807785, 160: -8.797049496139508, 162: -6.3730030486786715, 400: -3.8619250389526267, 401: -10.139110602282573, 402: -6.618422242618879, 403: -9.60171795113186, 404: -5.90584056783028, 405: -13.397207140304054, 406: -3.7021900670569434, 407: -2.7155421580234065, 409: -7.954789429782262, 410: -8.64361694919769, 412: -3.303305861076636, 413: -5.406630258560132, 414: -8.88086816802258, 417: -4.874131285729662, 419: -6.1161656515779885, 422: -4.063985638952057, 423: -9.93147123750433, 425: -2.560001596571246, 426: -3.387087381052046, 427: -4.6523207628750365, 431: -12.99174203219589, 433: -8.334612107277088, 434: -3.1431155344510278, 435: -3.9510439398565893, 438: -3.7057068525176384, 439: -10.999311867505686, 444: -6.090004825539316, 445: -4.403345081773321, 446: -6.884719144453637, 447: -7.5111031088538995, 448: -2.910791930970953, 449: -5.339512945488468, 450: -6.671573438113207, 451: -7.500053272667315, 452: -1.9713112343069297, 454: -4.846192400412307, 456: -8.832858948836218, 459: -6.674577345448607, 460: -5.386183761117614, 463: -13.397207140304054},
-		426: {100: -4.697871606694985, 101: -6.304364008899328, 103: -8.94568634514544, 104: -5.642819573212852, 106: -4.7012810883476055, 107: -3.7775499809823483, 108: -9.099837024972697, 110: -11.297061602308917, 112: -4.788540881684308, 115: -5.838895534818984, 117: -6.204334419494189, 120: -6.116214820235219, 122: -4.321959249867169, 124: -8.444430172395599, 125: -2.1141095564293915, 127: -3.5597361016571902, 128: -7.790503704988935, 129: -5.90192005112534, 130: -4.448702533283436, 131: -7.304995889207234, 132: -5.639904968992771, 133: -8.826141194495657, 134: -6.207409767894436, 135: -7.790503704988935, 138: -4.4395475397635265, 140: -13.088821071536971, 141: -9.425259425407326, 142: -5.4656680030600695, 143: -4.886338624960435, 145: -6.750226993333789, 152: -2.366368997756193, 153: -10.31623234929719, 156: -4.9953587970357916, 157: -11.70252671041708, 160: -8.9299379881773, 162: -6.512351502488747, 400: -3.7492962411799633, 401: -9.099837024972697, 402: -5.7702815229690705, 403: -11.479383159102872, 404: -6.0749055967264445, 405: -12.395673890977026, 406: -3.817385600200978, 407: -2.8382393242190087, 409: -7.677175019681932, 410: -8.556221578383715, 412: -3.375042294228816, 413: -4.515247546684627, 414: -9.011283627631252, 417: -4.625028656847849, 419: -5.85392265122214, 422: -4.085012985069802, 423: -9.953326855607822, 425: -2.6526192099772157, 426: -2.9829234280735464, 427: -4.4907854922766335, 431: -12.395673890977026, 433: -8.252539164585494, 434: -3.054085982391171, 435: -3.7196836878137916, 438: -3.7349865999220597, 439: -10.044298633813549, 443: -13.088821071536971, 444: -6.0597335073873095, 445: -4.31683063500473, 446: -7.280678581556528, 447: -7.169927217263825, 448: -3.261620787172963, 449: -6.311174477901855, 450: -6.430810025666223, 451: -7.464803565349633, 452: -1.996119085650453, 454: -4.4010415795452005, 456: -9.11852915798485, 459: -5.687589807123957, 460: -6.055314587249274, 461: -11.479383159102872, 463: -11.70252671041708},
-		427: {100: -5.2245052086251365, 101: -6.9481268726859735, 103: -9.86889610776651, 104: -6.202489509504631, 106: -5.3740369425961, 107: -4.238879783349917, 108: -10.274361215874674, 110: -11.372973504542783, 112: -5.4171361350779526, 115: -6.194002895627312, 117: -6.288468361880072, 120: -6.8034304961978425, 122: -4.442478738591157, 124: -9.021598247379305, 125: -1.7432322467199572, 127: -3.2703870791519933, 128: -7.803440808061413, 129: -4.9586952109920235, 130: -4.310353514999731, 131: -8.304920569409166, 132: -5.677559279557099, 133: -9.501171327641192, 134: -6.895636690064577, 135: -8.377241230988792, 138: -5.033496424074722, 140: -12.066120685102728, 141: -9.986679143422892, 142: -5.439402935853703, 143: -4.7978976639431625, 145: -6.984716320118265, 152: -2.7372864190787567, 153: -10.679826323982837, 156: -5.629970316733301, 160: -10.456682772668628, 162: -7.110293627501467, 400: -3.4741911475724736, 401: -9.358070484000518, 402: -5.86764196861042, 403: -12.066120685102728, 404: -5.181634033059946, 405: -10.967508396434619, 406: -4.133040913222314, 407: -3.2773748031645935, 409: -8.664923303440572, 410: -8.569613123636248, 412: -4.131965451566406, 413: -4.12767513393794, 414: -8.455202772458504, 417: -4.008426490287141, 419: -6.4457198193855785, 422: -4.3073601409450655, 423: -8.770283819098399, 425: -2.5035753446592652, 426: -3.1405333460724467, 427: -3.3559957577805206, 431: -11.372973504542783, 433: -8.194919674194837, 434: -3.0260942507962234, 435: -3.8497623527165725, 438: -4.068121367122998, 439: -9.293531962862946, 443: -10.679826323982837, 444: -5.389037223855593, 445: -4.049142938340466, 446: -6.777853654408193, 447: -5.333909978635522, 448: -3.5616075468438653, 449: -6.615082231537028, 450: -6.623702974580935, 451: -8.174300386992101, 452: -2.032350762303678, 454: -3.9275559478410957, 456: -10.967508396434619, 459: -5.3080261806749975, 460: -6.577182958946042, 461: -11.372973504542783, 463: -12.066120685102728},
-		428: {},
-		429: {},
-		430: {},
-		431: {130: -1.791759469228055, 156: -3.9318256327243257, 400: -3.9318256327243257, 404: -3.9318256327243257, 407: -4.624972813284271, 412: -4.624972813284271, 413: -3.2386784521643803, 417: -0.4978384282391796, 419: -4.624972813284271, 426: -4.624972813284271, 434: -4.624972813284271, 435: -3.5263605246161616, 452: -4.624972813284271, 454: -4.624972813284271, 459: -3.5263605246161616},
-		432: {132: 0.0},
-		433: {100: -6.403905268952571, 101: -8.013343181386672, 104: -7.607878073278508, 106: -5.3391945319601435, 107: -5.487614537078416, 110: -8.706490361946617, 112: -7.097052449512517, 115: -7.097052449512517, 117: -8.013343181386672, 120: -6.760580212891304, 122: -3.418223331252082, 124: -8.706490361946617, 125: -3.464743346886975, 127: -4.968820743663249, 129: -7.097052449512517, 130: -5.710758088392626, 132: -8.706490361946617, 133: -4.337042509479596, 134: -7.607878073278508, 138: -5.762051382780177, 142: -6.914730892718563, 143: -6.509265784610398, 152: -3.349904087274605, 156: -8.013343181386672, 162: -7.320196000826726, 400: -4.516835619920192, 401: -8.706490361946617, 402: -5.410653495942288, 404: -7.320196000826726, 406: -6.067433032331358, 407: -4.101320175958526, 410: -6.627048820266781, 412: -5.410653495942288, 413: -3.669537759532988, 417: -4.6289529180408975, 419: -8.706490361946617, 422: -4.899827872176298, 425: -3.524706811654532, 426: -3.122994053164918, 427: -4.48698265677051, 433: -1.313842841224994, 434: -4.992918295242309, 435: -3.944316427148861, 438: -4.006009996154201, 439: -7.320196000826726, 444: -6.760580212891304, 445: -6.403905268952571, 446: -7.607878073278508, 447: -8.013343181386672, 448: -2.353860965627051, 449: -5.122971423490507, 450: -2.211224806009609, 451: -5.710758088392626, 452: -2.267139990846519, 454: -4.2638391054563005, 459: -4.184701784897577, 460: -3.8236884393602466},
-		434: {100: -4.05760152463721, 101: -6.282052474887318, 103: -7.575710610013756, 104: -4.492210551788535, 106: -6.715195970417627, 107: -4.516276894329455, 108: -12.945185170878121, 110: -13.638332351438066, 112: -4.4327030591920025, 114: -13.232867243329903, 115: -5.9542383010092195, 117: -5.078846247831577, 118: -14.331479531998012, 120: -5.366144958193173, 122: -3.37680328913977, 124: -6.221953872245138, 125: -1.3806449631903925, 127: -3.105503074903735, 128: -7.286574414868641, 129: -5.915654834970063, 130: -2.672075600649059, 131: -5.251589589405894, 132: -5.002112453600188, 133: -9.788184749728007, 134: -7.061166645918987, 135: -6.444270946184079, 138: -3.0878855462669708, 140: -10.930282150335856, 141: -8.624369267249136, 142: -3.7880082620180042, 143: -3.5438319543421133, 145: -9.231613104173814, 152: -2.439809459619229, 153: -8.604631784410815, 155: -13.638332351438066, 156: -4.057187331617344, 157: -14.331479531998012, 160: -11.846572882210012, 162: -6.723105057617228, 400: -4.130780456793973, 401: -11.153425701650066, 402: -7.424724753349458, 403: -11.335747258444021, 404: -6.235880830619823, 406: -5.605485150983441, 407: -4.566507199318629, 409: -9.327533226052552, 410: -11.11260370712981, 412: -4.083055664428541, 413: -6.48763089384554, 414: -8.303201011767314, 417: -4.9554552443808975, 419: -7.7248293457997965, 422: -3.215738554304725, 423: -10.36118761844589, 425: -3.3559461412555853, 426: -4.578059340726505, 427: -5.163629211642781, 431: -11.195985316068862, 433: -10.027414438793842, 434: -4.259853867184403, 435: -4.434261592475479, 438: -2.678661698375892, 439: -11.11260370712981, 443: -12.72204161956391, 444: -6.014934814068613, 445: -5.979632793169767, 446: -7.661981442140132, 447: -7.3265975422851515, 448: -5.456472045949615, 449: -5.268827432921433, 450: -6.617694915399257, 451: -6.574000765413833, 452: -3.16988616655602, 454: -5.692776923184577, 456: -9.503165794695711, 459: -8.445375500547856, 460: -7.885759712612433, 461: -14.331479531998012, 463: -14.331479531998012},
-		435: {100: -4.030448538636748, 101: -6.522417525558315, 103: -7.867067185406487, 104: -5.265547775110294, 106: -4.874497803261004, 107: -4.011670227810285, 108: -9.242890246659082, 110: -10.06956881984355, 112: -4.541463359262635, 115: -5.305768207175917, 117: -5.950918866720331, 120: -5.704954813646845, 122: -4.120539671266992, 124: -7.8410916990032264, 125: -1.6252126137552978, 127: -3.9681827457501795, 128: -7.943607262528821, 129: -6.185553439402308, 130: -4.340242372304078, 131: -7.093067908217447, 132: -5.659371308202973, 133: -9.143799344014852, 134: -6.365331710075294, 135: -7.383991474593398, 138: -4.081323735362541, 140: -12.420944077007029, 141: -9.307428767796653, 142: -5.650154653098048, 143: -4.706043513411534, 145: -7.294008327214612, 152: -2.4596122347864617, 153: -8.683274458723659, 156: -4.662824216266903, 157: -11.322331788338918, 160: -8.614281587236707, 162: -6.316150844592043, 400: -3.4753508062001304, 401: -9.7467954275805, 402: -6.651061800646303, 403: -10.629184607778972, 404: -5.937836725549829, 406: -4.038425788917392, 407: -3.160861613136031, 409: -8.277809350615495, 410: -7.830887528828985, 412: -3.6236978403035005, 413: -5.465351468610731, 414: -8.986956872521882, 417: -4.880322548349875, 419: -5.880635838945534, 422: -3.790332918555234, 423: -10.06956881984355, 425: -2.6125367307211698, 426: -3.629610083705677, 427: -5.033544291742475, 433: -8.732064622893091, 434: -3.264479278000163, 435: -3.740357338682355, 438: -3.4628542856513613, 439: -10.916866680230754, 443: -13.114091257566974, 444: -6.238859170290397, 445: -4.992313838405899, 446: -7.219688423302123, 447: -7.458099446747121, 448: -3.2362805518208284, 449: -5.217166601298109, 450: -6.238859170290397, 451: -6.597898181524009, 452: -2.235893133027296, 454: -4.951004881983758, 456: -9.222270959456347, 459: -6.752788779993978, 460: -4.34567253070957, 461: -12.420944077007029},
-		436: {},
-		437: {435: 0.0},
-		438: {100: -4.524933076979363, 101: -5.679875906367215, 103: -8.304326538634133, 104: -5.73303527464731, 106: -4.915612769062181, 107: -3.1377376796068925, 108: -7.424793390495161, 110: -11.179772646313621, 112: -5.108573131186785, 114: -13.887822847415832, 115: -5.252135761951804, 116: -13.194675666855886, 117: -6.923687234997586, 120: -5.589532213056549, 122: -2.9914354356356574, 124: -7.824037638728223, 125: -2.2677933875314014, 127: -4.620913123951754, 128: -8.370369950951122, 129: -7.056948612769651, 130: -6.2272373857125745, 131: -8.953348914285138, 132: -7.178518507157532, 133: -8.656714230561244, 134: -6.271047038717458, 135: -6.131627503467713, 138: -3.668430263484182, 140: -13.194675666855886, 141: -9.14289071905258, 142: -4.76576739030722, 143: -4.521676024301448, 145: -6.718472830745231, 152: -2.121084965626463, 153: -10.30430390895972, 155: -12.096063378187775, 156: -5.173912218921906, 157: -9.862471156680682, 160: -9.713435577520194, 162: -6.109611372903338, 400: -4.533468715300743, 401: -11.248765517800573, 402: -7.778575264651465, 403: -11.40291619762783, 404: -6.151515750867546, 406: -4.4905051071095, 407: -2.250390337029464, 409: -9.531114020726239, 410: -8.800226512183446, 412: -5.345937043409222, 413: -7.118180870563328, 414: -9.205691620291612, 417: -6.399529332256403, 419: -6.226766465054001, 422: -3.6704373802970287, 423: -11.941912698360518, 425: -3.584720600449714, 426: -4.857446812659925, 427: -6.350392810829322, 431: -13.194675666855886, 433: -8.997473719194078, 434: -2.989159448161055, 435: -3.4468190558606673, 438: -3.7558062712891553, 439: -13.194675666855886, 444: -7.316939885076247, 445: -5.1806706720764275, 446: -6.8179487189572585, 447: -7.987925493833339, 448: -2.757696320054855, 449: -6.068588393556761, 450: -7.12163113275548, 451: -8.489660145898078, 452: -1.7177608727420508, 454: -5.9376729597638125, 456: -9.744688121024298, 459: -8.315668815238066, 460: -5.562032321529741},
-		439: {100: -4.983606621708336, 104: -4.983606621708336, 106: -4.647134385087123, 107: -4.395819956806218, 112: -5.8998973535824915, 117: -6.593044534142437, 120: -5.8998973535824915, 122: -4.513602992462601, 125: -2.465910149097345, 127: -2.721843523234546, 128: -6.593044534142437, 129: -2.661218901418111, 130: -4.395819956806218, 131: -5.8998973535824915, 132: -3.414990703794491, 134: -6.593044534142437, 135: -4.983606621708336, 142: -5.8998973535824915, 143: -5.494432245474327, 145: -5.8998973535824915, 152: -2.622752620590315, 156: -4.983606621708336, 400: -3.457550318213287, 402: -4.983606621708336, 404: -4.395819956806218, 406: -4.290459441148391, 407: -4.0280951766809, 412: -3.7026727762462723, 413: -3.457550318213287, 417: -3.8204558119026557, 419: -4.801285064914382, 422: -3.884994333040227, 425: -3.3741687092742363, 426: -2.018333555639054, 427: -4.647134385087123, 434: -3.648605554975996, 435: -3.502002080784121, 438: -4.647134385087123, 444: -5.8998973535824915, 445: -4.290459441148391, 446: -6.593044534142437, 448: -3.597312260588446, 450: -6.593044534142437, 452: -2.764403137653342, 454: -3.597312260588446, 456: -6.593044534142437, 459: -5.206750173022546, 460: -5.494432245474327},
-		440: {},
-		441: {},
-		442: {},
-		443: {112: -3.506557897319982, 125: -3.506557897319982, 127: -2.659260036932778, 130: -4.605170185988091, 132: -3.912023005428146, 138: -3.506557897319982, 143: -4.605170185988091, 152: -2.995732273553991, 406: -4.605170185988091, 407: -2.8134107167600364, 412: -4.605170185988091, 413: -4.605170185988091, 417: -4.605170185988091, 419: -3.912023005428146, 425: -2.3025850929940455, 427: -3.2188758248682006, 434: -3.506557897319982, 435: -2.659260036932778, 438: -3.912023005428146, 444: -3.912023005428146, 448: -2.995732273553991, 449: -4.605170185988091, 452: -1.2378743560016174},
-		444: {100: -5.510668424580223, 101: -7.354613646978849, 103: -8.489593579817834, 104: -6.343012735300369, 106: -5.977287955841718, 107: -4.789664289517312, 112: -5.8585044198517515, 115: -6.754992524429727, 117: -6.187008486823788, 120: -7.252830952668907, 122: -4.1573993188918275, 124: -9.300523796034161, 125: -2.7806393167592383, 127: -3.56395149855497, 128: -7.103299218697942, 129: -5.6179139549338215, 130: -1.3578950693509222, 131: -6.143523374884048, 132: -5.717004857578052, 133: -10.686818157154052, 134: -7.190310595687572, 135: -8.201911507366052, 138: -5.516334162115901, 141: -10.686818157154052, 142: -5.959430338441712, 143: -4.412056135912114, 145: -8.607376615474216, 152: -3.008954656475842, 153: -9.993670976594107, 156: -5.941886028790803, 157: -10.686818157154052, 162: -7.4287216191325705, 400: -3.715149552428263, 401: -9.993670976594107, 402: -6.661466466418903, 403: -10.686818157154052, 404: -6.643766889319503, 406: -4.899920775787345, 407: -2.996532136477285, 409: -8.288922884355681, 410: -8.740908008098739, 412: -4.253878064414873, 413: -5.358941988364472, 414: -8.047760827538793, 417: -4.986374583763366, 419: -6.679484971921582, 422: -4.586499205133989, 423: -9.300523796034161, 425: -2.65705963671323, 426: -3.586790990524793, 427: -5.070047059487481, 431: -10.686818157154052, 433: -8.895058687925998, 434: -2.74090855854092, 435: -3.617794730575793, 438: -4.43298934557858, 439: -10.686818157154052, 443: -10.686818157154052, 444: -3.991019240095561, 445: -5.077346361969093, 446: -6.754992524429727, 447: -8.121868799692516, 448: -4.118740245742076, 449: -4.188536007677619, 450: -7.103299218697942, 451: -8.607376615474216, 452: -2.0451090910402474, 454: -4.690366068535032, 456: -10.686818157154052, 459: -6.54368343076252, 460: -6.626375146607633},
-		445: {100: -4.060820938042829, 101: -6.703913242870266, 103: -7.9441760486014035, 104: -4.5052920172623265, 106: -5.164142283512511, 107: -4.611195442151311, 108: -11.079670264530552, 112: -5.875663577453757, 115: -6.317496329732797, 116: -11.772817445090498, 117: -5.828706594365986, 120: -5.694029069810841, 122: -3.5922164974960498, 124: -8.161899532446274, 125: -1.6086208433540188, 127: -4.198772439718298, 128: -8.096516773183422, 129: -6.634082148366926, 130: -4.664982554392703, 131: -7.721032497287193, 132: -6.229595035446739, 133: -10.52005447659513, 134: -6.176249054741446, 135: -7.33606591072737, 138: -4.185506939067882, 140: -12.465964625650443, 141: -10.856526713216343, 142: -5.832646192370067, 143: -5.3684157750356505, 145: -7.613934361730826, 152: -2.1293967979731776, 153: -9.521525646484003, 156: -4.172915485881999, 160: -10.268740048314225, 162: -7.568124825699532, 400: -3.8152900428597247, 401: -11.367352336982334, 402: -7.177697594955908, 403: -9.57559286775428, 404: -6.803004145514498, 406: -4.962123878951492, 407: -3.36443515953244, 409: -7.621777539191852, 410: -10.856526713216343, 412: -4.499031127245604, 413: -6.528428420568017, 414: -7.7746167434213, 417: -4.902764033292373, 419: -8.175505184502052, 422: -3.665699974340108, 423: -11.772817445090498, 425: -2.2963123472499105, 426: -2.942055160270633, 427: -5.673620198179634, 433: -11.367352336982334, 434: -3.529009021425217, 435: -3.7912549963592226, 438: -3.9600346265129165, 444: -6.3217789915247975, 445: -5.56422741899387, 446: -6.8064824098908225, 447: -7.234856008795857, 448: -4.808209354515578, 449: -6.5826422371621645, 450: -3.886548091054074, 451: -4.890892926142882, 452: -2.074572132732893, 454: -4.232727124945173, 456: -9.330470409721293, 459: -8.355090761477133, 460: -7.821573726509071, 463: -12.465964625650443},
-		446: {100: -3.1518593137220194, 101: -5.886559768042297, 103: -8.761664053807674, 104: -6.815753904752361, 106: -6.347374971233627, 107: -2.965882660650804, 108: -9.550121414171944, 110: -9.773264965486154, 112: -5.961062295340219, 115: -3.1812483567383234, 117: -7.099116316059625, 120: -4.435726885784836, 122: -3.7799271739964917, 124: -7.758361944943889, 125: -2.7553108942059414, 127: -3.9457910184874034, 128: -7.693823423806318, 129: -6.292024876150462, 130: -5.400657552728764, 131: -9.367799857377989, 132: -6.515168427464672, 133: -6.189746027030044, 134: -7.082021882700325, 135: -8.386970604366264, 138: -3.417157304790263, 141: -9.080117784926209, 142: -5.472583970266225, 143: -5.8077011931299785, 145: -8.386970604366264, 152: -2.5053906801627295, 156: -6.129121405213609, 157: -11.159559326606045, 160: -9.213649177550732, 162: -3.7473989916608397, 400: -2.925259690909791, 402: -7.016424600214512, 404: -8.11503688888262, 406: -5.3943682238212, 407: -2.0242658289527733, 409: -7.267739028495418, 410: -8.962334749269825, 412: -4.41785863195399, 413: -6.882893207589989, 414: -10.4664121460461, 417: -5.866254501881552, 419: -8.386970604366264, 422: -3.2656141883700855, 423: -10.4664121460461, 425: -3.186748542484641, 426: -4.8698437556970475, 427: -5.8562544185469685, 433: -6.752840079341792, 434: -3.4674459870105783, 435: -4.736312363072525, 438: -3.3323184248532334, 439: -11.159559326606045, 444: -6.855494233401875, 445: -5.531938212915407, 446: -7.247536321177899, 447: -5.287441537130629, 448: -4.799985457933667, 449: -3.6210643271925793, 450: -7.758361944943889, 451: -5.479386717588977, 452: -1.9145277148399316, 454: -6.109703319356508, 456: -11.159559326606045, 459: -7.9014627885845625, 460: -6.372067583823998},
-		447: {100: -4.212048792566965, 101: -6.357448302038598, 103: -7.502580606341601, 104: -5.982754852597188, 106: -4.818627956818451, 107: -4.456358932865219, 108: -10.14163793595686, 112: -5.281825531595188, 115: -5.641828265626595, 117: -6.302185623363549, 120: -5.974972712155133, 122: -2.2268375618321037, 124: -6.586289874467446, 125: -2.2186519772456643, 127: -3.962657015177819, 128: -7.369049213717078, 129: -6.249817637846233, 130: -3.9062472983521395, 131: -7.400797912031659, 132: -5.759611301282979, 133: -10.14163793595686, 134: -6.55811899750075, 135: -7.3382775550503245, 138: -2.926030377733559, 141: -8.062196394277024, 142: -5.593038101457163, 143: -4.466597930166313, 145: -5.851178494808469, 152: -3.208214910226145, 153: -9.225347204082706, 156: -4.915891262243659, 160: -9.225347204082706, 162: -6.883541397935378, 400: -2.7231570382090657, 402: -6.691650390125273, 403: -10.834785116516805, 404: -7.028122626746486, 406: -4.894613863796374, 407: -3.796879153069623, 409: -7.43358773485465, 410: -8.269835759055269, 412: -4.464884133688578, 413: -7.1971989567904195, 414: -9.448490755396914, 417: -5.886025226138637, 419: -8.755343574836969, 422: -3.2893953669049814, 423: -9.736172827848696, 425: -2.82708510363278, 426: -4.47175701297634, 427: -4.926702178347874, 431: -10.834785116516805, 433: -9.448490755396914, 434: -4.400238597729352, 435: -4.07321234771275, 438: -2.67169874093359, 444: -5.990598030058214, 445: -6.116286245221711, 446: -4.770999907829197, 447: -7.502580606341601, 448: -2.848620256184078, 449: -2.5465020713091144, 450: -7.22386720387258, 451: -5.258836013370489, 452: -2.651108533896148, 454: -6.0985866681223095, 456: -10.14163793595686, 459: -9.04302564728875, 460: -5.383746662951104},
-		448: {100: -3.3174172564405104, 101: -5.952569054914462, 103: -5.994046844318946, 104: -4.240407040754704, 106: -5.044076023252886, 107: -4.406817606884705, 108: -11.295899620601222, 110: -11.90900409348763, 112: -5.375327068602986, 114: -11.03996624646402, 115: -5.749746090148603, 116: -13.087659089829277, 117: -5.489510418483815, 118: -14.473953450949168, 120: -5.1242860137518855, 121: -12.394511909269331, 122: -3.436438204523975, 124: -7.547376417726443, 125: -0.7152037388066569, 127: -4.297618314919713, 128: -8.277509323154646, 129: -6.5070199525443275, 130: -4.275969595653588, 131: -6.811015600487632, 132: -5.471868017793444, 133: -9.583604322727414, 134: -6.925397471779294, 135: -7.27203713341754, 138: -4.325208666853718, 140: -11.90900409348763, 141: -9.338155013898906, 142: -5.168302899168659, 143: -5.27214940999527, 145: -8.624628671002307, 152: -2.394987168536261, 153: -7.543458684997541, 156: -2.7399666008690535, 160: -11.141748940773963, 162: -6.69993837369844, 400: -4.015632958654051, 401: -12.17136835795512, 402: -7.51170998668296, 403: -10.863035538304942, 404: -6.088921163135269, 405: -13.780806270389222, 406: -5.641949519692896, 407: -4.297732502758833, 409: -10.396416007043447, 410: -10.031302194458851, 412: -5.595874194822732, 413: -7.336675013688781, 414: -8.293936797296594, 417: -5.94206871078994, 419: -8.02823363156359, 422: -4.60754490665446, 423: -12.076058178150797, 425: -3.1169919755256617, 426: -5.411301351872589, 427: -5.923518925753128, 431: -13.375341162281057, 433: -9.801124616487261, 434: -5.192316197090951, 435: -5.258824562241187, 438: -4.473883800566795, 439: -11.701364728709386, 443: -13.780806270389222, 444: -7.9939088890225145, 445: -7.956282178036892, 446: -9.8105143568371, 447: -8.928776006469604, 448: -7.965184313977486, 449: -7.291601339063904, 450: -7.6594105536892085, 451: -9.941353957795911, 452: -3.4068152135657073, 454: -6.502177328068539, 456: -9.450072930102891, 459: -9.098675043265002, 460: -6.7508333586828355},
-		449: {100: -3.616755007317491, 101: -5.796477741523672, 103: -7.389440816754267, 104: -4.993032090981543, 106: -4.173182794428145, 107: -3.677543992753583, 108: -9.81718905270232, 110: -10.117293645152657, 112: -5.146439221068246, 115: -5.315323883189958, 117: -5.479656269027064, 118: -12.419878738146704, 120: -5.223566769302124, 121: -11.503588006272548, 122: -1.9410426658290847, 124: -6.26078334965477, 125: -1.7777927421467123, 127: -3.364264211373676, 128: -7.01046732409308, 129: -6.083053007000262, 130: -3.7355622336944077, 131: -6.339945543051113, 132: -5.426404583762029, 133: -8.538314940203266, 134: -5.801139754629483, 135: -7.194132064433502, 138: -3.005822312522409, 140: -11.503588006272548, 141: -8.440197084244742, 142: -4.893430464529568, 143: -3.947369154357106, 145: -7.141764078916186, 152: -2.5798636085661486, 153: -8.795537805170337, 156: -4.467263626495703, 160: -9.124041872142374, 162: -6.291918446450183, 400: -3.8784808580336483, 401: -11.726731557586758, 402: -7.688075901225246, 403: -11.726731557586758, 404: -6.567676258372229, 406: -4.925726648551501, 407: -3.3013795698361004, 409: -9.241824907798758, 410: -7.7013798668516085, 412: -5.372361516789407, 413: -7.131611707452167, 414: -8.878919414109388, 417: -5.576661980302137, 419: -8.682209119863336, 422: -3.524043652666177, 423: -10.628119268918647, 425: -3.422669707358005, 426: -5.039311277596791, 427: -5.001697915419915, 431: -11.726731557586758, 433: -8.850346041665333, 434: -3.9465329465107417, 435: -3.921155063014127, 438: -2.4740982734203234, 439: -11.503588006272548, 444: -7.263701138759789, 445: -6.619272078854962, 446: -7.556197857007111, 447: -8.001038130350105, 448: -5.312862848313688, 449: -8.822566477558258, 450: -7.575691651688111, 451: -9.502108006062423, 452: -2.892940902305051, 454: -6.317320143533134, 456: -10.404975717604438, 459: -8.954142835346977, 460: -4.944822988470596, 463: -13.113025918706649},
-		450: {100: -4.628041966911131, 101: -5.920532105901576, 103: -8.384385346491744, 104: -6.766648631242949, 106: -5.701652953373824, 107: -4.152697222758766, 108: -10.869291996279744, 110: -9.259854083845644, 112: -5.96772779723785, 115: -5.466614614407464, 117: -7.50199616629327, 120: -6.073501450683003, 122: -4.724033975992345, 124: -8.92338184722443, 125: -3.198164091848165, 127: -5.115133314298476, 128: -8.618000197673249, 129: -7.801239061146127, 130: -5.422554624613434, 131: -9.95300126440559, 132: -6.88030794971547, 133: -7.712291575129631, 134: -5.86870703803699, 135: -7.691238165931798, 138: -5.4182535427140435, 142: -6.499844143812722, 143: -5.55855210973315, 145: -8.343563351971488, 152: -1.1082036105401516, 153: -8.566706903285699, 155: -10.46382688817158, 156: -4.1281817947065536, 160: -10.869291996279744, 162: -6.750254821467272, 400: -4.62415469282273, 401: -10.176144815719798, 402: -7.25837408363552, 403: -9.95300126440559, 404: -7.898877530710043, 406: -4.849482976162619, 407: -3.5892837433955562, 409: -9.770679707611635, 410: -7.5921472632875675, 412: -4.593588798497741, 413: -7.231705836553358, 414: -9.770679707611635, 417: -6.085975624908179, 419: -6.987728198336306, 422: -4.8954823844104824, 423: -10.869291996279744, 425: -3.965544738695146, 426: -3.9848053442369613, 427: -6.115701805173379, 433: -8.384385346491744, 434: -2.6112582103820863, 435: -4.203608278497335, 438: -4.961209058110813, 444: -7.25837408363552, 445: -5.803537402962409, 446: -8.789850454599907, 447: -7.167990022167251, 448: -4.400041679483971, 449: -7.451565312666378, 450: -7.205730350150097, 451: -9.07753252705169, 452: -1.1814449633304263, 454: -5.534160656608991, 456: -11.562439176839689, 459: -8.672067418943525, 460: -7.271979735691298},
-		451: {100: -3.6847973057368475, 101: -6.299867942373015, 103: -7.058766500495741, 104: -5.513478835072804, 106: -5.407497394216512, 107: -3.7995503504894175, 108: -9.208588838937377, 110: -9.747585339670064, 112: -5.482895411700723, 114: -11.693495488725377, 115: -5.142415153681972, 117: -6.295332787207624, 120: -5.5259789978370355, 122: -2.507652748349594, 124: -6.818298165524225, 125: -1.5932083602146407, 127: -3.2867870304844113, 128: -6.841465224805759, 129: -5.999763349922677, 130: -4.699562513502188, 131: -7.582621624552066, 132: -6.051588417787263, 133: -7.742251770143949, 134: -7.239148192471869, 135: -7.108528010054805, 138: -3.1235201118701696, 140: -11.000348308165432, 141: -7.8868329989550565, 142: -4.805942917060759, 143: -4.958903828752429, 145: -8.167134964109215, 152: -2.6797784582540083, 153: -9.901736019497323, 156: -5.236725833153214, 157: -11.693495488725377, 160: -9.61405394704554, 162: -5.012640809935162, 400: -3.7042740799101006, 402: -7.239148192471869, 403: -10.307201127605486, 404: -6.70306290194664, 406: -5.658014056200621, 407: -3.451792328995558, 409: -8.920906766485595, 410: -8.397658622721048, 412: -5.55145808313802, 413: -6.766241803568172, 414: -9.496270911389157, 417: -5.818564757873347, 419: -9.054438159110118, 422: -4.438610678648039, 423: -10.594883200057266, 425: -2.685638565367101, 426: -4.96726208636663, 427: -4.968461846558534, 433: -8.082577576081151, 434: -3.7586239227802, 435: -3.9819465090962307, 438: -2.419898667114179, 444: -7.650444220890827, 445: -6.380289509683589, 446: -8.082577576081151, 447: -7.8868329989550565, 448: -3.7898991991110753, 449: -5.031640748180066, 450: -8.920906766485595, 451: -9.054438159110118, 452: -2.2393465649914, 454: -6.095073529727002, 456: -10.084057576291276, 459: -8.558001272796227, 460: -4.911303432718586},
-		452: {100: -4.244285160192155, 101: -5.95251064842533, 103: -7.751567260771836, 104: -5.474726402012031, 106: -5.0807413505320165, 107: -4.097275563085078, 108: -8.676206282037462, 110: -10.238852032964804, 112: -5.583328279708699, 114: -14.487347275014162, 115: -5.436588972862445, 116: -13.571056543140008, 117: -5.973359214546873, 118: -15.180494455574108, 120: -5.663920165751617, 121: -14.487347275014162, 122: -3.2656969966726006, 124: -7.588632740684174, 125: -2.0667602022011873, 127: -3.6437938719531098, 128: -7.595213376934982, 129: -6.107464034562532, 130: -3.8325379778670166, 131: -6.44095803301443, 132: -5.694266291028855, 133: -9.086924685528972, 134: -5.687911779896509, 135: -7.210445150597972, 138: -3.5243348225179716, 140: -12.236055476407667, 141: -9.302758673794468, 142: -5.433602235261054, 143: -4.582985105067373, 145: -7.608506006196667, 152: -2.3674672508364196, 153: -7.768938167762944, 156: -4.746584555525431, 157: -11.44282483729074, 160: -9.191533038684245, 162: -6.826290892652333, 400: -3.744082931704126, 401: -9.348611978290592, 402: -6.84818610335499, 403: -10.056530476170849, 404: -6.137626437541674, 405: -12.541437125958849, 406: -5.002486060864022, 407: -3.4127949979671715, 409: -8.04719850067804, 410: -8.361570390298587, 412: -4.212141005669752, 413: -5.68437381843574, 414: -8.946083729855737, 417: -5.004235443321953, 419: -7.090092158980787, 422: -3.976883363182774, 423: -10.507665621112201, 425: -2.9524548599183777, 426: -3.962718877903181, 427: -4.91573752967251, 431: -12.983269878237888, 433: -8.69738710411691, 434: -3.0310874041630016, 435: -3.70677770382916, 438: -3.0141454496435216, 439: -10.890035014425717, 443: -12.290122697677942, 444: -6.843385326011633, 445: -5.451598413770024, 446: -4.700562092264176, 447: -5.261478101420352, 448: -3.836958527586723, 449: -3.027854232841401, 450: -6.53702109824754, 451: -3.9542116034809975, 452: -2.0158132429772246, 454: -5.014450349366935, 456: -9.277861122172741, 459: -7.030315185891782, 460: -5.5300299330049185, 461: -14.487347275014162, 463: -13.571056543140008},
-		453: {},
-		454: {100: -4.766108483951023, 101: -7.053015764225838, 103: -8.867248089305953, 104: -5.93001202243357, 106: -5.184066656306654, 107: -4.382705891106475, 108: -10.348852630230168, 110: -10.859678253996158, 112: -5.915657708981887, 115: -5.979404777763146, 117: -6.094659367066171, 120: -6.295330062528322, 122: -4.002514761130081, 124: -8.66245367665994, 125: -2.1407967032951163, 127: -3.1965837741976286, 128: -7.016648120054964, 129: -5.753732780095578, 130: -4.291600342584183, 131: -7.576263907990387, 132: -5.71996591762476, 133: -8.82279632673512, 134: -7.179167049552738, 135: -8.10814294095421, 138: -4.7133489963272615, 141: -11.265143362104324, 142: -5.679769118498424, 143: -4.6707299023545445, 145: -7.831156157619176, 152: -2.9461572367122635, 153: -9.878849000984433, 155: -11.958290542664269, 156: -5.384610375703622, 157: -11.958290542664269, 160: -9.39334118520273, 162: -6.981556800243694, 400: -3.605971994438265, 401: -9.878849000984433, 402: -5.136093152043777, 403: -9.31923321304901, 404: -6.15315557374778, 405: -11.265143362104324, 406: -4.209830518764571, 407: -3.254451822974022, 409: -7.333317729379997, 410: -8.24471847595996, 412: -4.158127472271308, 413: -3.987895635472839, 414: -7.738782837488161, 417: -4.21893133997517, 419: -5.727809095085786, 422: -4.130650996297846, 423: -8.66245367665994, 425: -2.3555703021285765, 426: -2.603070909370001, 427: -4.2516776287000715, 431: -11.265143362104324, 433: -7.373323063993696, 434: -3.3807551222418692, 435: -3.4829615553467295, 438: -3.32100587099021, 439: -8.82279632673512, 443: -11.958290542664269, 444: -5.376265403771442, 445: -4.315286907103551, 446: -5.8141049085386225, 447: -6.345162436276198, 448: -4.179660395338459, 449: -4.09080197396514, 450: -5.889864954420158, 451: -5.0923994677808295, 452: -1.943485253996187, 454: -3.85237934467776, 456: -8.197090426970705, 459: -5.10288174405434, 460: -5.979404777763146, 461: -10.859678253996158, 463: -10.571996181544378},
-		455: {},
-		456: {100: -5.462418377228463, 101: -7.659642954564682, 104: -6.561030665896573, 106: -4.826429610508466, 107: -4.076124016108572, 112: -5.580201412884847, 115: -5.713732805509369, 117: -7.659642954564682, 122: -5.020585624949423, 125: -1.7434408919572473, 127: -3.970763500450746, 128: -6.050205042130582, 129: -4.8870542323249015, 130: -5.867883485336628, 132: -6.2733485934447915, 134: -6.2733485934447915, 138: -4.481589124216737, 142: -5.261747681766312, 143: -5.174736304776682, 152: -3.024913966335047, 156: -5.580201412884847, 162: -6.966495774004737, 400: -3.382976835548627, 401: -6.966495774004737, 402: -5.357057861570636, 404: -5.580201412884847, 406: -2.8889583300990176, 407: -2.8974690197669264, 409: -7.659642954564682, 412: -3.4401352493885757, 413: -4.826429610508466, 417: -4.826429610508466, 419: -6.561030665896573, 422: -4.524148738635533, 425: -1.7488463105241552, 426: -3.3691835134162913, 427: -4.826429610508466, 434: -3.670658908000408, 435: -4.258445572902527, 438: -3.6893510410125607, 444: -6.561030665896573, 445: -4.327438444389479, 446: -6.966495774004737, 447: -6.966495774004737, 448: -3.6523097693322115, 449: -6.561030665896573, 450: -7.659642954564682, 451: -7.659642954564682, 452: -2.221563645641487, 454: -4.440767129696482, 456: -5.580201412884847, 459: -6.2733485934447915, 460: -6.050205042130582},
-		457: {},
-		458: {},
-		459: {100: -5.527565702477573, 101: -7.724790279813792, 103: -9.228867676590067, 104: -6.626177991145682, 106: -5.914681671917541, 107: -4.872158849900475, 112: -6.2331354030360755, 115: -6.703139032281811, 117: -9.922014857150012, 120: -7.282957527534753, 122: -5.1345231143679655, 124: -8.823402568481901, 125: -3.2151525205472646, 127: -3.5976558947687005, 128: -8.535720496030121, 129: -5.6879083525527525, 130: -5.970771138568584, 131: -9.228867676590067, 132: -7.088801513093796, 133: -8.535720496030121, 134: -7.524119584351641, 135: -7.842573315470176, 138: -5.515295609885759, 142: -6.071867255439953, 143: -6.366666795660598, 145: -7.357065499688475, 152: -3.562440988477634, 156: -6.284428697423626, 162: -7.149426134910231, 400: -3.4087847462377048, 401: -7.282957527534753, 402: -4.122922202689486, 403: -9.922014857150012, 404: -5.59128151686368, 405: -7.842573315470176, 406: -4.85311065492978, 407: -3.5940780734208166, 409: -6.743961026802066, 410: -6.877492419426589, 412: -4.757228883226498, 413: -2.2751834657195293, 414: -8.823402568481901, 417: -3.8628916615682147, 419: -6.115352367379692, 422: -4.701659032071687, 423: -6.786520641220862, 425: -2.424807633946694, 426: -2.4795221343555713, 427: -3.1874231971770635, 433: -5.659334980108696, 434: -3.7907883676668708, 435: -3.410269527505284, 438: -4.24526105488173, 439: -6.66391831912853, 444: -5.63155541600162, 445: -5.039212934563641, 446: -7.282957527534753, 447: -8.31257694471591, 448: -4.096014749769561, 449: -6.115352367379692, 450: -5.914681671917541, 451: -6.366666795660598, 452: -1.7075496963908263, 454: -3.0112640691880763, 456: -9.228867676590067, 459: -2.756521382089166, 460: -5.31684467116192, 461: -9.922014857150012, 463: -8.535720496030121},
-		460: {100: -5.035070048455653, 101: -6.579044920106327, 103: -8.62921607948605, 104: -6.259691503816894, 106: -3.38584238655653, 107: -3.2457801518356866, 108: -7.6388173754581725, 110: -7.9752896120793855, 112: -5.680736690782604, 115: -5.921165878383839, 117: -6.311363514361215, 120: -4.993656262904896, 122: -4.133689070947785, 124: -6.787446189683333, 125: -2.2688440200037006, 127: -2.6912740302422082, 128: -6.588995250959495, 129: -5.84230730347152, 130: -4.505810723000824, 131: -5.796002735429833, 132: -4.648815776613167, 133: -7.019778167051949, 134: -5.538173626127733, 135: -8.796270164149215, 138: -2.852755225485721, 141: -7.9752896120793855, 142: -5.809670374158497, 143: -3.706991742733847, 145: -7.409975803029325, 152: -2.872987129457306, 153: -9.807871075827695, 156: -5.696997211654384, 157: -11.194165436947586, 160: -8.996940859611367, 162: -6.824717584480565, 400: -3.677188212343265, 401: -10.50101825638764, 402: -6.799716282275147, 404: -6.349978350488994, 406: -4.3493499577393235, 407: -2.8773763098760687, 409: -8.555108107332327, 410: -5.709368503456931, 412: -5.467317689360389, 413: -5.460824160049841, 414: -8.36095209289137, 417: -5.098340874515361, 419: -9.402405967719531, 422: -4.381820342770107, 423: -10.50101825638764, 425: -3.870334870745269, 426: -4.709530201312334, 427: -4.206675189946595, 431: -9.584727524513486, 433: -6.863432096661255, 434: -3.750501753831995, 435: -5.0182981668418245, 438: -1.6485678519067353, 444: -6.118991621713759, 445: -4.67501814900719, 446: -6.9746577317714795, 447: -7.2429217183661585, 448: -4.942261553781697, 449: -6.088219963047005, 450: -5.818887029263421, 451: -7.9752896120793855, 452: -2.367430838726674, 454: -5.507190080607766, 456: -11.194165436947586, 459: -6.559436448717951, 460: -4.479994907038114},
-		461: {125: -3.0204248861443626, 129: -2.327277705584417, 132: -3.713572066704308, 152: -3.713572066704308, 156: -3.713572066704308, 400: -2.327277705584417, 407: -3.713572066704308, 413: -3.713572066704308, 417: -2.1041341542702074, 422: -3.0204248861443626, 425: -3.0204248861443626, 426: -3.0204248861443626, 427: -3.713572066704308, 434: -3.0204248861443626, 435: -2.1041341542702074, 438: -3.713572066704308, 444: -3.713572066704308, 452: -2.1041341542702074},
-		462: {},
-		463: {152: -3.713572066704308, 400: -3.713572066704308, 402: -3.0204248861443626, 407: -3.713572066704308, 412: -3.713572066704308, 413: -2.1041341542702074, 417: -3.713572066704308, 425: -2.6149597780361984, 426: -2.6149597780361984, 427: -2.6149597780361984, 433: -3.0204248861443626, 435: -3.0204248861443626, 444: -3.0204248861443626, 452: -1.6341305250244718, 454: -2.327277705584417, 459: -3.713572066704308, 460: -3.713572066704308},
-	}
-)
-
-func init() {
-	for key := range probTrans {
-		probTransKeys = append(probTransKeys, key)
-	}
-}
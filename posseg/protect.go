@@ -0,0 +1,60 @@
+package posseg
+
+import (
+	"regexp"
+
+	"github.com/fumiama/jieba/util"
+)
+
+// ProtectedPattern is a pattern whose matched text is kept as a single
+// word tagged with Pos.
+type ProtectedPattern struct {
+	Regexp *regexp.Regexp
+	Pos    string
+}
+
+// DefaultProtectedPatterns are the built-in protected patterns, which keep
+// URLs (xu), emails (xe), dates (t), versions (x) and numbers (m) as
+// single words.
+var DefaultProtectedPatterns = []ProtectedPattern{
+	{util.URLPattern, "xu"},
+	{util.EmailPattern, "xe"},
+	{util.DatePattern, "t"},
+	{util.VersionPattern, "x"},
+	{util.NumberPattern, "m"},
+}
+
+/*
+SetProtectedPatterns sets the protected patterns of Segmenter. The text
+matched by any of them is found before cutting by dictionary, and is
+emitted as a single word with the POS of the pattern. The former pattern
+wins if several patterns match at the same position.
+
+No pattern is protected by default, use DefaultProtectedPatterns for the
+built-in ones. It should not be called concurrently with cutting.
+*/
+func (seg *Segmenter) SetProtectedPatterns(patterns ...ProtectedPattern) {
+	seg.patterns = append([]ProtectedPattern(nil), patterns...)
+	res := make([]*regexp.Regexp, len(patterns))
+	for i, p := range patterns {
+		res[i] = p.Regexp
+	}
+	seg.protected, seg.groups = util.JoinPatterns(res)
+}
+
+// ProtectedPatterns returns the protected patterns of Segmenter.
+func (seg *Segmenter) ProtectedPatterns() []ProtectedPattern {
+	return seg.patterns
+}
+
+// splitProtected calls fn with the parts of sentence in order, and
+// reports the POS of each part matched by a protected pattern.
+func (seg *Segmenter) splitProtected(sentence string, fn func(part string, pos string, protected bool)) {
+	util.SplitProtected(seg.protected, seg.groups, sentence, func(part string, pattern int) {
+		if pattern < 0 {
+			fn(part, "", false)
+			return
+		}
+		fn(part, seg.patterns[pattern].Pos, true)
+	})
+}
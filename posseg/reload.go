@@ -0,0 +1,48 @@
+package posseg
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/fumiama/jieba/dictionary"
+)
+
+// SetDictionary replaces the dictionary of Segmenter with d atomically.
+// Cuts in progress keep using the previous dictionary until they finish.
+func (seg *Segmenter) SetDictionary(d *Dictionary) {
+	seg.shared.SetDictionary(d)
+}
+
+// Reload builds a new dictionary from the base and user dictionaries,
+// keeps the words changed at runtime, and replaces the current dictionary
+// with it atomically, see dictionary.Shared.Reload.
+func (seg *Segmenter) Reload(base io.Reader, users ...io.Reader) error {
+	return seg.shared.Reload(base, users...)
+}
+
+// ReloadAt is like Reload but reads dictionaries from the given file names.
+func (seg *Segmenter) ReloadAt(base string, users ...string) error {
+	return seg.shared.ReloadAt(base, users...)
+}
+
+// Watch calls ReloadAt whenever any of the dictionary files changes until
+// ctx is done, see dictionary.Shared.Watch.
+func (seg *Segmenter) Watch(ctx context.Context, interval time.Duration, onError func(error), base string, users ...string) error {
+	return seg.shared.Watch(ctx, interval, onError, base, users...)
+}
+
+// SaveCompiled writes the dictionary to w in compiled format,
+// which can be loaded by LoadCompiled much faster than the text format.
+func (seg *Segmenter) SaveCompiled(w io.Writer) error {
+	return seg.Dictionary().SaveCompiled(w)
+}
+
+// LoadCompiled loads dictionary written by SaveCompiled.
+func LoadCompiled(file io.Reader) (*Segmenter, error) {
+	shared, err := dictionary.LoadSharedCompiled(file)
+	if err != nil {
+		return nil, err
+	}
+	return NewSegmenter(shared), nil
+}
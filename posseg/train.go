@@ -0,0 +1,254 @@
+package posseg
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/fumiama/jieba/util"
+)
+
+var (
+	// ErrEmptyCorpus is returned when training a model without any word.
+	ErrEmptyCorpus = errors.New("posseg: empty corpus")
+	// ErrInvalidCorpus is returned when reading a corpus in wrong format.
+	ErrInvalidCorpus = errors.New("posseg: invalid corpus")
+	// ErrInvalidSmoothing is returned when training a model with negative smoothing.
+	ErrInvalidSmoothing = errors.New("posseg: invalid smoothing")
+)
+
+/*
+Trainer counts the states of a POS tagged corpus to train a Model.
+
+A state pairs the position of a rune in its word, B, M, E or S as in
+finalseg, with the POS of the word, such as B-n for the first rune of a
+noun. The HMM of posseg only tags the runs of Chinese characters which
+are not in dictionary, so the words with other runes, like numbers
+tagged m and punctuations tagged w, count for the POS list only, and no
+transition is counted across them.
+*/
+type Trainer struct {
+	b     *modelBuilder
+	start map[uint16]float64
+	trans map[uint16]map[uint16]float64
+	emit  map[uint16]map[rune]float64
+	runes map[rune]struct{}
+}
+
+// NewTrainer creates an empty Trainer.
+func NewTrainer() *Trainer {
+	return &Trainer{
+		b:     newModelBuilder(),
+		start: make(map[uint16]float64),
+		trans: make(map[uint16]map[uint16]float64),
+		emit:  make(map[uint16]map[rune]float64),
+		runes: make(map[rune]struct{}),
+	}
+}
+
+/*
+parseToken splits a token of People's Daily style into its word and POS,
+such as 中国/ns. The brackets of a compound word are removed, so that
+[中央/n and 电台/n]nt give the words 中央 and 电台.
+*/
+func parseToken(token string) (word, pos string, ok bool) {
+	token = strings.TrimPrefix(token, "[")
+	i := strings.LastIndexByte(token, '/')
+	if i <= 0 {
+		return "", "", false
+	}
+	word, pos = token[:i], token[i+1:]
+	if j := strings.IndexByte(pos, ']'); j >= 0 {
+		pos = pos[:j]
+	}
+	return word, pos, len(pos) > 0
+}
+
+/*
+Add counts the tokens of one tagged sentence, every token is a word and
+its POS separated by '/', such as 中国/ns.
+
+It returns an error wrapping ErrInvalidCorpus without counting any token
+if a token is in wrong format, or there would be more than 100 POS.
+*/
+func (t *Trainer) Add(tokens ...string) error {
+	words := make([]string, len(tokens))
+	posList := make([]string, len(tokens))
+	added := make(map[string]struct{}) // the POS not seen before
+	for i, token := range tokens {
+		word, pos, ok := parseToken(token)
+		if !ok {
+			return fmt.Errorf("%w: token %q", ErrInvalidCorpus, token)
+		}
+		if _, ok := t.b.index[pos]; !ok {
+			added[pos] = struct{}{}
+		}
+		words[i], posList[i] = word, pos
+	}
+	if len(t.b.m.poss)+len(added) > maxPOS {
+		return fmt.Errorf("%w: more than %d POS", ErrInvalidCorpus, maxPOS)
+	}
+	for _, pos := range posList {
+		t.b.addPOS(pos)
+	}
+	var prev uint16 // the state of the previous rune, 0 at the sequence start
+	for i, word := range words {
+		if !util.IsHan(word) {
+			prev = 0
+			continue
+		}
+		n := len([]rune(word))
+		j := 0
+		for _, r := range word {
+			var position byte
+			switch {
+			case n == 1:
+				position = 'S'
+			case j == 0:
+				position = 'B'
+			case j == n-1:
+				position = 'E'
+			default:
+				position = 'M'
+			}
+			state, _ := t.b.state(position, posList[i])
+			if prev == 0 {
+				t.start[state]++
+			} else {
+				if t.trans[prev] == nil {
+					t.trans[prev] = make(map[uint16]float64)
+				}
+				t.trans[prev][state]++
+			}
+			if t.emit[state] == nil {
+				t.emit[state] = make(map[rune]float64)
+			}
+			t.emit[state][r]++
+			t.runes[r] = struct{}{}
+			prev = state
+			j++
+		}
+	}
+	return nil
+}
+
+// Read counts a corpus from r, which has one sentence per line with the
+// tokens separated by whitespaces, see Trainer.Add.
+func (t *Trainer) Read(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		if err := t.Add(strings.Fields(scanner.Text())...); err != nil {
+			return fmt.Errorf("%w at line %d", err, n)
+		}
+	}
+	return scanner.Err()
+}
+
+/*
+Model returns the model of the counted corpus. The start and emission
+probabilities use additive smoothing, the probability of an event is
+
+	(count + smoothing) / (total + smoothing * n)
+
+where n is the number of possible events, which is the number of B and
+S states for the start states, as the E and M states never start a
+sequence, and the number of distinct runes of the corpus plus one for
+the emissions, that one is shared by the runes not in the corpus. The
+transitions are the maximum likelihood estimation, so the states which
+never follow each other in the corpus remain impossible.
+The char states of a rune are the states it has been seen with.
+
+It returns ErrEmptyCorpus if no word has been counted, or
+ErrInvalidSmoothing if smoothing is negative or NaN.
+*/
+func (t *Trainer) Model(smoothing float64) (*Model, error) {
+	if !(smoothing >= 0) {
+		return nil, ErrInvalidSmoothing
+	}
+	if len(t.runes) == 0 {
+		return nil, ErrEmptyCorpus
+	}
+	m := &Model{
+		poss:    append([]string(nil), t.b.m.poss...),
+		start:   make(map[uint16]float64, len(t.emit)),
+		trans:   make(map[uint16]probTransMap, len(t.emit)),
+		unknown: make(map[uint16]float64, len(t.emit)),
+		states:  sortedStates(t.emit),
+	}
+	logp := func(count, total, n float64) float64 {
+		count += smoothing
+		if count <= 0 {
+			return minFloat
+		}
+		return math.Log(count / (total + smoothing*n))
+	}
+	total, starts := 0.0, 0
+	for _, count := range t.start {
+		total += count
+	}
+	for _, state := range m.states {
+		if canStart(state) {
+			starts++
+		}
+	}
+	for _, state := range m.states {
+		m.start[state] = minFloat
+		if canStart(state) {
+			m.start[state] = logp(t.start[state], total, float64(starts))
+		}
+		m.trans[state] = make(probTransMap, len(t.trans[state]))
+		total := 0.0
+		for _, count := range t.trans[state] {
+			total += count
+		}
+		for to, count := range t.trans[state] {
+			m.trans[state][to] = math.Log(count / total)
+		}
+	}
+	n := float64(len(t.runes) + 1)
+	emit := make(map[uint16]map[rune]float64, len(t.emit))
+	charState := make(map[rune][]uint16, len(t.runes))
+	for _, state := range m.states {
+		total := 0.0
+		for _, count := range t.emit[state] {
+			total += count
+		}
+		emit[state] = make(map[rune]float64, len(t.emit[state]))
+		for r, count := range t.emit[state] {
+			emit[state][r] = logp(count, total, n)
+			charState[r] = append(charState[r], state)
+		}
+		if smoothing > 0 {
+			m.unknown[state] = logp(0, total, n)
+		}
+	}
+	m.runes = newRuneStates(charState, emit)
+	return m, nil
+}
+
+// canStart reports whether state is the position B or S, which may start
+// a sequence.
+func canStart(state uint16) bool {
+	return state/100 == 1 || state/100 == 4
+}
+
+// Train trains a model from the corpus read from r with additive
+// smoothing, see Trainer.Read and Trainer.Model.
+func Train(r io.Reader, smoothing float64) (*Model, error) {
+	t := NewTrainer()
+	if err := t.Read(r); err != nil {
+		return nil, err
+	}
+	return t.Model(smoothing)
+}
+
+// TrainAt is like Train but reads the corpus from the given file name.
+func TrainAt(file string, smoothing float64) (*Model, error) {
+	return util.ReadFile(file, func(r io.Reader) (*Model, error) {
+		return Train(r, smoothing)
+	})
+}
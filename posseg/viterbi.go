@@ -31,31 +31,31 @@ func (pss probStates) Swap(i, j int) {
 	pss[i], pss[j] = pss[j], pss[i]
 }
 
-func viterbi(obs []rune) []tag {
+func (m *Model) viterbi(obs []rune) []tag {
 	V := make([]map[uint16]float64, len(obs))
 	V[0] = make(map[uint16]float64)
 	memPath := make([]map[uint16]uint16, len(obs))
 	memPath[0] = make(map[uint16]uint16)
-	ys := charStateTab.get(obs[0]) // default is all_states
+	ys := m.charStates(obs[0]) // default is all_states
 	for _, y := range ys {
-		V[0][y] = probEmit[y].get(obs[0]) + probStart[y]
+		V[0][y] = m.emitProb(y, obs[0]) + m.start[y]
 		memPath[0][y] = 0
 	}
 	for t := 1; t < len(obs); t++ {
 		prevStates := make([]uint16, 0, 256)
 		for x := range memPath[t-1] {
-			if len(probTrans[x]) > 0 {
+			if len(m.trans[x]) > 0 {
 				prevStates = append(prevStates, x)
 			}
 		}
 		// use Go's map to implement Python's Set()
 		prevStatesExpectNext := make(map[uint16]struct{}, 256)
 		for _, x := range prevStates {
-			for y := range probTrans[x] {
+			for y := range m.trans[x] {
 				prevStatesExpectNext[y] = struct{}{}
 			}
 		}
-		tmpObsStates := charStateTab.get(obs[t])
+		tmpObsStates := m.charStates(obs[t])
 
 		obsStates := make([]uint16, 0, 256)
 		for index := range tmpObsStates {
@@ -69,15 +69,16 @@ func viterbi(obs []rune) []tag {
 			}
 		}
 		if len(obsStates) == 0 {
-			obsStates = probTransKeys
+			obsStates = m.states
 		}
 		memPath[t] = make(map[uint16]uint16)
 		V[t] = make(map[uint16]float64)
 		for _, y := range obsStates {
 			var max, ps probState
+			emitP := m.emitProb(y, obs[t])
 			for i, y0 := range prevStates {
 				ps = probState{
-					prob:  V[t-1][y0] + probTrans[y0].Get(y) + probEmit[y].get(obs[t]),
+					prob:  V[t-1][y0] + m.trans[y0].Get(y) + emitP,
 					state: y0,
 				}
 				if i == 0 || ps.prob > max.prob || (ps.prob == max.prob && ps.state > max.state) {
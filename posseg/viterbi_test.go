@@ -1,6 +1,11 @@
 package posseg
 
 import (
+	"bytes"
+	"errors"
+	"math"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -52,7 +57,7 @@ func init() {
 
 func TestViterbi(t *testing.T) {
 	ss := "李小福是创新办主任也是云计算方面的专家;"
-	route := viterbi([]rune(ss))
+	route := DefaultModel().viterbi([]rune(ss))
 	if len(route) != len(defaultRoute) {
 		t.Fatal(len(route))
 	}
@@ -66,6 +71,132 @@ func TestViterbi(t *testing.T) {
 func BenchmarkViterbi(b *testing.B) {
 	ss := "李小福是创新办主任也是云计算方面的专家;"
 	for i := 0; i < b.N; i++ {
-		viterbi([]rune(ss))
+		DefaultModel().viterbi([]rune(ss))
+	}
+}
+
+func TestModel(t *testing.T) {
+	var buf bytes.Buffer
+	if err := DefaultModel().Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	m, err := LoadModel(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(m.POS(), poss[:]) {
+		t.Fatalf("got POS %v, expected %v", m.POS(), poss)
+	}
+	buf.Reset()
+	if err = m.SaveCompact(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), modelData) {
+		t.Fatal("the compact model should be the same as model.bin")
+	}
+	if compact, err := LoadCompactModel(bytes.NewReader(buf.Bytes())); err != nil || !reflect.DeepEqual(compact, DefaultModel()) {
+		t.Fatalf("got %v, expected the compact model decoded as the same", err)
+	}
+	if _, err = LoadCompactModel(bytes.NewReader(buf.Bytes()[:buf.Len()-1])); !errors.Is(err, ErrInvalidModel) {
+		t.Fatalf("got %v, expected %v", err, ErrInvalidModel)
+	}
+	obs := []rune("李小福是创新办主任也是云计算方面的专家")
+	if result, expected := m.viterbi(obs), DefaultModel().viterbi(obs); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("got %v, expected %v", result, expected)
+	}
+	m, err = LoadModel(strings.NewReader("# every rune is a noun\nstart S-n 0\ntrans S-n S-n 0\nemit S-n 小 -1\nchar 小 S-n\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := m.viterbi([]rune("小明")); m.stateName(uint16(result[0])) != "S-n" || m.stateName(uint16(result[1])) != "S-n" {
+		t.Fatalf("got %v, expected S-n S-n", result)
+	}
+	for _, model := range []string{"", "start X-n 0\nemit S-n 小 -1\n", "start S 0\nemit S 小 -1\n", "start S-n 0\nemit S-n 小明 -1\n", "start S-n x\nemit S-n 小 -1\n", "char 小\n", "prob S-n 0\n"} {
+		if _, err = LoadModel(strings.NewReader(model)); !errors.Is(err, ErrInvalidModel) {
+			t.Fatalf("got %v, expected %v for %q", err, ErrInvalidModel, model)
+		}
+	}
+}
+
+func TestTrain(t *testing.T) {
+	corpus := "19980101-01-001-001/m [中央/n 人民/n]nt 广播/vn\n我们/r 是/v 程序员/n ，/w\n他们/r 是/v 朋友/n\n"
+	m, err := Train(strings.NewReader(corpus), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"m", "n", "vn", "r", "v", "w"}; !reflect.DeepEqual(m.POS(), expected) {
+		t.Fatalf("got POS %v, expected %v", m.POS(), expected)
+	}
+	state := func(name string) uint16 {
+		for _, state := range m.states {
+			if m.stateName(state) == name {
+				return state
+			}
+		}
+		t.Fatalf("no state %s", name)
+		return 0
+	}
+	if p := m.start[state("B-r")]; math.Abs(p-math.Log(2.0/3.0)) > 1e-10 {
+		t.Fatalf("got start B-r %f, expected %f", p, math.Log(2.0/3.0))
+	}
+	if p := m.trans[state("E-r")].Get(state("S-v")); p != 0 {
+		t.Fatalf("got transition from E-r to S-v %f, expected 0", p)
+	}
+	if states := m.charStates('是'); len(states) != 1 || m.stateName(states[0]) != "S-v" {
+		t.Fatalf("got char states %v of 是, expected S-v", states)
+	}
+	seg := loadTestSegmenter(t, "是 100 v\n")
+	seg.SetHMMModel(m)
+	expected := []Segment{{"他们", "r"}, {"是", "v"}, {"程序员", "n"}}
+	if result := seg.Cut("他们是程序员", true); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("got %v, expected %v", result, expected)
+	}
+	smoothed, err := Train(strings.NewReader(corpus), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"E-r", "M-n"} {
+		if p := smoothed.start[state(name)]; p != minFloat {
+			t.Fatalf("got start %s %f, expected %f", name, p, minFloat)
+		}
+	}
+	var buf bytes.Buffer
+	if err = smoothed.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if m, err = LoadModel(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(m.unknown, smoothed.unknown) || !reflect.DeepEqual(m.runes, smoothed.runes) {
+		t.Fatal("the saved model should be loaded as the same")
+	}
+	buf.Reset()
+	if err = smoothed.SaveCompact(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if m, err = LoadCompactModel(&buf); err != nil || !reflect.DeepEqual(m, smoothed) {
+		t.Fatalf("got %v, expected the compact model loaded as the same", err)
+	}
+	if _, err = Train(strings.NewReader(corpus), -1); !errors.Is(err, ErrInvalidSmoothing) {
+		t.Fatalf("got %v, expected %v", err, ErrInvalidSmoothing)
+	}
+	if _, err = Train(strings.NewReader("\n19980101-01-001-001/m\n"), 0); !errors.Is(err, ErrEmptyCorpus) {
+		t.Fatalf("got %v, expected %v", err, ErrEmptyCorpus)
+	}
+	if _, err = Train(strings.NewReader("中国/ns 人民\n"), 0); !errors.Is(err, ErrInvalidCorpus) {
+		t.Fatalf("got %v, expected %v", err, ErrInvalidCorpus)
+	}
+	trainer := NewTrainer()
+	if err = trainer.Add("中国/ns", "人民"); !errors.Is(err, ErrInvalidCorpus) {
+		t.Fatalf("got %v, expected %v", err, ErrInvalidCorpus)
+	}
+	if err = trainer.Add("人民/n"); err != nil {
+		t.Fatal(err)
+	}
+	if m, err = trainer.Model(0); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(m.POS(), []string{"n"}) {
+		t.Fatalf("got POS %v, a rejected sentence should not add any POS", m.POS())
 	}
 }
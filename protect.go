@@ -0,0 +1,53 @@
+package jieba
+
+import (
+	"regexp"
+
+	"github.com/fumiama/jieba/util"
+)
+
+// DefaultProtectedPatterns are the built-in protected patterns, which keep
+// URLs, emails, dates, versions and numbers as single words.
+var DefaultProtectedPatterns = []*regexp.Regexp{
+	util.URLPattern,
+	util.EmailPattern,
+	util.DatePattern,
+	util.VersionPattern,
+	util.NumberPattern,
+}
+
+/*
+SetProtectedPatterns sets the protected patterns of Segmenter. The text
+matched by any of them is found before cutting by dictionary, and is
+emitted as a single word in all modes except full mode. The former
+pattern wins if several patterns match at the same position.
+
+No pattern is protected by default, use DefaultProtectedPatterns for the
+built-in ones. It should not be called concurrently with cutting.
+*/
+func (seg *Segmenter) SetProtectedPatterns(patterns ...*regexp.Regexp) {
+	seg.patterns = append([]*regexp.Regexp(nil), patterns...)
+	seg.protected, _ = util.JoinPatterns(seg.patterns)
+}
+
+// ProtectedPatterns returns the protected patterns of Segmenter.
+func (seg *Segmenter) ProtectedPatterns() []*regexp.Regexp {
+	return seg.patterns
+}
+
+// isProtected reports whether the whole word is matched by a protected pattern.
+func (seg *Segmenter) isProtected(word string) bool {
+	if seg.protected == nil {
+		return false
+	}
+	loc := seg.protected.FindStringIndex(word)
+	return loc != nil && loc[0] == 0 && loc[1] == len(word)
+}
+
+// splitProtected calls fn with the parts of sentence in order, and
+// reports whether each part is matched by a protected pattern.
+func (seg *Segmenter) splitProtected(sentence string, fn func(part string, protected bool)) {
+	util.SplitProtected(seg.protected, nil, sentence, func(part string, pattern int) {
+		fn(part, pattern >= 0)
+	})
+}
@@ -0,0 +1,40 @@
+package jieba
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestProtectedPatterns(t *testing.T) {
+	seg := loadTestSegmenter(t, "访问 100\n版本 100\n邮件 100\n发布 100\n增长 100\n长了 10\n于 100\n")
+	sentence := "访问https://a.b/c?d=1，邮件foo@bar.com，v1.2.3版本于2024-05-01发布，增长了3.14%"
+	seg.SetProtectedPatterns(DefaultProtectedPatterns...)
+	expected := []string{"访问", "https://a.b/c?d=1", "，", "邮件", "foo@bar.com", "，", "v1.2.3", "版本", "于", "2024-05-01", "发布", "，", "增长", "了", "3.14%"}
+	if result := seg.Cut(sentence, false); strings.Join(result, "/") != strings.Join(expected, "/") {
+		t.Fatalf("got %v, expected %v", result, expected)
+	}
+	for _, word := range seg.CutForSearch(sentence, true) {
+		if word == "ht" || word == "ba" {
+			t.Fatalf("protected word should not be cut in search mode: %s", word)
+		}
+	}
+	tokens := seg.Tokenize(sentence, SearchMode, false)
+	for _, token := range tokens {
+		if sentence[token.Start():token.End()] != token.Text() {
+			t.Fatalf("got %s at [%d,%d)", token.Text(), token.Start(), token.End())
+		}
+	}
+	if candidates := seg.CutNBest(sentence, 1, false); strings.Join(candidates[0].Words(), "/") != strings.Join(expected, "/") {
+		t.Fatalf("got %v, expected %v", candidates[0].Words(), expected)
+	}
+
+	seg.SetProtectedPatterns(regexp.MustCompile(`[a-z]+\d+`))
+	if result := seg.Cut("访问abc123", false); len(result) != 2 || result[1] != "abc123" {
+		t.Fatalf("got %v, expected [访问 abc123]", result)
+	}
+	seg.SetProtectedPatterns()
+	if result := seg.Cut("v1.2.3", false); len(result) == 1 {
+		t.Fatalf("got %v, expected v1.2.3 to be cut without protected patterns", result)
+	}
+}
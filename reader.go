@@ -0,0 +1,247 @@
+package jieba
+
+import (
+	"io"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/fumiama/jieba/util"
+)
+
+const (
+	readerChunkSize = 4096
+	// readerMaxSize is the size of text held without a safe boundary,
+	// over which the text is cut at a forced boundary.
+	readerMaxSize = 16 * readerChunkSize
+)
+
+// WordScanner reads words incrementally from an io.Reader.
+// It is created by CutReader, and is not safe for concurrent use.
+type WordScanner struct {
+	seg     *Segmenter
+	r       io.Reader
+	hmm     bool
+	buf     []byte
+	scanned int
+	bounds  []int // the boundaries in buf[:scanned] not checked against protected patterns
+	checked int   // scanned at the last check of protected patterns
+	han     bool  // whether the last scanned rune matches the Han block pattern
+	safe    int
+	words   []string
+	word    string
+	err     error
+	eof     bool
+}
+
+// CutReader cuts text read from r into words using accurate mode.
+// Parameter hmm controls whether to use the Hidden Markov Model.
+//
+// The text is read incrementally and only cut at characters that Cut
+// never puts into a word, such as whitespaces and punctuations, so the
+// words are exactly the same as calling Cut on the whole text, while only
+// a small piece of text is held in memory. If the Segmenter has protected
+// patterns, the text is not cut inside a match of them or in the last 4KB
+// read, so a match longer than that may be cut apart.
+//
+// If there is no such character in 64KB of text, like a long text without
+// punctuations, the text is cut where no word of dictionary crosses, then
+// the words around the cut may differ from Cut if hmm is true.
+func (seg *Segmenter) CutReader(r io.Reader, hmm bool) *WordScanner {
+	return &WordScanner{
+		seg: seg,
+		r:   r,
+		hmm: hmm,
+		buf: make([]byte, 0, readerChunkSize),
+	}
+}
+
+// Scan advances the scanner to the next word, which will then be
+// available through the Text method. It returns false when there are
+// no more words, either by reaching the end of the input or an error.
+func (s *WordScanner) Scan() bool {
+	for len(s.words) == 0 {
+		if s.eof {
+			if len(s.buf) == 0 {
+				s.word = ""
+				return false
+			}
+			s.words = s.seg.Cut(string(s.buf), s.hmm)
+			s.buf = s.buf[:0]
+			continue
+		}
+		s.fill()
+		if s.safe > 0 {
+			s.words = s.seg.Cut(string(s.buf[:s.safe]), s.hmm)
+			n := copy(s.buf, s.buf[s.safe:])
+			s.buf = s.buf[:n]
+			for i := range s.bounds {
+				s.bounds[i] -= s.safe
+			}
+			s.scanned -= s.safe
+			s.checked -= s.safe
+			s.safe = 0
+		}
+	}
+	s.word = s.words[0]
+	s.words = s.words[1:]
+	return true
+}
+
+// Text returns the most recent word generated by a call to Scan.
+func (s *WordScanner) Text() string {
+	return s.word
+}
+
+// Err returns the first non-EOF error that was encountered by the scanner.
+func (s *WordScanner) Err() error {
+	return s.err
+}
+
+// fill reads one more chunk and finds the last safe boundary in buffer.
+func (s *WordScanner) fill() {
+	if len(s.buf)+readerChunkSize > cap(s.buf) {
+		buf := make([]byte, len(s.buf), 2*cap(s.buf)+readerChunkSize)
+		copy(buf, s.buf)
+		s.buf = buf
+	}
+	n, err := s.r.Read(s.buf[len(s.buf) : len(s.buf)+readerChunkSize])
+	s.buf = s.buf[:len(s.buf)+n]
+	if err != nil {
+		if err != io.EOF {
+			s.err = err
+		}
+		s.eof = true
+		return
+	}
+	for s.scanned < len(s.buf) && utf8.FullRune(s.buf[s.scanned:]) {
+		r, size := utf8.DecodeRune(s.buf[s.scanned:])
+		// a word never crosses the boundary between a block matching the
+		// Han pattern and a block not matching it, while the Skip pattern
+		// may match several runes in a block not matching it.
+		han := s.inBlock(r, s.buf[s.scanned:s.scanned+size])
+		if s.scanned > 0 && han != s.han {
+			s.bounds = append(s.bounds, s.scanned)
+		}
+		s.han = han
+		s.scanned += size
+	}
+	if s.seg.protected != nil {
+		// matching the whole buffer once per chunk read at most
+		if s.scanned-s.checked >= readerChunkSize {
+			s.checked = s.scanned
+			s.safe = s.protectedBoundary()
+		}
+	} else if len(s.bounds) > 0 {
+		s.safe = s.bounds[len(s.bounds)-1]
+		s.bounds = s.bounds[:0]
+	}
+	if s.safe == 0 && s.scanned >= readerMaxSize {
+		s.safe = s.forcedBoundary()
+	}
+}
+
+// inBlock reports whether rune r encoded as p matches the Han block
+// pattern, after normalization if it is enabled.
+func (s *WordScanner) inBlock(r rune, p []byte) bool {
+	if s.seg.normalize {
+		return s.seg.blocks.Han.MatchString(util.NormalizeRune(r))
+	}
+	return s.seg.blocks.Han.Match(p)
+}
+
+/*
+forcedBoundary removes all bounds and returns the last one, which is
+inside a match of the protected patterns. If there is none, the scanned
+text is in one block, and it returns a boundary in the first half of the
+text: the last one where no word of dictionary crosses for a block
+matching the Han pattern, or the last one not inside a match of the Skip
+pattern for the other blocks, or the middle rune if there is none.
+*/
+func (s *WordScanner) forcedBoundary() int {
+	if n := len(s.bounds); n > 0 {
+		bound := s.bounds[n-1]
+		s.bounds = s.bounds[:0]
+		return bound
+	}
+	text := string(s.buf[:s.scanned])
+	origin := []int(nil)
+	if s.seg.normalize {
+		text, origin = util.Normalize(text)
+	}
+	// whether the byte i of text starts a rune of the buffer
+	isBoundary := func(i int) bool {
+		return utf8.RuneStart(text[i]) && (origin == nil || origin[i] != origin[i-1])
+	}
+	bound := len(text) / 2
+	for bound > 0 && !isBoundary(bound) {
+		bound--
+	}
+	if s.han {
+		runes := []rune(text)
+		reach := 0 // the end of the words starting before a rune
+		pos := 0
+		for i, e := range s.seg.dictionary().DAG(runes) {
+			if pos > len(text)/2 {
+				break
+			}
+			if i > 0 && reach <= i && isBoundary(pos) {
+				bound = pos
+			}
+			if end := e[len(e)-1].Index + 1; end > reach {
+				reach = end
+			}
+			_, size := utf8.DecodeRuneInString(text[pos:])
+			pos += size
+		}
+	} else {
+		for _, m := range s.seg.blocks.Skip.FindAllStringIndex(text, -1) {
+			if m[0] >= bound {
+				break
+			}
+			if m[1] > bound && m[0] > 0 && isBoundary(m[0]) {
+				bound = m[0]
+			}
+		}
+	}
+	if origin != nil {
+		bound = origin[bound]
+	}
+	return bound
+}
+
+// protectedBoundary removes the bounds up to the last one which is not
+// inside a match of the protected patterns and at least readerChunkSize
+// bytes before the end of scanned text, and returns it, or 0 if there is
+// none. The text after that boundary may still change the matches.
+func (s *WordScanner) protectedBoundary() int {
+	n := sort.SearchInts(s.bounds, s.scanned-readerChunkSize+1)
+	if n == 0 {
+		return 0
+	}
+	text := string(s.buf[:s.scanned])
+	origin := []int(nil)
+	if s.seg.normalize {
+		text, origin = util.Normalize(text)
+	}
+	matches := s.seg.protected.FindAllStringIndex(text, -1)
+	if origin != nil {
+		for _, m := range matches {
+			m[0] = origin[m[0]]
+			for m[1] < len(origin)-1 && origin[m[1]] == origin[m[1]-1] {
+				m[1]++
+			}
+			m[1] = origin[m[1]]
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		bound := s.bounds[i]
+		// the first match ending after bound
+		j := sort.Search(len(matches), func(j int) bool { return matches[j][1] > bound })
+		if j < len(matches) && matches[j][0] < bound {
+			continue
+		}
+		s.bounds = s.bounds[:copy(s.bounds, s.bounds[i+1:])]
+		return bound
+	}
+	return 0
+}
@@ -0,0 +1,101 @@
+package jieba
+
+import (
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestCutReader(t *testing.T) {
+	seg := loadTestSegmenter(t, testDict)
+	text := strings.Repeat("永和服装饰品有限公司\r\n成立于2001年，主营服装、饰品。 Hello world!\n", 500)
+	for _, hmm := range []bool{true, false} {
+		expected := seg.Cut(text, hmm)
+		for _, r := range []io.Reader{
+			strings.NewReader(text),
+			iotest.OneByteReader(strings.NewReader(text)),
+			iotest.HalfReader(strings.NewReader(text)),
+		} {
+			s := seg.CutReader(r, hmm)
+			i := 0
+			for s.Scan() {
+				if i >= len(expected) || s.Text() != expected[i] {
+					t.Fatalf("word %d: got %q", i, s.Text())
+				}
+				i++
+			}
+			if s.Err() != nil {
+				t.Fatal(s.Err())
+			}
+			if i != len(expected) {
+				t.Fatalf("got %d words, expected %d", i, len(expected))
+			}
+		}
+	}
+	s := seg.CutReader(iotest.TimeoutReader(strings.NewReader(text)), true)
+	for s.Scan() {
+	}
+	if s.Err() != iotest.ErrTimeout {
+		t.Fatal(s.Err())
+	}
+}
+
+func TestCutReaderProtected(t *testing.T) {
+	seg := loadTestSegmenter(t, "访问 100\n版本 100\n发布 100\n手机 100\nabc123 100\n")
+	text := strings.Repeat("访问https://a.b/c?d=1，v1.2.3版本于2024-05-01发布。ＡＢＣ/１２３手机ＡＢＣ１２３\n", 300)
+	for _, c := range []struct {
+		protected, normalize bool
+	}{{true, false}, {false, true}, {true, true}} {
+		if c.protected {
+			seg.SetProtectedPatterns(DefaultProtectedPatterns...)
+		} else {
+			seg.SetProtectedPatterns()
+		}
+		seg.SetNormalization(c.normalize)
+		expected := seg.Cut(text, true)
+		for _, r := range []io.Reader{
+			strings.NewReader(text),
+			iotest.OneByteReader(strings.NewReader(text)),
+			iotest.HalfReader(strings.NewReader(text)),
+		} {
+			var result []string
+			s := seg.CutReader(r, true)
+			for s.Scan() {
+				result = append(result, s.Text())
+			}
+			if s.Err() != nil {
+				t.Fatal(s.Err())
+			}
+			if strings.Join(result, "/") != strings.Join(expected, "/") {
+				t.Fatalf("protected %v, normalize %v: got %d words, expected %d", c.protected, c.normalize, len(result), len(expected))
+			}
+		}
+	}
+}
+
+func TestCutReaderForced(t *testing.T) {
+	seg := loadTestSegmenter(t, testDict)
+	seg.SetBlockPatterns(BlockPatterns{Skip: regexp.MustCompile(`(\s+)`)})
+	for _, text := range []string{
+		strings.Repeat("永和服装饰品有限公司", 10000),
+		strings.Repeat("永和服装 \t \r\n饰品有限公司，\n\n", 5000),
+		strings.Repeat("，  ", 50000),
+	} {
+		for _, hmm := range []bool{true, false} {
+			expected := seg.Cut(text, hmm)
+			var result []string
+			s := seg.CutReader(iotest.HalfReader(strings.NewReader(text)), hmm)
+			for s.Scan() {
+				result = append(result, s.Text())
+				if cap(s.buf) > 2*readerMaxSize {
+					t.Fatalf("got a buffer of %d bytes", cap(s.buf))
+				}
+			}
+			if strings.Join(result, "/") != strings.Join(expected, "/") {
+				t.Fatalf("hmm %v: got %d words, expected %d", hmm, len(result), len(expected))
+			}
+		}
+	}
+}
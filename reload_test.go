@@ -0,0 +1,113 @@
+package jieba
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadUserDictionaryFailure(t *testing.T) {
+	seg := loadTestSegmenter(t, "有限 300\n公司 600\n有限公司 400\n")
+	before := seg.Dictionary()
+	if err := seg.LoadUserDictionary(strings.NewReader("永和 50\n服装 bad\n")); err == nil {
+		t.Fatal("expected error for invalid frequency")
+	}
+	if seg.Dictionary() != before {
+		t.Fatal("dictionary should not be replaced by a failed load")
+	}
+	if _, ok := seg.Frequency("永和"); ok {
+		t.Fatal("words before the error should not be loaded")
+	}
+	if err := seg.LoadUserDictionary(strings.NewReader("永和 50\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := before.Frequency("永和"); ok {
+		t.Fatal("previous snapshot should not be modified")
+	}
+	if freq, ok := seg.Frequency("永和"); !ok || freq != 50 {
+		t.Fatalf("got %f %v, expected 50 true", freq, ok)
+	}
+}
+
+func TestReload(t *testing.T) {
+	seg := loadTestSegmenter(t, "有限 300\n公司 600\n")
+	if result := seg.Cut("有限公司", false); len(result) != 2 {
+		t.Fatalf("got %v, expected 2 words", result)
+	}
+	err := seg.Reload(strings.NewReader("有限 300\n公司 600\n"), strings.NewReader("有限公司 400\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := seg.Cut("有限公司", false); len(result) != 1 {
+		t.Fatalf("got %v, expected 1 word", result)
+	}
+	if seg.Dictionary().Total() != 1300 {
+		t.Fatalf("got total %f, expected 1300", seg.Dictionary().Total())
+	}
+	before := seg.Dictionary()
+	if err = seg.Reload(strings.NewReader("")); err != ErrEmptyDictionary {
+		t.Fatalf("got %v, expected ErrEmptyDictionary", err)
+	}
+	if err = seg.Reload(strings.NewReader("有限 300\n"), strings.NewReader("公司 x\n")); err == nil {
+		t.Fatal("expected error for invalid user dictionary")
+	}
+	if err = seg.ReloadAt("not_exist.txt"); err == nil {
+		t.Fatal("expected error for missing file")
+	}
+	if seg.Dictionary() != before {
+		t.Fatal("dictionary should not be replaced by a failed reload")
+	}
+}
+
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	base, user := filepath.Join(dir, "dict.txt"), filepath.Join(dir, "user.txt")
+	if err := os.WriteFile(base, []byte("有限 300\n公司 600\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(user, []byte("永和 50\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	seg, err := LoadDictionaryAt(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = seg.LoadUserDictionaryAt(user); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errs := make(chan error, 16)
+	go seg.Watch(ctx, 10*time.Millisecond, func(err error) { errs <- err }, base, user)
+	time.Sleep(50 * time.Millisecond)
+
+	if err = os.WriteFile(user, []byte("永和 50\n有限公司 400\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(time.Second)
+	for {
+		if _, ok := seg.Frequency("有限公司"); ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("changed user dictionary should be reloaded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	before := seg.Dictionary()
+	if err = os.WriteFile(user, []byte("永和 x\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-errs:
+	case <-time.After(time.Second):
+		t.Fatal("expected reload error")
+	}
+	if seg.Dictionary() != before {
+		t.Fatal("dictionary should not be replaced by a failed reload")
+	}
+}
@@ -0,0 +1,169 @@
+package jieba
+
+import "unicode/utf8"
+
+// Mode is a cutting mode of Segmenter.
+type Mode uint8
+
+const (
+	// DefaultMode cuts sentence using accurate mode, see Cut.
+	DefaultMode Mode = iota
+	// SearchMode cuts sentence using search engine mode, see CutForSearch.
+	SearchMode
+	// FullMode cuts sentence using full mode, see CutAll.
+	FullMode
+)
+
+// Token represents a word with its location in the sentence.
+type Token struct {
+	text               string
+	start, end         int
+	runeStart, runeEnd int
+	position           int
+}
+
+// Text returns the token's text.
+func (t Token) Text() string {
+	return t.text
+}
+
+// Start returns the byte offset where the token begins.
+func (t Token) Start() int {
+	return t.start
+}
+
+// End returns the byte offset where the token ends.
+func (t Token) End() int {
+	return t.end
+}
+
+// RuneStart returns the rune offset where the token begins.
+func (t Token) RuneStart() int {
+	return t.runeStart
+}
+
+// RuneEnd returns the rune offset where the token ends.
+func (t Token) RuneEnd() int {
+	return t.runeEnd
+}
+
+// Position returns the 1-based position of the token in the token stream.
+// In search mode, the short words are numbered before the long word
+// containing them.
+func (t Token) Position() int {
+	return t.position
+}
+
+/*
+Tokenize cuts sentence into tokens with their offsets in the sentence.
+Parameter hmm controls whether to use the Hidden Markov Model.
+
+In DefaultMode, the tokens are exactly the words returned by Cut. In
+SearchMode, every 2-gram and 3-gram found in dictionary of a long word
+is returned before the word itself, just like CutForSearch, so the
+tokens may overlap. In FullMode, the tokens are the words returned by
+CutAll except the empty ones, which may overlap as well, and hmm is
+ignored.
+*/
+func (seg *Segmenter) Tokenize(sentence string, mode Mode, hmm bool) []Token {
+	if mode == FullMode {
+		return seg.tokenizeAll(sentence, nil)
+	}
+	d := seg.dictionary()
+	return seg.tokenize(d, seg.cut(nil, d, sentence, hmm, nil), mode, searchGrams, nil)
+}
+
+// tokenize returns the tokens of words cut from a sentence, the grams of
+// the given lengths are added in SearchMode. The words for which drop
+// returns true are skipped and take no position, and drop may be nil.
+func (seg *Segmenter) tokenize(d *Dictionary, words []string, mode Mode, grams []int, drop func(word string) bool) []Token {
+	tokens := make([]Token, 0, len(words))
+	offsets := make([]int, 0, 64)
+	start, runeStart, position := 0, 0, 1
+	for _, word := range words {
+		width := utf8.RuneCountInString(word)
+		if mode == SearchMode && width > 1 && !seg.isProtected(seg.fold(word)) {
+			offsets = offsets[:0]
+			for i := range word {
+				offsets = append(offsets, i)
+			}
+			offsets = append(offsets, len(word))
+			for _, step := range grams {
+				if width <= step {
+					continue
+				}
+				for i := 0; i < width-step+1; i++ {
+					gram := word[offsets[i]:offsets[i+step]]
+					if v, ok := d.Frequency(seg.fold(gram)); ok && v > 0.0 {
+						if drop != nil && drop(gram) {
+							continue
+						}
+						tokens = append(tokens, Token{
+							text:      gram,
+							start:     start + offsets[i],
+							end:       start + offsets[i+step],
+							runeStart: runeStart + i,
+							runeEnd:   runeStart + i + step,
+							position:  position,
+						})
+						position++
+					}
+				}
+			}
+		}
+		if drop == nil || !drop(word) {
+			tokens = append(tokens, Token{
+				text:      word,
+				start:     start,
+				end:       start + len(word),
+				runeStart: runeStart,
+				runeEnd:   runeStart + width,
+				position:  position,
+			})
+			position++
+		}
+		start += len(word)
+		runeStart += width
+	}
+	return tokens
+}
+
+// tokenizeAll returns the tokens of the non-empty words of CutAll. The
+// words for which drop returns true are skipped and take no position, and
+// drop may be nil.
+func (seg *Segmenter) tokenizeAll(sentence string, drop func(word string) bool) []Token {
+	tokens := make([]Token, 0, int(float32(len(sentence))/RatioLetterWordFull)+1)
+	start, runeStart := 0, 0
+	seg.cutAllRanges(sentence, func(i, j int) {
+		word := sentence[i:j]
+		if i == j || drop != nil && drop(word) {
+			return
+		}
+		runeStart += utf8.RuneCountInString(sentence[start:i])
+		start = i
+		tokens = append(tokens, Token{
+			text:      word,
+			start:     i,
+			end:       j,
+			runeStart: runeStart,
+			runeEnd:   runeStart + utf8.RuneCountInString(word),
+			position:  len(tokens) + 1,
+		})
+	})
+	return tokens
+}
+
+// Range is the byte range [Start, End) of a word in the sentence.
+type Range struct {
+	Start, End int
+}
+
+// CutRanges is like Cut, but appends the byte ranges of the words in
+// sentence to dst instead of the words, and returns the extended slice.
+// The ranges are adjacent and cover the whole sentence.
+func (seg *Segmenter) CutRanges(dst []Range, sentence string, hmm bool) []Range {
+	seg.cutRanges(seg.dictionary(), sentence, hmm, nil, func(start, end int) {
+		dst = append(dst, Range{Start: start, End: end})
+	})
+	return dst
+}
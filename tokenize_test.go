@@ -0,0 +1,106 @@
+package jieba
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	seg := loadTestSegmenter(t, testDict)
+	sentence := "永和服装饰品有限公司 in 2022"
+	runes := []rune(sentence)
+	for _, test := range []struct {
+		mode  Mode
+		words []string
+	}{
+		{DefaultMode, []string{"永和", "服装", "饰品", "有限公司", " ", "in", " ", "2022"}},
+		{SearchMode, []string{"永和", "服装", "饰品", "有限", "公司", "有限公司", " ", "in", " ", "2022"}},
+		{FullMode, []string{"永和", "服装", "饰品", "有限", "有限公司", "公司", "in", "2022"}},
+	} {
+		tokens := seg.Tokenize(sentence, test.mode, false)
+		if len(tokens) != len(test.words) {
+			t.Fatalf("mode %d: got %v", test.mode, tokens)
+		}
+		for i, token := range tokens {
+			if token.Text() != test.words[i] {
+				t.Fatalf("mode %d: got %s, expected %s", test.mode, token.Text(), test.words[i])
+			}
+			if sentence[token.Start():token.End()] != token.Text() {
+				t.Fatalf("mode %d: wrong byte offsets %d:%d for %s", test.mode, token.Start(), token.End(), token.Text())
+			}
+			if string(runes[token.RuneStart():token.RuneEnd()]) != token.Text() {
+				t.Fatalf("mode %d: wrong rune offsets %d:%d for %s", test.mode, token.RuneStart(), token.RuneEnd(), token.Text())
+			}
+			if token.Position() != i+1 {
+				t.Fatalf("mode %d: got position %d for %s, expected %d", test.mode, token.Position(), token.Text(), i+1)
+			}
+		}
+	}
+}
+
+func TestCutRanges(t *testing.T) {
+	seg := loadTestSegmenter(t, testDict)
+	sentence := "永和服装饰品有限公司 in 2022"
+	for _, hmm := range []bool{false, true} {
+		words := seg.Cut(sentence, hmm)
+		ranges := seg.CutRanges(nil, sentence, hmm)
+		if len(ranges) != len(words) {
+			t.Fatalf("got %v, expected the ranges of %v", ranges, words)
+		}
+		for i, r := range ranges {
+			if sentence[r.Start:r.End] != words[i] {
+				t.Fatalf("got %s at [%d,%d), expected %s", sentence[r.Start:r.End], r.Start, r.End, words[i])
+			}
+		}
+	}
+	// the words are not built, so the allocations do not grow with them
+	ranges := seg.CutRanges(nil, "永和服装饰品有限公司永和服装饰品有限公司", true)
+	once := testing.AllocsPerRun(100, func() { seg.CutRanges(ranges[:0], "永和服装饰品有限公司", true) })
+	twice := testing.AllocsPerRun(100, func() {
+		seg.CutRanges(ranges[:0], "永和服装饰品有限公司永和服装饰品有限公司", true)
+	})
+	if twice != once {
+		t.Fatalf("got %v allocations for twice the words, expected %v", twice, once)
+	}
+	seg.SetProtectedPatterns(DefaultProtectedPatterns...)
+	seg.SetNormalization(true)
+	sentence = "永和服装饰品有限公司，ＡＢＣ 5㎏ https://a.b/c?d=1\r\n鲸鲨鲟鳄"
+	for _, hmm := range []bool{false, true} {
+		end := 0
+		for _, r := range seg.CutRanges(nil, sentence, hmm) {
+			if r.Start != end || r.End <= r.Start {
+				t.Fatalf("got [%d,%d) after %d, expected adjacent ranges", r.Start, r.End, end)
+			}
+			end = r.End
+		}
+		if end != len(sentence) {
+			t.Fatalf("got ranges ending at %d, expected %d", end, len(sentence))
+		}
+	}
+	var words []string
+	for _, word := range seg.CutAll(sentence) {
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+	tokens := seg.Tokenize(sentence, FullMode, false)
+	if len(tokens) != len(words) {
+		t.Fatalf("got %v, expected the tokens of %v", tokens, words)
+	}
+	runes := []rune(sentence)
+	for i, token := range tokens {
+		if token.Text() != words[i] || sentence[token.Start():token.End()] != token.Text() ||
+			string(runes[token.RuneStart():token.RuneEnd()]) != token.Text() {
+			t.Fatalf("got %s at [%d,%d) runes [%d,%d), expected %s",
+				token.Text(), token.Start(), token.End(), token.RuneStart(), token.RuneEnd(), words[i])
+		}
+	}
+	seg.SetProtectedPatterns()
+	seg.SetNormalization(false)
+	sentence = "永和服装饰品有限公司 in 2022"
+	dst := []string{"开头"}
+	dst = seg.CutAppend(dst, sentence, false)
+	if expected := append([]string{"开头"}, seg.Cut(sentence, false)...); strings.Join(dst, "/") != strings.Join(expected, "/") {
+		t.Fatalf("got %v, expected %v", dst, expected)
+	}
+}
@@ -8,7 +8,8 @@ import (
 	"github.com/blevesearch/bleve/analysis"
 	"github.com/blevesearch/bleve/registry"
 	jieba "github.com/fumiama/jieba"
-	"github.com/fumiama/jieba/util/helper"
+	"github.com/fumiama/jieba/dict"
+	"github.com/fumiama/jieba/dictionary"
 )
 
 // Name is the jieba tokenizer name.
@@ -18,8 +19,7 @@ var ideographRegexp = regexp.MustCompile(`\p{Han}+`)
 
 // JiebaTokenizer is the beleve tokenizer for jieba.
 type JiebaTokenizer struct {
-	seg             *jieba.Segmenter
-	hmm, searchMode bool
+	cutter *jieba.Cutter
 }
 
 /*
@@ -43,12 +43,8 @@ Parameters:
 	this word into "交换", "换机", which are valid Chinese words.
 */
 func NewJiebaTokenizer(dictFile io.Reader, hmm, searchMode bool) (analysis.Tokenizer, error) {
-	seg, err := jieba.LoadDictionary(dictFile)
-	return &JiebaTokenizer{
-		seg:        seg,
-		hmm:        hmm,
-		searchMode: searchMode,
-	}, err
+	shared, err := dictionary.LoadShared(dictFile)
+	return NewJiebaTokenizerShared(shared, hmm, searchMode), err
 }
 
 /*
@@ -72,56 +68,51 @@ Parameters:
 	this word into "交换", "换机", which are valid Chinese words.
 */
 func NewJiebaTokenizerAt(dictFilePath string, hmm, searchMode bool) (analysis.Tokenizer, error) {
-	seg, err := jieba.LoadDictionaryAt(dictFilePath)
-	return &JiebaTokenizer{
-		seg:        seg,
-		hmm:        hmm,
-		searchMode: searchMode,
-	}, err
+	shared, err := dictionary.LoadSharedAt(dictFilePath)
+	return NewJiebaTokenizerShared(shared, hmm, searchMode), err
+}
+
+// NewJiebaTokenizerShared creates a new JiebaTokenizer using the shared
+// dictionary, see NewJiebaTokenizer for the meaning of hmm and searchMode.
+func NewJiebaTokenizerShared(shared *dictionary.Shared, hmm, searchMode bool) analysis.Tokenizer {
+	mode := jieba.DefaultMode
+	if searchMode {
+		mode = jieba.SearchMode
+	}
+	return NewJiebaTokenizerCutter(jieba.New(shared, jieba.WithHMM(hmm), jieba.WithMode(mode)))
+}
+
+// NewJiebaTokenizerCutter creates a new JiebaTokenizer using cutter, whose
+// options decide how input is tokenized.
+func NewJiebaTokenizerCutter(cutter *jieba.Cutter) analysis.Tokenizer {
+	return &JiebaTokenizer{cutter: cutter}
+}
+
+// SetProtectedPatterns sets the patterns whose matched text is kept as a
+// single token, see jieba.Segmenter.SetProtectedPatterns.
+func (jt *JiebaTokenizer) SetProtectedPatterns(patterns ...*regexp.Regexp) {
+	jt.cutter.Segmenter().SetProtectedPatterns(patterns...)
+}
+
+// SetNormalization enables or disables the normalization of full-width,
+// case and compatibility characters, see jieba.Segmenter.SetNormalization.
+func (jt *JiebaTokenizer) SetNormalization(enabled bool) {
+	jt.cutter.Segmenter().SetNormalization(enabled)
 }
 
 // Tokenize cuts input into bleve token stream.
 func (jt *JiebaTokenizer) Tokenize(input []byte) analysis.TokenStream {
-	rv := make(analysis.TokenStream, 0, 256)
-	start := 0
-	end := 0
-	pos := 1
-	for _, word := range jt.seg.Cut(string(input), jt.hmm) {
-		if jt.searchMode {
-			runes := []rune(word)
-			width := len(runes)
-			for _, step := range [2]int{2, 3} {
-				if width <= step {
-					continue
-				}
-				for i := 0; i < width-step+1; i++ {
-					gram := string(runes[i : i+step])
-					if frequency, ok := jt.seg.Frequency(gram); ok && frequency > 0 {
-						gramStart := start + len(string(runes[:i]))
-						token := analysis.Token{
-							Term:     helper.StringToBytes(gram),
-							Start:    gramStart,
-							End:      gramStart + len(gram),
-							Position: pos,
-							Type:     detectTokenType(gram),
-						}
-						rv = append(rv, &token)
-						pos++
-					}
-				}
-			}
-		}
-		end = start + len(word)
+	tokens := jt.cutter.Tokenize(string(input))
+	rv := make(analysis.TokenStream, 0, len(tokens))
+	for _, t := range tokens {
 		token := analysis.Token{
-			Term:     []byte(word),
-			Start:    start,
-			End:      end,
-			Position: pos,
-			Type:     detectTokenType(word),
+			Term:     []byte(t.Text()),
+			Start:    t.Start(),
+			End:      t.End(),
+			Position: t.Position(),
+			Type:     detectTokenType(t.Text()),
 		}
 		rv = append(rv, &token)
-		pos++
-		start = end
 	}
 	return rv
 }
@@ -129,13 +120,22 @@ func (jt *JiebaTokenizer) Tokenize(input []byte) analysis.TokenStream {
 /*
 JiebaTokenizerConstructor creates a JiebaTokenizer.
 
-Parameter config should contains at least one parameter:
+Parameter config may contain these parameters:
+
+	dictionary: optional, the *dictionary.Shared to use, file is ignored if given.
 
-	file: the path of the dictionary file or io.Reader.
+	file: optional, the path of the dictionary file or io.Reader,
+	the embedded default dictionary is used if not given.
 
 	hmm: optional, specify whether to use Hidden Markov Model, see NewJiebaTokenizer for details.
 
 	search: optional, speficy whether to use search mode, see NewJiebaTokenizer for details.
+
+	protected: optional, specify whether to keep URLs, emails, dates, versions
+	and numbers as single tokens, see jieba.DefaultProtectedPatterns.
+
+	normalize: optional, specify whether to normalize full-width, case and
+	compatibility characters before cutting, see jieba.Segmenter.SetNormalization.
 */
 func JiebaTokenizerConstructor(config map[string]interface{}, cache *registry.Cache) (analysis.Tokenizer, error) {
 	hmm, ok := config["hmm"].(bool)
@@ -146,12 +146,38 @@ func JiebaTokenizerConstructor(config map[string]interface{}, cache *registry.Ca
 	if !ok {
 		searchMode = true
 	}
+	tokenizer, err := newJiebaTokenizerFromConfig(config, hmm, searchMode)
+	if err != nil {
+		return nil, err
+	}
+	if protected, _ := config["protected"].(bool); protected {
+		tokenizer.(*JiebaTokenizer).SetProtectedPatterns(jieba.DefaultProtectedPatterns...)
+	}
+	if normalize, _ := config["normalize"].(bool); normalize {
+		tokenizer.(*JiebaTokenizer).SetNormalization(true)
+	}
+	return tokenizer, nil
+}
+
+func newJiebaTokenizerFromConfig(config map[string]interface{}, hmm, searchMode bool) (analysis.Tokenizer, error) {
+	shared, ok := config["dictionary"].(*dictionary.Shared)
+	if ok {
+		return NewJiebaTokenizerShared(shared, hmm, searchMode), nil
+	}
 	dictFilePath, ok := config["file"].(string)
 	if ok {
 		return NewJiebaTokenizerAt(dictFilePath, hmm, searchMode)
 	}
-	dictFile := config["file"].(io.Reader)
-	return NewJiebaTokenizer(dictFile, hmm, searchMode)
+	dictFile, ok := config["file"].(io.Reader)
+	if ok {
+		return NewJiebaTokenizer(dictFile, hmm, searchMode)
+	}
+	file, err := dict.Open(dict.Default)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return NewJiebaTokenizer(file, hmm, searchMode)
 }
 
 func detectTokenType(term string) analysis.TokenType {
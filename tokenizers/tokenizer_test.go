@@ -2,9 +2,11 @@ package tokenizers
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/blevesearch/bleve/analysis"
+	"github.com/fumiama/jieba/dictionary"
 )
 
 func TestJiebaTokenizerDefaultModeWithHMM(t *testing.T) {
@@ -22514,3 +22516,45 @@ func TestJiebaTokenizerSearchModeWithoutHMM(t *testing.T) {
 		}
 	}
 }
+
+func TestJiebaTokenizerProtected(t *testing.T) {
+	shared, err := dictionary.LoadShared(strings.NewReader("访问 100\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenizer, err := JiebaTokenizerConstructor(map[string]interface{}{
+		"dictionary": shared,
+		"protected":  true,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := analysis.TokenStream{
+		{Start: 0, End: 6, Term: []byte("访问"), Position: 1, Type: analysis.Ideographic},
+		{Start: 6, End: 23, Term: []byte("https://a.b/c?d=1"), Position: 2, Type: analysis.AlphaNumeric},
+	}
+	if result := tokenizer.Tokenize([]byte("访问https://a.b/c?d=1")); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("got %v, expected %v", result, expected)
+	}
+}
+
+func TestJiebaTokenizerNormalize(t *testing.T) {
+	shared, err := dictionary.LoadShared(strings.NewReader("iphone 100\n手机 100\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenizer, err := JiebaTokenizerConstructor(map[string]interface{}{
+		"dictionary": shared,
+		"normalize":  true,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := analysis.TokenStream{
+		{Start: 0, End: 18, Term: []byte("ｉＰｈｏｎｅ"), Position: 1, Type: analysis.AlphaNumeric},
+		{Start: 18, End: 24, Term: []byte("手机"), Position: 2, Type: analysis.Ideographic},
+	}
+	if result := tokenizer.Tokenize([]byte("ｉＰｈｏｎｅ手机")); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("got %v, expected %v", result, expected)
+	}
+}
@@ -0,0 +1,110 @@
+package util
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+// CompactWriter writes the compact binary formats of models, whose
+// integers are uvarints and floats are little endian float64.
+type CompactWriter struct {
+	*bufio.Writer
+	buf [binary.MaxVarintLen64]byte
+}
+
+// NewCompactWriter creates a CompactWriter buffering the writes to w,
+// which should be flushed at the end.
+func NewCompactWriter(w io.Writer) *CompactWriter {
+	return &CompactWriter{Writer: bufio.NewWriter(w)}
+}
+
+// Uvarint writes x as an uvarint.
+func (w *CompactWriter) Uvarint(x uint64) {
+	w.Write(w.buf[:binary.PutUvarint(w.buf[:], x)])
+}
+
+// Float writes f as a little endian float64.
+func (w *CompactWriter) Float(f float64) {
+	binary.LittleEndian.PutUint64(w.buf[:8], math.Float64bits(f))
+	w.Write(w.buf[:8])
+}
+
+/*
+CompactReader decodes the data written by CompactWriter. It keeps the
+first error, which is set by Fail if the data is corrupted, and all the
+reads after that return zero values, so the error needs to be checked
+only once at the end.
+*/
+type CompactReader struct {
+	data      []byte
+	corrupted error
+	err       error
+}
+
+// NewCompactReader creates a CompactReader of data, which fails with
+// corrupted if the data is corrupted.
+func NewCompactReader(data []byte, corrupted error) *CompactReader {
+	return &CompactReader{data: data, corrupted: corrupted}
+}
+
+// Fail marks the data as corrupted.
+func (r *CompactReader) Fail() {
+	if r.err == nil {
+		r.err = r.corrupted
+	}
+	r.data = nil
+}
+
+// End returns the first error, or fails if there are bytes left unread.
+func (r *CompactReader) End() error {
+	if r.err == nil && len(r.data) != 0 {
+		r.Fail()
+	}
+	return r.err
+}
+
+// Uvarint reads an uvarint.
+func (r *CompactReader) Uvarint() uint64 {
+	x, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		r.Fail()
+		return 0
+	}
+	r.data = r.data[n:]
+	return x
+}
+
+// Count reads an uvarint which is the count of the following items, each
+// of which has at least size bytes.
+func (r *CompactReader) Count(size int) int {
+	n := r.Uvarint()
+	if n > uint64(len(r.data)/size) {
+		r.Fail()
+		return 0
+	}
+	return int(n)
+}
+
+// Float reads a little endian float64.
+func (r *CompactReader) Float() float64 {
+	if len(r.data) < 8 {
+		r.Fail()
+		return 0
+	}
+	f := math.Float64frombits(binary.LittleEndian.Uint64(r.data))
+	r.data = r.data[8:]
+	return f
+}
+
+// Bytes reads n bytes, which are a part of data.
+func (r *CompactReader) Bytes(n int) []byte {
+	if len(r.data) < n {
+		r.Fail()
+		return nil
+	}
+	p := r.data[:n]
+	r.data = r.data[n:]
+	return p
+}
@@ -0,0 +1,49 @@
+package util
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"unicode"
+)
+
+// ReadFile opens the named file and returns the result of read on it,
+// the file is closed after read returns.
+func ReadFile[T any](file string, read func(r io.Reader) (T, error)) (T, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	defer f.Close()
+	return read(f)
+}
+
+// WriteFile creates the named file and calls write on it, the error of
+// closing the file is returned if write succeeds.
+func WriteFile(file string, write func(w io.Writer) error) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	err = write(f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// LineError wraps err with the number and text of the line in wrong format.
+func LineError(err error, n int, line string) error {
+	return fmt.Errorf("%w at line %d: %q", err, n, line)
+}
+
+// IsHan reports whether every rune of s is a Chinese character.
+func IsHan(s string) bool {
+	for _, r := range s {
+		if !unicode.Is(unicode.Han, r) {
+			return false
+		}
+	}
+	return true
+}
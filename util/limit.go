@@ -0,0 +1,105 @@
+package util
+
+import (
+	"context"
+	"fmt"
+)
+
+// Limits are the limits of one cut, a zero field means no limit.
+type Limits struct {
+	// MaxInputLength is the maximum length of the sentence in bytes.
+	MaxInputLength int
+	// MaxHMMRun is the maximum number of consecutive runes cut by the
+	// Hidden Markov Model at once.
+	MaxHMMRun int
+}
+
+// LimitError is returned when a cut exceeds one of its Limits.
+type LimitError struct {
+	Limit  string // the name of the exceeded field of Limits
+	Length int    // the length that exceeds the limit
+	Max    int    // the value of the limit
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("jieba: %s %d exceeds %d", e.Limit, e.Length, e.Max)
+}
+
+/*
+Limiter checks the cancellation and limits of one cut, and keeps the
+first error, after which the cut should stop as soon as possible.
+
+A nil Limiter never stops the cut.
+*/
+type Limiter struct {
+	ctx    context.Context
+	limits Limits
+	err    error
+}
+
+// NewLimiter creates a Limiter of ctx with limits.
+func NewLimiter(ctx context.Context, limits Limits) *Limiter {
+	return &Limiter{ctx: ctx, limits: limits}
+}
+
+// Limit runs cut of an input of n bytes with a Limiter of ctx and limits,
+// and returns the result of cut, or the zero value and the first error
+// recorded by the Limiter.
+func Limit[T any](ctx context.Context, limits Limits, n int, cut func(l *Limiter) T) (T, error) {
+	var zero T
+	l := NewLimiter(ctx, limits)
+	if !l.Input(n) || l.Done() {
+		return zero, l.Err()
+	}
+	result := cut(l)
+	if err := l.Err(); err != nil {
+		return zero, err
+	}
+	return result, nil
+}
+
+// Done reports whether the cut should stop, either because the context
+// is done or a limit has been exceeded.
+func (l *Limiter) Done() bool {
+	if l == nil {
+		return false
+	}
+	if l.err == nil {
+		l.err = l.ctx.Err()
+	}
+	return l.err != nil
+}
+
+// Input reports whether an input of n bytes is allowed, and records a
+// LimitError if not.
+func (l *Limiter) Input(n int) bool {
+	if l == nil || l.err != nil {
+		return l == nil
+	}
+	if max := l.limits.MaxInputLength; max > 0 && n > max {
+		l.err = &LimitError{Limit: "MaxInputLength", Length: n, Max: max}
+		return false
+	}
+	return true
+}
+
+// Run reports whether n runes are allowed to be cut by the Hidden Markov
+// Model at once, and records a LimitError if not.
+func (l *Limiter) Run(n int) bool {
+	if l == nil || l.err != nil {
+		return l == nil
+	}
+	if max := l.limits.MaxHMMRun; max > 0 && n > max {
+		l.err = &LimitError{Limit: "MaxHMMRun", Length: n, Max: max}
+		return false
+	}
+	return true
+}
+
+// Err returns the first error recorded by l.
+func (l *Limiter) Err() error {
+	if l == nil {
+		return nil
+	}
+	return l.err
+}
@@ -0,0 +1,60 @@
+package util
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+/*
+NormalizeRune returns the normalized form of r: r is normalized by NFKC,
+which folds full-width and half-width forms, ligatures, circled numbers,
+CJK compatibility ideographs and so on to their common forms, and the
+Latin letters of the result are lowercased.
+
+Every rune is normalized alone, so a combining mark is not composed with
+the rune before it, which keeps every byte of the result produced by one
+rune.
+*/
+func NormalizeRune(r rune) string {
+	var buf [utf8.UTFMax]byte
+	b := buf[:utf8.EncodeRune(buf[:], r)]
+	if !norm.NFKC.IsNormal(b) {
+		b = norm.NFKC.Bytes(b)
+	}
+	return strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Latin, r) {
+			return unicode.ToLower(r)
+		}
+		return r
+	}, string(b))
+}
+
+/*
+Normalize normalizes every rune of s by NormalizeRune, and returns the
+normalized text with the mapping back to s. The i-th byte of the result
+is produced by the rune of s starting at origin[i], and the last element
+of origin is len(s). A rune may be normalized into several runes, so the
+consecutive bytes with the same origin come from one rune of s.
+*/
+func Normalize(s string) (normalized string, origin []int) {
+	var sb strings.Builder
+	sb.Grow(len(s))
+	origin = make([]int, 0, len(s)+1)
+	for i, r := range s {
+		if r < utf8.RuneSelf && (r < 'A' || r > 'Z') {
+			sb.WriteByte(byte(r))
+			origin = append(origin, i)
+			continue
+		}
+		n := NormalizeRune(r)
+		sb.WriteString(n)
+		for j := 0; j < len(n); j++ {
+			origin = append(origin, i)
+		}
+	}
+	origin = append(origin, len(s))
+	return sb.String(), origin
+}
@@ -0,0 +1,45 @@
+package util
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+/*
+Parallel calls fn for every index in [0, n) using at most workers
+goroutines, runtime.NumCPU() is used if workers is not positive.
+
+It stops handing out new indexes once ctx is done, and returns ctx.Err()
+if not all indexes have been processed.
+*/
+func Parallel(ctx context.Context, n, workers int, fn func(i int)) error {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > n {
+		workers = n
+	}
+	var next, done int64 = -1, 0
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= n {
+					return
+				}
+				fn(i)
+				atomic.AddInt64(&done, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	if int(done) < n {
+		return ctx.Err()
+	}
+	return nil
+}
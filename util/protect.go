@@ -0,0 +1,94 @@
+package util
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	// urlChars are the chars in a URL, except the parentheses.
+	urlChars = `\w\-.~:/?#\[\]@!$&'*+,;=%`
+	// urlParen is a pair of parentheses in a URL.
+	urlParen = `\([` + urlChars + `]*\)`
+)
+
+// Built-in protected patterns, which match the text that should be kept
+// as a single word instead of being cut.
+var (
+	// URLPattern matches URLs with a scheme, such as https://a.b/c?d=1. The
+	// punctuation ending a URL is left out, and so is ")" unless it closes
+	// a "(" in the URL.
+	URLPattern = regexp.MustCompile(`[a-zA-Z][a-zA-Z0-9+.\-]*://` +
+		`(?:[` + urlChars + `]|` + urlParen + `)*(?:[\w\-~/#\[\]@$&*+=%]|` + urlParen + `)`)
+	// EmailPattern matches email addresses, such as foo@bar.com.
+	EmailPattern = regexp.MustCompile(`[\w.+\-]+@[\w\-]+(?:\.[\w\-]+)+`)
+	// DatePattern matches dates, such as 2024-05-01 and 2024/5/1.
+	DatePattern = regexp.MustCompile(`\b\d{4}[\-/]\d{1,2}[\-/]\d{1,2}\b`)
+	// VersionPattern matches versions, such as v1.2.3, 1.2.3 and v2.
+	VersionPattern = regexp.MustCompile(`\b[vV]?\d+(?:\.\d+){2,}\b|\b[vV]\d+(?:\.\d+)*\b`)
+	// NumberPattern matches numbers and percentages, such as 1,024, 3.14 and
+	// 3.14%, which are not a part of a word like abc123 or 123abc.
+	NumberPattern = regexp.MustCompile(`\b(?:\d{1,3}(?:,\d{3})+|\d+)(?:\.\d+)?(?:%|\b)`)
+)
+
+// JoinPatterns compiles patterns into one expression matching any of
+// them, the former pattern wins if several patterns match at the same
+// position. The i-th pattern is wrapped in a capturing group, whose index
+// is the i-th element of groups. It returns nil if patterns is empty.
+func JoinPatterns(patterns []*regexp.Regexp) (re *regexp.Regexp, groups []int) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+	var sb strings.Builder
+	groups = make([]int, len(patterns))
+	group := 1
+	for i, p := range patterns {
+		if i > 0 {
+			sb.WriteByte('|')
+		}
+		sb.WriteByte('(')
+		sb.WriteString(p.String())
+		sb.WriteByte(')')
+		groups[i] = group
+		group += p.NumSubexp() + 1
+	}
+	return regexp.MustCompile(sb.String()), groups
+}
+
+/*
+SplitProtected calls fn with the parts of s in order, which are split by
+the matches of re joined by JoinPatterns, and reports the index of the
+pattern matching each part, or -1 for a part not matched. If groups is
+nil, the patterns are not told apart and every match reports 0.
+*/
+func SplitProtected(re *regexp.Regexp, groups []int, s string, fn func(part string, pattern int)) {
+	start := 0
+	if re != nil {
+		var locs [][]int
+		if groups == nil {
+			locs = re.FindAllStringIndex(s, -1)
+		} else {
+			locs = re.FindAllStringSubmatchIndex(s, -1)
+		}
+		for _, loc := range locs {
+			if loc[0] == loc[1] {
+				continue
+			}
+			if start < loc[0] {
+				fn(s[start:loc[0]], -1)
+			}
+			pattern := 0
+			for i, group := range groups {
+				if loc[2*group] >= 0 {
+					pattern = i
+					break
+				}
+			}
+			fn(s[loc[0]:loc[1]], pattern)
+			start = loc[1]
+		}
+	}
+	if start < len(s) {
+		fn(s[start:], -1)
+	}
+}
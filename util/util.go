@@ -3,6 +3,11 @@ package util
 
 import "regexp"
 
+// ExtendedHanPattern matches the blocks of ExtendedBlockPatterns of jieba
+// and posseg, which also let spaces, hyphens, "%" and full-width letters,
+// digits, "+" and "-" reach dictionary.
+var ExtendedHanPattern = regexp.MustCompile(`([\p{Han}+[:alnum:]+#&\._% \-\x{FF0B}\x{FF0D}\x{FF10}-\x{FF19}\x{FF21}-\x{FF3A}\x{FF41}-\x{FF5A}]+)`)
+
 /*
 RegexpSplit split slices s into substrings separated by the expression and
 returns a slice of the substrings between those expression matches.
@@ -1,8 +1,15 @@
 package util
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestRegexpSplit(t *testing.T) {
@@ -22,3 +29,129 @@ func TestRegexpSplit(t *testing.T) {
 		t.Fatal(result)
 	}
 }
+
+func TestParallel(t *testing.T) {
+	counts := make([]int, 1000)
+	if err := Parallel(context.Background(), len(counts), 8, func(i int) {
+		counts[i]++
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for i, c := range counts {
+		if c != 1 {
+			t.Fatalf("index %d called %d times", i, c)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	n := 0
+	err := Parallel(ctx, len(counts), 1, func(i int) {
+		n++
+		if n == 10 {
+			cancel()
+		}
+	})
+	if err != context.Canceled || n != 10 {
+		t.Fatal(err, n)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "dict.txt")
+	if err := os.WriteFile(file, []byte("a 1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	reloaded := make(chan struct{}, 16)
+	done := make(chan error)
+	go func() {
+		done <- Watch(ctx, 10*time.Millisecond, []string{file}, func() error {
+			reloaded <- struct{}{}
+			return os.ErrInvalid
+		}, func(err error) {
+			if err != os.ErrInvalid {
+				t.Error(err)
+			}
+		})
+	}()
+	select {
+	case <-reloaded:
+		t.Fatal("unchanged file should not be reloaded")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if err := os.WriteFile(file, []byte("a 1\nb 2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-reloaded:
+	case <-time.After(time.Second):
+		t.Fatal("changed file should be reloaded")
+	}
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Fatal(err)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	normalized, origin := Normalize("ＡＢＣ１２３Abc㎏")
+	if normalized != "abc123abckg" {
+		t.Fatalf("got %s, expected abc123abckg", normalized)
+	}
+	expected := []int{0, 3, 6, 9, 12, 15, 18, 19, 20, 21, 21, 24}
+	if !reflect.DeepEqual(origin, expected) {
+		t.Fatalf("got %v, expected %v", origin, expected)
+	}
+	for r, expected := range map[rune]string{
+		'⑳': "20", '㉑': "21", 'ﬁ': "fi", 'ｶ': "カ", '豈': "豈", '\u3000': " ", '℃': "°c", '中': "中",
+	} {
+		if n := NormalizeRune(r); n != expected {
+			t.Fatalf("got %q for %q, expected %q", n, r, expected)
+		}
+	}
+}
+
+func TestPatterns(t *testing.T) {
+	for _, c := range []struct {
+		pattern  *regexp.Regexp
+		text     string
+		expected []string
+	}{
+		{URLPattern, "访问https://a.b/c?d=1，", []string{"https://a.b/c?d=1"}},
+		{URLPattern, "见https://a.b/c.", []string{"https://a.b/c"}},
+		{URLPattern, "(见https://a.b/c?d=1)", []string{"https://a.b/c?d=1"}},
+		{URLPattern, "见https://a.b/c),", []string{"https://a.b/c"}},
+		{URLPattern, "见https://a.b/c!?", []string{"https://a.b/c"}},
+		{URLPattern, "(https://a.b/wiki/Go_(language))", []string{"https://a.b/wiki/Go_(language)"}},
+		{URLPattern, "见https://", nil},
+		{NumberPattern, "共1,024个，占3.14%", []string{"1,024", "3.14%"}},
+		{NumberPattern, "第12,345,678名", []string{"12,345,678"}},
+		{NumberPattern, "1234,567", []string{"1234", "567"}},
+		{NumberPattern, "abc123和123abc", nil},
+		{NumberPattern, "x86_64", nil},
+		{NumberPattern, "3.", []string{"3"}},
+	} {
+		if matched := c.pattern.FindAllString(c.text, -1); !reflect.DeepEqual(matched, c.expected) {
+			t.Fatalf("%s got %q in %s, expected %q", c.pattern, matched, c.text, c.expected)
+		}
+	}
+}
+
+func TestSplitProtected(t *testing.T) {
+	re, groups := JoinPatterns([]*regexp.Regexp{DatePattern, NumberPattern})
+	for _, c := range []struct {
+		groups   []int
+		expected string
+	}{
+		{groups, "于:-1/2024-05-01:0/共:-1/12:1/个:-1"},
+		{nil, "于:-1/2024-05-01:0/共:-1/12:0/个:-1"},
+	} {
+		var parts []string
+		SplitProtected(re, c.groups, "于2024-05-01共12个", func(part string, pattern int) {
+			parts = append(parts, fmt.Sprintf("%s:%d", part, pattern))
+		})
+		if result := strings.Join(parts, "/"); result != c.expected {
+			t.Fatalf("got %s, expected %s", result, c.expected)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package util
+
+import (
+	"context"
+	"os"
+	"time"
+)
+
+type fileState struct {
+	exist   bool
+	size    int64
+	modTime time.Time
+}
+
+func statFile(name string) fileState {
+	info, err := os.Stat(name)
+	if err != nil {
+		return fileState{}
+	}
+	return fileState{exist: true, size: info.Size(), modTime: info.ModTime()}
+}
+
+func (s fileState) equal(o fileState) bool {
+	return s.exist == o.exist && s.size == o.size && s.modTime.Equal(o.modTime)
+}
+
+/*
+Watch polls files every interval, and calls reload once if any of them
+has been modified, created or removed since the last poll. The error
+returned by reload is reported to onError, which may be nil.
+
+It blocks until ctx is done and returns ctx.Err().
+*/
+func Watch(ctx context.Context, interval time.Duration, files []string, reload func() error, onError func(error)) error {
+	states := make([]fileState, len(files))
+	for i, file := range files {
+		states[i] = statFile(file)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		changed := false
+		for i, file := range files {
+			if state := statFile(file); !state.equal(states[i]) {
+				states[i] = state
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		if err := reload(); err != nil && onError != nil {
+			onError(err)
+		}
+	}
+}